@@ -33,5 +33,6 @@ func initLogging() {
 	}
 
 	cfg := logging.LoadConfig(logPath)
+	cfg.RedactPatterns = config.GetLogRedactPatterns()
 	logging.Init(cfg)
 }
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var bookmarksCmd = &cobra.Command{
+	Use:   "bookmarks",
+	Short: "List bookmarked posts",
+	Long: `List the posts you bookmarked in the interactive feed, in the order they
+were saved.
+
+Press b in smoke feed to bookmark the selected post and B to show only
+bookmarked posts. Bookmarks are stored in ~/.config/smoke/bookmarks.json;
+bookmarks of deleted posts are removed automatically.
+
+Examples:
+  smoke bookmarks`,
+	Args: cobra.NoArgs,
+	RunE: runBookmarks,
+}
+
+func init() {
+	rootCmd.AddCommand(bookmarksCmd)
+}
+
+func runBookmarks(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("bookmarks", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	ids, err := feed.ActiveBookmarks(posts, true)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	tracker.AddMetric(slog.Int("bookmarks", len(ids)))
+
+	if len(ids) == 0 {
+		fmt.Fprintln(os.Stderr, "No bookmarks yet. Press b in smoke feed to bookmark a post.")
+		tracker.Complete()
+		return nil
+	}
+
+	byID := make(map[string]*feed.Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+	opts := feed.FormatOptions{Oneline: true}
+	for _, id := range ids {
+		feed.FormatPost(os.Stdout, byID[id], opts)
+	}
+
+	tracker.Complete()
+	return nil
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func TestRunBookmarks(t *testing.T) {
+	seedSearchFeed(t)
+
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.SaveBookmarks([]string{posts[1].ID, "smk-gone00"}); err != nil {
+		t.Fatal(err)
+	}
+
+	output := captureStdout(t, func() {
+		if err := runBookmarks(nil, []string{}); err != nil {
+			t.Fatalf("runBookmarks error: %v", err)
+		}
+	})
+	if !strings.Contains(output, posts[1].ID) || !strings.Contains(output, "lunch time") {
+		t.Errorf("expected bookmarked post, got: %s", output)
+	}
+	if strings.Contains(output, "retry bug") {
+		t.Errorf("expected only bookmarked posts, got: %s", output)
+	}
+
+	saved, err := config.LoadBookmarks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 1 || saved[0] != posts[1].ID {
+		t.Errorf("expected missing post pruned from bookmarks, got %v", saved)
+	}
+}
+
+func TestRunBookmarks_Empty(t *testing.T) {
+	seedSearchFeed(t)
+
+	output := captureStdout(t, func() {
+		if err := runBookmarks(nil, []string{}); err != nil {
+			t.Fatalf("runBookmarks error: %v", err)
+		}
+	})
+	if output != "" {
+		t.Errorf("expected no output without bookmarks, got: %s", output)
+	}
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	cardFormat string
+	cardOutput string
+)
+
+var cardCmd = &cobra.Command{
+	Use:   "card <post-id>",
+	Short: "Save a post as a share card image",
+	Long: `Render a post as a PNG share card and save it to a file.
+
+This is the same image the TUI copy menu puts on the clipboard, for
+machines without one. Cards use your TUI theme colors. Without -o the card
+is saved to ~/smoke-cards/<post-id>.png.
+
+Examples:
+  smoke card smk-abc123                          # Square card in ~/smoke-cards
+  smoke card smk-abc123 --format landscape -o og.png`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCard,
+}
+
+func init() {
+	cardCmd.Flags().StringVar(&cardFormat, "format", feed.SquareImage.Name,
+		"Image size ("+strings.Join(feed.ImageFormats, "|")+")")
+	cardCmd.Flags().StringVarP(&cardOutput, "output", "o", "", "Write to this file instead of ~/smoke-cards/<post-id>.png")
+	rootCmd.AddCommand(cardCmd)
+}
+
+func runCard(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("card", args)
+	path, err := saveCard(args[0])
+	if err == nil {
+		fmt.Printf("Saved card to: %s\n", path)
+	}
+	return finishTracked(tracker, err)
+}
+
+// saveCard renders the post with the given ID and returns where it was written.
+func saveCard(postID string) (string, error) {
+	dims, ok := feed.ImageDimensionsByName(cardFormat)
+	if !ok {
+		return "", fmt.Errorf("unknown card format %q (valid: %s)", cardFormat, strings.Join(feed.ImageFormats, ", "))
+	}
+	if !feed.ValidateID(postID) {
+		return "", fmt.Errorf("invalid post ID format: %s", postID)
+	}
+	if err := config.EnsureInitialized(); err != nil {
+		return "", err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return "", err
+	}
+	post, err := feed.NewStoreWithPath(feedPath).FindByID(postID)
+	if errors.Is(err, feed.ErrPostNotFound) {
+		return "", fmt.Errorf("post %s not found", postID)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	path := cardOutput
+	if path == "" {
+		if path, err = config.GetCardPath(post.ID); err != nil {
+			return "", err
+		}
+	}
+	registerCustomThemes()
+	cfg := config.LoadTUIConfig()
+	feed.ApplyAppearance(cfg.Appearance)
+	theme := feed.GetTheme(cfg.Theme)
+	if err := feed.WriteShareCard(path, post, theme, dims); err != nil {
+		return "", err
+	}
+	return path, nil
+}
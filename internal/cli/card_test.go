@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"bytes"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func resetCardFlags(t *testing.T) {
+	t.Helper()
+	prevFormat, prevOutput := cardFormat, cardOutput
+	t.Cleanup(func() {
+		cardFormat, cardOutput = prevFormat, prevOutput
+	})
+	cardFormat, cardOutput = "square", ""
+}
+
+func decodeCard(t *testing.T, path string) (int, int) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("%s is not a valid PNG: %v", path, err)
+	}
+	return img.Bounds().Dx(), img.Bounds().Dy()
+}
+
+func TestRunCard_DefaultPath(t *testing.T) {
+	_, parent, _ := seedThread(t)
+	resetCardFlags(t)
+
+	output := captureStdout(t, func() {
+		if err := runCard(nil, []string{parent.ID}); err != nil {
+			t.Fatalf("runCard error: %v", err)
+		}
+	})
+	want := filepath.Join(os.Getenv("HOME"), "smoke-cards", parent.ID+".png")
+	if !strings.Contains(output, want) {
+		t.Errorf("output %q should name %s", output, want)
+	}
+	if w, h := decodeCard(t, want); w != feed.SquareImage.Width || h != feed.SquareImage.Height {
+		t.Errorf("card is %dx%d, want square", w, h)
+	}
+}
+
+func TestRunCard_LandscapeToFile(t *testing.T) {
+	_, _, reply := seedThread(t)
+	resetCardFlags(t)
+	cardFormat = "landscape"
+	cardOutput = filepath.Join(t.TempDir(), "out.png")
+
+	captureStdout(t, func() {
+		if err := runCard(nil, []string{reply.ID}); err != nil {
+			t.Fatalf("runCard error: %v", err)
+		}
+	})
+	if w, h := decodeCard(t, cardOutput); w != feed.LandscapeImage.Width || h != feed.LandscapeImage.Height {
+		t.Errorf("card is %dx%d, want landscape", w, h)
+	}
+}
+
+func TestRunCard_Errors(t *testing.T) {
+	seedThread(t)
+	resetCardFlags(t)
+	cardOutput = filepath.Join(t.TempDir(), "out.png")
+
+	if err := runCard(nil, []string{"smk-zzz999"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if err := runCard(nil, []string{"nope"}); err == nil {
+		t.Error("expected error for invalid ID")
+	}
+	cardFormat = "poster"
+	if err := runCard(nil, []string{"smk-zzz999"}); err == nil || !strings.Contains(err.Error(), "unknown card format") {
+		t.Errorf("expected format error, got %v", err)
+	}
+	if _, err := os.Stat(cardOutput); !os.IsNotExist(err) {
+		t.Error("no file should be written on error")
+	}
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	compactDryRun bool
+	compactKeep   int
+)
+
+var compactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Rewrite the feed without records it no longer needs",
+	Long: `Rewrite feed.jsonl keeping only what the feed currently shows.
+
+The feed is append-only, so edits, deletions, and reactions pile up as
+extra lines. Compacting drops deleted posts and their tombstones, every
+edit but the latest, and duplicate reactions. Post content and threads are
+unchanged; a deleted post that still has replies stays as "[deleted]".
+
+The original is backed up to feed.jsonl.bak.<timestamp> first.
+
+Examples:
+  smoke compact --dry-run     # Report how many lines would be removed
+  smoke compact               # Compact the feed
+  smoke compact --keep 5000   # Also drop all but the newest 5000 posts`,
+	Args: cobra.NoArgs,
+	RunE: runCompact,
+}
+
+func init() {
+	compactCmd.Flags().BoolVar(&compactDryRun, "dry-run", false, "Report what would be removed without changing the feed")
+	compactCmd.Flags().IntVar(&compactKeep, "keep", 0, "Keep only the newest N posts (plus parents of kept replies)")
+	rootCmd.AddCommand(compactCmd)
+}
+
+func runCompact(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("compact", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if compactKeep < 0 {
+		err := fmt.Errorf("--keep must be 0 or more (got %d)", compactKeep)
+		tracker.Fail(err)
+		return err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	opts := feed.CompactOptions{KeepPosts: compactKeep, DryRun: compactDryRun}
+
+	if compactDryRun {
+		result, err := store.Compact(opts)
+		if err != nil {
+			tracker.Fail(err)
+			return err
+		}
+		fmt.Printf("Would remove %d of %d lines (%d posts), %s -> %s\n",
+			result.LinesBefore-result.LinesAfter, result.LinesBefore, result.PostsRemoved,
+			formatBytes(result.BytesBefore), formatBytes(result.BytesAfter))
+		tracker.Complete()
+		return nil
+	}
+
+	backupPath, err := backupFile(feedPath)
+	if err != nil {
+		err = fmt.Errorf("backup feed: %w", err)
+		tracker.Fail(err)
+		return err
+	}
+	result, err := store.Compact(opts)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	fmt.Printf("Backed up to: %s\n", backupPath)
+	fmt.Printf("Removed %d of %d lines (%d posts), %s -> %s\n",
+		result.LinesBefore-result.LinesAfter, result.LinesBefore, result.PostsRemoved,
+		formatBytes(result.BytesBefore), formatBytes(result.BytesAfter))
+	tracker.Complete()
+	return nil
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// seedCompactFeed writes a thread, an edited post, and a deleted post.
+func seedCompactFeed(t *testing.T) *feed.FileStore {
+	t.Helper()
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	root, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", "root post")
+	require.NoError(t, err)
+	require.NoError(t, store.Append(root))
+	reply, err := feed.NewReply("calm-owl@smoke", "smoke", "owl", "a reply", root.ID)
+	require.NoError(t, err)
+	require.NoError(t, store.Append(reply))
+	for _, content := range []string{"root post v2", "root post v3"} {
+		rev, err := feed.NewRevision("ember-fox@smoke", root.ID, content)
+		require.NoError(t, err)
+		require.NoError(t, store.AppendRevision(rev))
+	}
+	gone, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", "regrettable")
+	require.NoError(t, err)
+	require.NoError(t, store.Append(gone))
+	require.NoError(t, store.DeleteByID(gone.ID))
+	return store
+}
+
+func TestRunCompact(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	store := seedCompactFeed(t)
+	before, err := store.ReadAll()
+	require.NoError(t, err)
+	original, err := os.ReadFile(store.Path())
+	require.NoError(t, err)
+
+	compactDryRun, compactKeep = true, 0
+	output := captureStdout(t, func() {
+		require.NoError(t, runCompact(nil, nil))
+	})
+	compactDryRun = false
+	assert.Contains(t, output, "Would remove 3 of 6 lines (1 posts)")
+	data, err := os.ReadFile(store.Path())
+	require.NoError(t, err)
+	assert.Equal(t, original, data, "dry run leaves the feed alone")
+
+	output = captureStdout(t, func() {
+		require.NoError(t, runCompact(nil, nil))
+	})
+	assert.Contains(t, output, "Removed 3 of 6 lines")
+
+	data, err = os.ReadFile(store.Path())
+	require.NoError(t, err)
+	assert.Less(t, len(data), len(original))
+
+	backups, err := filepath.Glob(store.Path() + ".bak.*")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	backup, err := os.ReadFile(backups[0])
+	require.NoError(t, err)
+	assert.Equal(t, original, backup)
+	assert.Contains(t, output, backups[0])
+
+	after, err := store.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, after, 2)
+	assert.Equal(t, before[0].Content, after[0].Content)
+	assert.Equal(t, "root post v3", after[0].Content)
+	assert.Equal(t, before[1].ParentID, after[1].ParentID)
+	assert.Equal(t, "a reply", after[1].Content)
+}
+
+func TestRunCompactRejectsNegativeKeep(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	compactKeep = -1
+	defer func() { compactKeep = 0 }()
+	assert.Error(t, runCompact(nil, nil))
+}
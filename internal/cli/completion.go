@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// completionPostLimit caps how many recent posts are offered when completing
+// post IDs, keeping tab completion fast on large feeds.
+const completionPostLimit = 50
+
+// completionPreviewLen is the content length shown next to a completed post ID.
+const completionPreviewLen = 40
+
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh|fish|powershell>",
+	Short: "Generate shell completion scripts",
+	Long: `Generate a completion script for your shell.
+
+Completion covers commands and flags, and post IDs for reply, react, edit,
+and delete (from the most recent posts in the feed).
+
+Bash:
+  source <(smoke completion bash)
+  # or permanently:
+  smoke completion bash > /etc/bash_completion.d/smoke
+
+Zsh:
+  smoke completion zsh > "${fpath[1]}/_smoke"
+
+Fish:
+  smoke completion fish > ~/.config/fish/completions/smoke.fish
+
+PowerShell:
+  smoke completion powershell | Out-String | Invoke-Expression
+
+Examples:
+  smoke completion zsh
+  smoke completion bash > ~/.local/share/bash-completion/completions/smoke`,
+	Args:                  cobra.ExactArgs(1),
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	DisableFlagsInUseLine: true,
+	RunE:                  runCompletion,
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+
+	replyCmd.ValidArgsFunction = completeFirstPostID
+	reactCmd.ValidArgsFunction = completeFirstPostID
+	editCmd.ValidArgsFunction = completeFirstPostID
+	deleteCmd.ValidArgsFunction = completePostIDs
+}
+
+func runCompletion(cmd *cobra.Command, args []string) error {
+	out := cmd.OutOrStdout()
+	switch args[0] {
+	case "bash":
+		return rootCmd.GenBashCompletionV2(out, true)
+	case "zsh":
+		return rootCmd.GenZshCompletion(out)
+	case "fish":
+		return rootCmd.GenFishCompletion(out, true)
+	case "powershell":
+		return rootCmd.GenPowerShellCompletionWithDesc(out)
+	default:
+		return fmt.Errorf("unsupported shell %q (use bash, zsh, fish, or powershell)", args[0])
+	}
+}
+
+// completeFirstPostID completes a post ID for commands whose first argument
+// is the post and whose remaining arguments are free text.
+func completeFirstPostID(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completePostIDs(cmd, args, toComplete)
+}
+
+// completePostIDs offers recent post IDs matching toComplete, each described
+// by its author and a content preview. IDs already on the command line are skipped.
+func completePostIDs(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadRecent(completionPostLimit)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	used := make(map[string]bool, len(args))
+	for _, arg := range args {
+		used[arg] = true
+	}
+
+	var ids []string
+	for _, post := range posts {
+		if used[post.ID] || !strings.HasPrefix(post.ID, toComplete) {
+			continue
+		}
+		ids = append(ids, fmt.Sprintf("%s\t%s: %s", post.ID, post.Author, completionPreview(post.Content)))
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionPreview flattens content to one line and truncates it for display.
+func completionPreview(content string) string {
+	preview := strings.Join(strings.Fields(content), " ")
+	if runes := []rune(preview); len(runes) > completionPreviewLen {
+		preview = string(runes[:completionPreviewLen-3]) + "..."
+	}
+	return preview
+}
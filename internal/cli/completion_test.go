@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func TestCompletionCmd_Shells(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		t.Run(shell, func(t *testing.T) {
+			var buf bytes.Buffer
+			completionCmd.SetOut(&buf)
+			t.Cleanup(func() { completionCmd.SetOut(nil) })
+
+			if err := runCompletion(completionCmd, []string{shell}); err != nil {
+				t.Fatalf("runCompletion(%s) error: %v", shell, err)
+			}
+			if buf.Len() == 0 {
+				t.Fatalf("expected %s completion script, got empty output", shell)
+			}
+			if !strings.Contains(buf.String(), "smoke") {
+				t.Errorf("%s completion script should reference smoke", shell)
+			}
+		})
+	}
+}
+
+func TestCompletionCmd_UnknownShell(t *testing.T) {
+	if err := runCompletion(completionCmd, []string{"tcsh"}); err == nil {
+		t.Error("expected error for unsupported shell")
+	}
+}
+
+func seedCompletionFeed(t *testing.T) []*feed.Post {
+	t.Helper()
+	cleanup := setupSmokeEnv(t)
+	t.Cleanup(cleanup)
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	var posts []*feed.Post
+	for _, content := range []string{"first post", "second\npost with a very long body that needs truncating"} {
+		post, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+func TestCompletePostIDs(t *testing.T) {
+	posts := seedCompletionFeed(t)
+
+	ids, directive := completePostIDs(deleteCmd, nil, "")
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want NoFileComp", directive)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 completions, got %v", ids)
+	}
+	for _, id := range ids {
+		value, desc, ok := strings.Cut(id, "\t")
+		if !ok || !strings.HasPrefix(value, "smk-") || !strings.HasPrefix(desc, "ember-fox@smoke: ") {
+			t.Errorf("unexpected completion %q", id)
+		}
+		if strings.Contains(desc, "\n") || len([]rune(desc)) > len("ember-fox@smoke: ")+completionPreviewLen {
+			t.Errorf("completion description not flattened and truncated: %q", desc)
+		}
+	}
+
+	// Prefix filtering and skipping IDs already given
+	ids, _ = completePostIDs(deleteCmd, []string{posts[0].ID}, "smk-")
+	if len(ids) != 1 || !strings.HasPrefix(ids[0], posts[1].ID) {
+		t.Errorf("expected only %s, got %v", posts[1].ID, ids)
+	}
+	ids, _ = completePostIDs(deleteCmd, nil, posts[0].ID)
+	if len(ids) != 1 || !strings.HasPrefix(ids[0], posts[0].ID) {
+		t.Errorf("expected only %s, got %v", posts[0].ID, ids)
+	}
+}
+
+func TestCompleteFirstPostID(t *testing.T) {
+	seedCompletionFeed(t)
+
+	if ids, _ := completeFirstPostID(replyCmd, nil, ""); len(ids) != 2 {
+		t.Errorf("expected post IDs for the first argument, got %v", ids)
+	}
+	if ids, _ := completeFirstPostID(replyCmd, []string{"smk-abc123"}, ""); len(ids) != 0 {
+		t.Errorf("expected no completions for the message argument, got %v", ids)
+	}
+}
@@ -0,0 +1,407 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "View and change settings",
+	Long: `View and change the settings kept in config.yaml and tui.yaml.
+
+Values are checked before anything is written, and the file is backed up
+next to itself (as <file>.bak.<time>) whenever a setting changes.
+
+get, set, and list work with the values in the files. When an environment
+variable such as SMOKE_THEME overrides a setting, they say so; the override
+is never written to the file.
+
+Examples:
+  smoke config list
+  smoke config get theme
+  smoke config set pressure quiet
+  smoke config set layout dense`,
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List settings and their current values",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigList,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the current value of a setting",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigGet,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a setting",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConfigSet,
+}
+
+func init() {
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// configSetting is a key smoke config can read and write. parse validates a
+// value and returns it in canonical form; write stores a canonical value.
+// get returns the value in the file, even when env overrides it.
+type configSetting struct {
+	key   string
+	file  string
+	env   string
+	get   func() string
+	parse func(value string) (string, error)
+	write func(value string) error
+}
+
+// configSettings lists the supported keys in the order smoke config list
+// prints them.
+func configSettings() []configSetting {
+	return []configSetting{
+		tuiSetting("theme", config.ThemeEnv, func() []string {
+			registerCustomThemes()
+			return themeNames()
+		}, func(c *config.TUIConfig) *string { return &c.Theme }),
+		tuiSetting("contrast", config.ContrastEnv, contrastNames, func(c *config.TUIConfig) *string { return &c.Contrast }),
+		tuiSetting("layout", config.LayoutEnv, layoutNames, func(c *config.TUIConfig) *string { return &c.Layout }),
+		tuiSetting("appearance", "", func() []string { return feed.AllAppearances },
+			func(c *config.TUIConfig) *string { return &c.Appearance }),
+		tuiSetting("time_format", "", func() []string { return feed.AllTimestampFormats },
+			func(c *config.TUIConfig) *string { return &c.TimeFormat }),
+		{
+			key:   "auto_refresh",
+			file:  config.DefaultTUIConfigFile,
+			env:   config.AutoRefreshEnv,
+			get:   func() string { return strconv.FormatBool(config.LoadTUIConfigFile().AutoRefresh) },
+			parse: parseBoolSetting("auto_refresh"),
+			write: writeTUIConfig(func(c *config.TUIConfig, v string) { c.AutoRefresh = v == "true" }),
+		},
+		{
+			key:   "remember_position",
+			file:  config.DefaultTUIConfigFile,
+			get:   func() string { return strconv.FormatBool(config.LoadTUIConfigFile().PositionRemembered()) },
+			parse: parseBoolSetting("remember_position"),
+			write: writeTUIConfig(func(c *config.TUIConfig, v string) {
+				remember := v == "true"
+				c.RememberPosition = &remember
+			}),
+		},
+		{
+			key:   "show_status_bar",
+			file:  config.DefaultTUIConfigFile,
+			get:   func() string { return strconv.FormatBool(config.LoadTUIConfigFile().StatusBarShown()) },
+			parse: parseBoolSetting("show_status_bar"),
+			write: writeTUIConfig(func(c *config.TUIConfig, v string) {
+				shown := v == "true"
+				c.ShowStatusBar = &shown
+			}),
+		},
+		{
+			key:   "max_replies",
+			file:  config.DefaultTUIConfigFile,
+			get:   func() string { return strconv.Itoa(config.LoadTUIConfigFile().MaxReplies) },
+			parse: parseIntSetting("max_replies", 0, -1),
+			write: writeTUIConfig(func(c *config.TUIConfig, v string) { c.MaxReplies, _ = strconv.Atoi(v) }),
+		},
+		{
+			// 0 never collapses, which tui.yaml stores as a negative value.
+			key:   "collapse_lines",
+			file:  config.DefaultTUIConfigFile,
+			get:   func() string { return strconv.Itoa(config.LoadTUIConfigFile().CollapseThreshold()) },
+			parse: parseIntSetting("collapse_lines", 0, -1),
+			write: writeTUIConfig(func(c *config.TUIConfig, v string) {
+				c.CollapseLines, _ = strconv.Atoi(v)
+				if c.CollapseLines == 0 {
+					c.CollapseLines = -1
+				}
+			}),
+		},
+		{
+			key:  "pressure",
+			file: config.DefaultConfigFile,
+			env:  config.PressureEnv,
+			get: func() string {
+				if p := config.LoadSuggestConfig().Pressure; p != nil {
+					return strconv.Itoa(max(0, min(*p, config.MaxPressure)))
+				}
+				return strconv.Itoa(config.DefaultPressure)
+			},
+			parse: func(value string) (string, error) {
+				level, err := parsePressureLevel(value)
+				return strconv.Itoa(level), err
+			},
+			write: func(v string) error {
+				level, _ := strconv.Atoi(v)
+				return config.SetPressure(level)
+			},
+		},
+		{
+			key:   "max_post_length",
+			file:  config.DefaultConfigFile,
+			get:   func() string { return strconv.Itoa(config.GetMaxPostLength()) },
+			parse: parseIntSetting("max_post_length", config.MinMaxPostLength, config.MaxPostLengthCeiling),
+			write: writeUserConfig(func(c *config.SuggestConfig, v string) { c.MaxPostLength, _ = strconv.Atoi(v) }),
+		},
+		{
+			key:   "max_posts_per_minute",
+			file:  config.DefaultConfigFile,
+			get:   func() string { return strconv.Itoa(config.GetMaxPostsPerMinute()) },
+			parse: parseIntSetting("max_posts_per_minute", 0, -1),
+			write: writeUserConfig(func(c *config.SuggestConfig, v string) { c.MaxPostsPerMinute, _ = strconv.Atoi(v) }),
+		},
+		{
+			key:   "nudge_output",
+			file:  config.DefaultConfigFile,
+			get:   func() string { return config.LoadSuggestConfig().GetNudgeOutput() },
+			parse: parseEnumSetting("nudge_output", []string{config.NudgeOutputStdout, config.NudgeOutputStderr}),
+			write: writeUserConfig(func(c *config.SuggestConfig, v string) { c.NudgeOutput = v }),
+		},
+		{
+			key:  "feed_scope",
+			file: config.DefaultConfigFile,
+			get: func() string {
+				if strings.EqualFold(config.LoadSuggestConfig().FeedScope, config.FeedScopeProject) {
+					return config.FeedScopeProject
+				}
+				return config.FeedScopeGlobal
+			},
+			parse: parseEnumSetting("feed_scope", []string{config.FeedScopeGlobal, config.FeedScopeProject}),
+			write: writeUserConfig(func(c *config.SuggestConfig, v string) { c.FeedScope = v }),
+		},
+		{
+			key:   "sync_path",
+			file:  config.DefaultConfigFile,
+			get:   func() string { return config.LoadSuggestConfig().SyncPath },
+			parse: func(value string) (string, error) { return strings.TrimSpace(value), nil },
+			write: writeUserConfig(func(c *config.SuggestConfig, v string) { c.SyncPath = v }),
+		},
+	}
+}
+
+// tuiSetting builds a tui.yaml setting whose value is one of valid(). env
+// names the variable that overrides it, if any.
+func tuiSetting(key, env string, valid func() []string, field func(*config.TUIConfig) *string) configSetting {
+	return configSetting{
+		key:  key,
+		file: config.DefaultTUIConfigFile,
+		env:  env,
+		get:  func() string { return *field(config.LoadTUIConfigFile()) },
+		parse: func(value string) (string, error) {
+			return parseEnumSetting(key, valid())(value)
+		},
+		write: writeTUIConfig(func(c *config.TUIConfig, v string) { *field(c) = v }),
+	}
+}
+
+// writeTUIConfig returns a write func that stores a value in tui.yaml.
+// It edits the file's own values, so env overrides are neither saved nor
+// allowed to hide the change.
+func writeTUIConfig(store func(c *config.TUIConfig, value string)) func(string) error {
+	return func(value string) error {
+		cfg := config.LoadTUIConfigFile()
+		store(cfg, value)
+		return config.SaveTUIConfigFile(cfg)
+	}
+}
+
+// writeUserConfig returns a write func that stores a value in config.yaml.
+func writeUserConfig(store func(c *config.SuggestConfig, value string)) func(string) error {
+	return func(value string) error {
+		return config.UpdateUserConfig(func(raw *config.SuggestConfig) { store(raw, value) })
+	}
+}
+
+// parseEnumSetting accepts one of valid, ignoring case.
+func parseEnumSetting(key string, valid []string) func(string) (string, error) {
+	return func(value string) (string, error) {
+		for _, v := range valid {
+			if strings.EqualFold(strings.TrimSpace(value), v) {
+				return v, nil
+			}
+		}
+		return "", fmt.Errorf("invalid %s %q (valid: %s)", key, value, strings.Join(valid, ", "))
+	}
+}
+
+// parseBoolSetting accepts true/false and the other forms strconv.ParseBool does.
+func parseBoolSetting(key string) func(string) (string, error) {
+	return func(value string) (string, error) {
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("invalid %s %q: must be true or false", key, value)
+		}
+		return strconv.FormatBool(b), nil
+	}
+}
+
+// parseIntSetting accepts a whole number from lo to hi; a negative hi means
+// no upper bound.
+func parseIntSetting(key string, lo, hi int) func(string) (string, error) {
+	return func(value string) (string, error) {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		switch {
+		case err != nil:
+			return "", fmt.Errorf("invalid %s %q: must be a whole number", key, value)
+		case n < lo:
+			return "", fmt.Errorf("invalid %s %d: must be at least %d", key, n, lo)
+		case hi >= 0 && n > hi:
+			return "", fmt.Errorf("invalid %s %d: must be %d-%d", key, n, lo, hi)
+		}
+		return strconv.Itoa(n), nil
+	}
+}
+
+func themeNames() []string {
+	names := make([]string, len(feed.AllThemes))
+	for i, theme := range feed.AllThemes {
+		names[i] = theme.Name
+	}
+	return names
+}
+
+func contrastNames() []string {
+	names := make([]string, len(feed.AllContrastLevels))
+	for i, level := range feed.AllContrastLevels {
+		names[i] = level.Name
+	}
+	return names
+}
+
+func layoutNames() []string {
+	names := make([]string, len(feed.AllLayouts))
+	for i, layout := range feed.AllLayouts {
+		names[i] = layout.Name
+	}
+	return names
+}
+
+// configValidNames returns the names config.Validate accepts. Custom theme
+// files are only loaded when tui.yaml names a theme that is not built in.
+func configValidNames() config.ValidNames {
+	if !slices.Contains(themeNames(), config.LoadTUIConfig().Theme) {
+		registerCustomThemes()
+	}
+	return config.ValidNames{
+		Themes:      themeNames(),
+		Contrasts:   contrastNames(),
+		Layouts:     layoutNames(),
+		Appearances: feed.AllAppearances,
+		TimeFormats: feed.AllTimestampFormats,
+	}
+}
+
+// warnConfigProblems prints config.Validate warnings to stderr, each only
+// the first time it is seen. smoke doctor lists all of them.
+func warnConfigProblems() {
+	for _, warning := range config.UnseenConfigWarnings(config.Validate(configValidNames())) {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+}
+
+// noteEnvOverride tells the user when an env var overrides the setting, so
+// smoke is not using the value in the file.
+func noteEnvOverride(s configSetting) {
+	if s.env == "" {
+		return
+	}
+	if v, ok := config.EnvOverride(s.env); ok {
+		fmt.Fprintf(os.Stderr, "note: %s=%s overrides %s while it is set\n", s.env, v, s.key)
+	}
+}
+
+// lookupConfigSetting finds the setting for key.
+func lookupConfigSetting(key string) (configSetting, error) {
+	settings := configSettings()
+	keys := make([]string, len(settings))
+	for i, s := range settings {
+		if s.key == key {
+			return s, nil
+		}
+		keys[i] = s.key
+	}
+	return configSetting{}, fmt.Errorf("unknown config key %q (valid: %s)", key, strings.Join(keys, ", "))
+}
+
+func runConfigList(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("config list", args)
+	for _, s := range configSettings() {
+		fmt.Printf("%-21s %-11s %s\n", s.key, s.file, s.get())
+		noteEnvOverride(s)
+	}
+	tracker.Complete()
+	return nil
+}
+
+func runConfigGet(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("config get", args)
+	setting, err := lookupConfigSetting(args[0])
+	if err != nil {
+		return finishTracked(tracker, err)
+	}
+	fmt.Println(setting.get())
+	noteEnvOverride(setting)
+	tracker.Complete()
+	return nil
+}
+
+func runConfigSet(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("config set", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		return finishTracked(tracker, err)
+	}
+	setting, err := lookupConfigSetting(args[0])
+	if err != nil {
+		return finishTracked(tracker, err)
+	}
+	value, err := setting.parse(args[1])
+	if err != nil {
+		return finishTracked(tracker, err)
+	}
+	if value == setting.get() {
+		fmt.Printf("%s is already %s\n", setting.key, value)
+		noteEnvOverride(setting)
+		tracker.Complete()
+		return nil
+	}
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return finishTracked(tracker, err)
+	}
+	backupPath, err := backupFile(filepath.Join(configDir, setting.file))
+	if err != nil {
+		return finishTracked(tracker, fmt.Errorf("backup %s: %w", setting.file, err))
+	}
+	if err := setting.write(value); err != nil {
+		return finishTracked(tracker, err)
+	}
+
+	fmt.Printf("Set %s to %s\n", setting.key, value)
+	if backupPath != "" {
+		fmt.Printf("Backup: %s\n", backupPath)
+	}
+	noteEnvOverride(setting)
+	tracker.Complete()
+	return nil
+}
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func configGet(t *testing.T, key string) string {
+	t.Helper()
+	return strings.TrimSpace(captureStdout(t, func() {
+		require.NoError(t, runConfigGet(nil, []string{key}))
+	}))
+}
+
+func TestConfigSetGetRoundtrip(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	tests := []struct {
+		key, value, want string
+	}{
+		{"theme", "nord", "nord"},
+		{"layout", "DENSE", "dense"},
+		{"contrast", "high", "high"},
+		{"time_format", "relative", "relative"},
+		{"auto_refresh", "false", "false"},
+		{"collapse_lines", "0", "0"},
+		{"pressure", "quiet", "3"},
+		{"pressure", "9", "9"},
+		{"max_post_length", "500", "500"},
+		{"nudge_output", "stderr", "stderr"},
+		{"feed_scope", "project", "project"},
+	}
+	for _, tt := range tests {
+		captureStdout(t, func() {
+			require.NoError(t, runConfigSet(nil, []string{tt.key, tt.value}), tt.key)
+		})
+		assert.Equal(t, tt.want, configGet(t, tt.key), tt.key)
+	}
+
+	assert.Equal(t, "nord", config.LoadTUIConfig().Theme)
+	assert.Equal(t, 500, config.GetMaxPostLength())
+	assert.Equal(t, 9, config.GetPressure())
+}
+
+func TestConfigSetGet_IgnoreEnvOverride(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	t.Setenv(config.ThemeEnv, "dracula")
+
+	// The file still says nord, so setting dracula must write it.
+	captureStdout(t, func() {
+		require.NoError(t, runConfigSet(nil, []string{"theme", "nord"}))
+	})
+	var stdout string
+	stderr := captureStderr(t, func() {
+		stdout = captureStdout(t, func() {
+			require.NoError(t, runConfigSet(nil, []string{"theme", "dracula"}))
+		})
+	})
+	assert.Contains(t, stdout, "Set theme to dracula")
+	assert.Contains(t, stderr, "SMOKE_THEME=dracula overrides theme")
+	assert.Equal(t, "dracula", config.LoadTUIConfigFile().Theme)
+
+	t.Setenv(config.ThemeEnv, "bogus")
+	stderr = captureStderr(t, func() {
+		assert.Equal(t, "dracula", configGet(t, "theme"))
+	})
+	assert.Contains(t, stderr, "SMOKE_THEME=bogus overrides theme")
+}
+
+func TestConfigSet_RejectsInvalidValues(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	tests := []struct {
+		key, value, wantErr string
+	}{
+		{"theme", "neon", "invalid theme"},
+		{"layout", "cozy", "valid: dense, comfy, relaxed"},
+		{"auto_refresh", "maybe", "must be true or false"},
+		{"pressure", "11", "out of range"},
+		{"max_post_length", "10", "must be at least 50"},
+		{"max_post_length", "9999", "must be 50-4000"},
+		{"max_replies", "lots", "whole number"},
+		{"colour", "red", "unknown config key"},
+	}
+	for _, tt := range tests {
+		err := runConfigSet(nil, []string{tt.key, tt.value})
+		require.Error(t, err, tt.key)
+		assert.Contains(t, err.Error(), tt.wantErr, tt.key)
+	}
+
+	configDir, err := config.GetConfigDir()
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(configDir, config.DefaultTUIConfigFile))
+	assert.True(t, os.IsNotExist(err), "invalid values must not write tui.yaml")
+}
+
+func TestConfigSet_BacksUpOnChange(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	configDir, err := config.GetConfigDir()
+	require.NoError(t, err)
+	configPath := filepath.Join(configDir, config.DefaultConfigFile)
+	require.NoError(t, os.WriteFile(configPath, []byte("pressure: 5\npressure_scale: 10\n"), 0o644))
+
+	output := captureStdout(t, func() {
+		require.NoError(t, runConfigSet(nil, []string{"pressure", "5"}))
+	})
+	assert.Contains(t, output, "already 5")
+	backups, _ := filepath.Glob(configPath + ".bak.*")
+	assert.Empty(t, backups, "no backup when nothing changes")
+
+	output = captureStdout(t, func() {
+		require.NoError(t, runConfigSet(nil, []string{"pressure", "2"}))
+	})
+	assert.Contains(t, output, "Set pressure to 2")
+	backups, _ = filepath.Glob(configPath + ".bak.*")
+	require.Len(t, backups, 1)
+	saved, err := os.ReadFile(backups[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(saved), "pressure: 5")
+}
+
+func TestConfigList(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	output := captureStdout(t, func() {
+		require.NoError(t, runConfigList(nil, nil))
+	})
+	for _, key := range []string{"theme", "layout", "pressure", "auto_refresh", "max_post_length"} {
+		assert.Contains(t, output, key)
+	}
+	assert.Contains(t, output, config.DefaultTUIConfigFile)
+}
+
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	oldStderr := os.Stderr
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stderr = w
+
+	fn()
+
+	_ = w.Close()
+	os.Stderr = oldStderr
+
+	var buf bytes.Buffer
+	_, _ = io.Copy(&buf, r)
+	return buf.String()
+}
+
+func TestWarnConfigProblems_UnknownThemeOnce(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	tuiPath, err := config.GetTUIConfigPath()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tuiPath, []byte("theme: neon\n"), 0o600))
+
+	output := captureStderr(t, warnConfigProblems)
+	assert.Contains(t, output, `warning: tui.yaml: unknown theme "neon" (using dracula)`)
+	assert.Empty(t, captureStderr(t, warnConfigProblems), "a warning is shown once")
+
+	// The config still loads, and the feed falls back to the default theme.
+	assert.Equal(t, "neon", config.LoadTUIConfig().Theme)
+	assert.Equal(t, "dracula", feed.GetTheme("neon").Name)
+}
+
+func TestPerformConfigValuesCheck(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	assert.Equal(t, StatusPass, performConfigValuesCheck().Status)
+
+	tuiPath, err := config.GetTUIConfigPath()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tuiPath, []byte("theme: neon\nlayout: cozy\n"), 0o600))
+
+	check := performConfigValuesCheck()
+	assert.Equal(t, StatusWarn, check.Status)
+	assert.Equal(t, "2 problems", check.Message)
+	assert.Contains(t, check.Detail, `unknown layout "cozy"`)
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	deleteYes    bool
+	deleteDryRun bool
+
+	// deleteInput is where the confirmation answer is read from (stdin; replaced in tests)
+	deleteInput io.Reader = os.Stdin
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete <post-id>...",
+	Short: "Delete posts from the feed",
+	Long: `Delete one or more posts by ID.
+
+Deletion appends a tombstone record rather than rewriting the feed. A deleted
+post that has replies stays in its thread as "[deleted]" so the replies still
+make sense.
+
+You are asked to confirm before anything is deleted. Use --yes to skip the
+prompt in scripts, or --dry-run to list what would be deleted.
+
+Examples:
+  smoke delete smk-abc123
+  smoke delete smk-abc123 smk-def456 --yes
+  smoke delete smk-abc123 --dry-run`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runDelete,
+}
+
+func init() {
+	deleteCmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Delete without asking for confirmation")
+	deleteCmd.Flags().BoolVar(&deleteDryRun, "dry-run", false, "List posts that would be deleted without deleting them")
+	rootCmd.AddCommand(deleteCmd)
+}
+
+func runDelete(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("delete", args)
+	return finishTracked(tracker, deletePosts(tracker, args))
+}
+
+// deletePosts resolves every ID before deleting anything, so a typo in one
+// ID leaves the feed untouched.
+func deletePosts(tracker *logging.CommandTracker, ids []string) error {
+	if err := config.EnsureInitialized(); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if !feed.ValidateID(id) {
+			return fmt.Errorf("invalid post ID format: %s", id)
+		}
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return err
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	targets, err := findDeleteTargets(store, ids)
+	if err != nil {
+		return err
+	}
+	tracker.AddMetric(slog.Int("posts", len(targets)))
+
+	if deleteDryRun {
+		fmt.Printf("Would delete %d post(s):\n", len(targets))
+		printDeleteTargets(os.Stdout, targets)
+		return nil
+	}
+
+	if !deleteYes {
+		fmt.Fprintf(os.Stderr, "About to delete %d post(s):\n", len(targets))
+		printDeleteTargets(os.Stderr, targets)
+		if !confirm(os.Stderr, deleteInput, "Delete?") {
+			fmt.Fprintln(os.Stderr, "Aborted, nothing deleted.")
+			return nil
+		}
+	}
+
+	for _, post := range targets {
+		if err := store.DeleteByID(post.ID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", post.ID, err)
+		}
+		fmt.Printf("Deleted %s\n", post.ID)
+	}
+	return nil
+}
+
+// findDeleteTargets returns the live posts for ids, in argument order,
+// skipping duplicate IDs. Returns an error naming the first missing ID.
+func findDeleteTargets(store feed.Store, ids []string) ([]*feed.Post, error) {
+	posts, err := store.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*feed.Post, len(posts))
+	for _, post := range posts {
+		if !post.Deleted {
+			byID[post.ID] = post
+		}
+	}
+
+	seen := make(map[string]bool, len(ids))
+	targets := make([]*feed.Post, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		post, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("post %s not found", id)
+		}
+		targets = append(targets, post)
+	}
+	return targets, nil
+}
+
+func printDeleteTargets(w io.Writer, targets []*feed.Post) {
+	opts := feed.FormatOptions{Oneline: true}
+	for _, post := range targets {
+		fmt.Fprint(w, "  ")
+		feed.FormatPost(w, post, opts)
+	}
+}
+
+// confirm asks a yes/no question and reports whether the answer was yes.
+// Anything other than "y" or "yes", including EOF, counts as no.
+func confirm(w io.Writer, r io.Reader, question string) bool {
+	fmt.Fprintf(w, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(r).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
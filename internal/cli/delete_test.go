@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func resetDeleteFlags(t *testing.T, answer string) {
+	t.Helper()
+	prevYes, prevDryRun, prevInput := deleteYes, deleteDryRun, deleteInput
+	t.Cleanup(func() {
+		deleteYes, deleteDryRun, deleteInput = prevYes, prevDryRun, prevInput
+	})
+	deleteYes, deleteDryRun, deleteInput = false, false, strings.NewReader(answer)
+}
+
+// seedThread creates a parent post with one reply and returns both.
+func seedThread(t *testing.T) (*feed.FileStore, *feed.Post, *feed.Post) {
+	t.Helper()
+	cleanup := setupSmokeEnv(t)
+	t.Cleanup(cleanup)
+
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	parent, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", "parent post")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err := feed.NewReply("spark-owl@smoke", "smoke", "owl", "child reply", parent.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, post := range []*feed.Post{parent, reply} {
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store, parent, reply
+}
+
+func TestRunDelete_ParentWithReplies(t *testing.T) {
+	store, parent, reply := seedThread(t)
+	resetDeleteFlags(t, "")
+	deleteYes = true
+
+	output := captureStdout(t, func() {
+		if err := runDelete(nil, []string{parent.ID}); err != nil {
+			t.Fatalf("runDelete error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Deleted "+parent.ID) {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	posts, err := store.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("expected placeholder and reply, got %d posts", len(posts))
+	}
+	if posts[0].Content != feed.DeletedContent || posts[1].ID != reply.ID {
+		t.Errorf("parent should render as %s with reply intact, got %q / %s", feed.DeletedContent, posts[0].Content, posts[1].ID)
+	}
+}
+
+func TestRunDelete_ConfirmationDeclined(t *testing.T) {
+	store, parent, _ := seedThread(t)
+	resetDeleteFlags(t, "n\n")
+
+	if err := runDelete(nil, []string{parent.ID}); err != nil {
+		t.Fatalf("runDelete error: %v", err)
+	}
+	post, err := store.FindByID(parent.ID)
+	if err != nil || post.Deleted {
+		t.Error("declined confirmation should not delete")
+	}
+}
+
+func TestRunDelete_ConfirmationAccepted(t *testing.T) {
+	store, _, reply := seedThread(t)
+	resetDeleteFlags(t, "yes\n")
+
+	captureStdout(t, func() {
+		if err := runDelete(nil, []string{reply.ID}); err != nil {
+			t.Fatalf("runDelete error: %v", err)
+		}
+	})
+	if _, err := store.FindByID(reply.ID); err != feed.ErrPostNotFound {
+		t.Errorf("reply should be gone, got %v", err)
+	}
+}
+
+func TestRunDelete_DryRun(t *testing.T) {
+	store, parent, _ := seedThread(t)
+	resetDeleteFlags(t, "")
+	deleteDryRun = true
+
+	output := captureStdout(t, func() {
+		if err := runDelete(nil, []string{parent.ID}); err != nil {
+			t.Fatalf("runDelete error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Would delete 1 post(s)") || !strings.Contains(output, "parent post") {
+		t.Errorf("unexpected dry-run output: %s", output)
+	}
+	if post, _ := store.FindByID(parent.ID); post == nil || post.Deleted {
+		t.Error("dry run should not delete")
+	}
+}
+
+func TestRunDelete_ValidatesAllIDsFirst(t *testing.T) {
+	store, parent, _ := seedThread(t)
+	resetDeleteFlags(t, "")
+	deleteYes = true
+
+	if err := runDelete(nil, []string{parent.ID, "not-an-id"}); err == nil {
+		t.Error("expected error for invalid ID format")
+	}
+	if err := runDelete(nil, []string{parent.ID, "smk-zzz999"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if post, _ := store.FindByID(parent.ID); post == nil || post.Deleted {
+		t.Error("no post should be deleted when any ID is bad")
+	}
+}
+
+// stubStore is a feed.Store serving fixed posts, for tests that never write.
+type stubStore struct {
+	posts []*feed.Post
+}
+
+func (s *stubStore) Append(post *feed.Post) error {
+	s.posts = append(s.posts, post)
+	return nil
+}
+
+func (s *stubStore) ReadAll() ([]*feed.Post, error) { return s.posts, nil }
+
+func (s *stubStore) DeleteByID(string) error { return feed.ErrPostNotFound }
+
+func TestFindDeleteTargets_Store(t *testing.T) {
+	store := &stubStore{posts: []*feed.Post{
+		{ID: "smk-aaaaaa", Content: "live"},
+		{ID: "smk-bbbbbb", Content: feed.DeletedContent, Deleted: true},
+		{ID: "smk-cccccc", Content: "also live"},
+	}}
+
+	targets, err := findDeleteTargets(store, []string{"smk-cccccc", "smk-aaaaaa", "smk-cccccc"})
+	if err != nil {
+		t.Fatalf("findDeleteTargets error: %v", err)
+	}
+	if len(targets) != 2 || targets[0].ID != "smk-cccccc" || targets[1].ID != "smk-aaaaaa" {
+		t.Errorf("targets = %v, want cccccc then aaaaaa", targets)
+	}
+
+	if _, err := findDeleteTargets(store, []string{"smk-bbbbbb"}); err == nil {
+		t.Error("already deleted posts should not be found")
+	}
+}
@@ -106,7 +106,9 @@ var doctorCmd = &cobra.Command{
 	Short: "Check smoke installation health",
 	Long: `Diagnose smoke installation and report any issues.
 
-Checks configuration directory, feed file, and data integrity.
+Checks configuration directory, feed file, and data integrity, and warns
+when the feed outgrows feed_limits in config.yaml (10 MB or 20000 posts by
+default). Fixing an oversized feed backs it up, then compacts it.
 Also checks agent integrations (Claude Code hooks, Codex instructions).
 Use --fix to automatically repair common problems.
 
@@ -189,8 +191,11 @@ func runChecks() []Category {
 			Name: "DATA",
 			Checks: []Check{
 				performFeedFormatCheck(),
+				performFeedSizeCheck(),
+				performClockCheck(),
 				performConfigFileCheck(),
 				performTUIConfigCheck(),
+				performConfigValuesCheck(),
 			},
 		},
 		{
@@ -449,7 +454,7 @@ func fixConfigDir() (*FixResult, error) {
 
 // fixFeedFile creates an empty feed file
 func fixFeedFile() (*FixResult, error) {
-	feedPath, err := config.GetFeedPath()
+	feedPath, err := config.GetGlobalFeedPath()
 	if err != nil {
 		return nil, err
 	}
@@ -555,7 +560,7 @@ func performConfigDirCheck() Check {
 // performFeedFileCheck verifies the feed file exists and is readable
 func performFeedFileCheck() Check {
 	const name = "Feed File"
-	feedPath, err := config.GetFeedPath()
+	feedPath, err := config.GetGlobalFeedPath()
 	if err != nil {
 		return Check{Name: name, Status: StatusFail, Message: "cannot determine feed path", Detail: err.Error()}
 	}
@@ -584,7 +589,7 @@ func performFeedFileCheck() Check {
 // performFeedFormatCheck validates JSONL integrity of the feed file
 func performFeedFormatCheck() Check {
 	const name = "Feed Format"
-	feedPath, err := config.GetFeedPath()
+	feedPath, err := config.GetGlobalFeedPath()
 	if err != nil {
 		return Check{Name: name, Status: StatusFail, Message: "cannot determine feed path"}
 	}
@@ -623,7 +628,19 @@ func performFeedFormatCheck() Check {
 	}
 
 	if invalidLines > 0 {
-		return warnCheck(name, fmt.Sprintf("%d/%d lines valid", validLines, totalLines), "Some lines contain invalid JSON - manual inspection recommended")
+		msg := fmt.Sprintf("%d/%d lines valid", validLines, totalLines)
+		store := feed.NewStoreWithPath(feedPath)
+		if partial, partialErr := store.PartialTail(); partialErr == nil && partial > 0 {
+			return Check{
+				Name:    name,
+				Status:  StatusWarn,
+				Message: fmt.Sprintf("%s, last line is a partial record (%d bytes)", msg, partial),
+				Detail:  "An interrupted write cut off the last post; run 'smoke doctor --fix' to remove it (the feed is backed up first)",
+				CanFix:  true,
+				Fix:     func() (*FixResult, error) { return fixFeedPartialTail(store) },
+			}
+		}
+		return warnCheck(name, msg, "Some lines contain invalid JSON - manual inspection recommended")
 	}
 
 	if validLines == 1 {
@@ -632,6 +649,155 @@ func performFeedFormatCheck() Check {
 	return passCheck(name, fmt.Sprintf("%d posts, all valid", validLines))
 }
 
+// performFeedSizeCheck warns when the feed file outgrows feed_limits
+func performFeedSizeCheck() Check {
+	const name = "Feed Size"
+	feedPath, err := config.GetGlobalFeedPath()
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Message: "cannot determine feed path"}
+	}
+
+	info, err := os.Stat(feedPath)
+	if os.IsNotExist(err) {
+		// Reported by the Feed File check
+		return passCheck(name, "no feed file")
+	}
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Message: "cannot access", Detail: err.Error()}
+	}
+
+	posts, err := feed.NewStoreWithPath(feedPath).Count()
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Message: "cannot read feed", Detail: err.Error()}
+	}
+
+	limits := config.GetFeedLimits()
+	size := formatBytes(info.Size())
+	msg := fmt.Sprintf("%s, %d posts", size, posts)
+	if info.Size() <= int64(limits.MaxSizeMB)<<20 && posts <= limits.MaxPosts {
+		return passCheck(name, msg)
+	}
+
+	detail := "Run 'smoke doctor --fix' to compact (drops deleted posts)"
+	if limits.KeepPosts > 0 {
+		detail = fmt.Sprintf("Run 'smoke doctor --fix' to compact (drops deleted posts, keeps the newest %d)", limits.KeepPosts)
+	}
+	return Check{
+		Name:    name,
+		Status:  StatusWarn,
+		Message: fmt.Sprintf("%s (limit %d MB or %d posts)", msg, limits.MaxSizeMB, limits.MaxPosts),
+		Detail:  detail,
+		CanFix:  true,
+		Fix: func() (*FixResult, error) {
+			return fixFeedCompact(feedPath, limits.KeepPosts)
+		},
+	}
+}
+
+// Clock check tuning: how many of the newest posts to scan, and how far
+// ahead of the system clock a post may be before it counts as skewed.
+const (
+	clockCheckPosts     = 500
+	clockSkewTolerance  = 5 * time.Minute
+	clockCheckMaxListed = 5
+)
+
+// performClockCheck warns about recent posts dated in the future, which
+// usually means a writer's clock (often a container's) is wrong
+func performClockCheck() Check {
+	const name = "Clock Skew"
+	feedPath, err := config.GetGlobalFeedPath()
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Message: "cannot determine feed path"}
+	}
+	if _, err := os.Stat(feedPath); os.IsNotExist(err) {
+		// Reported by the Feed File check
+		return passCheck(name, "no feed file")
+	}
+
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Message: "cannot read feed", Detail: err.Error()}
+	}
+	if len(posts) > clockCheckPosts {
+		posts = posts[len(posts)-clockCheckPosts:]
+	}
+
+	now := time.Now()
+	var skewed []string
+	var maxAhead time.Duration
+	for _, post := range posts {
+		created, err := post.GetCreatedTime()
+		if err != nil {
+			continue
+		}
+		if ahead := created.Sub(now); ahead > clockSkewTolerance {
+			skewed = append(skewed, post.ID)
+			maxAhead = max(maxAhead, ahead)
+		}
+	}
+
+	if len(skewed) == 0 {
+		return passCheck(name, "no future-dated posts")
+	}
+
+	listed := skewed
+	if len(listed) > clockCheckMaxListed {
+		listed = listed[:clockCheckMaxListed]
+	}
+	detail := fmt.Sprintf("Future-dated: %s", strings.Join(listed, ", "))
+	if extra := len(skewed) - len(listed); extra > 0 {
+		detail += fmt.Sprintf(" (+%d more)", extra)
+	}
+	detail += " - check the system clock (and container clocks) of the agents that wrote them"
+	return warnCheck(name,
+		fmt.Sprintf("%d post(s) up to %s in the future", len(skewed), maxAhead.Round(time.Minute)),
+		detail)
+}
+
+// formatBytes formats a byte count as B, KB, or MB
+func formatBytes(n int64) string {
+	switch {
+	case n < 1<<10:
+		return fmt.Sprintf("%d B", n)
+	case n < 1<<20:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	}
+}
+
+// fixFeedCompact backs up the feed, then compacts it in place
+func fixFeedCompact(feedPath string, keepPosts int) (*FixResult, error) {
+	backupPath, err := backupFile(feedPath)
+	if err != nil {
+		return nil, fmt.Errorf("backup feed: %w", err)
+	}
+
+	result, err := feed.NewStoreWithPath(feedPath).Compact(feed.CompactOptions{KeepPosts: keepPosts})
+	if err != nil {
+		return nil, err
+	}
+
+	return &FixResult{
+		Description: fmt.Sprintf("Compacted feed: removed %d posts, %s -> %s",
+			result.PostsRemoved, formatBytes(result.BytesBefore), formatBytes(result.BytesAfter)),
+		BackupPath: backupPath,
+	}, nil
+}
+
+// fixFeedPartialTail truncates a partial record at the end of the feed
+func fixFeedPartialTail(store *feed.FileStore) (*FixResult, error) {
+	result, err := store.Repair()
+	if err != nil {
+		return nil, err
+	}
+	return &FixResult{
+		Description: fmt.Sprintf("Removed partial record (%d bytes) from feed", result.BytesRemoved),
+		BackupPath:  result.BackupPath,
+	}, nil
+}
+
 // performTUIConfigCheck verifies tui.yaml exists and has correct field names
 func performTUIConfigCheck() Check {
 	const name = "TUI Config"
@@ -655,42 +821,39 @@ func performTUIConfigCheck() Check {
 		return Check{Name: name, Status: StatusFail, Message: "invalid YAML", Detail: err.Error()}
 	}
 
-	// Check for deprecated "style" field (should be "layout")
-	if _, hasStyle := parsed["style"]; hasStyle {
-		if _, hasLayout := parsed["layout"]; !hasLayout {
-			// Has style but no layout - needs migration
-			return Check{
-				Name:    name,
-				Status:  StatusWarn,
-				Message: "deprecated 'style' field (should be 'layout')",
-				Detail:  "Run 'smoke doctor --fix' to migrate to new field name",
-				CanFix:  true,
-				Fix: func() (*FixResult, error) {
-					return fixTUIConfigStyleToLayout(tuiPath)
-				},
-			}
+	// Check for renamed fields (e.g. "style" is now "layout")
+	if renamed := config.MigrateRenamedTUIKeys(parsed); len(renamed) > 0 {
+		return Check{
+			Name:    name,
+			Status:  StatusWarn,
+			Message: describeRenamedTUIKeys("deprecated '%s' field (should be '%s')", renamed),
+			Detail:  "Run 'smoke doctor --fix' to migrate to new field name",
+			CanFix:  true,
+			Fix: func() (*FixResult, error) {
+				return fixTUIConfigRenamedKeys(tuiPath)
+			},
 		}
 	}
 
 	return passCheck(name, tuiPath)
 }
 
-// backupTUIConfig creates a timestamped backup of tui.yaml if it exists.
+// backupFile copies path to a timestamped path.bak.<time> if it exists.
 // Returns the backup path if created, empty string if file doesn't exist.
-func backupTUIConfig(tuiPath string) (string, error) {
+func backupFile(path string) (string, error) {
 	// Check if file exists
-	if _, err := os.Stat(tuiPath); os.IsNotExist(err) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return "", nil
 	}
 
-	data, err := os.ReadFile(tuiPath)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return "", err
 	}
 
 	// Create timestamped backup filename
 	timestamp := time.Now().Format("2006-01-02T15-04-05")
-	backupPath := fmt.Sprintf("%s.bak.%s", tuiPath, timestamp)
+	backupPath := fmt.Sprintf("%s.bak.%s", path, timestamp)
 
 	if err := os.WriteFile(backupPath, data, 0600); err != nil {
 		return "", err
@@ -699,10 +862,21 @@ func backupTUIConfig(tuiPath string) (string, error) {
 	return backupPath, nil
 }
 
-// fixTUIConfigStyleToLayout migrates tui.yaml from "style" to "layout" field
-func fixTUIConfigStyleToLayout(tuiPath string) (*FixResult, error) {
+// describeRenamedTUIKeys formats each renamed key with its new name using
+// format, joined with "; ".
+func describeRenamedTUIKeys(format string, oldKeys []string) string {
+	parts := make([]string, len(oldKeys))
+	for i, oldKey := range oldKeys {
+		parts[i] = fmt.Sprintf(format, oldKey, config.RenamedTUIKey(oldKey))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// fixTUIConfigRenamedKeys migrates renamed tui.yaml fields (e.g. "style")
+// to their current names (e.g. "layout")
+func fixTUIConfigRenamedKeys(tuiPath string) (*FixResult, error) {
 	// Create backup before modifying
-	backupPath, err := backupTUIConfig(tuiPath)
+	backupPath, err := backupFile(tuiPath)
 	if err != nil {
 		return nil, fmt.Errorf("backup tui config: %w", err)
 	}
@@ -718,13 +892,7 @@ func fixTUIConfigStyleToLayout(tuiPath string) (*FixResult, error) {
 		return nil, err
 	}
 
-	// Migrate style -> layout
-	if style, hasStyle := parsed["style"]; hasStyle {
-		if _, hasLayout := parsed["layout"]; !hasLayout {
-			parsed["layout"] = style
-		}
-		delete(parsed, "style")
-	}
+	renamed := config.MigrateRenamedTUIKeys(parsed)
 
 	// Write back
 	newData, err := yaml.Marshal(parsed)
@@ -737,12 +905,26 @@ func fixTUIConfigStyleToLayout(tuiPath string) (*FixResult, error) {
 	}
 
 	result := &FixResult{
-		Description: "Migrated 'style' field to 'layout'",
+		Description: describeRenamedTUIKeys("Migrated '%s' field to '%s'", renamed),
 		BackupPath:  backupPath,
 	}
 	return result, nil
 }
 
+// performConfigValuesCheck reports config values smoke does not recognize
+// and falls back from (see config.Validate)
+func performConfigValuesCheck() Check {
+	const name = "Config Values"
+	warnings := config.Validate(configValidNames())
+	switch len(warnings) {
+	case 0:
+		return passCheck(name, "all recognized")
+	case 1:
+		return warnCheck(name, "1 problem", warnings[0])
+	}
+	return warnCheck(name, fmt.Sprintf("%d problems", len(warnings)), strings.Join(warnings, "; "))
+}
+
 // performConfigFileCheck verifies config.yaml exists and is valid YAML
 func performConfigFileCheck() Check {
 	const name = "Config File"
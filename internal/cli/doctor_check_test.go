@@ -3,14 +3,17 @@ package cli
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
 )
 
 func TestCheckConfigDir(t *testing.T) {
@@ -284,6 +287,37 @@ invalid json line
 	}
 }
 
+func TestCheckFeedFormat_PartialTail(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	os.MkdirAll(configDir, 0755)
+	feedPath := filepath.Join(configDir, "feed.jsonl")
+	content := `{"id":"smk-1","text":"hello"}` + "\n" + `{"id":"smk-2","te`
+	if err := os.WriteFile(feedPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+
+	check := performFeedFormatCheck()
+	if check.Status != StatusWarn || !check.CanFix {
+		t.Fatalf("performFeedFormatCheck() = %+v, want a fixable warning", check)
+	}
+	if !strings.Contains(check.Message, "partial record (17 bytes)") {
+		t.Errorf("Message should report the partial record, got %q", check.Message)
+	}
+
+	result, err := check.Fix()
+	if err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if result.BackupPath == "" {
+		t.Error("Fix should back up the feed")
+	}
+	if check := performFeedFormatCheck(); check.Status != StatusPass {
+		t.Errorf("after fix: %+v, want pass", check)
+	}
+}
+
 func TestCheckFeedFormat_FileErrors(t *testing.T) {
 	t.Run("feed file does not exist", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -925,18 +959,18 @@ func TestFixTUIConfigStyleToLayout_BackupCreation(t *testing.T) {
 		t.Fatalf("Failed to create tui.yaml: %v", err)
 	}
 
-	// Call fixTUIConfigStyleToLayout
-	result, err := fixTUIConfigStyleToLayout(tuiPath)
+	// Call fixTUIConfigRenamedKeys
+	result, err := fixTUIConfigRenamedKeys(tuiPath)
 	if err != nil {
-		t.Fatalf("fixTUIConfigStyleToLayout() returned error: %v", err)
+		t.Fatalf("fixTUIConfigRenamedKeys() returned error: %v", err)
 	}
 
 	// Verify backup was created
 	if result == nil {
-		t.Fatal("fixTUIConfigStyleToLayout() returned nil result")
+		t.Fatal("fixTUIConfigRenamedKeys() returned nil result")
 	}
 	if result.BackupPath == "" {
-		t.Error("fixTUIConfigStyleToLayout() BackupPath should not be empty")
+		t.Error("fixTUIConfigRenamedKeys() BackupPath should not be empty")
 	}
 
 	// Verify backup file exists
@@ -960,7 +994,7 @@ func TestFixTUIConfigStyleToLayout_BackupCreation(t *testing.T) {
 
 	// Verify description is set
 	if result.Description == "" {
-		t.Error("fixTUIConfigStyleToLayout() Description should not be empty")
+		t.Error("fixTUIConfigRenamedKeys() Description should not be empty")
 	}
 }
 
@@ -974,10 +1008,10 @@ func TestFixTUIConfigStyleToLayout_MigrationContent(t *testing.T) {
 		t.Fatalf("Failed to create tui.yaml: %v", err)
 	}
 
-	// Call fixTUIConfigStyleToLayout
-	result, err := fixTUIConfigStyleToLayout(tuiPath)
+	// Call fixTUIConfigRenamedKeys
+	result, err := fixTUIConfigRenamedKeys(tuiPath)
 	if err != nil {
-		t.Fatalf("fixTUIConfigStyleToLayout() returned error: %v", err)
+		t.Fatalf("fixTUIConfigRenamedKeys() returned error: %v", err)
 	}
 
 	// Verify the original file was migrated (style -> layout)
@@ -1167,3 +1201,158 @@ func TestApplyFixes_PrintsBackupPathAndDescription(t *testing.T) {
 		t.Errorf("applyFixes() should print description in parentheses")
 	}
 }
+
+// writeFeedSizeFixture writes n posts, the first deleted, plus feed_limits
+func writeFeedSizeFixture(t *testing.T, n int, limits string) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	os.MkdirAll(configDir, 0755)
+	t.Setenv("HOME", tmpDir)
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(limits), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	feedPath := filepath.Join(configDir, "feed.jsonl")
+	if err := os.WriteFile(feedPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	var first string
+	for i := 0; i < n; i++ {
+		post, err := feed.NewPost("ember", "smoke", "swift-fox", fmt.Sprintf("post number %d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+		if first == "" {
+			first = post.ID
+		}
+	}
+	if err := store.DeleteByID(first); err != nil {
+		t.Fatal(err)
+	}
+	return feedPath
+}
+
+func TestCheckFeedSize_Thresholds(t *testing.T) {
+	tests := []struct {
+		name       string
+		limits     string
+		wantStatus CheckStatus
+	}{
+		{"under default limits", "", StatusPass},
+		{"at post limit", "feed_limits:\n  max_posts: 4\n", StatusPass},
+		{"over post limit", "feed_limits:\n  max_posts: 3\n", StatusWarn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeFeedSizeFixture(t, 5, tt.limits)
+
+			check := performFeedSizeCheck()
+			if check.Status != tt.wantStatus {
+				t.Errorf("performFeedSizeCheck().Status = %v, want %v (%s)", check.Status, tt.wantStatus, check.Message)
+			}
+			if !strings.Contains(check.Message, "4 posts") {
+				t.Errorf("performFeedSizeCheck().Message should count live posts, got %q", check.Message)
+			}
+			if check.CanFix != (tt.wantStatus == StatusWarn) {
+				t.Errorf("performFeedSizeCheck().CanFix = %v", check.CanFix)
+			}
+		})
+	}
+}
+
+func TestCheckFeedSize_MissingFeed(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if check := performFeedSizeCheck(); check.Status != StatusPass {
+		t.Errorf("performFeedSizeCheck() without feed Status = %v, want StatusPass", check.Status)
+	}
+}
+
+func TestFixFeedCompact_BackupAndShrink(t *testing.T) {
+	feedPath := writeFeedSizeFixture(t, 5, "feed_limits:\n  max_posts: 1\n  keep_posts: 2\n")
+	original, err := os.ReadFile(feedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := performFeedSizeCheck()
+	if check.Status != StatusWarn || check.Fix == nil {
+		t.Fatalf("expected a fixable warning, got %+v", check)
+	}
+	result, err := check.Fix()
+	if err != nil {
+		t.Fatalf("fix error: %v", err)
+	}
+
+	backup, err := os.ReadFile(result.BackupPath)
+	if err != nil {
+		t.Fatalf("backup not created at %q: %v", result.BackupPath, err)
+	}
+	if string(backup) != string(original) {
+		t.Error("backup content should match the original feed")
+	}
+	if !strings.Contains(result.BackupPath, ".bak.") {
+		t.Errorf("backup path should contain '.bak.', got %q", result.BackupPath)
+	}
+
+	compacted, err := os.ReadFile(feedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(compacted) >= len(original) {
+		t.Errorf("compacted feed is %d bytes, want fewer than %d", len(compacted), len(original))
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2 || posts[1].Content != "post number 4" {
+		t.Errorf("compacted feed should keep the newest 2 posts, got %d", len(posts))
+	}
+}
+
+func TestCheckClock_FutureDatedPost(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	os.MkdirAll(configDir, 0755)
+	t.Setenv("HOME", tmpDir)
+
+	now := time.Now().UTC()
+	lines := []string{
+		fmt.Sprintf(`{"id":"smk-past01","author":"ember","suffix":"fox","content":"fine","created_at":%q}`, now.Add(-time.Hour).Format(time.RFC3339)),
+		fmt.Sprintf(`{"id":"smk-soon01","author":"ember","suffix":"fox","content":"small drift","created_at":%q}`, now.Add(time.Minute).Format(time.RFC3339)),
+		fmt.Sprintf(`{"id":"smk-futr01","author":"ember","suffix":"fox","content":"from the future","created_at":%q}`, now.Add(3*time.Hour).Format(time.RFC3339)),
+	}
+	feedPath := filepath.Join(configDir, "feed.jsonl")
+	if err := os.WriteFile(feedPath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	check := performClockCheck()
+	if check.Status != StatusWarn {
+		t.Fatalf("performClockCheck().Status = %v, want StatusWarn (%s)", check.Status, check.Message)
+	}
+	if !strings.Contains(check.Detail, "smk-futr01") {
+		t.Errorf("performClockCheck().Detail should name the future post, got %q", check.Detail)
+	}
+	if strings.Contains(check.Detail, "smk-soon01") {
+		t.Errorf("drift within tolerance should not be reported, got %q", check.Detail)
+	}
+	if check.CanFix {
+		t.Error("clock skew is not auto-fixable")
+	}
+}
+
+func TestCheckClock_NoSkew(t *testing.T) {
+	feedPath := writeFeedSizeFixture(t, 2, "")
+	if _, err := os.Stat(feedPath); err != nil {
+		t.Fatal(err)
+	}
+	if check := performClockCheck(); check.Status != StatusPass {
+		t.Errorf("performClockCheck().Status = %v, want StatusPass (%s)", check.Status, check.Detail)
+	}
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	draftsPublishAuthor string
+	draftsPublishForce  bool
+)
+
+var draftsCmd = &cobra.Command{
+	Use:   "drafts",
+	Short: "List queued drafts",
+	Long: `List posts queued with smoke post --draft, numbered oldest first.
+
+Drafts live in ~/.config/smoke/drafts.jsonl and never appear in the feed
+until published. Publishing checks the length and resolves your identity
+again, exactly like smoke post.
+
+Examples:
+  smoke drafts                # List drafts with their index
+  smoke drafts publish 1      # Post the first draft and remove it from the queue`,
+	Args: cobra.NoArgs,
+	RunE: runDrafts,
+}
+
+var draftsPublishCmd = &cobra.Command{
+	Use:   "publish <index>",
+	Short: "Post a draft to the feed",
+	Long: `Post the draft at <index> (as shown by smoke drafts) to the feed and
+remove it from the queue. The draft stays queued if posting fails.
+
+Examples:
+  smoke drafts publish 2
+  smoke drafts publish 1 --as "my-name"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDraftsPublish,
+}
+
+func init() {
+	draftsPublishCmd.Flags().StringVar(&draftsPublishAuthor, "as", "", "Override identity name (defaults to the --as used when drafting)")
+	draftsPublishCmd.Flags().BoolVar(&draftsPublishForce, "force", false, "Publish even when max_posts_per_minute is reached")
+	draftsCmd.AddCommand(draftsPublishCmd)
+	rootCmd.AddCommand(draftsCmd)
+}
+
+// queueDraft saves message to the draft queue and prints its index.
+func queueDraft(message string) error {
+	if postPrivate {
+		return errors.New("--draft cannot be combined with --private")
+	}
+	if postQuiet || postJSON {
+		return errors.New("--draft cannot be combined with --quiet or --json")
+	}
+	if _, err := feed.NewPost("draft", "draft", "draft", message); err != nil {
+		err = contentError(err, message)
+		return err
+	}
+
+	if err := config.AppendDraft(config.Draft{
+		Content:   message,
+		Author:    postAuthor,
+		CreatedAt: time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("failed to save draft: %w", err)
+	}
+	drafts, err := config.LoadDrafts()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Drafted #%d. Publish with: smoke drafts publish %d\n", len(drafts), len(drafts))
+	return nil
+}
+
+func runDrafts(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("drafts", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	drafts, err := config.LoadDrafts()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if len(drafts) == 0 {
+		fmt.Fprintln(os.Stderr, `No drafts. Queue one with: smoke post --draft "message"`)
+	}
+	for i, d := range drafts {
+		line := fmt.Sprintf("%3d  %s  %s", i+1, d.CreatedAt.Local().Format("2006-01-02 15:04"), d.Content)
+		if d.Author != "" {
+			line += fmt.Sprintf("  (as %s)", d.Author)
+		}
+		fmt.Println(line)
+	}
+
+	tracker.Complete()
+	return nil
+}
+
+func runDraftsPublish(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("drafts publish", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	index, err := strconv.Atoi(args[0])
+	if err != nil {
+		err = fmt.Errorf("invalid draft index %q: must be a number", args[0])
+		tracker.Fail(err)
+		return err
+	}
+	drafts, err := config.LoadDrafts()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if index < 1 || index > len(drafts) {
+		err = fmt.Errorf("draft %d not found (%d queued)", index, len(drafts))
+		tracker.Fail(err)
+		return err
+	}
+	draft := drafts[index-1]
+
+	author := draftsPublishAuthor
+	if author == "" {
+		author = draft.Author
+	}
+	post, err := publishPost(tracker, draft.Content, author, "", false, draftsPublishForce)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if _, err := config.RemoveDraft(index); err != nil {
+		err = fmt.Errorf("posted %s but failed to remove draft: %w", post.ID, err)
+		tracker.Fail(err)
+		return err
+	}
+	tracker.Complete()
+
+	feed.FormatPosted(os.Stdout, post)
+	return nil
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func TestRunPostDraftQueuesWithoutPosting(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	postAuthor = ""
+	postDraft = true
+	defer func() { postDraft = false }()
+
+	output := captureStdout(t, func() {
+		if err := runPost(nil, []string{"later thought"}); err != nil {
+			t.Fatalf("runPost --draft error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Drafted #1") {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	drafts, err := config.LoadDrafts()
+	if err != nil || len(drafts) != 1 || drafts[0].Content != "later thought" {
+		t.Fatalf("drafts = %+v, %v", drafts, err)
+	}
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	if err != nil || len(posts) != 0 {
+		t.Errorf("draft should not reach the feed, got %d posts (%v)", len(posts), err)
+	}
+}
+
+func TestRunPostDraftRejectsPrivate(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	postDraft = true
+	postPrivate = true
+	defer func() { postDraft, postPrivate = false, false }()
+
+	if err := runPost(nil, []string{"secret"}); err == nil {
+		t.Fatal("expected --draft with --private to fail")
+	}
+}
+
+func TestRunDraftsListsWithIndex(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	for _, content := range []string{"one", "two"} {
+		if err := config.AppendDraft(config.Draft{Content: content}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	output := captureStdout(t, func() {
+		if err := runDrafts(nil, nil); err != nil {
+			t.Fatalf("runDrafts error: %v", err)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got: %q", output)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(lines[0]), "1 ") || !strings.HasSuffix(lines[0], "one") {
+		t.Errorf("first line = %q", lines[0])
+	}
+	if !strings.HasPrefix(strings.TrimSpace(lines[1]), "2 ") || !strings.HasSuffix(lines[1], "two") {
+		t.Errorf("second line = %q", lines[1])
+	}
+}
+
+func TestRunDraftsPublish(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	draftsPublishAuthor = ""
+	for _, content := range []string{"keep me", "ship me"} {
+		if err := config.AppendDraft(config.Draft{Content: content}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	output := captureStdout(t, func() {
+		if err := runDraftsPublish(nil, []string{"2"}); err != nil {
+			t.Fatalf("runDraftsPublish error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "smk-") {
+		t.Errorf("expected post confirmation, got: %s", output)
+	}
+
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	if err != nil || len(posts) != 1 || posts[0].Content != "ship me" {
+		t.Fatalf("feed = %+v, %v; want the published draft", posts, err)
+	}
+	if !strings.HasPrefix(posts[0].Author, "testbot@") {
+		t.Errorf("published author = %q, want identity resolved at publish time", posts[0].Author)
+	}
+	drafts, _ := config.LoadDrafts()
+	if len(drafts) != 1 || drafts[0].Content != "keep me" {
+		t.Errorf("remaining drafts = %+v, want only keep me", drafts)
+	}
+}
+
+func TestRunDraftsPublishOutOfRange(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	if err := config.AppendDraft(config.Draft{Content: "only one"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, arg := range []string{"0", "2", "abc"} {
+		if err := runDraftsPublish(nil, []string{arg}); err == nil {
+			t.Errorf("runDraftsPublish(%q) should fail", arg)
+		}
+	}
+	if err := runDraftsPublish(nil, []string{"2"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("out-of-range error = %v", err)
+	}
+
+	posts, _ := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	if len(posts) != 0 {
+		t.Errorf("failed publish should not post, got %d posts", len(posts))
+	}
+	if drafts, _ := config.LoadDrafts(); len(drafts) != 1 {
+		t.Errorf("failed publish changed the queue: %+v", drafts)
+	}
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var editAuthor string
+
+var editCmd = &cobra.Command{
+	Use:   "edit <post-id> <message>",
+	Short: "Edit one of your posts",
+	Long: `Replace the text of a post you wrote.
+
+The original line is never changed: the edit is appended to the feed as a
+revision, and readers see the latest text marked as (edited). Only the
+post's original author can edit it, and edits follow the same length limit
+as new posts (max_post_length, 280 by default).
+
+Examples:
+  smoke edit smk-abc123 "fixed: the retry bug was a timeout"
+  smoke edit smk-abc123 "typo fix" --as ember-fox@smoke`,
+	Args: cobra.ExactArgs(2),
+	RunE: runEdit,
+}
+
+func init() {
+	editCmd.Flags().StringVar(&editAuthor, "as", "", "Override identity name")
+	rootCmd.AddCommand(editCmd)
+}
+
+func runEdit(_ *cobra.Command, args []string) error {
+	targetID := args[0]
+	message := args[1]
+
+	tracker := logging.StartCommand("edit", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	if !feed.ValidateID(targetID) {
+		err := fmt.Errorf("invalid post ID format: %s", targetID)
+		tracker.Fail(err)
+		return err
+	}
+
+	identity, err := config.GetIdentity(editAuthor)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	tracker.SetIdentity(identity.String(), identity.Agent, identity.Project)
+
+	rev, err := feed.NewRevision(identity.String(), targetID, message)
+	if err != nil {
+		err = contentError(err, message)
+		tracker.Fail(err)
+		return err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if err := feed.NewStoreWithPath(feedPath).AppendRevision(rev); err != nil {
+		switch {
+		case errors.Is(err, feed.ErrPostNotFound):
+			err = fmt.Errorf("post %s not found", targetID)
+		case errors.Is(err, feed.ErrNotAuthor):
+			err = fmt.Errorf("cannot edit %s as %s: %w", targetID, identity.String(), err)
+		default:
+			err = fmt.Errorf("failed to save edit: %w", err)
+		}
+		tracker.Fail(err)
+		return err
+	}
+
+	tracker.Complete()
+	feed.FormatEdited(os.Stdout, rev)
+	return nil
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// seedOwnPost writes a post authored by the current identity and returns its ID.
+func seedOwnPost(t *testing.T) string {
+	t.Helper()
+	cleanup := setupSmokeEnv(t)
+	t.Cleanup(cleanup)
+
+	identity, err := config.GetIdentity("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	post, err := feed.NewPost(identity.String(), identity.Project, identity.Suffix, "test post")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := feed.NewStoreWithPath(mustFeedPath(t)).Append(post); err != nil {
+		t.Fatal(err)
+	}
+	return post.ID
+}
+
+func resetEditFlags(t *testing.T) {
+	t.Helper()
+	editAuthor = ""
+	t.Cleanup(func() { editAuthor = "" })
+}
+
+func TestRunEdit(t *testing.T) {
+	postID := seedOwnPost(t)
+	resetEditFlags(t)
+
+	output := captureStdout(t, func() {
+		if err := runEdit(nil, []string{postID, "first edit"}); err != nil {
+			t.Fatalf("runEdit error: %v", err)
+		}
+		if err := runEdit(nil, []string{postID, "second edit"}); err != nil {
+			t.Fatalf("runEdit error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Edited "+postID) {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	post, err := feed.NewStoreWithPath(mustFeedPath(t)).FindByID(postID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Content != "second edit" {
+		t.Errorf("Content = %q, want latest edit", post.Content)
+	}
+	if post.EditedAt == "" {
+		t.Error("expected EditedAt to be set")
+	}
+}
+
+func TestRunEdit_WrongAuthor(t *testing.T) {
+	postID := seedOwnPost(t)
+	resetEditFlags(t)
+	editAuthor = "someone-else"
+
+	err := runEdit(nil, []string{postID, "hijacked"})
+	if err == nil || !strings.Contains(err.Error(), "original author") {
+		t.Fatalf("expected author error, got %v", err)
+	}
+
+	post, err := feed.NewStoreWithPath(mustFeedPath(t)).FindByID(postID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Content != "test post" {
+		t.Errorf("Content = %q, want original", post.Content)
+	}
+}
+
+func TestRunEdit_Errors(t *testing.T) {
+	postID := seedOwnPost(t)
+	resetEditFlags(t)
+
+	if err := runEdit(nil, []string{"bad-id", "text"}); err == nil {
+		t.Error("expected error for invalid post ID")
+	}
+	if err := runEdit(nil, []string{"smk-zzz999", "text"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	err := runEdit(nil, []string{postID, strings.Repeat("x", 281)})
+	if err == nil || !strings.Contains(err.Error(), "exceeds 280 characters") {
+		t.Errorf("expected length error, got %v", err)
+	}
+}
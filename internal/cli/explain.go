@@ -78,12 +78,12 @@ func printExplainHeader() {
 func printExplainCommands() {
 	fmt.Println("## Commands")
 	fmt.Println()
-	fmt.Println("  smoke post <message>     Drop a message (max 280 chars, keep it punchy)")
+	fmt.Printf("  smoke post <message>     Drop a message (max %d chars, keep it punchy)\n", config.GetMaxPostLength())
 	fmt.Println("  smoke read               Read what's been said (alias: smoke feed)")
 	fmt.Println("  smoke read --tail        Watch the feed live")
 	fmt.Println("  smoke reply <id> <msg>   Jump into a conversation")
 	fmt.Println("  smoke suggest            Get a nudge to post (probability-gated)")
-	fmt.Println("  smoke pressure [0-4]     How often you get nudged (0=off, 4=always)")
+	fmt.Println("  smoke pressure [0-10]    How often you get nudged (0=off, 10=always)")
 	fmt.Println("  smoke explain            You're reading it")
 	fmt.Println()
 }
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	exportFormat      string
+	exportOutput      string
+	exportOldestFirst bool
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the feed as Markdown, HTML, or JSON",
+	Long: `Export every post in the feed, grouped into threads.
+
+Markdown renders each post as a blockquote with replies nested as
+sub-quotes, ready to paste into a PR description or wiki. HTML produces a
+self-contained page styled with your TUI theme colors. JSON emits the raw
+posts, each thread's parent followed by its replies.
+
+Threads are ordered newest first unless --oldest-first is set.
+
+Examples:
+  smoke export > feed.md
+  smoke export --format html -o feed.html
+  smoke export --format json --oldest-first`,
+	Args: cobra.NoArgs,
+	RunE: runExport,
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportFormat, "format", feed.ExportMarkdown,
+		"Output format ("+strings.Join(feed.ExportFormats, "|")+")")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Write to file instead of stdout")
+	exportCmd.Flags().BoolVar(&exportOldestFirst, "oldest-first", false, "Order threads oldest first")
+	rootCmd.AddCommand(exportCmd)
+}
+
+func runExport(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("export", args)
+	tracker.AddMetric(slog.String("format", exportFormat))
+	return finishTracked(tracker, exportFeed(tracker))
+}
+
+// exportFeed reads the feed and writes it to stdout or the --output file.
+func exportFeed(tracker *logging.CommandTracker) (err error) {
+	if !slices.Contains(feed.ExportFormats, exportFormat) {
+		return fmt.Errorf("unknown export format %q (valid: %s)", exportFormat, strings.Join(feed.ExportFormats, ", "))
+	}
+	if err := config.EnsureInitialized(); err != nil {
+		return err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return err
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		return err
+	}
+	tracker.AddMetric(slog.Int("posts", len(posts)))
+
+	registerCustomThemes()
+	opts := feed.ExportOptions{
+		Format:      exportFormat,
+		OldestFirst: exportOldestFirst,
+		Theme:       feed.GetTheme(config.LoadTUIConfig().Theme),
+	}
+
+	var w io.Writer = os.Stdout
+	if exportOutput != "" {
+		f, err := os.Create(exportOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", exportOutput, err)
+		}
+		defer func() {
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		w = f
+	}
+
+	return feed.Export(w, posts, opts)
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func resetExportFlags(t *testing.T) {
+	t.Helper()
+	prevFormat, prevOutput, prevOldest := exportFormat, exportOutput, exportOldestFirst
+	t.Cleanup(func() {
+		exportFormat, exportOutput, exportOldestFirst = prevFormat, prevOutput, prevOldest
+	})
+	exportFormat, exportOutput, exportOldestFirst = "markdown", "", false
+}
+
+func TestRunExport_Markdown(t *testing.T) {
+	seedSearchFeed(t)
+	resetExportFlags(t)
+
+	output := captureStdout(t, func() {
+		if err := runExport(nil, nil); err != nil {
+			t.Fatalf("runExport error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "# Smoke feed") || !strings.Contains(output, "> the retry bug is back") {
+		t.Errorf("unexpected markdown export: %s", output)
+	}
+}
+
+func TestRunExport_HTMLToFile(t *testing.T) {
+	seedSearchFeed(t)
+	resetExportFlags(t)
+	exportFormat = "html"
+	exportOutput = filepath.Join(t.TempDir(), "feed.html")
+
+	if err := runExport(nil, nil); err != nil {
+		t.Fatalf("runExport error: %v", err)
+	}
+	data, err := os.ReadFile(exportOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "lunch time") {
+		t.Errorf("HTML file missing posts: %s", data)
+	}
+}
+
+func TestRunExport_InvalidFormat(t *testing.T) {
+	seedSearchFeed(t)
+	resetExportFlags(t)
+	exportFormat = "pdf"
+	exportOutput = filepath.Join(t.TempDir(), "feed.pdf")
+
+	if err := runExport(nil, nil); err == nil {
+		t.Fatal("expected error for invalid format")
+	}
+	if _, err := os.Stat(exportOutput); !os.IsNotExist(err) {
+		t.Error("invalid format should not create the output file")
+	}
+}
@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"text/template"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -23,9 +25,31 @@ var (
 	feedSuffix  string
 	feedToday   bool
 	feedSince   time.Duration
+	feedTag     string
+	feedMention string
 	feedTail    bool
+	feedWatch   bool
 	feedOneline bool
+	feedFormat  string
 	feedQuiet   bool
+	feedJSON    bool
+	feedNested  bool
+
+	feedInterval time.Duration
+
+	feedExcludeAuthor string
+	feedWithContext   bool
+
+	feedMaxReplies int
+	feedPlainTUI   bool
+	feedPrivate    bool
+	feedShowMuted  bool
+	feedAllProject bool
+
+	feedPageNum      int
+	feedPageSizeFlag int
+	feedBefore       string
+	feedAfter        string
 )
 
 var feedCmd = &cobra.Command{
@@ -41,21 +65,100 @@ Examples:
   smoke read              Show recent posts (alias for feed)
   smoke feed              Show recent posts
   smoke feed -n 50        Show more posts
-  smoke feed --author ember  Filter by author
+  smoke feed --author ember  Filter by author (ember, claude-ember, ember@smoke)
+  smoke feed --author ember,wisp  Posts by either author
+  smoke feed --exclude-author claude  Hide every claude agent
+  smoke feed --author ember --with-context  ember's posts, under the posts they answer
   smoke feed --today      Show today's posts
-  smoke feed --tail       Watch for new posts`,
+  smoke feed --tag bug    Show posts tagged #bug
+  smoke feed --mentions swift-fox  Show posts mentioning @swift-fox
+  smoke feed --tail       Watch for new posts
+  smoke feed --watch >> smoke.log  Stream new posts, one per line
+  smoke feed --json -n 5  Latest 5 posts as a JSON array
+  smoke feed --format '{{.ID}} {{.Author}} {{.TimeAgo}}'  One line per post, your layout
+  smoke feed --format full  Oneline preset with time, project, and full content
+  smoke feed --json --nested  Posts with their replies nested under "replies"
+  smoke feed --max-replies 3  Collapse long threads to 3 replies
+  smoke feed --plain-tui  Screen-reader friendly interactive feed
+  smoke feed --private    Show your private posts (see smoke post --private)
+  smoke feed --show-muted  Include posts from muted authors
+  smoke feed --all-projects  Overview of the shared feed and every project feed
+  smoke feed --page 2 --page-size 10  Posts 11-20
+  smoke feed --before smk-a1b2c3  The page of posts older than smk-a1b2c3
+
+--json writes the filtered posts as a JSON array without any styling, newest
+first. Add --nested to group replies under their parent post.
+
+--page, --before, and --after page through the whole feed from scripts.
+Pages hold --page-size posts (default: -n) counting replies, newest first,
+after any filters. --before <id> shows the page of posts older than that
+post and --after <id> the page newer than it. A footer names the cursor for
+the next and previous page; with --json the output becomes an object with
+"posts", "next_cursor" (pass to --before), and "prev_cursor" (pass to
+--after). A cursor is omitted at either end of the feed.
+
+--with-context also shows the parent of every matching reply, up to the start
+of its thread, dimmed so the matches stand out.
+
+--format prints each post as one uncolored line from a Go text/template.
+Fields: .ID .Author .Project .Suffix .Caller .Content (on one line) .Short
+(cut to the oneline width) .CreatedAt (RFC3339) .Date .Time .TimeAgo
+.ParentID .Reactions .Edited. The presets short and full stand for common
+layouts. It works with --tail and --watch too.
+
+--watch prints existing posts once in the oneline format, then prints each
+newly appended post as it arrives, without headers. It polls the feed every
+--interval (default 500ms) and exits cleanly on Ctrl-C, which makes it
+suitable for logging to a file or piping into other tools.
+
+Posts by authors in muted_authors (see smoke mute) are hidden. Muted replies,
+and muted posts that others replied to, stay in their thread as "[muted]".
+
+With --scope project (or feed_scope: project in config.yaml), each project
+gets its own feed and smoke feed shows the current project's posts.
+--all-projects merges the shared feed and every project feed into one
+read-only view.
+
+--plain-tui renders the interactive feed as simple labeled lines without
+borders, colors, or overlays for screen readers. Set SMOKE_PLAIN_TUI=1 to make
+it the default; --no-color and NO_COLOR select it too.
+
+--max-replies defaults to max_replies in ~/.config/smoke/tui.yaml, or all
+replies if unset. Collapsed threads show the first and last replies with a
+"… N more replies" stub between them.
+
+In the interactive feed, click a post to select it, scroll with the mouse
+wheel, and click the + or - of the pressure indicator to change pressure.`,
 	RunE: runFeed,
 }
 
 func init() {
 	feedCmd.Flags().IntVarP(&feedLimit, "limit", "n", 20, "Number of posts to show")
-	feedCmd.Flags().StringVar(&feedAuthor, "author", "", "Filter by author")
+	feedCmd.Flags().StringVar(&feedAuthor, "author", "", "Filter by author name, suffix, or agent (comma-separated)")
+	feedCmd.Flags().StringVar(&feedExcludeAuthor, "exclude-author", "", "Hide posts by these authors (comma-separated)")
+	feedCmd.Flags().BoolVar(&feedWithContext, "with-context", false, "Include the parents of matching replies, dimmed")
 	feedCmd.Flags().StringVar(&feedSuffix, "suffix", "", "Filter by identity suffix")
 	feedCmd.Flags().BoolVar(&feedToday, "today", false, "Show only today's posts")
 	feedCmd.Flags().DurationVar(&feedSince, "since", 0, "Show posts since duration (e.g., 1h, 30m)")
+	feedCmd.Flags().StringVar(&feedTag, "tag", "", "Filter by hashtag (e.g., bug or #bug)")
+	feedCmd.Flags().StringVar(&feedMention, "mentions", "", "Filter by posts mentioning a name (e.g., swift-fox)")
 	feedCmd.Flags().BoolVar(&feedTail, "tail", false, "Watch for new posts (streaming mode)")
+	feedCmd.Flags().BoolVar(&feedWatch, "watch", false, "Stream new posts in oneline format without the TUI")
+	feedCmd.Flags().DurationVar(&feedInterval, "interval", defaultTailInterval, "Poll period for --tail and --watch")
 	feedCmd.Flags().BoolVar(&feedOneline, "oneline", false, "Compact single-line format")
+	feedCmd.Flags().StringVar(&feedFormat, "format", "", "Print each post through a text/template, or a preset: "+strings.Join(feed.LineFormatPresetNames(), ", "))
 	feedCmd.Flags().BoolVar(&feedQuiet, "quiet", false, "Suppress headers and formatting")
+	feedCmd.Flags().BoolVar(&feedJSON, "json", false, "Output posts as a JSON array")
+	feedCmd.Flags().BoolVar(&feedNested, "nested", false, "With --json, nest replies under their parent post")
+	feedCmd.Flags().BoolVar(&feedPrivate, "private", false, "Show your private feed instead of the shared one")
+	feedCmd.Flags().BoolVar(&feedShowMuted, "show-muted", false, "Show posts from muted authors")
+	feedCmd.Flags().BoolVar(&feedAllProject, "all-projects", false, "Merge the shared feed and every project feed (read-only)")
+	feedCmd.Flags().BoolVar(&feedPlainTUI, "plain-tui", false, "Screen-reader friendly TUI without borders or colors (or set SMOKE_PLAIN_TUI=1)")
+	feedCmd.Flags().IntVar(&feedPageNum, "page", 0, "Show this page of posts (from 1)")
+	feedCmd.Flags().IntVar(&feedPageSizeFlag, "page-size", 0, "Posts per page for --page, --before, and --after (default: -n)")
+	feedCmd.Flags().StringVar(&feedBefore, "before", "", "Show the page of posts older than this post ID")
+	feedCmd.Flags().StringVar(&feedAfter, "after", "", "Show the page of posts newer than this post ID")
+	feedCmd.Flags().IntVar(&feedMaxReplies, "max-replies", -1, "Max replies shown per thread (0 = all, -1 means use config default)")
 	rootCmd.AddCommand(feedCmd)
 }
 
@@ -72,75 +175,235 @@ func runFeed(_ *cobra.Command, args []string) error {
 	tracker := logging.StartCommand("feed", args)
 
 	mode := "normal"
-	if feedTail {
+	switch {
+	case feedJSON:
+		mode = "json"
+	case feedPaging():
+		mode = "page"
+	case feedWatch:
+		mode = "watch"
+	case feedTail:
 		mode = "tail"
-	} else if feed.IsTerminal(os.Stdout.Fd()) {
+	case feed.IsTerminal(os.Stdout.Fd()) && feedFormat == "" && !feedWithContext:
 		mode = "tui"
 	}
 	tracker.AddMetric(slog.String("feed.mode", mode))
 
+	if err := validateFeedPaging(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if err := validateWithContext(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if _, err := feedLineFormat(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
 	if err := config.EnsureInitialized(); err != nil {
 		tracker.Fail(err)
 		return err
 	}
 
-	feedPath, err := config.GetFeedPath()
+	store, err := resolveFeedStore(tracker)
 	if err != nil {
 		tracker.Fail(err)
 		return err
 	}
-	store := feed.NewStoreWithPath(feedPath)
 
-	if info, statErr := os.Stat(feedPath); statErr == nil {
-		posts, readErr := store.ReadAll()
-		if readErr == nil {
-			tracker.AddFeedMetrics(info.Size(), len(posts))
-		}
+	applyClockSettings(config.LoadTUIConfig())
+
+	if feedJSON || feedPaging() {
+		return finishTracked(tracker, runNormalFeed(store, tracker))
 	}
 
-	if feedTail {
+	if feedTail || feedWatch {
 		return finishTracked(tracker, runTailMode(store, tracker))
 	}
 
-	if feed.IsTerminal(os.Stdout.Fd()) {
+	if feed.IsTerminal(os.Stdout.Fd()) && feedFormat == "" && !feedWithContext {
 		return finishTracked(tracker, runTUIMode(store, tracker))
 	}
 
 	return finishTracked(tracker, runNormalFeed(store, tracker))
 }
 
-func runNormalFeed(store *feed.Store, _ *logging.CommandTracker) error {
+// applyClockSettings applies clock_24h and timezone from tui.yaml to the
+// timestamps the feed shows, warning about an unknown timezone.
+func applyClockSettings(cfg *config.TUIConfig) {
+	if err := feed.ApplyClockSettings(cfg.Clock24h, cfg.Timezone); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	}
+}
+
+// feedMutedAuthors returns the authors to hide, or nil with --show-muted.
+func feedMutedAuthors() []string {
+	if feedShowMuted {
+		return nil
+	}
+	return config.GetMutedAuthors()
+}
+
+// resolveFeedStore opens the feed to show: every feed merged with
+// --all-projects, otherwise the single feed from resolveFeedPath, whose size
+// is recorded on tracker.
+func resolveFeedStore(tracker *logging.CommandTracker) (feed.Store, error) {
+	if feedAllProject {
+		if feedPrivate {
+			return nil, errors.New("--all-projects cannot be combined with --private")
+		}
+		paths, err := config.GetAllFeedPaths()
+		if err != nil {
+			return nil, err
+		}
+		return feed.NewMergedStore(paths...), nil
+	}
+
+	feedPath, err := resolveFeedPath()
+	if err != nil {
+		return nil, err
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	if info, statErr := os.Stat(feedPath); statErr == nil {
+		posts, readErr := store.ReadAll()
+		if readErr == nil {
+			tracker.AddFeedMetrics(info.Size(), len(posts))
+		}
+	}
+	return store, nil
+}
+
+// resolveFeedPath returns the feed path for the current scope, or the
+// current identity's private feed when --private is set.
+func resolveFeedPath() (string, error) {
+	if !feedPrivate {
+		return config.GetFeedPath()
+	}
+	identity, err := config.GetIdentity("")
+	if err != nil {
+		return "", err
+	}
+	return config.EnsurePrivateFeed(identity)
+}
+
+func runNormalFeed(store feed.Store, _ *logging.CommandTracker) error {
 	// Read posts sorted by time (most recent first)
-	posts, err := store.ReadRecent(0) // 0 = no limit, just sorted
+	posts, err := feed.RecentPosts(store, 0) // 0 = no limit, just sorted
 	if err != nil {
 		return err
 	}
 
+	posts = feed.ApplyMutes(posts, feedMutedAuthors())
 	total := len(posts)
+	all := posts
 
 	// Apply filters
-	criteria := feed.FilterCriteria{
-		Author: feedAuthor,
-		Suffix: feedSuffix,
-		Today:  feedToday,
-	}
+	criteria := feedMatchCriteria()
+	criteria.Today = feedToday
 	if feedSince > 0 {
 		criteria.Since = time.Now().Add(-feedSince)
 	}
 	posts = feed.FilterPosts(posts, criteria)
+	if len(posts) == 0 {
+		warnAuthorTypos(all)
+	}
+
+	if feedPaging() {
+		return printFeedPage(posts)
+	}
 
 	// Limit results (already sorted, so take first N)
 	if feedLimit > 0 && len(posts) > feedLimit {
 		posts = posts[:feedLimit]
 	}
 
-	// Format and output
-	opts := feed.FormatOptions{
-		Oneline: feedOneline,
-		Quiet:   feedQuiet,
+	if feedJSON {
+		return feed.FormatJSON(os.Stdout, posts, feedNested)
+	}
+
+	opts := normalFeedOptions()
+	if feedWithContext {
+		posts, opts.Context = feed.WithContext(posts, all)
 	}
 	feed.FormatFeed(os.Stdout, posts, opts, total)
+	return nil
+}
+
+// warnAuthorTypos suggests the closest real author for each --author name
+// that matches no post in the feed.
+func warnAuthorTypos(posts []*feed.Post) {
+	if feedAuthor == "" {
+		return
+	}
+	for _, name := range strings.Split(feedAuthor, ",") {
+		if suggestion := feed.ClosestAuthor(name, posts); suggestion != "" {
+			fmt.Fprintf(os.Stderr, "warning: no posts by %q; did you mean %q?\n", strings.TrimSpace(name), suggestion)
+		}
+	}
+}
 
+// validateWithContext rejects --with-context in modes that print posts
+// without their threads.
+func validateWithContext() error {
+	if !feedWithContext {
+		return nil
+	}
+	switch {
+	case feedJSON:
+		return errors.New("--with-context cannot be combined with --json")
+	case feedPaging():
+		return errors.New("--with-context cannot be combined with --page, --before, or --after")
+	case feedTail || feedWatch:
+		return errors.New("--with-context cannot be combined with --tail or --watch")
+	}
+	return nil
+}
+
+// normalFeedOptions returns the formatting for non-interactive feed output.
+func normalFeedOptions() feed.FormatOptions {
+	lineFormat, _ := feedLineFormat()
+	return feed.FormatOptions{
+		Oneline:    feedOneline,
+		Quiet:      feedQuiet,
+		MaxReplies: resolveMaxReplies(config.LoadTUIConfig()),
+		LineFormat: lineFormat,
+	}
+}
+
+// feedLineFormat parses --format, returning nil when it is not set.
+func feedLineFormat() (*template.Template, error) {
+	if feedFormat == "" {
+		return nil, nil
+	}
+	if feedJSON {
+		return nil, errors.New("--format cannot be combined with --json")
+	}
+	return feed.ParseLineFormat(feedFormat)
+}
+
+// printFeedPage prints the page of the filtered, newest-first posts that
+// --page, --before, or --after selects, with the cursors around it.
+func printFeedPage(posts []*feed.Post) error {
+	page, err := paginatePosts(posts, feedPageSize(), feedPageNum, feedBefore, feedAfter)
+	if err != nil {
+		return err
+	}
+	if feedJSON {
+		return feed.FormatJSONPage(os.Stdout, page.Posts, feedNested, page.Next, page.Prev)
+	}
+
+	feed.FormatFeed(os.Stdout, page.Posts, normalFeedOptions(), len(page.Posts))
+	if feedQuiet || (page.Prev == "" && page.Next == "") {
+		return nil
+	}
+	fmt.Println()
+	if page.Prev != "" {
+		fmt.Printf("Newer posts: smoke feed --after %s\n", page.Prev)
+	}
+	if page.Next != "" {
+		fmt.Printf("Older posts: smoke feed --before %s\n", page.Next)
+	}
 	return nil
 }
 
@@ -158,64 +421,129 @@ func displayInitialPosts(posts []*feed.Post, opts feed.FormatOptions) {
 }
 
 func displayNewPosts(newPosts []*feed.Post, opts feed.FormatOptions) {
-	for _, post := range newPosts {
-		if feedAuthor != "" && !strings.Contains(post.Author, feedAuthor) {
-			continue
-		}
-		if feedSuffix != "" && post.Suffix != feedSuffix {
-			continue
-		}
+	for _, post := range feed.FilterPosts(newPosts, feedMatchCriteria()) {
 		feed.FormatPost(os.Stdout, post, opts)
 	}
 }
 
-func runTailMode(store *feed.Store, _ *logging.CommandTracker) error {
-	if !feedQuiet {
+// feedMatchCriteria returns the author, suffix, tag, and mention filters,
+// which apply to listed and streamed posts alike.
+func feedMatchCriteria() feed.FilterCriteria {
+	return feed.FilterCriteria{
+		Author:        feedAuthor,
+		ExcludeAuthor: feedExcludeAuthor,
+		Suffix:        feedSuffix,
+		Tag:           feedTag,
+		Mention:       feedMention,
+	}
+}
+
+// defaultTailInterval is how often --tail and --watch poll the feed file.
+const defaultTailInterval = 500 * time.Millisecond
+
+// runTailMode streams new posts until interrupted. --watch is the plain
+// variant: oneline posts, no header, suitable for files and pipes.
+func runTailMode(store feed.Store, _ *logging.CommandTracker) error {
+	if !feedQuiet && !feedWatch {
 		feed.FormatTailHeader(os.Stdout)
 	}
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
 
+	lineFormat, _ := feedLineFormat()
 	opts := feed.FormatOptions{
-		Oneline: feedOneline,
-		Quiet:   feedQuiet,
+		Oneline:    feedOneline || feedWatch,
+		Quiet:      feedQuiet,
+		LineFormat: lineFormat,
 	}
 
+	muted := feedMutedAuthors()
 	posts, err := store.ReadAll()
 	if err != nil {
 		return err
 	}
-	lastCount := len(posts)
+	posts = feed.ApplyMutes(posts, muted)
+	lastSeen := lastPost(posts)
 
 	displayInitialPosts(posts, opts)
 
-	ticker := time.NewTicker(500 * time.Millisecond)
+	interval := feedInterval
+	if interval <= 0 {
+		interval = defaultTailInterval
+	}
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-sigChan:
-			fmt.Println()
+			if !feedWatch {
+				fmt.Println()
+			}
 			return nil
 		case <-ticker.C:
 			currentPosts, readErr := store.ReadAll()
 			if readErr != nil {
 				continue
 			}
-			if len(currentPosts) > lastCount {
-				displayNewPosts(currentPosts[lastCount:], opts)
-				lastCount = len(currentPosts)
+			newPosts := postsAfter(feed.ApplyMutes(currentPosts, muted), lastSeen)
+			if len(newPosts) > 0 {
+				displayNewPosts(newPosts, opts)
+				lastSeen = newPosts[len(newPosts)-1]
 			}
 		}
 	}
 }
 
+// lastPost returns the most recently appended post, or nil for an empty feed.
+func lastPost(posts []*feed.Post) *feed.Post {
+	if len(posts) == 0 {
+		return nil
+	}
+	return posts[len(posts)-1]
+}
+
+// postsAfter returns the posts appended after last. Posts are in file order,
+// so everything following last's ID is new; if last has since been deleted,
+// posts created after it are treated as new instead.
+func postsAfter(posts []*feed.Post, last *feed.Post) []*feed.Post {
+	if last == nil {
+		return posts
+	}
+	for i, post := range posts {
+		if post.ID == last.ID {
+			return posts[i+1:]
+		}
+	}
+	var newer []*feed.Post
+	for _, post := range posts {
+		if post.CreatedAt > last.CreatedAt {
+			newer = append(newer, post)
+		}
+	}
+	return newer
+}
+
+// resolveMaxReplies returns the --max-replies flag if set, else the config default.
+func resolveMaxReplies(cfg *config.TUIConfig) int {
+	if feedMaxReplies >= 0 {
+		return feedMaxReplies
+	}
+	if cfg.MaxReplies > 0 {
+		return cfg.MaxReplies
+	}
+	return 0
+}
+
 // runTUIMode launches the interactive TUI feed
-func runTUIMode(store *feed.Store, _ *logging.CommandTracker) error {
+func runTUIMode(store feed.Store, _ *logging.CommandTracker) error {
 	// Load TUI config (never returns error, gracefully handles all failures)
 	cfg := config.LoadTUIConfig()
 
+	registerCustomThemes()
+	feed.ApplyAppearance(cfg.Appearance)
 	theme := feed.GetTheme(cfg.Theme)
 	contrast := feed.GetContrastLevel(cfg.Contrast)
 	layout := feed.GetLayout(cfg.Layout)
@@ -223,16 +551,26 @@ func runTUIMode(store *feed.Store, _ *logging.CommandTracker) error {
 	// Get short version (e.g., "1.3.0" not the full string with commit info)
 	version := Version
 
+	// Identity is only used to emphasize mentions, so a failure is not fatal
+	self := ""
+	if identity, err := config.GetIdentity(""); err == nil {
+		self = identity.String()
+	}
+
 	// Create model and run
 	m := feed.NewModel(feed.ModelOptions{
-		Store:    store,
-		Theme:    theme,
-		Contrast: contrast,
-		Layout:   layout,
-		Config:   cfg,
-		Version:  version,
+		Store:      store,
+		Theme:      theme,
+		Contrast:   contrast,
+		Layout:     layout,
+		Config:     cfg,
+		Version:    version,
+		MaxReplies: resolveMaxReplies(cfg),
+		Plain:      feedPlainTUI || feed.PlainTUIFromEnv() || feed.NoColor(),
+		Identity:   self,
+		ShowMuted:  feedShowMuted,
 	})
-	p := tea.NewProgram(m, tea.WithAltScreen())
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
 	_, err := p.Run()
 	return err
 }
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// feedPage is one page of the feed, newest first, with the cursors to the
+// pages around it. Next is the last post of the page, for --before; Prev is
+// the first, for --after. Each is empty when there is nothing beyond it.
+type feedPage struct {
+	Posts []*feed.Post
+	Next  string
+	Prev  string
+}
+
+// feedPaging reports whether --page, --before, or --after was given.
+func feedPaging() bool {
+	return feedPageNum > 0 || feedBefore != "" || feedAfter != ""
+}
+
+// validateFeedPaging rejects paging flags that cannot work together.
+func validateFeedPaging() error {
+	if !feedPaging() {
+		return nil
+	}
+	switch {
+	case feedBefore != "" && feedAfter != "":
+		return errors.New("--before cannot be combined with --after")
+	case feedPageNum > 0 && (feedBefore != "" || feedAfter != ""):
+		return errors.New("--page cannot be combined with --before or --after")
+	case feedTail || feedWatch:
+		return errors.New("--page, --before, and --after cannot be combined with --tail or --watch")
+	case feedAllProject:
+		return errors.New("--page, --before, and --after cannot be combined with --all-projects")
+	}
+	return nil
+}
+
+// feedPageSize returns --page-size, falling back to --limit.
+func feedPageSize() int {
+	if feedPageSizeFlag > 0 {
+		return feedPageSizeFlag
+	}
+	return feedLimit
+}
+
+// paginatePosts cuts one page of size posts (0 = all) out of posts, which
+// are newest first. page counts from 1; before selects the posts older than
+// that ID and after the ones newer than it, nearest first. A cursor that is
+// not among posts is an error.
+func paginatePosts(posts []*feed.Post, size, page int, before, after string) (feedPage, error) {
+	if size <= 0 {
+		size = len(posts)
+	}
+	var start, end int
+	switch {
+	case before != "":
+		i, err := cursorIndex(posts, before)
+		if err != nil {
+			return feedPage{}, err
+		}
+		start, end = i+1, i+1+size
+	case after != "":
+		i, err := cursorIndex(posts, after)
+		if err != nil {
+			return feedPage{}, err
+		}
+		start, end = i-size, i
+	default:
+		if page < 1 {
+			page = 1
+		}
+		start, end = (page-1)*size, page*size
+	}
+	start = max(0, min(start, len(posts)))
+	end = max(start, min(end, len(posts)))
+
+	result := feedPage{Posts: posts[start:end]}
+	if end > start {
+		if end < len(posts) {
+			result.Next = posts[end-1].ID
+		}
+		if start > 0 {
+			result.Prev = posts[start].ID
+		}
+	}
+	return result, nil
+}
+
+// cursorIndex returns the position of the post with id in posts.
+func cursorIndex(posts []*feed.Post, id string) (int, error) {
+	for i, post := range posts {
+		if post.ID == id {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("post %s is not in the feed (or is hidden by the filters)", id)
+}
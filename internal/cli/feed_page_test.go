@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// pagedPosts returns n posts, newest first, as the feed command orders them.
+func pagedPosts(t *testing.T, n int) []*feed.Post {
+	t.Helper()
+	posts := make([]*feed.Post, n)
+	for i := range posts {
+		post, err := feed.NewPost("ember", "smoke", "fox", fmt.Sprintf("post %d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		posts[i] = post
+	}
+	return posts
+}
+
+// assertCovers checks that pages hold every post exactly once, in order.
+func assertCovers(t *testing.T, posts []*feed.Post, pages [][]*feed.Post) {
+	t.Helper()
+	var got []*feed.Post
+	for _, page := range pages {
+		got = append(got, page...)
+	}
+	if len(got) != len(posts) {
+		t.Fatalf("pages hold %d posts, want %d", len(got), len(posts))
+	}
+	for i := range posts {
+		if got[i].ID != posts[i].ID {
+			t.Fatalf("post %d is %s, want %s (overlap or gap)", i, got[i].ID, posts[i].ID)
+		}
+	}
+}
+
+func TestPaginatePosts_Pages(t *testing.T) {
+	posts := pagedPosts(t, 25)
+
+	var pages [][]*feed.Post
+	for n := 1; n <= 3; n++ {
+		page, err := paginatePosts(posts, 10, n, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, page.Posts)
+	}
+	if len(pages[2]) != 5 {
+		t.Errorf("last page has %d posts, want 5", len(pages[2]))
+	}
+	assertCovers(t, posts, pages)
+
+	if page, _ := paginatePosts(posts, 10, 4, "", ""); len(page.Posts) != 0 || page.Next != "" || page.Prev != "" {
+		t.Errorf("page past the end = %+v, want empty", page)
+	}
+}
+
+func TestPaginatePosts_Cursors(t *testing.T) {
+	posts := pagedPosts(t, 25)
+
+	// Forward with --before
+	var pages [][]*feed.Post
+	page, err := paginatePosts(posts, 10, 0, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Prev != "" {
+		t.Errorf("first page has prev cursor %q", page.Prev)
+	}
+	pages = append(pages, page.Posts)
+	for page.Next != "" {
+		if page, err = paginatePosts(posts, 10, 0, page.Next, ""); err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, page.Posts)
+	}
+	if len(pages) != 3 {
+		t.Fatalf("got %d pages, want 3", len(pages))
+	}
+	assertCovers(t, posts, pages)
+
+	// Back with --after from the last page
+	var back [][]*feed.Post
+	back = append(back, page.Posts)
+	for page.Prev != "" {
+		if page, err = paginatePosts(posts, 10, 0, "", page.Prev); err != nil {
+			t.Fatal(err)
+		}
+		back = append([][]*feed.Post{page.Posts}, back...)
+	}
+	assertCovers(t, posts, back)
+
+	if _, err := paginatePosts(posts, 10, 0, "smk-zzzzzz", ""); err == nil {
+		t.Error("expected an error for an unknown cursor")
+	}
+}
+
+func TestRunFeed_PageJSON(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	base := time.Now().Add(-time.Hour).UTC()
+	for i := range 25 {
+		post, err := feed.NewPost("ember", "smoke", "fox", fmt.Sprintf("post %d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.CreatedAt = base.Add(time.Duration(i) * time.Minute).Format(time.RFC3339)
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	prevJSON, prevLimit, prevSize := feedJSON, feedLimit, feedPageSizeFlag
+	prevPage, prevBefore, prevAfter := feedPageNum, feedBefore, feedAfter
+	defer func() {
+		feedJSON, feedLimit, feedPageSizeFlag = prevJSON, prevLimit, prevSize
+		feedPageNum, feedBefore, feedAfter = prevPage, prevBefore, prevAfter
+	}()
+	feedJSON, feedLimit, feedPageSizeFlag = true, 20, 10
+	feedPageNum, feedBefore, feedAfter = 1, "", ""
+
+	seen := map[string]bool{}
+	pages := 0
+	for {
+		output := captureStdout(t, func() {
+			if err := runFeed(nil, nil); err != nil {
+				t.Fatalf("runFeed error: %v", err)
+			}
+		})
+		var page struct {
+			Posts      []*feed.Post `json:"posts"`
+			NextCursor string       `json:"next_cursor"`
+		}
+		if err := json.Unmarshal([]byte(output), &page); err != nil {
+			t.Fatalf("invalid page JSON %q: %v", output, err)
+		}
+		pages++
+		for _, post := range page.Posts {
+			if seen[post.ID] {
+				t.Fatalf("post %s shown twice", post.ID)
+			}
+			seen[post.ID] = true
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		feedPageNum, feedBefore = 0, page.NextCursor
+	}
+	if pages != 3 || len(seen) != 25 {
+		t.Errorf("paged through %d posts in %d pages, want 25 in 3", len(seen), pages)
+	}
+
+	feedAfter = "smk-aaaaaa"
+	if err := runFeed(nil, nil); err == nil {
+		t.Error("expected an error combining --before and --after")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dreamiurg/smoke/internal/config"
 	"github.com/dreamiurg/smoke/internal/feed"
 )
 
@@ -96,3 +97,295 @@ func captureFeedStdout(t *testing.T, fn func()) string {
 	_, _ = io.Copy(&buf, r)
 	return buf.String()
 }
+
+func TestRunFeed_Tag(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	seedTaggedFeed(t)
+
+	prevLimit, prevOneline, prevTag := feedLimit, feedOneline, feedTag
+	defer func() {
+		feedLimit, feedOneline, feedTag = prevLimit, prevOneline, prevTag
+	}()
+	feedLimit, feedOneline, feedTag = 0, true, "#WIN"
+
+	output := captureFeedStdout(t, func() {
+		if err := runFeed(nil, []string{}); err != nil {
+			t.Fatalf("runFeed error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "another #Bug and a #win") {
+		t.Errorf("expected tagged post, got: %s", output)
+	}
+	if strings.Contains(output, "found a #bug") || strings.Contains(output, "quiet day") {
+		t.Errorf("expected only #win posts, got: %s", output)
+	}
+}
+
+func TestPostsAfter(t *testing.T) {
+	a := &feed.Post{ID: "smk-aaaaaa", CreatedAt: "2026-01-01T10:00:00Z"}
+	b := &feed.Post{ID: "smk-bbbbbb", CreatedAt: "2026-01-01T10:01:00Z"}
+	c := &feed.Post{ID: "smk-cccccc", CreatedAt: "2026-01-01T10:02:00Z"}
+
+	ids := func(posts []*feed.Post) string {
+		var out []string
+		for _, p := range posts {
+			out = append(out, p.ID)
+		}
+		return strings.Join(out, ",")
+	}
+
+	tests := []struct {
+		name  string
+		posts []*feed.Post
+		last  *feed.Post
+		want  string
+	}{
+		{"empty feed before", []*feed.Post{a, b}, nil, "smk-aaaaaa,smk-bbbbbb"},
+		{"new posts after last", []*feed.Post{a, b, c}, a, "smk-bbbbbb,smk-cccccc"},
+		{"nothing new", []*feed.Post{a, b}, b, ""},
+		{"last deleted", []*feed.Post{a, c}, b, "smk-cccccc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(postsAfter(tt.posts, tt.last)); got != tt.want {
+				t.Errorf("postsAfter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// chdirProject moves into a fresh non-git directory named project, so
+// project detection falls back to the directory name.
+func chdirProject(t *testing.T, project string) {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), project)
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+}
+
+func TestRunFeed_ProjectScope(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	if err := config.SetFeedScope(config.FeedScopeProject); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = config.SetFeedScope("") })
+
+	prevLimit, prevOneline, prevAll := feedLimit, feedOneline, feedAllProject
+	defer func() {
+		feedLimit, feedOneline, feedAllProject = prevLimit, prevOneline, prevAll
+	}()
+	feedLimit, feedOneline, feedAllProject = 0, true, false
+
+	readFeed := func() string {
+		return captureFeedStdout(t, func() {
+			if err := runFeed(nil, []string{}); err != nil {
+				t.Fatalf("runFeed error: %v", err)
+			}
+		})
+	}
+	post := func(message string) {
+		captureFeedStdout(t, func() {
+			if err := runPost(nil, []string{message}); err != nil {
+				t.Fatalf("runPost error: %v", err)
+			}
+		})
+	}
+
+	chdirProject(t, "alpha")
+	post("alpha only news")
+	chdirProject(t, "beta")
+	post("beta only news")
+
+	if output := readFeed(); !strings.Contains(output, "beta only news") || strings.Contains(output, "alpha only news") {
+		t.Errorf("beta's feed should hold only beta's post, got: %s", output)
+	}
+	chdirProject(t, "alpha")
+	if output := readFeed(); !strings.Contains(output, "alpha only news") || strings.Contains(output, "beta only news") {
+		t.Errorf("alpha's feed should hold only alpha's post, got: %s", output)
+	}
+
+	// The shared feed stays untouched
+	if err := config.SetFeedScope(config.FeedScopeGlobal); err != nil {
+		t.Fatal(err)
+	}
+	if output := readFeed(); strings.Contains(output, "only news") {
+		t.Errorf("global feed should not hold project posts, got: %s", output)
+	}
+
+	feedAllProject = true
+	output := readFeed()
+	if !strings.Contains(output, "alpha only news") || !strings.Contains(output, "beta only news") {
+		t.Errorf("--all-projects should show every project's posts, got: %s", output)
+	}
+}
+
+func TestRunFeed_AllProjectsRejectsPrivate(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	prevAll, prevPrivate := feedAllProject, feedPrivate
+	defer func() { feedAllProject, feedPrivate = prevAll, prevPrivate }()
+	feedAllProject, feedPrivate = true, true
+
+	err := runFeed(nil, []string{})
+	if err == nil || !strings.Contains(err.Error(), "--all-projects cannot be combined") {
+		t.Errorf("runFeed() error = %v, want --all-projects conflict", err)
+	}
+}
+
+func TestRunFeed_Format(t *testing.T) {
+	seedSearchFeed(t)
+
+	prevFormat, prevJSON, prevLimit := feedFormat, feedJSON, feedLimit
+	defer func() { feedFormat, feedJSON, feedLimit = prevFormat, prevJSON, prevLimit }()
+	feedFormat, feedJSON, feedLimit = "{{.Author}}|{{.Content}}", false, 10
+
+	output := captureStdout(t, func() {
+		if err := runFeed(nil, nil); err != nil {
+			t.Fatalf("runFeed error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "ember-fox@smoke|lunch time\n") || !strings.Contains(output, "ember-fox@smoke|the retry bug is back\n") {
+		t.Errorf("unexpected --format output: %q", output)
+	}
+
+	feedFormat = "{{.Missing}}"
+	if err := runFeed(nil, nil); err == nil || !strings.Contains(err.Error(), "invalid --format template") {
+		t.Errorf("expected an invalid template error, got %v", err)
+	}
+
+	feedFormat, feedJSON = "short", true
+	if err := runFeed(nil, nil); err == nil {
+		t.Error("expected --format with --json to fail")
+	}
+}
+
+func TestRunFeed_AuthorMatching(t *testing.T) {
+	seedSearchFeed(t)
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	post, err := feed.NewPost("claude-calm-owl@smoke", "smoke", "calm-owl", "owl checking in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := feed.NewStoreWithPath(feedPath).Append(post); err != nil {
+		t.Fatal(err)
+	}
+
+	prevFormat, prevLimit := feedFormat, feedLimit
+	prevAuthor, prevExclude := feedAuthor, feedExcludeAuthor
+	defer func() {
+		feedFormat, feedLimit = prevFormat, prevLimit
+		feedAuthor, feedExcludeAuthor = prevAuthor, prevExclude
+	}()
+	feedFormat, feedLimit = "{{.Content}}", 10
+
+	tests := []struct {
+		author, exclude string
+		want, unwanted  []string
+	}{
+		{"calm-owl", "", []string{"owl checking in"}, []string{"lunch time"}},
+		{"CLAUDE", "", []string{"owl checking in"}, []string{"lunch time"}},
+		{"owl", "", nil, []string{"owl checking in", "lunch time"}},
+		{"ember-fox,calm-owl", "", []string{"owl checking in", "lunch time"}, nil},
+		{"", "claude", []string{"lunch time", "the retry bug is back"}, []string{"owl checking in"}},
+	}
+	for _, tt := range tests {
+		feedAuthor, feedExcludeAuthor = tt.author, tt.exclude
+		output := captureStdout(t, func() {
+			if err := runFeed(nil, nil); err != nil {
+				t.Fatalf("runFeed error: %v", err)
+			}
+		})
+		for _, want := range tt.want {
+			if !strings.Contains(output, want) {
+				t.Errorf("--author %q --exclude-author %q missing %q:\n%s", tt.author, tt.exclude, want, output)
+			}
+		}
+		for _, unwanted := range tt.unwanted {
+			if strings.Contains(output, unwanted) {
+				t.Errorf("--author %q --exclude-author %q should hide %q:\n%s", tt.author, tt.exclude, unwanted, output)
+			}
+		}
+	}
+}
+
+func TestRunFeed_WithContext(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	parent, err := feed.NewPost("wisp@smoke", "smoke", "wisp", "should we ship friday")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err := feed.NewReply("ember@smoke", "smoke", "ember", "not before the retry fix", parent.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, post := range []*feed.Post{parent, reply} {
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	prevFormat, prevLimit, prevAuthor := feedFormat, feedLimit, feedAuthor
+	prevContext, prevJSON := feedWithContext, feedJSON
+	defer func() {
+		feedFormat, feedLimit, feedAuthor = prevFormat, prevLimit, prevAuthor
+		feedWithContext, feedJSON = prevContext, prevJSON
+	}()
+	feedFormat, feedLimit, feedAuthor = "{{.Content}}", 10, "ember"
+
+	for _, withContext := range []bool{false, true} {
+		feedWithContext = withContext
+		output := captureStdout(t, func() {
+			if err := runFeed(nil, nil); err != nil {
+				t.Fatalf("runFeed error: %v", err)
+			}
+		})
+		if !strings.Contains(output, "not before the retry fix") {
+			t.Errorf("--with-context=%v: missing the matching reply:\n%s", withContext, output)
+		}
+		if got := strings.Contains(output, "should we ship friday"); got != withContext {
+			t.Errorf("--with-context=%v: parent shown = %v:\n%s", withContext, got, output)
+		}
+	}
+
+	feedJSON = true
+	if err := runFeed(nil, nil); err == nil || !strings.Contains(err.Error(), "--with-context") {
+		t.Errorf("--with-context --json error = %v, want a conflict", err)
+	}
+}
+
+func TestRunFeed_AuthorTypoSuggestsClosest(t *testing.T) {
+	seedSearchFeed(t)
+	prevAuthor := feedAuthor
+	defer func() { feedAuthor = prevAuthor }()
+	feedAuthor = "embr-fox"
+
+	oldStderr := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stderr = w
+	captureStdout(t, func() {
+		if err := runFeed(nil, nil); err != nil {
+			t.Fatalf("runFeed error: %v", err)
+		}
+	})
+	w.Close()
+	os.Stderr = oldStderr
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	if !strings.Contains(buf.String(), `did you mean "ember-fox"?`) {
+		t.Errorf("stderr = %q, want a suggestion for ember-fox", buf.String())
+	}
+}
@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	identityDebugJSON bool
+)
+
+var identityCmd = &cobra.Command{
+	Use:   "identity",
+	Short: "Inspect and pin how your identity is resolved",
+	Long: `Inspect how your smoke identity is resolved, or pin a name for the
+current project.
+
+Examples:
+  smoke identity debug          Show every seed source and which one won
+  smoke identity debug --json   Same, as JSON
+  smoke identity set ember      Post as ember@<project> in this project (humans only)
+  smoke identity clear          Go back to the generated name`,
+	Args: cobra.NoArgs,
+}
+
+var identityDebugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "Show each identity seed source and which one won",
+	Long: `Show how the current identity was resolved.
+
+Identity is resolved in this order:
+  1. SMOKE_NAME (or --as on post/reply) overrides everything
+  2. An interactive terminal with no agent detected is a human: the name
+     saved for the project with smoke identity set, or <human>
+  3. Otherwise the first valid session seed wins:
+       agent-ancestor   Claude/Codex/Gemini process in the process tree
+       session-file     ~/.config/smoke/session.json, if same terminal and agent still running
+       TERM_SESSION_ID  Terminal session identifier
+       WINDOWID         X11 window identifier
+       ppid             Parent process ID (always available, changes per shell)
+
+Use this when your name changes unexpectedly between commands.
+
+Examples:
+  smoke identity debug
+  smoke identity debug --json`,
+	Args: cobra.NoArgs,
+	RunE: runIdentityDebug,
+}
+
+var identitySetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "Save a name to post as in the current project",
+	Long: `Save a name to post as in the current project.
+
+The name is kept under project_identities in ~/.config/smoke/config.yaml and
+used instead of <human> when you run smoke from an interactive terminal in
+this project. Agent sessions keep their own generated names. SMOKE_NAME and
+--as still take precedence. As with --as, an @project part is
+ignored; the project always comes from the repository.
+
+Examples:
+  smoke identity set ember
+  smoke identity set "night owl"    # Saved as night-owl`,
+	Args: cobra.ExactArgs(1),
+	RunE: runIdentitySet,
+}
+
+var identityClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Forget the name saved for the current project",
+	Long: `Forget the name saved with smoke identity set for the current project,
+so smoke goes back to generating one.
+
+Examples:
+  smoke identity clear`,
+	Args: cobra.NoArgs,
+	RunE: runIdentityClear,
+}
+
+func init() {
+	identityDebugCmd.Flags().BoolVar(&identityDebugJSON, "json", false, "Output in JSON format")
+	identityCmd.AddCommand(identityDebugCmd)
+	identityCmd.AddCommand(identitySetCmd)
+	identityCmd.AddCommand(identityClearCmd)
+	rootCmd.AddCommand(identityCmd)
+}
+
+func runIdentitySet(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("identity set", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	project := config.CurrentProject()
+	name, err := config.SetProjectIdentityName(project, args[0])
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	fmt.Printf("Saved %s@%s as your name in %s (agent sessions keep their own names)\n", name, project, project)
+	if os.Getenv("SMOKE_NAME") != "" {
+		fmt.Fprintln(os.Stderr, "warning: SMOKE_NAME is set and takes precedence over the saved name")
+	}
+	tracker.Complete()
+	return nil
+}
+
+func runIdentityClear(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("identity clear", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	project := config.CurrentProject()
+	cleared, err := config.ClearProjectIdentityName(project)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if cleared {
+		fmt.Printf("Cleared the saved name for %s\n", project)
+	} else {
+		fmt.Printf("No name saved for %s\n", project)
+	}
+	tracker.Complete()
+	return nil
+}
+
+func runIdentityDebug(_ *cobra.Command, _ []string) error {
+	debug, err := config.DebugIdentity()
+	if err != nil {
+		return err
+	}
+
+	if identityDebugJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(debug)
+	}
+
+	fmt.Printf("Identity:      %s\n", debug.Identity)
+	fmt.Printf("Resolved by:   %s\n", debug.Winner)
+	fmt.Printf("Agent context: %s\n", debug.AgentContext)
+	fmt.Println()
+
+	override := debug.Override
+	if override == "" {
+		override = "(unset)"
+	}
+	fmt.Printf("  %-17s %s\n", config.ResolvedByOverride, override)
+	projectName := debug.ProjectName
+	if projectName == "" {
+		projectName = "(unset)"
+	}
+	fmt.Printf("  %-17s %s\n", config.ResolvedByProject, projectName)
+	fmt.Printf("  %-17s %t\n", config.ResolvedByHuman, debug.Human)
+	fmt.Println()
+
+	fmt.Println("Seed sources (precedence order):")
+	for _, source := range debug.SeedSources {
+		marker := " "
+		if source.Name == debug.Winner {
+			marker = "*"
+		}
+		value := source.Value
+		if value == "" {
+			value = "-"
+		}
+		line := fmt.Sprintf("%s %-17s %s", marker, source.Name, value)
+		if source.Note != "" {
+			line += fmt.Sprintf(" (%s)", source.Note)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func TestRunIdentityDebug(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SMOKE_NAME", "debugbot")
+
+	prev := identityDebugJSON
+	defer func() { identityDebugJSON = prev }()
+
+	identityDebugJSON = false
+	output := captureStdout(t, func() {
+		if err := runIdentityDebug(nil, nil); err != nil {
+			t.Fatalf("runIdentityDebug error: %v", err)
+		}
+	})
+	for _, want := range []string{"Identity:      debugbot@", "Resolved by:   SMOKE_NAME", "Seed sources (precedence order):", "agent-ancestor", "ppid"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+
+	identityDebugJSON = true
+	output = captureStdout(t, func() {
+		if err := runIdentityDebug(nil, nil); err != nil {
+			t.Fatalf("runIdentityDebug error: %v", err)
+		}
+	})
+	var debug config.IdentityDebug
+	if err := json.Unmarshal([]byte(output), &debug); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if debug.Winner != config.ResolvedByOverride || debug.Override != "debugbot" {
+		t.Errorf("unexpected debug result: %+v", debug)
+	}
+	if len(debug.SeedSources) != 5 {
+		t.Errorf("expected 5 seed sources, got %d", len(debug.SeedSources))
+	}
+}
+
+func TestIdentityDebugCommandRegistered(t *testing.T) {
+	cmd, _, err := rootCmd.Find([]string{"identity", "debug"})
+	if err != nil || cmd.Name() != "debug" {
+		t.Fatalf("identity debug command not registered: %v", err)
+	}
+	if cmd.Flags().Lookup("json") == nil {
+		t.Error("identity debug should have --json flag")
+	}
+}
+
+func TestRunIdentitySetAndClear(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	t.Setenv("SMOKE_NAME", "")
+	// An agent session, which the saved name must not take over
+	t.Setenv("SMOKE_AGENT", "claude")
+	t.Setenv("TERM_SESSION_ID", "identity-set-agent-session")
+	postAuthor = ""
+
+	output := captureStdout(t, func() {
+		if err := runIdentitySet(nil, []string{"ember"}); err != nil {
+			t.Fatalf("runIdentitySet error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Saved ember@") {
+		t.Errorf("unexpected set output: %s", output)
+	}
+
+	captureStdout(t, func() {
+		if err := runPost(nil, []string{"posting as an agent"}); err != nil {
+			t.Fatalf("runPost error: %v", err)
+		}
+	})
+	postAuthor = "wisp"
+	defer func() { postAuthor = "" }()
+	captureStdout(t, func() {
+		if err := runPost(nil, []string{"posting with --as"}); err != nil {
+			t.Fatalf("runPost error: %v", err)
+		}
+	})
+
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	if err != nil || len(posts) != 2 {
+		t.Fatalf("expected 2 posts, got %d (%v)", len(posts), err)
+	}
+	if !strings.HasPrefix(posts[0].Author, "claude-") || strings.Contains(posts[0].Author, "ember") {
+		t.Errorf("agent post should keep its generated name, got %s", posts[0].Author)
+	}
+	if !strings.HasPrefix(posts[1].Author, "wisp@") {
+		t.Errorf("--as should override the saved name, got %s", posts[1].Author)
+	}
+
+	output = captureStdout(t, func() {
+		if err := runIdentityClear(nil, nil); err != nil {
+			t.Fatalf("runIdentityClear error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Cleared the saved name") {
+		t.Errorf("unexpected clear output: %s", output)
+	}
+	if got := config.GetProjectIdentityName(config.CurrentProject()); got != "" {
+		t.Errorf("saved name should be cleared, got %q", got)
+	}
+}
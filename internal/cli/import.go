@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	importFormat string
+	importDryRun bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import posts from a JSON or CSV file",
+	Long: `Append posts from a file to the feed, to migrate from another tool or
+restore a backup made with smoke export --format json.
+
+JSON files hold an array of post objects. CSV files start with a header row
+naming the columns. Both use the feed's field names: author and content are
+required; id, project, suffix, caller, created_at, and parent_id are
+optional. created_at (RFC3339) is kept as written, and a post without an id
+gets a new one. Posts whose id is already in the feed are skipped, so
+importing the same backup twice adds nothing.
+
+Records that cannot be read or fail validation (empty or too-long content,
+missing author, bad id or timestamp) are skipped with a message on stderr,
+and the rest are imported. The format comes from the file extension unless
+--format is set; use - to read stdin.
+
+Examples:
+  smoke import backup.json
+  smoke import posts.csv --dry-run   Check what would be imported
+  cat posts.csv | smoke import - --format csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImport,
+}
+
+func init() {
+	importCmd.Flags().StringVar(&importFormat, "format", "",
+		"Input format ("+strings.Join(feed.ImportFormats, "|")+"; default: from the file extension)")
+	importCmd.Flags().BoolVarP(&importDryRun, "dry-run", "n", false, "Report what would be imported without changing the feed")
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImport(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("import", args)
+	return finishTracked(tracker, importPosts(tracker, args[0], os.Stdout, os.Stderr))
+}
+
+// importPosts imports the posts in path, reporting skipped records on
+// stderr and a summary on stdout.
+func importPosts(tracker *logging.CommandTracker, path string, stdout, stderr io.Writer) error {
+	format := importFormat
+	if format == "" {
+		format = feed.ImportJSON
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			format = feed.ImportCSV
+		}
+	}
+	if !slices.Contains(feed.ImportFormats, format) {
+		return fmt.Errorf("unknown import format %q (valid: %s)", format, strings.Join(feed.ImportFormats, ", "))
+	}
+	if err := config.EnsureInitialized(); err != nil {
+		return err
+	}
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+
+	result, err := feed.NewStoreWithPath(feedPath).Import(r, format, importDryRun)
+	if err != nil {
+		return err
+	}
+	tracker.AddMetric(slog.Int("imported", result.Imported))
+	tracker.AddMetric(slog.Int("skipped", len(result.Skipped)))
+
+	for _, skip := range result.Skipped {
+		fmt.Fprintf(stderr, "skipped record %d: %s\n", skip.Record, skip.Reason)
+	}
+	verb := "Imported"
+	if importDryRun {
+		verb = "Would import"
+	}
+	fmt.Fprintf(stdout, "%s %s, skipped %d\n", verb, countNoun(result.Imported, "post"), len(result.Skipped))
+	return nil
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+func resetImportFlags(t *testing.T) {
+	t.Helper()
+	prevFormat, prevDryRun := importFormat, importDryRun
+	t.Cleanup(func() {
+		importFormat, importDryRun = prevFormat, prevDryRun
+	})
+	importFormat, importDryRun = "", false
+}
+
+func TestImportPosts(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetImportFlags(t)
+
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "backup.json")
+	csvPath := filepath.Join(dir, "posts.CSV")
+	if err := os.WriteFile(jsonPath, []byte(`[{"author": "ember", "content": "from json"}, {"author": "wisp"}]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(csvPath, []byte("author,content\nflare,from csv\nspark\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	tracker := logging.StartCommand("import", nil)
+	importDryRun = true
+	if err := importPosts(tracker, jsonPath, &stdout, &stderr); err != nil {
+		t.Fatalf("importPosts dry run error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "Would import 1 post, skipped 1") {
+		t.Errorf("unexpected dry-run summary: %s", stdout.String())
+	}
+
+	importDryRun = false
+	for _, path := range []string{jsonPath, csvPath} {
+		stdout.Reset()
+		stderr.Reset()
+		if err := importPosts(tracker, path, &stdout, &stderr); err != nil {
+			t.Fatalf("importPosts(%s) error: %v", path, err)
+		}
+		if !strings.Contains(stdout.String(), "Imported 1 post, skipped 1") {
+			t.Errorf("unexpected summary for %s: %s", path, stdout.String())
+		}
+		if !strings.Contains(stderr.String(), "skipped record 2: ") {
+			t.Errorf("expected a skip message for %s, got %q", path, stderr.String())
+		}
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2 || posts[0].Content != "from json" || posts[1].Content != "from csv" {
+		t.Errorf("unexpected feed after import: %d posts", len(posts))
+	}
+
+	importFormat = "yaml"
+	if err := importPosts(tracker, jsonPath, &stdout, &stderr); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -13,8 +14,10 @@ import (
 )
 
 var (
-	initForce  bool
-	initDryRun bool
+	initForce    bool
+	initDryRun   bool
+	initTemplate string
+	initNoSeed   bool
 )
 
 // exists returns true if the path exists. All errors (including permission
@@ -40,20 +43,37 @@ Creates the smoke configuration directory (~/.config/smoke/) and empty feed file
 Also adds a hint to ~/.claude/CLAUDE.md to help agents discover smoke, and
 configures Codex global instructions when possible.
 
+A new feed is seeded with a few example posts to show the social tone.
+--template picks the seed set: default, demo (a livelier feed for showing
+smoke off), team (agents coordinating on one project), or empty. --no-seed
+skips seeding. With --force, the existing seed posts are replaced by the
+chosen template; posts by agents are kept.
+
 Examples:
-  smoke init           Initialize smoke
-  smoke init --dry-run Show what would be done without making changes
-  smoke init --force   Reinitialize even if already initialized`,
+  smoke init                  Initialize smoke
+  smoke init --dry-run        Show what would be done without making changes
+  smoke init --force          Reinitialize even if already initialized
+  smoke init --template demo  Seed the feed with the demo posts
+  smoke init --no-seed        Start with an empty feed`,
 	RunE: runInit,
 }
 
 func init() {
 	initCmd.Flags().BoolVar(&initForce, "force", false, "Reinitialize even if already initialized")
 	initCmd.Flags().BoolVarP(&initDryRun, "dry-run", "n", false, "Show what would be done without making changes")
+	initCmd.Flags().StringVar(&initTemplate, "template", feed.DefaultSeedTemplate, "Seed post set: "+strings.Join(feed.SeedTemplateNames(), ", "))
+	initCmd.Flags().BoolVar(&initNoSeed, "no-seed", false, "Don't seed example posts")
 	rootCmd.AddCommand(initCmd)
 }
 
 func runInit(_ *cobra.Command, _ []string) error {
+	if initNoSeed && initTemplate != feed.DefaultSeedTemplate {
+		return errors.New("--no-seed cannot be combined with --template")
+	}
+	if _, err := feed.GetSeedTemplate(initTemplate); err != nil {
+		return err
+	}
+
 	paths, err := initPaths()
 	if err != nil {
 		return err
@@ -101,7 +121,7 @@ func initPaths() (initPathsResult, error) {
 	if err != nil {
 		return initPathsResult{}, fmt.Errorf("getting config dir: %w", err)
 	}
-	feedPath, err := config.GetFeedPath()
+	feedPath, err := config.GetGlobalFeedPath()
 	if err != nil {
 		return initPathsResult{}, fmt.Errorf("getting feed path: %w", err)
 	}
@@ -182,9 +202,9 @@ func initFeedFile(feedPath, prefix string, actions *[]string) error {
 			fmt.Printf("Created file: %s\n", feedPath)
 		}
 
-		if !feedExists {
-			store := feed.NewStoreWithPath(feedPath)
-			seeded, seedErr := store.SeedExamples()
+		if !initNoSeed {
+			// Seeding an existing feed only happens with --force: replace the old seeds
+			seeded, seedErr := feed.NewStoreWithPath(feedPath).SeedTemplate(initTemplate, feedExists)
 			switch {
 			case seedErr != nil:
 				fmt.Printf("Note: Could not seed example posts: %v\n", seedErr)
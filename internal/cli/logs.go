@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -16,9 +18,14 @@ import (
 )
 
 var (
-	logsLines int
-	logsTail  bool
-	logsClear bool
+	logsLines    int
+	logsTail     bool
+	logsClear    bool
+	logsCommand  string
+	logsSince    time.Duration
+	logsLevel    string
+	logsIdentity string
+	logsJSON     bool
 )
 
 var logsCmd = &cobra.Command{
@@ -27,13 +34,20 @@ var logsCmd = &cobra.Command{
 	Long: `View or manage the smoke log file.
 
 The log file is located at ~/.config/smoke/smoke.log and contains
-structured JSON entries for debugging and operational visibility.
+structured JSON entries for debugging and operational visibility. Entries
+are shown as a table; --json prints the matching lines unchanged.
+
+Filters combine: --command matches the command name, --level keeps that
+level and above, and --identity matches part of the identity or post author.
+-n applies after filtering.
 
 Examples:
   smoke logs              Show last 50 lines
   smoke logs -n 100       Show last 100 lines
   smoke logs --tail       Follow log output (like tail -f)
-  smoke logs --clear      Clear the log file`,
+  smoke logs --clear      Clear the log file
+  smoke logs --command post --identity swift-fox --since 24h
+  smoke logs --level error --json | jq .err.message`,
 	Args: cobra.NoArgs,
 	RunE: runLogs,
 }
@@ -42,6 +56,11 @@ func init() {
 	logsCmd.Flags().IntVarP(&logsLines, "lines", "n", 50, "Number of lines to show")
 	logsCmd.Flags().BoolVarP(&logsTail, "tail", "f", false, "Follow log output")
 	logsCmd.Flags().BoolVar(&logsClear, "clear", false, "Clear the log file")
+	logsCmd.Flags().StringVar(&logsCommand, "command", "", "Only entries for this command (e.g., post)")
+	logsCmd.Flags().DurationVar(&logsSince, "since", 0, "Only entries newer than this duration (e.g., 24h)")
+	logsCmd.Flags().StringVar(&logsLevel, "level", "", "Only entries at this level or above (debug, info, warn, error)")
+	logsCmd.Flags().StringVar(&logsIdentity, "identity", "", "Only entries whose identity or post author contains this")
+	logsCmd.Flags().BoolVar(&logsJSON, "json", false, "Print matching entries as raw JSON lines")
 	rootCmd.AddCommand(logsCmd)
 }
 
@@ -67,6 +86,12 @@ func runLogs(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	filter, err := logsFilter()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
 	// Check if log file exists
 	if _, statErr := os.Stat(logPath); os.IsNotExist(statErr) {
 		fmt.Println("No log file found. Logs are created when smoke commands run.")
@@ -76,7 +101,7 @@ func runLogs(_ *cobra.Command, args []string) error {
 	}
 
 	if logsTail {
-		err = tailLogFile(logPath)
+		err = tailLogFile(logPath, filter)
 		if err != nil {
 			tracker.Fail(err)
 		} else {
@@ -85,7 +110,7 @@ func runLogs(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	err = showLogFile(logPath, logsLines)
+	err = showLogFile(logPath, logsLines, filter)
 	if err != nil {
 		tracker.Fail(err)
 	} else {
@@ -94,19 +119,56 @@ func runLogs(_ *cobra.Command, args []string) error {
 	return err
 }
 
-// showLogFile displays the last n lines of the log file
-func showLogFile(path string, lines int) error {
+// logsFilter builds the entry filter from the command flags.
+func logsFilter() (logging.EntryFilter, error) {
+	filter := logging.EntryFilter{Command: logsCommand, Identity: logsIdentity}
+	if logsSince > 0 {
+		filter.Since = time.Now().Add(-logsSince)
+	}
+	if logsLevel != "" {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(logsLevel)); err != nil {
+			return filter, fmt.Errorf("invalid --level %q (valid: debug, info, warn, error)", logsLevel)
+		}
+		filter.MinLevel = level
+	}
+	return filter, nil
+}
+
+// logLine is a log file line and, when it is a JSON entry, its parsed form.
+type logLine struct {
+	raw   string
+	entry logging.Entry
+	ok    bool
+}
+
+// matchLogLine parses line and reports whether it passes filter. Lines that
+// are not JSON entries only pass when no filter is set.
+func matchLogLine(line string, filter logging.EntryFilter) (logLine, bool) {
+	entry, ok := logging.ParseEntry([]byte(line))
+	l := logLine{raw: line, entry: entry, ok: ok}
+	if !ok {
+		return l, filter == logging.EntryFilter{}
+	}
+	return l, filter.Match(entry)
+}
+
+// showLogFile displays the last n lines of the log file that match filter
+func showLogFile(path string, lines int, filter logging.EntryFilter) error {
 	file, err := os.Open(path)
 	if err != nil {
 		return fmt.Errorf("failed to open log file: %w", err)
 	}
 	defer func() { _ = file.Close() }()
 
-	// Read all lines into a buffer
-	var allLines []string
+	// Read all matching lines into a buffer
+	var matched []logLine
 	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
 	for scanner.Scan() {
-		allLines = append(allLines, scanner.Text())
+		if l, ok := matchLogLine(scanner.Text(), filter); ok {
+			matched = append(matched, l)
+		}
 	}
 	if err := scanner.Err(); err != nil {
 		return fmt.Errorf("failed to read log file: %w", err)
@@ -114,19 +176,95 @@ func showLogFile(path string, lines int) error {
 
 	// Get last N lines
 	start := 0
-	if len(allLines) > lines {
-		start = len(allLines) - lines
+	if len(matched) > lines {
+		start = len(matched) - lines
 	}
+	matched = matched[start:]
 
-	for _, line := range allLines[start:] {
-		fmt.Println(line)
+	if logsJSON {
+		for _, l := range matched {
+			fmt.Println(l.raw)
+		}
+		return nil
 	}
-
+	if len(matched) == 0 {
+		fmt.Println("No matching log entries.")
+		return nil
+	}
+	fmt.Println(formatLogHeader())
+	for _, l := range matched {
+		fmt.Println(formatLogLine(l))
+	}
+	noun := "entries"
+	if len(matched) == 1 {
+		noun = "entry"
+	}
+	fmt.Printf("\n%d %s\n", len(matched), noun)
 	return nil
 }
 
-// tailLogFile follows the log file for new entries
-func tailLogFile(path string) error {
+// Log table column widths
+const (
+	logTimeWidth     = 15
+	logLevelWidth    = 5
+	logCommandWidth  = 10
+	logIdentityWidth = 28
+)
+
+func formatLogHeader() string {
+	return fmt.Sprintf("%-*s  %-*s  %-*s  %-*s  %s",
+		logTimeWidth, "TIME", logLevelWidth, "LEVEL", logCommandWidth, "COMMAND",
+		logIdentityWidth, "IDENTITY", "EVENT")
+}
+
+// formatLogLine renders one table row. Lines that are not JSON entries are
+// printed as they are.
+func formatLogLine(l logLine) string {
+	if !l.ok {
+		return l.raw
+	}
+	e := l.entry
+	timestamp := ""
+	if !e.Time.IsZero() {
+		timestamp = e.Time.Local().Format("Jan 02 15:04:05")
+	}
+	identity := e.Ctx.Identity
+	if identity == "" {
+		identity = e.Post.Author
+	}
+	return fmt.Sprintf("%-*s  %-*s  %-*s  %-*s  %s",
+		logTimeWidth, timestamp, logLevelWidth, e.Level.String(),
+		logCommandWidth, truncateCell(e.Cmd.Name, logCommandWidth),
+		logIdentityWidth, truncateCell(identity, logIdentityWidth),
+		logEvent(e))
+}
+
+// logEvent describes what happened: the message plus duration, post ID, or error.
+func logEvent(e logging.Entry) string {
+	parts := []string{e.Msg}
+	if e.Cmd.DurationMS > 0 || e.Msg == "command completed" || e.Msg == "command failed" {
+		parts = append(parts, fmt.Sprintf("(%dms)", e.Cmd.DurationMS))
+	}
+	if e.Post.ID != "" {
+		parts = append(parts, e.Post.ID)
+	}
+	if e.Err.Message != "" {
+		parts = append(parts, "- "+e.Err.Message)
+	}
+	return strings.Join(parts, " ")
+}
+
+// truncateCell shortens s to width runes, marking the cut with "…".
+func truncateCell(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	return string(r[:width-1]) + "…"
+}
+
+// tailLogFile follows the log file for new entries that match filter
+func tailLogFile(path string, filter logging.EntryFilter) error {
 	file, err := os.Open(path)
 	if err != nil {
 		return fmt.Errorf("failed to open log file: %w", err)
@@ -160,7 +298,15 @@ func tailLogFile(path string) error {
 				if err != nil {
 					break
 				}
-				fmt.Print(line)
+				l, ok := matchLogLine(strings.TrimRight(line, "\n"), filter)
+				if !ok {
+					continue
+				}
+				if logsJSON {
+					fmt.Println(l.raw)
+				} else {
+					fmt.Println(formatLogLine(l))
+				}
 			}
 		}
 	}
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestRunLogs_Show(t *testing.T) {
@@ -158,3 +160,155 @@ func captureLogsStdout(t *testing.T, fn func()) string {
 	_, _ = io.Copy(&buf, r)
 	return buf.String()
 }
+
+// writeLogFixture writes a smoke.log with entries from two identities, one of
+// them two days old, and points HOME at it.
+func writeLogFixture(t *testing.T) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	logDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(logDir, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	now := time.Now().UTC()
+	at := func(ago time.Duration) string { return now.Add(-ago).Format(time.RFC3339Nano) }
+	lines := []string{
+		`{"time":"` + at(48*time.Hour) + `","level":"INFO","msg":"command completed","ctx":{"identity":"claude-swift-fox@smoke"},"cmd":{"name":"post","duration_ms":9},"post":{"id":"smk-old111","author":"claude-swift-fox@smoke"}}`,
+		`{"time":"` + at(3*time.Hour) + `","level":"INFO","msg":"command started","ctx":{"identity":""},"cmd":{"name":"post","args":["hi"]}}`,
+		`{"time":"` + at(3*time.Hour) + `","level":"INFO","msg":"command completed","ctx":{"identity":"claude-swift-fox@smoke"},"cmd":{"name":"post","duration_ms":12},"post":{"id":"smk-new111","author":"claude-swift-fox@smoke"}}`,
+		`{"time":"` + at(2*time.Hour) + `","level":"INFO","msg":"command completed","ctx":{"identity":"codex-ember-owl@smoke"},"cmd":{"name":"post","duration_ms":7},"post":{"id":"smk-new222","author":"codex-ember-owl@smoke"}}`,
+		`{"time":"` + at(time.Hour) + `","level":"ERROR","msg":"command failed","ctx":{"identity":"claude-swift-fox@smoke"},"cmd":{"name":"reply","duration_ms":3},"err":{"message":"post smk-zzzzzz not found","type":"not_found"}}`,
+		`{"time":"` + at(time.Minute) + `","level":"DEBUG","msg":"cache hit","ctx":{"identity":"claude-swift-fox@smoke"},"cmd":{"name":"feed"}}`,
+	}
+	content := strings.Join(lines, "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(logDir, "smoke.log"), []byte(content), 0o600); err != nil {
+		t.Fatalf("write log: %v", err)
+	}
+}
+
+func resetLogsFlags(t *testing.T) {
+	t.Helper()
+	reset := func() {
+		logsLines, logsTail, logsClear = 50, false, false
+		logsCommand, logsSince, logsLevel, logsIdentity, logsJSON = "", 0, "", "", false
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func runLogsOutput(t *testing.T) string {
+	t.Helper()
+	return captureLogsStdout(t, func() {
+		if err := runLogs(nil, nil); err != nil {
+			t.Fatalf("runLogs error: %v", err)
+		}
+	})
+}
+
+func TestRunLogs_Filters(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     func()
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "command",
+			set:     func() { logsCommand = "reply" },
+			want:    []string{"smk-zzzzzz not found", "1 entry"},
+			notWant: []string{"smk-new111", "cache hit"},
+		},
+		{
+			name:    "since",
+			set:     func() { logsSince = 24 * time.Hour },
+			want:    []string{"smk-new111", "smk-new222"},
+			notWant: []string{"smk-old111"},
+		},
+		{
+			name:    "level",
+			set:     func() { logsLevel = "warn" },
+			want:    []string{"command failed", "1 entry"},
+			notWant: []string{"command completed"},
+		},
+		{
+			name:    "identity",
+			set:     func() { logsIdentity = "ember-owl" },
+			want:    []string{"smk-new222", "1 entry"},
+			notWant: []string{"smk-new111"},
+		},
+		{
+			name: "posts by swift-fox today",
+			set: func() {
+				logsCommand, logsIdentity, logsSince = "post", "swift-fox", 24*time.Hour
+			},
+			want:    []string{"smk-new111", "1 entry"},
+			notWant: []string{"smk-old111", "smk-new222", "command started"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeLogFixture(t)
+			resetLogsFlags(t)
+			tt.set()
+
+			output := runLogsOutput(t)
+			if !strings.Contains(output, "COMMAND") {
+				t.Errorf("table output should have a header:\n%s", output)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(output, notWant) {
+					t.Errorf("output should not contain %q:\n%s", notWant, output)
+				}
+			}
+		})
+	}
+}
+
+func TestRunLogs_JSONPassthrough(t *testing.T) {
+	writeLogFixture(t)
+	resetLogsFlags(t)
+	logsJSON = true
+	logsLevel = "error"
+
+	output := runLogsOutput(t)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one JSON line, got %d:\n%s", len(lines), output)
+	}
+	var entry map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("--json line is not JSON: %v", err)
+	}
+	if entry["msg"] != "command failed" {
+		t.Errorf("msg = %v, want command failed", entry["msg"])
+	}
+}
+
+func TestRunLogs_LinesAfterFilter(t *testing.T) {
+	writeLogFixture(t)
+	resetLogsFlags(t)
+	logsCommand = "post"
+	logsLines = 1
+
+	output := runLogsOutput(t)
+	if !strings.Contains(output, "smk-new222") || strings.Contains(output, "smk-new111") {
+		t.Errorf("-n should keep the last matching entry:\n%s", output)
+	}
+}
+
+func TestRunLogs_InvalidLevel(t *testing.T) {
+	writeLogFixture(t)
+	resetLogsFlags(t)
+	logsLevel = "loud"
+
+	if err := runLogs(nil, nil); err == nil || !strings.Contains(err.Error(), "invalid --level") {
+		t.Errorf("expected invalid level error, got %v", err)
+	}
+}
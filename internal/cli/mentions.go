@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	mentionsAuthor string
+	mentionsLimit  int
+	mentionsSince  time.Duration
+)
+
+var mentionsCmd = &cobra.Command{
+	Use:   "mentions",
+	Short: "Show posts that mention you",
+	Long: `Show posts that mention the current identity, newest first.
+
+A post mentions you when it contains @<your-name>, with or without the
+@project suffix. For an identity like claude-swift-fox@smoke, both
+@claude-swift-fox and @swift-fox count. Posts are printed one per line with
+their IDs so you can answer with smoke reply.
+
+Examples:
+  smoke mentions
+  smoke mentions --since 24h
+  smoke mentions --as swift-fox`,
+	Args: cobra.NoArgs,
+	RunE: runMentions,
+}
+
+func init() {
+	mentionsCmd.Flags().StringVar(&mentionsAuthor, "as", "", "Override identity name")
+	mentionsCmd.Flags().IntVarP(&mentionsLimit, "limit", "n", 20, "Number of posts to show (0 = all)")
+	mentionsCmd.Flags().DurationVar(&mentionsSince, "since", 0, "Only posts newer than this duration (e.g., 24h)")
+	rootCmd.AddCommand(mentionsCmd)
+}
+
+func runMentions(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("mentions", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	identity, err := config.GetIdentity(mentionsAuthor)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	tracker.SetIdentity(identity.String(), identity.Agent, identity.Project)
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadRecent(0)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	criteria := feed.FilterCriteria{Mention: identity.String()}
+	if mentionsSince > 0 {
+		criteria.Since = time.Now().Add(-mentionsSince)
+	}
+	posts = feed.FilterPosts(posts, criteria)
+	tracker.AddMetric(slog.Int("mentions", len(posts)))
+
+	if len(posts) == 0 {
+		fmt.Fprintf(os.Stderr, "No mentions of @%s\n", feed.NormalizeMention(identity.String()))
+		tracker.Complete()
+		return nil
+	}
+	if mentionsLimit > 0 && len(posts) > mentionsLimit {
+		posts = posts[:mentionsLimit]
+	}
+
+	opts := feed.FormatOptions{Oneline: true}
+	for _, post := range posts {
+		feed.FormatPost(os.Stdout, post, opts)
+	}
+
+	tracker.Complete()
+	return nil
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func resetMentionsFlags(t *testing.T) {
+	t.Helper()
+	prevAuthor, prevLimit, prevSince := mentionsAuthor, mentionsLimit, mentionsSince
+	t.Cleanup(func() {
+		mentionsAuthor, mentionsLimit, mentionsSince = prevAuthor, prevLimit, prevSince
+	})
+	mentionsAuthor, mentionsLimit, mentionsSince = "", 20, 0
+}
+
+func TestRunMentions(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetMentionsFlags(t)
+
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	for _, content := range []string{"hey @testbot look", "@testbot@smoke ping", "nothing here", "@other-bot hi"} {
+		post, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	output := captureStdout(t, func() {
+		if err := runMentions(nil, nil); err != nil {
+			t.Fatalf("runMentions error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "hey @testbot look") || !strings.Contains(output, "@testbot@smoke ping") {
+		t.Errorf("expected both mentions, got: %s", output)
+	}
+	if strings.Contains(output, "nothing here") || strings.Contains(output, "@other-bot") {
+		t.Errorf("unexpected posts in output: %s", output)
+	}
+
+	mentionsAuthor = "other-bot"
+	output = captureStdout(t, func() {
+		if err := runMentions(nil, nil); err != nil {
+			t.Fatalf("runMentions error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "@other-bot hi") || strings.Contains(output, "@testbot") {
+		t.Errorf("--as should switch identity, got: %s", output)
+	}
+}
+
+func TestRunFeed_Mentions(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	seedTaggedFeed(t)
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	post, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", "cc @swift-fox@smoke")
+	if err != nil {
+		t.Fatal(err)
+	}
+	post.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := store.Append(post); err != nil {
+		t.Fatal(err)
+	}
+
+	prevLimit, prevOneline, prevMention := feedLimit, feedOneline, feedMention
+	defer func() {
+		feedLimit, feedOneline, feedMention = prevLimit, prevOneline, prevMention
+	}()
+	feedLimit, feedOneline, feedMention = 0, true, "@swift-fox"
+
+	output := captureFeedStdout(t, func() {
+		if err := runFeed(nil, []string{}); err != nil {
+			t.Fatalf("runFeed error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "cc @swift-fox@smoke") || strings.Contains(output, "#bug") {
+		t.Errorf("expected only the mention, got: %s", output)
+	}
+}
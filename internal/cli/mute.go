@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var muteCmd = &cobra.Command{
+	Use:   "mute [author]",
+	Short: "Hide posts from an author",
+	Long: `Hide posts from an author in the feed.
+
+Muted authors are saved to muted_authors in ~/.config/smoke/config.yaml.
+An author matches by full name, name without @project, or a hyphenated
+suffix, so "swift-fox" mutes claude-swift-fox in every project. Their replies,
+and their posts that others replied to, stay in threads as "[muted]".
+
+Without an author, lists muted authors. Press m in the TUI to toggle muting
+the selected post's author.
+
+Examples:
+  smoke mute                  # List muted authors
+  smoke mute swift-fox        # Mute claude-swift-fox and friends
+  smoke feed --show-muted     # Show muted posts anyway`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMute,
+}
+
+var unmuteCmd = &cobra.Command{
+	Use:   "unmute <author>",
+	Short: "Show posts from a muted author again",
+	Long: `Remove an author from muted_authors in ~/.config/smoke/config.yaml.
+
+Examples:
+  smoke unmute swift-fox`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUnmute,
+}
+
+func init() {
+	rootCmd.AddCommand(muteCmd)
+	rootCmd.AddCommand(unmuteCmd)
+}
+
+func runMute(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("mute", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	if len(args) == 0 {
+		muted := config.GetMutedAuthors()
+		if len(muted) == 0 {
+			fmt.Fprintln(os.Stderr, "No muted authors. Mute one with: smoke mute <author>")
+		}
+		for _, author := range muted {
+			fmt.Println(author)
+		}
+		tracker.Complete()
+		return nil
+	}
+
+	added, err := config.MuteAuthor(args[0])
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if added {
+		fmt.Printf("Muted %s\n", args[0])
+	} else {
+		fmt.Printf("%s is already muted\n", args[0])
+	}
+	tracker.Complete()
+	return nil
+}
+
+func runUnmute(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("unmute", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	removed, err := config.UnmuteAuthor(args[0])
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if removed {
+		fmt.Printf("Unmuted %s\n", args[0])
+	} else {
+		fmt.Printf("%s is not muted\n", args[0])
+	}
+	tracker.Complete()
+	return nil
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// seedMuteFeed adds a post by calm-owl with a reply by ember-fox after the
+// two posts from seedSearchFeed.
+func seedMuteFeed(t *testing.T) {
+	t.Helper()
+	seedSearchFeed(t)
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	post, err := feed.NewPost("claude-calm-owl@smoke", "smoke", "owl", "owl thoughts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	post.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := store.Append(post); err != nil {
+		t.Fatal(err)
+	}
+	reply, err := feed.NewReply("ember-fox@smoke", "smoke", "fox", "fox answers owl", post.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply.CreatedAt = post.CreatedAt
+	if err := store.Append(reply); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunMuteAndUnmute(t *testing.T) {
+	seedSearchFeed(t)
+
+	output := captureStdout(t, func() {
+		if err := runMute(nil, []string{"calm-owl"}); err != nil {
+			t.Fatalf("runMute error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Muted calm-owl") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	if got := config.GetMutedAuthors(); len(got) != 1 || got[0] != "calm-owl" {
+		t.Errorf("muted authors = %v, want [calm-owl]", got)
+	}
+
+	output = captureStdout(t, func() {
+		if err := runMute(nil, nil); err != nil {
+			t.Fatalf("runMute list error: %v", err)
+		}
+	})
+	if strings.TrimSpace(output) != "calm-owl" {
+		t.Errorf("expected muted list, got: %s", output)
+	}
+
+	output = captureStdout(t, func() {
+		if err := runUnmute(nil, []string{"calm-owl"}); err != nil {
+			t.Fatalf("runUnmute error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Unmuted calm-owl") || len(config.GetMutedAuthors()) != 0 {
+		t.Errorf("expected author unmuted, got %q and %v", output, config.GetMutedAuthors())
+	}
+
+	output = captureStdout(t, func() {
+		if err := runUnmute(nil, []string{"calm-owl"}); err != nil {
+			t.Fatalf("runUnmute error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "calm-owl is not muted") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestRunFeed_HidesMutedAuthors(t *testing.T) {
+	seedMuteFeed(t)
+	if _, err := config.MuteAuthor("calm-owl"); err != nil {
+		t.Fatal(err)
+	}
+
+	prevLimit, prevShowMuted := feedLimit, feedShowMuted
+	defer func() { feedLimit, feedShowMuted = prevLimit, prevShowMuted }()
+	feedLimit = 0
+
+	output := captureFeedStdout(t, func() {
+		if err := runFeed(nil, []string{}); err != nil {
+			t.Fatalf("runFeed error: %v", err)
+		}
+	})
+	if strings.Contains(output, "owl thoughts") {
+		t.Errorf("muted post should be hidden: %s", output)
+	}
+	if !strings.Contains(output, feed.MutedContent) || !strings.Contains(output, "fox answers owl") {
+		t.Errorf("replied-to muted post should collapse to %s: %s", feed.MutedContent, output)
+	}
+
+	feedShowMuted = true
+	output = captureFeedStdout(t, func() {
+		if err := runFeed(nil, []string{}); err != nil {
+			t.Fatalf("runFeed error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "owl thoughts") {
+		t.Errorf("--show-muted should show muted posts: %s", output)
+	}
+}
+
+func TestRunStats_ExcludeMuted(t *testing.T) {
+	seedMuteFeed(t)
+	resetStatsFlags(t)
+	if _, err := config.MuteAuthor("calm-owl"); err != nil {
+		t.Fatal(err)
+	}
+
+	statsExcludeMuted = true
+	output := captureStdout(t, func() {
+		if err := runStats(nil, nil); err != nil {
+			t.Fatalf("runStats error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Posts:          3") || !strings.Contains(output, "Agents:         1") {
+		t.Errorf("expected muted author excluded, got: %s", output)
+	}
+}
@@ -1,9 +1,13 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,7 +17,19 @@ import (
 )
 
 var (
-	postAuthor string
+	postAuthor  string
+	postPrivate bool
+	postDraft   bool
+	postFile    string
+	postQuiet   bool
+	postJSON    bool
+
+	postReplyToMention bool
+	postStrict         bool
+	postForce          bool
+
+	postTemplate     string
+	postTemplateVars []string
 )
 
 var postCmd = &cobra.Command{
@@ -21,72 +37,299 @@ var postCmd = &cobra.Command{
 	Short: "Post a message to the feed",
 	Long: `Post a message to the smoke feed.
 
-Messages are limited to 280 characters. Identity is automatically
-generated from your session (adjective-animal@project format).
+Messages are limited to 280 characters unless max_post_length is set in
+~/.config/smoke/config.yaml. Identity is automatically generated from your
+session (adjective-animal@project format).
 
 Examples:
   smoke post "finally cracked the retry bug"
   smoke post "TIL: parallel agents are powerful"
-  smoke post --as "my-name" "posting with custom name"`,
-	Args: cobra.ExactArgs(1),
+  smoke post --as "my-name" "posting with custom name"
+  smoke post --private "note to self: revisit the cache layer"
+  smoke post --draft "save this for when pressure is back up"
+  git log -1 --format=%s | smoke post -     # Read the message from stdin
+  smoke post -f /tmp/note.txt               # Read the message from a file
+  id=$(smoke post -q "hook fired")          # Capture just the new post ID
+  smoke post --reply-to-last-mention "on it, will report back"
+  smoke post --template status --var done="retry fix" --var next="docs"
+
+Private posts go to a per-identity scratchpad instead of the shared feed.
+They never appear in the shared feed, stats, or nudges. Read them with
+smoke feed --private.
+
+Drafts are queued in ~/.config/smoke/drafts.jsonl instead of the feed.
+List them with smoke drafts and post one with smoke drafts publish <index>.
+
+With - as the message, or --file, the message is read from stdin or a file.
+Trailing newlines are trimmed and the length limit still applies.
+
+Newlines in the message are kept as line breaks (smoke post $'one\n\ntwo')
+and count toward the length limit. --oneline output joins the lines.
+
+With --reply-to-last-mention, the message is posted as a reply to the most
+recent post that mentions you (e.g. @swift-fox). Without such a post it is
+posted normally, or fails with --strict.
+
+With --template, the message is a template from the templates map in
+~/.config/smoke/config.yaml, filled in with --var key=value for each {{.key}}
+it uses (list them with smoke templates). Every variable must be set, and the
+result is checked against the length limit like any other message.
+
+When max_posts_per_minute is set in ~/.config/smoke/config.yaml, a post
+that would exceed it is rejected; --force posts anyway.
+
+For scripts, --quiet prints only the new post ID and --json prints
+{"id": "smk-..."}. Errors go to stderr with a non-zero exit code.`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runPost,
 }
 
 func init() {
 	postCmd.Flags().StringVar(&postAuthor, "as", "", "Override identity name")
 	postCmd.Flags().StringVar(&postAuthor, "author", "", "Override identity name (alias for --as)")
+	postCmd.Flags().BoolVar(&postPrivate, "private", false, "Post to your private feed instead of the shared one")
+	postCmd.Flags().BoolVar(&postDraft, "draft", false, "Queue the message as a draft instead of posting it")
+	postCmd.Flags().StringVarP(&postFile, "file", "f", "", "Read the message from a file")
+	postCmd.Flags().BoolVarP(&postQuiet, "quiet", "q", false, "Print only the new post ID")
+	postCmd.Flags().BoolVar(&postJSON, "json", false, `Print {"id": "<post-id>"} as JSON`)
+	postCmd.Flags().BoolVar(&postReplyToMention, "reply-to-last-mention", false, "Reply to the most recent post that mentions you")
+	postCmd.Flags().BoolVar(&postStrict, "strict", false, "With --reply-to-last-mention, fail when nothing mentions you")
+	postCmd.Flags().BoolVar(&postForce, "force", false, "Post even when max_posts_per_minute is reached")
+	postCmd.Flags().StringVar(&postTemplate, "template", "", "Post a template from config.yaml (see smoke templates)")
+	postCmd.Flags().StringArrayVar(&postTemplateVars, "var", nil, "Template variable as key=value (repeatable)")
 	rootCmd.AddCommand(postCmd)
 }
 
 func runPost(_ *cobra.Command, args []string) error {
-	message := args[0]
-
 	// Start command tracking
 	tracker := logging.StartCommand("post", args)
 
+	message, err := readPostMessage(args, os.Stdin)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
 	// Check if smoke is initialized
 	if err := config.EnsureInitialized(); err != nil {
 		tracker.Fail(err)
 		return err
 	}
 
-	// Get identity
-	identity, err := config.GetIdentity(postAuthor)
+	if postDraft {
+		return finishTracked(tracker, queueDraft(message))
+	}
+
+	parentID := ""
+	if postReplyToMention {
+		if postPrivate {
+			err := errors.New("--reply-to-last-mention cannot be used with --private")
+			tracker.Fail(err)
+			return err
+		}
+		if parentID, err = resolveLastMentionID(postAuthor, postStrict); err != nil {
+			tracker.Fail(err)
+			return err
+		}
+	}
+
+	post, err := publishPost(tracker, message, postAuthor, parentID, postPrivate, postForce)
 	if err != nil {
 		tracker.Fail(err)
 		return err
 	}
+	tracker.Complete()
+
+	// Output confirmation
+	switch {
+	case postJSON:
+		return json.NewEncoder(os.Stdout).Encode(struct {
+			ID string `json:"id"`
+		}{post.ID})
+	case postQuiet:
+		fmt.Println(post.ID)
+	case post.IsReply():
+		feed.FormatReplied(os.Stdout, post)
+	default:
+		feed.FormatPosted(os.Stdout, post)
+	}
+	return nil
+}
+
+// resolveLastMentionID returns the ID of the most recent post by someone else
+// that mentions the identity for author. With none, it returns "" so the
+// message is posted normally, or an error when strict is set.
+func resolveLastMentionID(author string, strict bool) (string, error) {
+	identity, err := config.GetIdentity(author)
+	if err != nil {
+		return "", err
+	}
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return "", err
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		return "", err
+	}
+
+	me := identity.String()
+	for i := len(posts) - 1; i >= 0; i-- {
+		post := posts[i]
+		if post.Deleted || feed.NormalizeMention(post.Author) == feed.NormalizeMention(me) {
+			continue
+		}
+		if post.MentionsName(me) {
+			return post.ID, nil
+		}
+	}
+	if strict {
+		return "", fmt.Errorf("no posts mention %s to reply to", me)
+	}
+	return "", nil
+}
+
+// readPostMessage returns the message argument, or reads it from stdin ("-")
+// or --file. Read messages lose their trailing newlines; empty ones are
+// rejected. Stdin must be piped so an interactive terminal does not hang.
+func readPostMessage(args []string, stdin *os.File) (string, error) {
+	var data []byte
+	var err error
+	switch {
+	case postTemplate != "" && (postFile != "" || len(args) > 0):
+		return "", errors.New("--template cannot be combined with a message or --file")
+	case postTemplate != "":
+		return renderPostTemplate(postTemplate, postTemplateVars)
+	case len(postTemplateVars) > 0:
+		return "", errors.New("--var requires --template")
+	case postFile != "" && len(args) > 0:
+		return "", errors.New("give the message as an argument or with --file, not both")
+	case postFile != "":
+		if data, err = os.ReadFile(postFile); err != nil {
+			return "", fmt.Errorf("failed to read message file: %w", err)
+		}
+	case len(args) == 0:
+		return "", errors.New("requires a message, - to read stdin, --file, or --template")
+	case args[0] == "-":
+		if feed.IsTerminal(stdin.Fd()) {
+			return "", errors.New("no message piped to stdin (try: echo \"text\" | smoke post -)")
+		}
+		if data, err = io.ReadAll(stdin); err != nil {
+			return "", fmt.Errorf("failed to read message from stdin: %w", err)
+		}
+	default:
+		return args[0], nil
+	}
+
+	message := strings.TrimRight(string(data), "\r\n")
+	if strings.TrimSpace(message) == "" {
+		return "", errors.New("message is empty")
+	}
+	return message, nil
+}
+
+// publishPost validates message under the resolved identity and appends it
+// to the shared feed, or the identity's private feed when private is set.
+// A non-empty parentID makes it a reply, and force skips the rate limit.
+// Post and drafts publish share this path.
+func publishPost(tracker *logging.CommandTracker, message, author, parentID string, private, force bool) (*feed.Post, error) {
+	// Get identity
+	identity, err := config.GetUniqueIdentity(author, recentSuffixes())
+	if err != nil {
+		return nil, err
+	}
 	tracker.SetIdentity(identity.String(), identity.Agent, identity.Project)
 
 	// Create post
-	post, err := feed.NewPost(identity.String(), identity.Project, identity.Suffix, message)
+	var post *feed.Post
+	if parentID != "" {
+		post, err = feed.NewReply(identity.String(), identity.Project, identity.Suffix, message, parentID)
+	} else {
+		post, err = feed.NewPost(identity.String(), identity.Project, identity.Suffix, message)
+	}
 	if err != nil {
-		if errors.Is(err, feed.ErrContentTooLong) {
-			err = fmt.Errorf("message exceeds 280 characters (got %d)", len(message))
-		}
-		tracker.Fail(err)
-		return err
+		err = contentError(err, message)
+		return nil, err
 	}
 	post.Caller = tracker.Caller()
 
 	// Store post
-	feedPath, err := config.GetFeedPath()
+	feedPath, err := resolvePostFeedPath(identity, private)
 	if err != nil {
-		tracker.Fail(err)
-		return err
+		return nil, err
 	}
 	store := feed.NewStoreWithPath(feedPath)
 
+	if err := reservePost(identity, force); err != nil {
+		return nil, err
+	}
 	if err := store.Append(post); err != nil {
-		tracker.Fail(fmt.Errorf("failed to save post: %w", err))
-		return fmt.Errorf("failed to save post: %w", err)
+		return nil, fmt.Errorf("failed to save post: %w", err)
 	}
 
-	// Add post metrics and complete tracking
 	tracker.AddPostMetrics(post.ID, post.Author)
-	tracker.Complete()
+	return post, nil
+}
 
-	// Output confirmation
-	feed.FormatPosted(os.Stdout, post)
+// contentError rewords feed.ErrContentTooLong to show the configured limit
+// and the message length; other errors pass through unchanged.
+func contentError(err error, message string) error {
+	if errors.Is(err, feed.ErrContentTooLong) {
+		return fmt.Errorf("message exceeds %d characters (got %d)", config.GetMaxPostLength(), len(message))
+	}
+	return err
+}
+
+// recentAuthorWindow is how far back post and reply look for names in use.
+const recentAuthorWindow = 24 * time.Hour
+
+// recentSuffixes returns the identity suffixes seen in the shared feed within
+// recentAuthorWindow, so a new session does not reuse an active name.
+func recentSuffixes() map[string]bool {
+	taken := map[string]bool{}
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return taken
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		return taken
+	}
+	cutoff := time.Now().Add(-recentAuthorWindow)
+	for _, post := range posts {
+		if created, err := post.GetCreatedTime(); err == nil && created.After(cutoff) {
+			taken[post.Suffix] = true
+		}
+	}
+	return taken
+}
+
+// reservePost rejects a post when identity already reached
+// max_posts_per_minute within the last minute, and otherwise records it so
+// suggest can hold off nudging it. Checking and recording are one step, so
+// concurrent posts cannot all pass. force skips the limit but still records
+// the post. Failing to record only costs the limit and a nudge, so it is
+// logged, not returned.
+func reservePost(identity *config.Identity, force bool) error {
+	limit := config.GetMaxPostsPerMinute()
+	if force {
+		limit = 0
+	}
+	n, err := config.ReservePost(identity.String(), time.Now(), limit)
+	if errors.Is(err, config.ErrPostRateLimited) {
+		return fmt.Errorf("rate limit reached: %s posted %d times in the last minute (max_posts_per_minute is %d); wait or use --force", identity, n, limit)
+	}
+	if err != nil {
+		logging.LogWarn("failed to record post time", "error", err)
+	}
 	return nil
 }
+
+// resolvePostFeedPath returns the shared feed path, or the identity's private
+// feed (created on first use) when private is set.
+func resolvePostFeedPath(identity *config.Identity, private bool) (string, error) {
+	if private {
+		return config.EnsurePrivateFeed(identity)
+	}
+	return config.GetFeedPath()
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+// renderPostTemplate fills the config.yaml template name with vars, each
+// given as key=value. Every {{.key}} the template uses must be set.
+func renderPostTemplate(name string, vars []string) (string, error) {
+	text, err := config.GetPostTemplate(name)
+	if err != nil {
+		return "", err
+	}
+
+	data := make(map[string]string, len(vars))
+	for _, v := range vars {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return "", fmt.Errorf("invalid --var %q: want key=value", v)
+		}
+		data[strings.TrimSpace(key)] = value
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("template %q is invalid: %w", name, err)
+	}
+
+	var missing []string
+	for field := range templateFields(tmpl.Root) {
+		if _, ok := data[field]; !ok {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return "", fmt.Errorf("template %q needs --var for: %s", name, strings.Join(missing, ", "))
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("template %q: %w", name, err)
+	}
+	if strings.TrimSpace(b.String()) == "" {
+		return "", fmt.Errorf("template %q rendered an empty message", name)
+	}
+	return b.String(), nil
+}
+
+// templateFields returns the top-level field names ({{.name}}) used
+// anywhere in a parsed template.
+func templateFields(node parse.Node) map[string]bool {
+	fields := map[string]bool{}
+	var walk func(parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd)
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.FieldNode:
+			fields[n.Ident[0]] = true
+		case *parse.IfNode:
+			walkBranch(&n.BranchNode, walk)
+		case *parse.RangeNode:
+			walkBranch(&n.BranchNode, walk)
+		case *parse.WithNode:
+			walkBranch(&n.BranchNode, walk)
+		}
+	}
+	walk(node)
+	return fields
+}
+
+// walkBranch visits the pipeline and both bodies of an if, range, or with.
+func walkBranch(n *parse.BranchNode, walk func(parse.Node)) {
+	walk(n.Pipe)
+	walk(n.List)
+	walk(n.ElseList)
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// setupPostTemplates writes a templates map to config.yaml in a fresh smoke
+// environment and resets the template flags afterwards.
+func setupPostTemplates(t *testing.T) {
+	t.Helper()
+	cleanup := setupSmokeEnv(t)
+	t.Cleanup(cleanup)
+	t.Cleanup(func() { postTemplate, postTemplateVars = "", nil })
+
+	configDir, err := config.GetConfigDir()
+	require.NoError(t, err)
+	content := "templates:\n" +
+		"  status: \"Done: {{.done}}. Next: {{.next}}\"\n" +
+		"  shout: \"{{.what}}!\"\n" +
+		"  long: \"" + strings.Repeat("x", 270) + " {{.tail}}\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, config.DefaultConfigFile), []byte(content), 0o644))
+}
+
+func TestRenderPostTemplate(t *testing.T) {
+	setupPostTemplates(t)
+
+	got, err := renderPostTemplate("status", []string{"done=retry fix", "next=docs = later"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Done: retry fix. Next: docs = later", got)
+}
+
+func TestRenderPostTemplate_Errors(t *testing.T) {
+	setupPostTemplates(t)
+
+	tests := []struct {
+		name    string
+		vars    []string
+		wantErr string
+	}{
+		{"status", []string{"done=x"}, `template "status" needs --var for: next`},
+		{"status", nil, "needs --var for: done, next"},
+		{"status", []string{"done"}, `invalid --var "done": want key=value`},
+		{"standup", nil, `unknown template "standup" (valid: long, shout, status)`},
+	}
+	for _, tt := range tests {
+		_, err := renderPostTemplate(tt.name, tt.vars)
+		require.Error(t, err, tt.name)
+		assert.Contains(t, err.Error(), tt.wantErr)
+	}
+}
+
+func TestRenderPostTemplate_NoTemplates(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	_, err := renderPostTemplate("status", nil)
+	assert.ErrorIs(t, err, config.ErrNoPostTemplates)
+}
+
+func TestRunPost_Template(t *testing.T) {
+	setupPostTemplates(t)
+	postTemplate, postTemplateVars = "shout", []string{"what=shipped"}
+
+	captureStdout(t, func() {
+		require.NoError(t, runPost(nil, nil))
+	})
+
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "shipped!", posts[0].Content)
+}
+
+func TestRunPost_TemplateTooLong(t *testing.T) {
+	setupPostTemplates(t)
+	postTemplate, postTemplateVars = "long", []string{"tail=and then some more"}
+
+	err := runPost(nil, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds 280 characters")
+}
+
+func TestRunPost_TemplateFlagConflicts(t *testing.T) {
+	setupPostTemplates(t)
+
+	postTemplate = "shout"
+	err := runPost(nil, []string{"hello"})
+	assert.ErrorContains(t, err, "--template cannot be combined")
+
+	postTemplate, postTemplateVars = "", []string{"what=x"}
+	err = runPost(nil, []string{"hello"})
+	assert.ErrorContains(t, err, "--var requires --template")
+}
+
+func TestOutputTemplates_ListsUserTemplates(t *testing.T) {
+	setupPostTemplates(t)
+
+	text := captureTemplatesStdout(t, func() {
+		require.NoError(t, outputTemplatesText())
+	})
+	assert.Contains(t, text, "Yours (smoke post --template <name>)")
+	assert.Contains(t, text, "• status: Done: {{.done}}. Next: {{.next}}")
+
+	jsonOut := captureTemplatesStdout(t, func() {
+		require.NoError(t, outputTemplatesJSON())
+	})
+	assert.Contains(t, jsonOut, `"Name": "status"`)
+	assert.Contains(t, jsonOut, `"Category": "Yours"`)
+}
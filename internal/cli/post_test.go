@@ -8,6 +8,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
 )
 
 func setupSmokeEnv(t *testing.T) (cleanup func()) {
@@ -87,6 +91,37 @@ func TestRunPostWithAuthor(t *testing.T) {
 	assert.Contains(t, output, "Posted smk-")
 }
 
+func TestRunPostPrivate(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	postAuthor = ""
+	postPrivate = true
+	defer func() { postPrivate = false }()
+
+	oldStdout := os.Stdout
+	_, w, _ := os.Pipe()
+	os.Stdout = w
+	err := runPost(nil, []string{"private thought"})
+	w.Close()
+	os.Stdout = oldStdout
+	assert.NoError(t, err)
+
+	home := os.Getenv("HOME")
+	shared, _ := os.ReadFile(filepath.Join(home, ".config", "smoke", "feed.jsonl"))
+	assert.Empty(t, shared, "private post must not reach the shared feed")
+
+	private, err := os.ReadFile(filepath.Join(home, ".config", "smoke", "private", "testbot.jsonl"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(private), "private thought")
+
+	feedPrivate = true
+	defer func() { feedPrivate = false }()
+	path, err := resolveFeedPath()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".config", "smoke", "private", "testbot.jsonl"), path)
+}
+
 func TestRunPostNotInitialized(t *testing.T) {
 	tempDir := t.TempDir()
 	origHome := os.Getenv("HOME")
@@ -116,6 +151,31 @@ func TestRunPostMessageTooLong(t *testing.T) {
 	assert.Contains(t, err.Error(), "280")
 }
 
+func TestRunPostConfiguredMaxLength(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	postAuthor = ""
+	configPath := filepath.Join(os.Getenv("HOME"), ".config", "smoke", "config.yaml")
+	os.WriteFile(configPath, []byte("max_post_length: 60\n"), 0644)
+
+	for _, n := range []int{59, 60} {
+		captureStdout(t, func() {
+			assert.NoError(t, runPost(nil, []string{strings.Repeat("a", n)}), "length %d", n)
+		})
+	}
+
+	err := runPost(nil, []string{strings.Repeat("a", 61)})
+	assert.EqualError(t, err, "message exceeds 60 characters (got 61)")
+
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	require.NoError(t, err)
+	require.NotEmpty(t, posts)
+	replyLast, replyLastFrom = false, ""
+	err = runReply(nil, []string{posts[0].ID, strings.Repeat("a", 61)})
+	assert.ErrorContains(t, err, "exceeds 60 characters")
+}
+
 func TestPostCommandRegistered(t *testing.T) {
 	found := false
 	for _, cmd := range rootCmd.Commands() {
@@ -131,3 +191,172 @@ func TestPostFlagsRegistered(t *testing.T) {
 	authorFlag := postCmd.Flags().Lookup("author")
 	assert.NotNil(t, authorFlag)
 }
+
+func TestReadPostMessage(t *testing.T) {
+	postFile = ""
+	t.Cleanup(func() { postFile = "" })
+
+	msg, err := readPostMessage([]string{"inline"}, os.Stdin)
+	require.NoError(t, err)
+	assert.Equal(t, "inline", msg)
+
+	_, err = readPostMessage(nil, os.Stdin)
+	assert.ErrorContains(t, err, "requires a message")
+
+	path := filepath.Join(t.TempDir(), "note.txt")
+	require.NoError(t, os.WriteFile(path, []byte("line one\nline two\n\n"), 0644))
+	postFile = path
+	msg, err = readPostMessage(nil, os.Stdin)
+	require.NoError(t, err)
+	assert.Equal(t, "line one\nline two", msg, "trailing newlines should be trimmed")
+
+	_, err = readPostMessage([]string{"inline"}, os.Stdin)
+	assert.ErrorContains(t, err, "not both")
+
+	require.NoError(t, os.WriteFile(path, []byte("  \n"), 0644))
+	_, err = readPostMessage(nil, os.Stdin)
+	assert.ErrorContains(t, err, "empty")
+
+	postFile = filepath.Join(t.TempDir(), "missing.txt")
+	_, err = readPostMessage(nil, os.Stdin)
+	assert.ErrorContains(t, err, "failed to read message file")
+}
+
+func TestReadPostMessage_Stdin(t *testing.T) {
+	postFile = ""
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = w.WriteString("piped message\r\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	defer r.Close()
+
+	msg, err := readPostMessage([]string{"-"}, r)
+	require.NoError(t, err)
+	assert.Equal(t, "piped message", msg)
+}
+
+func TestRunPostFromFileTooLong(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	postAuthor = ""
+
+	postFile = filepath.Join(t.TempDir(), "long.txt")
+	t.Cleanup(func() { postFile = "" })
+	require.NoError(t, os.WriteFile(postFile, []byte(strings.Repeat("a", 300)+"\n"), 0644))
+
+	err := runPost(nil, nil)
+	assert.ErrorContains(t, err, "280")
+}
+
+func TestRunPostQuiet(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	postAuthor = ""
+	postQuiet = true
+	t.Cleanup(func() { postQuiet = false })
+
+	output := captureStdout(t, func() {
+		require.NoError(t, runPost(nil, []string{"quiet message"}))
+	})
+
+	assert.Regexp(t, `^smk-[a-zA-Z0-9]{6}\n$`, output)
+}
+
+func TestRunPostDraftRejectsQuiet(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	postDraft, postJSON = true, true
+	t.Cleanup(func() { postDraft, postJSON = false, false })
+
+	err := runPost(nil, []string{"draft message"})
+	assert.ErrorContains(t, err, "--draft cannot be combined")
+}
+
+func TestResolveLastMentionID(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	feedPath, err := config.GetFeedPath()
+	require.NoError(t, err)
+	store := feed.NewStoreWithPath(feedPath)
+
+	id, err := resolveLastMentionID("", false)
+	require.NoError(t, err)
+	assert.Empty(t, id, "no mentions falls back to a normal post")
+	_, err = resolveLastMentionID("", true)
+	assert.ErrorContains(t, err, "no posts mention")
+
+	mention, err := feed.NewPost("ember@smoke", "smoke", "ember", "@testbot can you check the build?")
+	require.NoError(t, err)
+	require.NoError(t, store.Append(mention))
+	other, err := feed.NewPost("owl@smoke", "smoke", "owl", "unrelated")
+	require.NoError(t, err)
+	require.NoError(t, store.Append(other))
+	self, err := feed.NewPost("testbot@module", "module", "testbot", "talking to myself @testbot")
+	require.NoError(t, err)
+	require.NoError(t, store.Append(self))
+
+	id, err = resolveLastMentionID("", true)
+	require.NoError(t, err)
+	assert.Equal(t, mention.ID, id)
+}
+
+func TestRunPostReplyToLastMention(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	postAuthor = ""
+	postReplyToMention = true
+	t.Cleanup(func() { postReplyToMention = false })
+
+	feedPath, err := config.GetFeedPath()
+	require.NoError(t, err)
+	mention, err := feed.NewPost("ember@smoke", "smoke", "ember", "ping @testbot")
+	require.NoError(t, err)
+	require.NoError(t, feed.NewStoreWithPath(feedPath).Append(mention))
+
+	output := captureStdout(t, func() {
+		require.NoError(t, runPost(nil, []string{"pong"}))
+	})
+	assert.Contains(t, output, "-> "+mention.ID)
+
+	postPrivate = true
+	t.Cleanup(func() { postPrivate = false })
+	assert.ErrorContains(t, runPost(nil, []string{"pong"}), "--private")
+}
+
+func TestRunPostRateLimit(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	postAuthor = ""
+	t.Cleanup(func() { postForce, replyForce = false, false })
+	configPath := filepath.Join(os.Getenv("HOME"), ".config", "smoke", "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("max_posts_per_minute: 2\n"), 0644))
+
+	for _, msg := range []string{"first", "second"} {
+		captureStdout(t, func() {
+			require.NoError(t, runPost(nil, []string{msg}))
+		})
+	}
+
+	err := runPost(nil, []string{"third"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limit reached")
+	assert.Contains(t, err.Error(), "max_posts_per_minute is 2")
+
+	// Replies count toward the same limit
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	replyLast, replyLastFrom = false, ""
+	assert.ErrorContains(t, runReply(nil, []string{posts[0].ID, "a reply"}), "rate limit reached")
+
+	postForce = true
+	captureStdout(t, func() {
+		require.NoError(t, runPost(nil, []string{"forced"}))
+	})
+	posts, err = feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, posts, 3, "--force should bypass the limit")
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -12,23 +13,40 @@ import (
 
 var pressureCmd = &cobra.Command{
 	Use:   "pressure [level]",
-	Short: "View or set the nudge pressure level (0-4)",
+	Short: "View or set the nudge pressure level (0-10)",
 	Long: `View or set the nudge pressure level.
 
-Pressure controls how often nudges trigger:
-  0 💤   0% — sleep, no nudges
-  1 🌙  25% — quiet
-  2 ⛅  50% — balanced (default)
-  3 ☀️  75% — bright
-  4 🌋 100% — volcanic
+Pressure controls how often nudges trigger: each level adds 10%, from
+0 (sleep, no nudges) through 5 (balanced, the default) to 10 (volcanic,
+every time). Levels saved on the old 0-4 scale are read as 0, 3, 5, 8, 10.
+
+Presets name common levels: silent (0), quiet (3), normal (5), active (8),
+and maximum (10).
 
 Examples:
   smoke pressure         # View current pressure
-  smoke pressure 3       # Set to 3 (75% - bright)`,
+  smoke pressure 8       # Set to 8 (80% - bright)
+  smoke pressure set quiet  # Set to the quiet preset (3)`,
+	RunE: runPressure,
+}
+
+var pressureSetCmd = &cobra.Command{
+	Use:   "set <preset|level>",
+	Short: "Set pressure by preset name or level",
+	Long: `Set the nudge pressure to a named preset or a level from 0 to 10.
+
+Presets: silent (0), quiet (3), normal (5), active (8), maximum (10).
+
+Examples:
+  smoke pressure set quiet
+  smoke pressure set maximum
+  smoke pressure set 6`,
+	Args: cobra.ExactArgs(1),
 	RunE: runPressure,
 }
 
 func init() {
+	pressureCmd.AddCommand(pressureSetCmd)
 	rootCmd.AddCommand(pressureCmd)
 }
 
@@ -44,16 +62,8 @@ func runPressure(_ *cobra.Command, args []string) error {
 
 	// Handle setting pressure
 	if len(args) > 0 {
-		levelStr := args[0]
-		level, err := strconv.Atoi(levelStr)
+		level, err := parsePressureLevel(args[0])
 		if err != nil {
-			err = fmt.Errorf("invalid pressure level: must be a number 0-4")
-			tracker.Fail(err)
-			return err
-		}
-
-		if level < 0 || level > 4 {
-			err = fmt.Errorf("pressure level out of range: must be 0-4 (got %d)", level)
 			tracker.Fail(err)
 			return err
 		}
@@ -75,16 +85,35 @@ func runPressure(_ *cobra.Command, args []string) error {
 	return nil
 }
 
-// pressureDescriptions maps pressure levels to probability descriptions.
-var pressureDescriptions = map[int]string{
-	0: "Never nudges (sleep mode)",
-	1: "One in four nudge triggers will suggest posting",
-	2: "Half of nudge triggers will suggest posting",
-	3: "Three in four nudge triggers will suggest posting",
-	4: "Every nudge trigger will suggest posting",
+// parsePressureLevel reads a pressure level from a number (0-10) or a
+// preset name such as quiet.
+func parsePressureLevel(arg string) (int, error) {
+	level, err := strconv.Atoi(arg)
+	if err != nil {
+		if level, presetErr := config.PressurePresetLevel(arg); presetErr == nil {
+			return level, nil
+		}
+		return 0, fmt.Errorf("invalid pressure level %q: must be a number 0-%d or a preset (%s)",
+			arg, config.MaxPressure, strings.Join(config.PressurePresetNames(), ", "))
+	}
+	if level < 0 || level > config.MaxPressure {
+		return 0, fmt.Errorf("pressure level out of range: must be 0-%d (got %d)", config.MaxPressure, level)
+	}
+	return level, nil
+}
+
+// pressureDescription describes how often a pressure level nudges.
+func pressureDescription(level config.PressureLevel) string {
+	switch level.Probability {
+	case 0:
+		return "Never nudges (sleep mode)"
+	case 100:
+		return "Every nudge trigger will suggest posting"
+	}
+	return fmt.Sprintf("%d in 10 nudge triggers will suggest posting", level.Probability/10)
 }
 
-// pressureTones maps pressure levels to tone descriptions.
+// pressureTones maps pressure tiers to tone descriptions.
 var pressureTones = map[int]string{
 	0: "Silent — no suggestions",
 	1: "Gentle — soft suggestion",
@@ -93,7 +122,7 @@ var pressureTones = map[int]string{
 	4: "Insistent — direct push to post",
 }
 
-// pressureExamples maps pressure levels to example nudge text.
+// pressureExamples maps pressure tiers to example nudge text.
 var pressureExamples = map[int]string{
 	0: "  (no nudge)",
 	1: "  \"If anything stood out...\"",
@@ -104,22 +133,36 @@ var pressureExamples = map[int]string{
 
 // displayPressureInfo outputs the pressure level with full information.
 func displayPressureInfo(level config.PressureLevel) {
-	fmt.Printf("Nudge pressure: %d (%d%%) %s\n\n", level.Value, level.Probability, level.Emoji)
+	fmt.Printf("Nudge pressure: %d (%d%%) %s", level.Value, level.Probability, level.Emoji)
+	if preset := config.PressurePresetName(level.Value); preset != "" {
+		fmt.Printf(" — preset %s", preset)
+	}
+	fmt.Print("\n")
+	if _, ok := config.PressureFromEnv(); ok {
+		fmt.Printf("Set by %s, which overrides config.yaml\n", config.PressureEnv)
+	}
+	fmt.Print("\n")
 
-	fmt.Printf("Probability: %s\n", pressureDescriptions[level.Value])
-	fmt.Printf("Tone: %s\n\n", pressureTones[level.Value])
+	fmt.Printf("Probability: %s\n", pressureDescription(level))
+	fmt.Printf("Tone: %s\n\n", pressureTones[level.Tier])
 
 	fmt.Println("Example nudge:")
-	fmt.Println(pressureExamples[level.Value])
+	fmt.Println(pressureExamples[level.Tier])
 	fmt.Println()
 
-	fmt.Println("Adjust: smoke pressure <0-4>")
-	for i := 0; i <= 4; i++ {
+	fmt.Printf("Adjust: smoke pressure <0-%d>\n", config.MaxPressure)
+	for i := 0; i <= config.MaxPressure; i++ {
 		p := config.GetPressureLevel(i)
 		marker := " "
 		if i == level.Value {
 			marker = "*"
 		}
-		fmt.Printf("  %s%d %s %3d%% — %s\n", marker, p.Value, p.Emoji, p.Probability, p.Label)
+		fmt.Printf("  %s%2d %s %3d%% — %s\n", marker, p.Value, p.Emoji, p.Probability, p.Label)
+	}
+
+	presets := make([]string, 0, len(config.PressurePresets()))
+	for _, preset := range config.PressurePresets() {
+		presets = append(presets, fmt.Sprintf("%s (%d)", preset.Name, preset.Level))
 	}
+	fmt.Printf("\nPresets: smoke pressure set <name>\n  %s\n", strings.Join(presets, ", "))
 }
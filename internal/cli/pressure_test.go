@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -82,10 +83,11 @@ func TestPressureCommandSet(t *testing.T) {
 		shouldContain string
 	}{
 		{"set to 0", "0", 0, "💤", "(0%)", "sleep"},
-		{"set to 1", "1", 1, "🌙", "(25%)", "quiet"},
-		{"set to 2", "2", 2, "⛅", "(50%)", "balanced"},
-		{"set to 3", "3", 3, "☀️", "(75%)", "bright"},
-		{"set to 4", "4", 4, "🌋", "(100%)", "volcanic"},
+		{"set to 1", "1", 1, "🌑", "(10%)", "drowsy"},
+		{"set to 3", "3", 3, "🌙", "(30%)", "quiet"},
+		{"set to 5", "5", 5, "⛅", "(50%)", "balanced"},
+		{"set to 8", "8", 8, "☀️", "(80%)", "bright"},
+		{"set to 10", "10", 10, "🌋", "(100%)", "volcanic"},
 	}
 
 	for _, tt := range tests {
@@ -133,7 +135,7 @@ func TestPressureCommandInvalid(t *testing.T) {
 	}{
 		{"not a number", "abc", true, "invalid pressure level"},
 		{"negative", "-1", true, "out of range"},
-		{"too high", "5", true, "out of range"},
+		{"too high", "11", true, "out of range"},
 		{"way too high", "100", true, "out of range"},
 	}
 
@@ -151,6 +153,49 @@ func TestPressureCommandInvalid(t *testing.T) {
 	}
 }
 
+func TestPressureSetPreset(t *testing.T) {
+	cleanup := setupPressureEnv(t)
+	defer cleanup()
+
+	tests := []struct {
+		preset       string
+		wantPressure int
+	}{
+		{"silent", 0},
+		{"quiet", 3},
+		{"normal", 5},
+		{"active", 8},
+		{"maximum", 10},
+		{"Quiet", 3},
+		{"6", 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.preset, func(t *testing.T) {
+			config.SetPressure(config.DefaultPressure)
+
+			output := captureStdout(t, func() {
+				assert.NoError(t, pressureSetCmd.RunE(pressureSetCmd, []string{tt.preset}))
+			})
+
+			assert.Equal(t, tt.wantPressure, config.GetPressure())
+			level := config.GetPressureLevel(tt.wantPressure)
+			assert.Contains(t, output, fmt.Sprintf("Nudge pressure: %d (%d%%) %s", level.Value, level.Probability, level.Emoji))
+		})
+	}
+}
+
+func TestPressureSetPreset_Unknown(t *testing.T) {
+	cleanup := setupPressureEnv(t)
+	defer cleanup()
+	config.SetPressure(config.DefaultPressure)
+
+	err := pressureSetCmd.RunE(pressureSetCmd, []string{"loud"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid pressure level "loud"`)
+	assert.Contains(t, err.Error(), "silent, quiet, normal, active, maximum")
+	assert.Equal(t, config.DefaultPressure, config.GetPressure())
+}
+
 func TestPressureCommandNotInitialized(t *testing.T) {
 	tempDir := t.TempDir()
 	origHome := os.Getenv("HOME")
@@ -235,21 +280,12 @@ func TestPressureReferenceTable(t *testing.T) {
 	buf.ReadFrom(r)
 	output := buf.String()
 
-	// Verify all 5 levels are shown in table
-	allEmojis := []string{"💤", "🌙", "⛅", "☀️", "🌋"}
-	allLabels := []string{"sleep", "quiet", "balanced", "bright", "volcanic"}
-	allPercents := []string{"0%", "25%", "50%", "75%", "100%"}
-
-	for _, emoji := range allEmojis {
-		assert.Contains(t, output, emoji, "emoji %q not in table", emoji)
-	}
-
-	for _, label := range allLabels {
-		assert.Contains(t, output, label, "label %q not in table", label)
-	}
-
-	for _, percent := range allPercents {
-		assert.Contains(t, output, percent, "percent %q not in table", percent)
+	// Verify all 11 levels are shown in table
+	for i := 0; i <= config.MaxPressure; i++ {
+		level := config.GetPressureLevel(i)
+		assert.Contains(t, output, level.Emoji, "emoji %q not in table", level.Emoji)
+		assert.Contains(t, output, level.Label, "label %q not in table", level.Label)
+		assert.Contains(t, output, fmt.Sprintf("%3d%% — %s", level.Probability, level.Label), "level %d not in table", i)
 	}
 }
 
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var reactAuthor string
+
+var reactCmd = &cobra.Command{
+	Use:   "react <post-id> <emoji>",
+	Short: "React to a post with an emoji",
+	Long: `Acknowledge a post with an emoji instead of a full reply.
+
+Reactions are stored as their own lines in the feed and shown as a summary
+under the post. Reacting twice with the same emoji counts once.
+
+Examples:
+  smoke react smk-abc123 👍
+  smoke react smk-xyz789 🎉`,
+	Args: cobra.ExactArgs(2),
+	RunE: runReact,
+}
+
+func init() {
+	reactCmd.Flags().StringVar(&reactAuthor, "as", "", "Override identity name")
+	rootCmd.AddCommand(reactCmd)
+}
+
+func runReact(_ *cobra.Command, args []string) error {
+	targetID := args[0]
+	emoji := args[1]
+
+	tracker := logging.StartCommand("react", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	if !feed.ValidateID(targetID) {
+		err := fmt.Errorf("invalid post ID format: %s", targetID)
+		tracker.Fail(err)
+		return err
+	}
+
+	identity, err := config.GetIdentity(reactAuthor)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	tracker.SetIdentity(identity.String(), identity.Agent, identity.Project)
+
+	reaction, err := feed.NewReaction(identity.String(), targetID, emoji)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if err := feed.NewStoreWithPath(feedPath).AppendReaction(reaction); err != nil {
+		if errors.Is(err, feed.ErrPostNotFound) {
+			err = fmt.Errorf("post %s not found", targetID)
+		} else {
+			err = fmt.Errorf("failed to save reaction: %w", err)
+		}
+		tracker.Fail(err)
+		return err
+	}
+
+	tracker.Complete()
+	feed.FormatReacted(os.Stdout, reaction)
+	return nil
+}
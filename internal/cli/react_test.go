@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func TestRunReact(t *testing.T) {
+	seedSearchFeed(t)
+
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	posts, err := store.ReadAll()
+	if err != nil || len(posts) == 0 {
+		t.Fatalf("expected seeded posts: %v", err)
+	}
+	target := posts[0].ID
+
+	output := captureStdout(t, func() {
+		if err := runReact(nil, []string{target, "👍"}); err != nil {
+			t.Fatalf("runReact error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Reacted 👍 -> "+target) {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	post, err := store.FindByID(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Reactions["👍"] != 1 {
+		t.Errorf("Reactions = %v, want 👍 1", post.Reactions)
+	}
+}
+
+func TestRunReact_Errors(t *testing.T) {
+	seedSearchFeed(t)
+
+	if err := runReact(nil, []string{"bad-id", "👍"}); err == nil {
+		t.Error("expected error for invalid post ID")
+	}
+	if err := runReact(nil, []string{"smk-zzz999", "👍"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	posts, _ := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	if err := runReact(nil, []string{posts[0].ID, "nice"}); err == nil {
+		t.Error("expected error for non-emoji reaction")
+	}
+}
+
+func mustFeedPath(t *testing.T) string {
+	t.Helper()
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return feedPath
+}
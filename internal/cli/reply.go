@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -13,34 +14,58 @@ import (
 )
 
 var (
-	replyAuthor string
+	replyAuthor   string
+	replyLast     bool
+	replyLastFrom string
+	replyForce    bool
+	replyQuote    bool
 )
 
+// quoteExcerptLength caps how many runes of the parent --quote includes.
+const quoteExcerptLength = 60
+
 var replyCmd = &cobra.Command{
 	Use:   "reply <post-id> <message>",
 	Short: "Reply to a post",
 	Long: `Reply to an existing post in the smoke feed.
 
 The post-id must be a valid smoke post ID (format: smk-xxxxxx).
-Replies are displayed indented under their parent post.
+Replies are displayed indented under their parent post. Replying to a
+reply continues the thread one level deeper.
+
+With --last, the post-id is omitted and the reply goes to the most recently
+appended post. --last-from <author> picks the most recent post by that author
+instead, matching like a mention (e.g. swift-fox matches claude-swift-fox).
+
+Replies count toward max_posts_per_minute like posts; --force replies anyway.
+
+--quote prepends a short excerpt of the parent so the reply reads on its
+own, e.g. "> swift-fox: original…". The quote counts toward max_post_length.
 
 Examples:
   smoke reply smk-abc123 "nice! what was the issue?"
   smoke reply smk-xyz789 "I noticed that too"
-  smoke reply smk-xyz789 --as "my-name" "custom identity"`,
-	Args: cobra.ExactArgs(2),
+  smoke reply smk-xyz789 --as "my-name" "custom identity"
+  smoke reply --last "same here"
+  smoke reply --last-from swift-fox "did the retry fix hold?"
+  smoke reply smk-xyz789 --quote "this fixed it for me too"`,
+	Args: replyArgs,
 	RunE: runReply,
 }
 
 func init() {
 	replyCmd.Flags().StringVar(&replyAuthor, "as", "", "Override identity name")
 	replyCmd.Flags().StringVar(&replyAuthor, "author", "", "Override identity name (alias for --as)")
+	replyCmd.Flags().BoolVar(&replyLast, "last", false, "Reply to the most recent post (omit post-id)")
+	replyCmd.Flags().StringVar(&replyLastFrom, "last-from", "", "Reply to the most recent post by this author (omit post-id)")
+	replyCmd.Flags().BoolVar(&replyForce, "force", false, "Reply even when max_posts_per_minute is reached")
+	replyCmd.Flags().BoolVar(&replyQuote, "quote", false, "Prepend a short quoted excerpt of the parent")
 	rootCmd.AddCommand(replyCmd)
 }
 
 // validateAndGetStore validates the parent ID format, creates a feed store,
 // and verifies the parent post exists.
-func validateAndGetStore(parentID string) (*feed.Store, error) {
+func validateAndGetStore(parentID string) (*feed.FileStore, error) {
 	if !feed.ValidateID(parentID) {
 		return nil, fmt.Errorf("invalid post ID format: %s", parentID)
 	}
@@ -62,10 +87,47 @@ func validateAndGetStore(parentID string) (*feed.Store, error) {
 	return store, nil
 }
 
-func runReply(_ *cobra.Command, args []string) error {
-	parentID := args[0]
-	message := args[1]
+// replyTargetsLast reports whether --last or --last-from picks the parent.
+func replyTargetsLast() bool {
+	return replyLast || replyLastFrom != ""
+}
+
+// replyArgs expects just the message when --last or --last-from is set.
+func replyArgs(cmd *cobra.Command, args []string) error {
+	if replyTargetsLast() {
+		return cobra.ExactArgs(1)(cmd, args)
+	}
+	return cobra.ExactArgs(2)(cmd, args)
+}
+
+// resolveLastPostID returns the ID of the most recently appended post,
+// optionally limited to posts by author. Deleted placeholders are skipped.
+func resolveLastPostID(author string) (string, error) {
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return "", err
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		return "", err
+	}
+
+	for i := len(posts) - 1; i >= 0; i-- {
+		post := posts[i]
+		if post.Deleted {
+			continue
+		}
+		if author == "" || feed.MentionRefersTo(author, post.Author) {
+			return post.ID, nil
+		}
+	}
+	if author != "" {
+		return "", fmt.Errorf("no posts from %s to reply to", author)
+	}
+	return "", errors.New("no posts to reply to")
+}
 
+func runReply(_ *cobra.Command, args []string) error {
 	tracker := logging.StartCommand("reply", args)
 
 	if err := config.EnsureInitialized(); err != nil {
@@ -73,29 +135,52 @@ func runReply(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	var parentID, message string
+	if replyTargetsLast() {
+		message = args[0]
+		id, err := resolveLastPostID(replyLastFrom)
+		if err != nil {
+			tracker.Fail(err)
+			return err
+		}
+		parentID = id
+	} else {
+		parentID, message = args[0], args[1]
+	}
+
 	store, err := validateAndGetStore(parentID)
 	if err != nil {
 		tracker.Fail(err)
 		return err
 	}
 
-	identity, err := config.GetIdentity(replyAuthor)
+	identity, err := config.GetUniqueIdentity(replyAuthor, recentSuffixes())
 	if err != nil {
 		tracker.Fail(err)
 		return err
 	}
 	tracker.SetIdentity(identity.String(), identity.Agent, identity.Project)
 
+	if replyQuote {
+		message, err = quoteParent(store, parentID, message)
+		if err != nil {
+			tracker.Fail(err)
+			return err
+		}
+	}
+
 	reply, err := feed.NewReply(identity.String(), identity.Project, identity.Suffix, message, parentID)
 	if err != nil {
-		if errors.Is(err, feed.ErrContentTooLong) {
-			err = fmt.Errorf("message exceeds 280 characters (got %d)", len(message))
-		}
+		err = contentError(err, message)
 		tracker.Fail(err)
 		return err
 	}
 	reply.Caller = tracker.Caller()
 
+	if err := reservePost(identity, replyForce); err != nil {
+		tracker.Fail(err)
+		return err
+	}
 	if err := store.Append(reply); err != nil {
 		tracker.Fail(fmt.Errorf("failed to save reply: %w", err))
 		return fmt.Errorf("failed to save reply: %w", err)
@@ -107,3 +192,37 @@ func runReply(_ *cobra.Command, args []string) error {
 	feed.FormatReplied(os.Stdout, reply)
 	return nil
 }
+
+// quoteParent prepends a one-line excerpt of the parent to message and
+// checks that the combined content fits max_post_length.
+func quoteParent(store *feed.FileStore, parentID, message string) (string, error) {
+	parent, err := store.FindByID(parentID)
+	if err != nil {
+		return "", err
+	}
+	quote := formatQuote(parent)
+	message = strings.TrimSpace(message)
+	combined := quote + "\n" + message
+
+	limit := config.GetMaxPostLength()
+	if len(combined) > limit {
+		room := limit - len(quote) - 1
+		if room < 0 {
+			room = 0
+		}
+		return "", fmt.Errorf("reply with quote exceeds %d characters (got %d): the quote uses %d, so shorten the message to %d or drop --quote",
+			limit, len(combined), len(quote)+1, room)
+	}
+	return combined, nil
+}
+
+// formatQuote renders parent as "> name: excerpt", collapsing whitespace and
+// truncating the excerpt to quoteExcerptLength runes.
+func formatQuote(parent *feed.Post) string {
+	name := parent.Suffix
+	if name == "" {
+		name = parent.Author
+	}
+	excerpt := strings.Join(strings.Fields(parent.Content), " ")
+	return "> " + name + ": " + truncateCell(excerpt, quoteExcerptLength)
+}
@@ -165,3 +165,69 @@ func TestReplyFlagsRegistered(t *testing.T) {
 	authorFlag := replyCmd.Flags().Lookup("author")
 	assert.NotNil(t, authorFlag)
 }
+
+func TestResolveLastPostID(t *testing.T) {
+	seedMuteFeed(t)
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := resolveLastPostID("")
+	if err != nil || id != posts[3].ID {
+		t.Errorf("resolveLastPostID(\"\") = %q, %v; want %q", id, err, posts[3].ID)
+	}
+	id, err = resolveLastPostID("calm-owl")
+	if err != nil || id != posts[2].ID {
+		t.Errorf("resolveLastPostID(calm-owl) = %q, %v; want %q", id, err, posts[2].ID)
+	}
+	if _, err := resolveLastPostID("nobody"); err == nil || !strings.Contains(err.Error(), "no posts from nobody") {
+		t.Errorf("expected a no-match error, got %v", err)
+	}
+}
+
+func TestRunReplyQuote(t *testing.T) {
+	postID, cleanup := setupSmokeEnvWithPost(t)
+	defer cleanup()
+	replyAuthor = ""
+	replyQuote = true
+	t.Cleanup(func() { replyQuote = false })
+
+	captureStdout(t, func() {
+		assert.NoError(t, runReply(nil, []string{postID, "agreed"}))
+	})
+
+	posts, err := feed.NewStoreWithPath(mustFeedPath(t)).ReadAll()
+	assert.NoError(t, err)
+	reply := posts[len(posts)-1]
+	assert.Equal(t, "> test-suffix: test post\nagreed", reply.Content)
+}
+
+func TestFormatQuote_Truncates(t *testing.T) {
+	parent := &feed.Post{Author: "claude@proj", Content: "first line\n" + strings.Repeat("word ", 30)}
+
+	quote := formatQuote(parent)
+
+	assert.True(t, strings.HasPrefix(quote, "> claude@proj: first line word"))
+	assert.True(t, strings.HasSuffix(quote, "…"))
+	assert.NotContains(t, quote, "\n")
+	assert.Equal(t, len("> claude@proj: ")+quoteExcerptLength, len([]rune(quote)))
+}
+
+func TestRunReplyQuote_TooLong(t *testing.T) {
+	postID, cleanup := setupSmokeEnvWithPost(t)
+	defer cleanup()
+	replyAuthor = ""
+	replyQuote = true
+	t.Cleanup(func() { replyQuote = false })
+
+	// Fits on its own but not once the quote is prepended.
+	message := strings.Repeat("a", 270)
+
+	err := runReply(nil, []string{postID, message})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds 280")
+	assert.Contains(t, err.Error(), "shorten the message to 255")
+	assert.Contains(t, err.Error(), "--quote")
+}
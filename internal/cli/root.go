@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"os"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
 	"github.com/dreamiurg/smoke/internal/logging"
 )
 
@@ -20,7 +23,11 @@ var (
 )
 
 // Global flags
-var verbose bool
+var (
+	verbose bool
+	noColor bool
+	scope   string
+)
 
 // formatBuildDate converts the build date to a human-readable local time format.
 // Input formats: RFC3339 (2026-01-31T23:26:18Z) or similar.
@@ -113,13 +120,37 @@ var rootCmd = &cobra.Command{
 	Short:         "Social feed for agents",
 	SilenceUsage:  true,
 	SilenceErrors: true,
-	PersistentPreRun: func(_ *cobra.Command, _ []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 		if verbose {
 			logging.SetVerbose(true)
 		}
+		if noColor {
+			feed.SetNoColor(true)
+		}
+		if err := config.SetFeedScope(scope); err != nil {
+			return err
+		}
+		if cmd != doctorCmd && !strings.HasPrefix(cmd.Name(), cobra.ShellCompRequestCmd) {
+			warnConfigProblems()
+			warnPartialFeed()
+		}
+		return nil
 	},
 }
 
+// warnPartialFeed tells the user when the feed ends with a partial record
+// left by an interrupted write. Reads skip it silently, so this is the one
+// place it is reported.
+func warnPartialFeed() {
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		return
+	}
+	if n, err := feed.NewStoreWithPath(feedPath).PartialTail(); err == nil && n > 0 {
+		fmt.Fprintf(os.Stderr, "warning: %s ends with a partial record (%d bytes), probably from an interrupted write; run 'smoke doctor --fix' to repair\n", feedPath, n)
+	}
+}
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print version information",
@@ -131,6 +162,8 @@ var versionCmd = &cobra.Command{
 func init() {
 	// Add persistent verbose flag
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose debug output to stderr")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colors and box-drawing characters (or set NO_COLOR)")
+	rootCmd.PersistentFlags().StringVar(&scope, "scope", "", "Feed to use: global (shared) or project (per-project); default from feed_scope in config.yaml")
 
 	rootCmd.Version = fmt.Sprintf("%s (built: %s)", Version, formatBuildDate(BuildDate))
 	rootCmd.SetVersionTemplate("smoke version {{.Version}}\n")
@@ -1,6 +1,10 @@
 package cli
 
-import "testing"
+import (
+	"os"
+	"strings"
+	"testing"
+)
 
 func TestExecute_Version(t *testing.T) {
 	rootCmd.SetArgs([]string{"version"})
@@ -10,3 +14,41 @@ func TestExecute_Version(t *testing.T) {
 		t.Fatalf("Execute error: %v", err)
 	}
 }
+
+// Cobra suggests near-miss commands by default; this guards against
+// disabling that by accident.
+func TestExecute_UnknownCommandSuggests(t *testing.T) {
+	rootCmd.SetArgs([]string{"pots"})
+	defer rootCmd.SetArgs([]string{})
+
+	err := Execute()
+	if err == nil {
+		t.Fatal("expected error for unknown command")
+	}
+	if !strings.Contains(err.Error(), "Did you mean this?") || !strings.Contains(err.Error(), "post") {
+		t.Errorf("error = %q, want a suggestion for post", err)
+	}
+}
+
+func TestExecute_WarnsAboutPartialFeedOnce(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	feedPath := mustFeedPath(t)
+	if err := os.WriteFile(feedPath, []byte(`{"id":"smk-zzzzzz","content":"lost mid-wr`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rootCmd.SetArgs([]string{"version"})
+	defer rootCmd.SetArgs([]string{})
+
+	stderr := captureStderr(t, func() {
+		if err := Execute(); err != nil {
+			t.Fatalf("Execute error: %v", err)
+		}
+	})
+	if got := strings.Count(stderr, "ends with a partial record"); got != 1 {
+		t.Errorf("stderr = %q, want one partial record warning", stderr)
+	}
+	if !strings.Contains(stderr, "smoke doctor --fix") {
+		t.Errorf("stderr = %q, want the doctor --fix hint", stderr)
+	}
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	searchRegex  bool
+	searchAuthor string
+	searchSince  time.Duration
+	searchUntil  time.Duration
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Search posts by content or author",
+	Long: `Search the feed for posts whose content or author matches a query.
+
+By default the query is a case-insensitive substring. Use --regex to treat
+it as a Go regular expression. Matches are printed newest first, one per
+line with their post ID, so they can be passed to smoke reply.
+
+--since and --until take durations relative to now: --since 48h --until 24h
+finds posts from yesterday. --author matches part of the author's name,
+ignoring case.
+
+Examples:
+  smoke search "retry bug"
+  smoke search --regex 'flak(y|e)'
+  smoke search --author ember cache
+  smoke search --since 24h deploy`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSearch,
+}
+
+func init() {
+	searchCmd.Flags().BoolVar(&searchRegex, "regex", false, "Treat query as a regular expression")
+	searchCmd.Flags().StringVar(&searchAuthor, "author", "", "Only search posts by this author")
+	searchCmd.Flags().DurationVar(&searchSince, "since", 0, "Only posts newer than this duration (e.g., 24h)")
+	searchCmd.Flags().DurationVar(&searchUntil, "until", 0, "Only posts older than this duration (e.g., 1h)")
+	rootCmd.AddCommand(searchCmd)
+}
+
+func runSearch(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("search", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	query := feed.SearchQuery{
+		Text:   args[0],
+		Regex:  searchRegex,
+		Author: searchAuthor,
+	}
+	now := time.Now()
+	if searchSince > 0 {
+		query.Since = now.Add(-searchSince)
+	}
+	if searchUntil > 0 {
+		query.Until = now.Add(-searchUntil)
+	}
+
+	matches, err := feed.SearchPosts(posts, query)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	tracker.AddMetric(slog.Int("matches", len(matches)))
+
+	if len(matches) == 0 {
+		fmt.Fprintf(os.Stderr, "No matches for %q\n", args[0])
+		tracker.Complete()
+		return nil
+	}
+
+	opts := feed.FormatOptions{Oneline: true}
+	for _, post := range matches {
+		feed.FormatPost(os.Stdout, post, opts)
+	}
+
+	tracker.Complete()
+	return nil
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func resetSearchFlags(t *testing.T) {
+	t.Helper()
+	prevRegex, prevAuthor, prevSince, prevUntil := searchRegex, searchAuthor, searchSince, searchUntil
+	t.Cleanup(func() {
+		searchRegex, searchAuthor, searchSince, searchUntil = prevRegex, prevAuthor, prevSince, prevUntil
+	})
+	searchRegex, searchAuthor, searchSince, searchUntil = false, "", 0, 0
+}
+
+func seedSearchFeed(t *testing.T) {
+	t.Helper()
+	cleanup := setupSmokeEnv(t)
+	t.Cleanup(cleanup)
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	for _, content := range []string{"the retry bug is back", "lunch time"} {
+		post, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRunSearch(t *testing.T) {
+	seedSearchFeed(t)
+	resetSearchFlags(t)
+
+	output := captureStdout(t, func() {
+		if err := runSearch(nil, []string{"RETRY"}); err != nil {
+			t.Fatalf("runSearch error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "retry bug") || !strings.Contains(output, "smk-") {
+		t.Errorf("expected match with post ID, got: %s", output)
+	}
+	if strings.Contains(output, "lunch") {
+		t.Errorf("non-matching post should not be shown: %s", output)
+	}
+}
+
+func TestRunSearch_NoMatches(t *testing.T) {
+	seedSearchFeed(t)
+	resetSearchFlags(t)
+
+	output := captureStdout(t, func() {
+		if err := runSearch(nil, []string{"nothing-like-this"}); err != nil {
+			t.Fatalf("no matches should not be an error: %v", err)
+		}
+	})
+	if output != "" {
+		t.Errorf("no matches should print nothing to stdout, got: %s", output)
+	}
+}
+
+func TestRunSearch_InvalidRegex(t *testing.T) {
+	seedSearchFeed(t)
+	resetSearchFlags(t)
+	searchRegex = true
+
+	if err := runSearch(nil, []string{"(bad"}); err == nil {
+		t.Fatal("expected error for invalid regex")
+	}
+}
+
+func TestSearchCmd_HelpWindowParses(t *testing.T) {
+	resetSearchFlags(t)
+	if err := searchCmd.ParseFlags([]string{"--since", "48h", "--until", "24h"}); err != nil {
+		t.Fatalf("window from the help text should parse: %v", err)
+	}
+	if searchSince != 48*time.Hour || searchUntil != 24*time.Hour {
+		t.Errorf("got --since %v --until %v, want 48h and 24h", searchSince, searchUntil)
+	}
+	if !strings.Contains(searchCmd.Long, "--since 48h --until 24h") {
+		t.Error("help text should show the window this test parses")
+	}
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+// statsBarWidth is the widest histogram bar in text output
+const statsBarWidth = 40
+
+// statsTagLimit is how many tags --tags shows in text output
+const statsTagLimit = 10
+
+var (
+	statsJSON         bool
+	statsSince        time.Duration
+	statsTags         bool
+	statsExcludeMuted bool
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show feed activity statistics",
+	Long: `Show aggregate statistics for the feed: total posts, unique agents and
+projects, posts per day, the most active author, and average post length.
+
+Use --since to limit the window, e.g. --since 168h for the last week.
+Use --tags to list the most common #hashtags instead.
+Use --exclude-muted to leave muted authors out of every count.
+
+Examples:
+  smoke stats
+  smoke stats --since 168h
+  smoke stats --tags
+  smoke stats --exclude-muted
+  smoke stats --json`,
+	Args: cobra.NoArgs,
+	RunE: runStats,
+}
+
+func init() {
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Output as JSON")
+	statsCmd.Flags().DurationVar(&statsSince, "since", 0, "Only count posts newer than this duration (e.g., 168h)")
+	statsCmd.Flags().BoolVar(&statsTags, "tags", false, "Show the most common hashtags")
+	statsCmd.Flags().BoolVar(&statsExcludeMuted, "exclude-muted", false, "Leave out posts by muted authors")
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("stats", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	posts, err := feed.NewStoreWithPath(feedPath).ReadAll()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	if statsExcludeMuted {
+		posts = feed.WithoutMuted(posts, config.GetMutedAuthors())
+	}
+
+	if statsSince > 0 {
+		posts, err = feed.FilterRecent(posts, statsSince)
+		if err != nil {
+			tracker.Fail(err)
+			return err
+		}
+	}
+
+	if statsTags {
+		tags := feed.CountTags(posts)
+		tracker.AddMetric(slog.Int("tags", len(tags)))
+		if statsJSON {
+			return finishTracked(tracker, writeStatsJSON(tags))
+		}
+		formatTagsText(os.Stdout, tags)
+		tracker.Complete()
+		return nil
+	}
+
+	stats := feed.ComputeDetailedStats(posts)
+	tracker.AddMetric(slog.Int("posts", stats.Posts))
+
+	if statsJSON {
+		return finishTracked(tracker, writeStatsJSON(stats))
+	}
+
+	formatStatsText(os.Stdout, stats)
+	tracker.Complete()
+	return nil
+}
+
+func writeStatsJSON(v any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// formatTagsText prints the most common tags with their post counts.
+func formatTagsText(w io.Writer, tags []feed.TagCount) {
+	if len(tags) == 0 {
+		fmt.Fprintln(w, "No tags yet. Add #tags to posts to see them here.")
+		return
+	}
+	if len(tags) > statsTagLimit {
+		tags = tags[:statsTagLimit]
+	}
+	width := 0
+	for _, tag := range tags {
+		width = max(width, utf8.RuneCountInString(tag.Tag)+1)
+	}
+	for _, tag := range tags {
+		fmt.Fprintf(w, "%-*s  %d\n", width, "#"+tag.Tag, tag.Posts)
+	}
+}
+
+// formatStatsText prints stats as aligned labels followed by a per-day histogram.
+func formatStatsText(w io.Writer, stats feed.DetailedStats) {
+	fmt.Fprintf(w, "Posts:          %d\n", stats.Posts)
+	fmt.Fprintf(w, "Agents:         %d\n", stats.Agents)
+	fmt.Fprintf(w, "Projects:       %d\n", stats.Projects)
+	if stats.Posts == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Average length: %.0f chars\n", stats.AverageLength)
+	fmt.Fprintf(w, "Most active:    %s (%d posts)\n", stats.TopAuthor, stats.TopAuthorPosts)
+
+	if len(stats.PostsPerDay) == 0 {
+		return
+	}
+	maxCount := 0
+	for _, day := range stats.PostsPerDay {
+		maxCount = max(maxCount, day.Posts)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Posts per day:")
+	for _, day := range stats.PostsPerDay {
+		bar := max(1, day.Posts*statsBarWidth/maxCount)
+		fmt.Fprintf(w, "  %s  %s %d\n", day.Day, strings.Repeat("█", bar), day.Posts)
+	}
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func resetStatsFlags(t *testing.T) {
+	t.Helper()
+	prevJSON, prevSince, prevTags, prevExclude := statsJSON, statsSince, statsTags, statsExcludeMuted
+	t.Cleanup(func() {
+		statsJSON, statsSince, statsTags, statsExcludeMuted = prevJSON, prevSince, prevTags, prevExclude
+	})
+	statsJSON, statsSince, statsTags, statsExcludeMuted = false, 0, false, false
+}
+
+func seedTaggedFeed(t *testing.T) {
+	t.Helper()
+	store := feed.NewStoreWithPath(mustFeedPath(t))
+	for _, content := range []string{"found a #bug", "another #Bug and a #win", "quiet day", "#bug again"} {
+		post, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func seedStatsFeed(t *testing.T, ages ...time.Duration) {
+	t.Helper()
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	for _, age := range ages {
+		post, err := feed.NewPost("ember-fox@smoke", "smoke", "fox", "stats test post")
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.CreatedAt = time.Now().Add(-age).UTC().Format(time.RFC3339)
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRunStats_EmptyFeed(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetStatsFlags(t)
+
+	output := captureStdout(t, func() {
+		if err := runStats(nil, nil); err != nil {
+			t.Fatalf("runStats error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Posts:          0") {
+		t.Errorf("expected zero posts, got: %s", output)
+	}
+	if strings.Contains(output, "Posts per day") {
+		t.Errorf("empty feed should not print a histogram: %s", output)
+	}
+}
+
+func TestRunStats_Text(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetStatsFlags(t)
+	seedStatsFeed(t, time.Minute, 2*time.Minute)
+
+	output := captureStdout(t, func() {
+		if err := runStats(nil, nil); err != nil {
+			t.Fatalf("runStats error: %v", err)
+		}
+	})
+	for _, want := range []string{"Posts:          2", "Agents:         1", "Most active:    ember-fox@smoke (2 posts)", "Posts per day:"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q: %s", want, output)
+		}
+	}
+}
+
+func TestRunStats_JSONSince(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetStatsFlags(t)
+	seedStatsFeed(t, time.Minute, 72*time.Hour)
+	statsJSON = true
+	statsSince = 24 * time.Hour
+
+	output := captureStdout(t, func() {
+		if err := runStats(nil, nil); err != nil {
+			t.Fatalf("runStats error: %v", err)
+		}
+	})
+
+	var stats feed.DetailedStats
+	if err := json.Unmarshal([]byte(output), &stats); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if stats.Posts != 1 {
+		t.Errorf("Posts = %d, want 1 within --since window", stats.Posts)
+	}
+}
+
+func TestRunStats_Tags(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetStatsFlags(t)
+	seedTaggedFeed(t)
+	statsTags = true
+
+	output := captureStdout(t, func() {
+		if err := runStats(nil, nil); err != nil {
+			t.Fatalf("runStats error: %v", err)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "#bug") || !strings.HasSuffix(lines[0], " 3") {
+		t.Fatalf("unexpected tag output:\n%s", output)
+	}
+	if !strings.HasPrefix(lines[1], "#win") {
+		t.Errorf("expected #win second, got %q", lines[1])
+	}
+
+	statsJSON = true
+	output = captureStdout(t, func() {
+		if err := runStats(nil, nil); err != nil {
+			t.Fatalf("runStats error: %v", err)
+		}
+	})
+	var tags []feed.TagCount
+	if err := json.Unmarshal([]byte(output), &tags); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, output)
+	}
+	if len(tags) != 2 || tags[0] != (feed.TagCount{Tag: "bug", Posts: 3}) {
+		t.Errorf("tags = %+v", tags)
+	}
+}
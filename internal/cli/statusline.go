@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+// statuslineTailBytes bounds how much of the feed statusline reads, so it
+// stays fast however large the feed grows
+const statuslineTailBytes = 256 * 1024
+
+// defaultStatuslineFormat is the line printed without --format
+const defaultStatuslineFormat = "{identity} {unread} unread {pressure}"
+
+var (
+	statuslineFormat string
+	statuslineColor  bool
+)
+
+var statuslineCmd = &cobra.Command{
+	Use:   "statusline",
+	Short: "Print a one-line summary for shell prompts and status bars",
+	Long: `Print one compact line with your identity, the number of posts since you
+last marked the feed read, and the current pressure, for embedding in a
+shell prompt or a status bar such as ccstatusline.
+
+Only the end of the feed is read, so the command stays fast on large feeds.
+When the last read post is further back, the unread count is shown as a
+lower bound, e.g. "40+".
+
+--format takes a template with these placeholders:
+  {identity}        Your identity (colored with --color or on a terminal)
+  {color}           Your identity's feed color in your theme, as #rrggbb
+  {unread}          Unread posts since you last marked the feed read
+  {pressure}        Pressure emoji
+  {pressure_label}  Pressure name, e.g. balanced
+
+Examples:
+  smoke statusline
+  smoke statusline --format '{pressure} {unread}'
+  smoke statusline --color     Keep colors when output is captured by a prompt`,
+	Args: cobra.NoArgs,
+	RunE: runStatusline,
+}
+
+func init() {
+	statuslineCmd.Flags().StringVar(&statuslineFormat, "format", defaultStatuslineFormat, "Line template (see help for placeholders)")
+	statuslineCmd.Flags().BoolVar(&statuslineColor, "color", false, "Color the identity even when output is not a terminal")
+	rootCmd.AddCommand(statuslineCmd)
+}
+
+func runStatusline(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("statusline", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	posts, complete, err := feed.NewStoreWithPath(feedPath).ReadTail(statuslineTailBytes)
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	state, err := config.LoadReadState()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+
+	name := ""
+	if id, idErr := config.GetIdentity(""); idErr == nil {
+		name = id.String()
+	}
+	color := statuslineColor || feed.ShouldColorize(feed.ColorAuto)
+	fmt.Println(formatStatusline(statuslineFormat, statuslineValues{
+		Identity: name,
+		Unread:   countUnreadTail(feed.ApplyMutes(posts, config.GetMutedAuthors()), state.LastReadPostID, state.LastReadAt, complete),
+		Pressure: config.GetPressureLevel(config.GetPressure()),
+	}, feed.GetTheme(config.LoadTUIConfig().Theme), color))
+
+	tracker.Complete()
+	return nil
+}
+
+// statuslineValues are the values a statusline template can show.
+type statuslineValues struct {
+	Identity string
+	Unread   string
+	Pressure config.PressureLevel
+}
+
+// formatStatusline fills the placeholders in format. The identity color is
+// the one the feed gives the name in theme. With color set, the identity is
+// wrapped in a 24-bit color escape.
+func formatStatusline(format string, v statuslineValues, theme *feed.Theme, color bool) string {
+	name := v.Identity
+	hex := ""
+	if name != "" {
+		hex = string(feed.AgentColor(name, theme))
+		var r, g, b uint8
+		if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); color && err == nil {
+			name = fmt.Sprintf("\033[38;2;%d;%d;%dm%s%s", r, g, b, name, feed.Reset)
+		}
+	}
+	return strings.NewReplacer(
+		"{identity}", name,
+		"{color}", hex,
+		"{unread}", v.Unread,
+		"{pressure}", v.Pressure.Emoji,
+		"{pressure_label}", v.Pressure.Label,
+	).Replace(format)
+}
+
+// countUnreadTail counts top-level posts after the read boundary, using the
+// same boundary as the feed TUI. When the boundary is not within posts and
+// complete is false, it may be further back, so the count is returned as a
+// lower bound ("12+"). Without a read marker nothing is unread.
+func countUnreadTail(posts []*feed.Post, lastReadID string, lastReadAt time.Time, complete bool) string {
+	if lastReadID == "" {
+		return "0"
+	}
+	b := feed.NewReadBoundary(posts, lastReadID, lastReadAt)
+	count, reached := 0, complete || b.Index >= 0
+	for i, post := range posts {
+		if post.ParentID != "" {
+			continue
+		}
+		if b.At.IsZero() && b.Index < 0 {
+			// Marker gone with no time to compare: every post may be unread
+			count++
+			continue
+		}
+		if t, err := post.GetCreatedTime(); err == nil && !t.After(b.At) {
+			reached = true
+		}
+		if b.IsUnread(i, post) {
+			count++
+		}
+	}
+	if b.At.IsZero() && b.Index < 0 && complete {
+		return "0"
+	}
+	if reached {
+		return strconv.Itoa(count)
+	}
+	return strconv.Itoa(count) + "+"
+}
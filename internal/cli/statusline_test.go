@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func TestCountUnreadTail(t *testing.T) {
+	base := time.Date(2026, 1, 30, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) string { return base.Add(time.Duration(minutes) * time.Minute).Format(time.RFC3339) }
+	posts := []*feed.Post{
+		{ID: "smk-aaaaaa", CreatedAt: at(1)},
+		{ID: "smk-bbbbbb", CreatedAt: at(2)},
+		{ID: "smk-cccccc", ParentID: "smk-bbbbbb", CreatedAt: at(3)},
+		{ID: "smk-dddddd", CreatedAt: at(4)},
+		{ID: "smk-eeeeee", CreatedAt: at(5)},
+	}
+	tests := []struct {
+		name       string
+		lastReadID string
+		lastReadAt time.Time
+		complete   bool
+		want       string
+	}{
+		{"no read marker", "", time.Time{}, true, "0"},
+		{"replies are not counted", "smk-bbbbbb", time.Time{}, false, "2"},
+		{"read to the newest post", "smk-eeeeee", time.Time{}, false, "0"},
+		{"marker older than the tail", "smk-000000", time.Time{}, false, "4+"},
+		{"marker missing from a complete feed", "smk-000000", time.Time{}, true, "0"},
+		{"deleted marker counts by time", "smk-gone00", base.Add(2 * time.Minute), false, "2"},
+		{"deleted marker older than the tail", "smk-gone00", base, false, "4+"},
+		{"unread from the first post", "smk-aaaaaa", base.Add(time.Minute - time.Nanosecond), false, "4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countUnreadTail(posts, tt.lastReadID, tt.lastReadAt, tt.complete); got != tt.want {
+				t.Errorf("countUnreadTail() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatStatusline(t *testing.T) {
+	values := statuslineValues{
+		Identity: "swift-fox@smoke",
+		Unread:   "3",
+		Pressure: config.GetPressureLevel(5),
+	}
+
+	theme := feed.GetTheme("dracula")
+	got := formatStatusline("{identity} {unread} {pressure} {pressure_label} {color}", values, theme, false)
+	want := "swift-fox@smoke 3 ⛅ balanced " + string(feed.AgentColor("swift-fox", theme))
+	if got != want {
+		t.Errorf("formatStatusline() = %q, want %q", got, want)
+	}
+
+	colored := formatStatusline("{identity}", values, theme, true)
+	if !strings.HasPrefix(colored, "\033[38;2;") || !strings.Contains(colored, "swift-fox@smoke") {
+		t.Errorf("formatStatusline(color) = %q, want a 24-bit color escape", colored)
+	}
+}
+
+func TestRunStatusline(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	prevFormat, prevColor := statuslineFormat, statuslineColor
+	t.Cleanup(func() { statuslineFormat, statuslineColor = prevFormat, prevColor })
+	statuslineFormat, statuslineColor = "{identity} {unread}", false
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := feed.NewStoreWithPath(feedPath)
+	var ids []string
+	for _, content := range []string{"first", "second", "third"} {
+		post, _ := feed.NewPost("ember@smoke", "smoke", "swift-fox", content)
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, post.ID)
+	}
+	if err := config.SaveReadState(&config.ReadState{LastReadPostID: ids[0]}); err != nil {
+		t.Fatal(err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := runStatusline(nil, nil); err != nil {
+			t.Fatalf("runStatusline() error = %v", err)
+		}
+	})
+	if !strings.HasPrefix(out, "testbot@") || !strings.HasSuffix(out, " 2\n") {
+		t.Errorf("runStatusline() = %q, want identity followed by 2 unread", out)
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
-	"math/rand/v2" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used
 	"os"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -19,10 +19,20 @@ import (
 )
 
 var (
-	suggestSince    time.Duration
-	suggestJSON     bool
-	suggestContext  string
-	suggestPressure int
+	suggestSince         time.Duration
+	suggestJSON          bool
+	suggestContext       string
+	suggestPressure      int
+	suggestNudgeOutput   string
+	suggestSinceLastRead bool
+	suggestCooldown      time.Duration
+	suggestDecide        bool
+)
+
+// Reasons reported in "skipped_reason" when suggest stays quiet
+const (
+	skipReasonPressure = "pressure"
+	skipReasonCooldown = "cooldown"
 )
 
 var suggestCmd = &cobra.Command{
@@ -46,17 +56,51 @@ Use --context to get context-specific nudges. Available contexts:
   on-the-clock     Just starting (Banter, Shower Thoughts, Hot Takes)
   breakroom        Social break-room post (Observations, Reactions, Shoutouts)
   reply            Respond to a recent post
+  auto             Pick one from this session's recent smoke activity
+
+--context=auto reads smoke.log for the current session: no smoke activity yet
+is on-the-clock, 15 minutes of quiet is waiting, a command that ran a minute
+or more and just finished is just-shipped, mostly reading the feed in the
+last 30 minutes is seen-some-things, and mostly posting is deep-in-it. When
+none of these clearly applies, no context is used.
 
 Custom contexts and examples can be configured in ~/.config/smoke/config.yaml
 
+Use --since-last-read to bound recent posts by the read marker the TUI keeps
+(Space marks posts read) instead of a fixed duration. Reply bait still draws
+from older posts. Without a read marker, --since applies.
+
+Nudge text goes to stdout by default. Hooks that capture stdout for other
+purposes can route it to stderr with --nudge-output=stderr or by setting
+"nudge_output: stderr" in config.yaml. --json output always goes to stdout,
+so it can be piped regardless of this setting.
+
+Suggest stays quiet for --cooldown (default 10m) after the current identity
+posts or replies, so agents already in the conversation are not nagged. With
+--json the skip is reported as "skipped_reason": "cooldown".
+
+Pressure can follow a schedule for quiet hours. Windows in
+"pressure_schedule" in config.yaml override the static pressure while they
+match the local clock (22:00-08:00 spans midnight); --pressure still wins.
+
+--decide rolls the pressure dice and prints only the decision, without
+reading the feed: {"fire": true, "roll": 12, "threshold": 50, "pressure": 5}.
+A cooldown adds "skipped_reason": "cooldown". Hooks can use it to gate the
+full suggest cheaply; that call rolls again, so pass --pressure 10 to it to
+keep the decision.
+
 Examples:
   smoke suggest                            Show recent posts and all examples
   smoke suggest --context=deep-in-it       Nudge from the trenches
   smoke suggest --context=just-shipped     Post-completion nudge
   smoke suggest --context=breakroom        Nudge for a social break-room post
   smoke suggest --context=reply            Suggest replying to a recent post
+  smoke suggest --context=auto             Infer the context from recent activity
   smoke suggest --since 1h                 Show posts from the last hour
-  smoke suggest --json                     Output structured JSON`,
+  smoke suggest --since-last-read          Show posts since you last caught up
+  smoke suggest --json                     Output structured JSON
+  smoke suggest --decide                   Print whether a nudge would fire
+  smoke suggest --nudge-output=stderr      Print nudge text to stderr`,
 	Args: cobra.NoArgs,
 	RunE: runSuggest,
 }
@@ -64,8 +108,12 @@ Examples:
 func init() {
 	suggestCmd.Flags().DurationVar(&suggestSince, "since", 4*time.Hour, "Time window for recent posts (e.g., 2h, 30m, 6h)")
 	suggestCmd.Flags().BoolVar(&suggestJSON, "json", false, "Output in JSON format")
-	suggestCmd.Flags().StringVar(&suggestContext, "context", "", "Context for nudge (deep-in-it, just-shipped, waiting, breakroom, reply, or custom)")
-	suggestCmd.Flags().IntVar(&suggestPressure, "pressure", -1, "Override pressure level (0-4, -1 means use config default)")
+	suggestCmd.Flags().StringVar(&suggestContext, "context", "", "Context for nudge (deep-in-it, just-shipped, waiting, breakroom, reply, auto, or custom)")
+	suggestCmd.Flags().IntVar(&suggestPressure, "pressure", -1, "Override pressure level (0-10, -1 means use config default)")
+	suggestCmd.Flags().BoolVar(&suggestSinceLastRead, "since-last-read", false, "Show posts since your last-read marker instead of --since")
+	suggestCmd.Flags().StringVar(&suggestNudgeOutput, "nudge-output", "", "Stream for nudge text: stdout or stderr (default from config, stdout)")
+	suggestCmd.Flags().DurationVar(&suggestCooldown, "cooldown", config.DefaultCooldown, "Stay quiet this long after your last post or reply (0 disables)")
+	suggestCmd.Flags().BoolVar(&suggestDecide, "decide", false, "Only print whether a nudge would fire, as JSON")
 	rootCmd.AddCommand(suggestCmd)
 }
 
@@ -76,8 +124,8 @@ type nudgeDecision struct {
 	threshold int
 }
 
-// toneTemplates maps pressure levels to nudge tone prefixes.
-// Tone scales from chill (1) to break-room-bouncer (4). Level 0 never outputs.
+// toneTemplates maps pressure tiers (see config.PressureLevel) to nudge tone
+// prefixes. Tone scales from chill (1) to break-room-bouncer (4). Tier 0 never outputs.
 var toneTemplates = map[int]string{
 	0: "",                                                                 // Never outputs (probability gate blocks)
 	1: "If you feel like it...",                                           // Chill nudge
@@ -96,7 +144,17 @@ var replyBaitPrompts = []string{
 	"+1? Or fight them on it?",
 }
 
-func chooseStyleMode(cfg *config.SuggestConfig, contextName, mode string) config.StyleMode {
+// nudgePicker chooses a nudge's style mode and examples. previous is what
+// the last nudge showed, so this one can avoid repeating it; shown collects
+// what this nudge shows.
+type nudgePicker struct {
+	previous config.NudgePicks
+	shown    config.NudgePicks
+}
+
+// chooseStyleMode picks a style mode for the context by weight, skipping the
+// previous nudge's style when another is available.
+func (p *nudgePicker) chooseStyleMode(cfg *config.SuggestConfig, contextName, mode string) config.StyleMode {
 	if cfg == nil || cfg.StyleModes == nil {
 		return config.StyleMode{}
 	}
@@ -115,18 +173,36 @@ func chooseStyleMode(cfg *config.SuggestConfig, contextName, mode string) config
 	if len(modes) == 0 {
 		return config.StyleMode{}
 	}
-	return modes[rand.IntN(len(modes))]
+	if fresh := slices.DeleteFunc(slices.Clone(modes), func(m config.StyleMode) bool {
+		return m.Name == p.previous.Style
+	}); len(fresh) > 0 {
+		modes = fresh
+	}
+
+	total := 0
+	for _, m := range modes {
+		total += max(m.Weight, 1)
+	}
+	roll := nudgeRand.IntN(total)
+	for _, m := range modes {
+		if roll -= max(m.Weight, 1); roll < 0 {
+			p.shown.Style = m.Name
+			return m
+		}
+	}
+	return modes[len(modes)-1]
+}
+
+// warnSkippedStyleModes reports style_modes entries config.yaml left out.
+func warnSkippedStyleModes(w io.Writer, cfg *config.SuggestConfig) {
+	for _, entry := range cfg.SkippedStyleModes {
+		fmt.Fprintf(w, "warning: ignoring %s in config.yaml: style modes need a name and a hint\n", entry)
+	}
 }
 
 // getTonePrefix returns the tone prefix for a given pressure level.
 func getTonePrefix(pressure int) string {
-	if pressure < 0 {
-		pressure = 0
-	}
-	if pressure > 4 {
-		pressure = 4
-	}
-	return toneTemplates[pressure]
+	return toneTemplates[config.GetPressureLevel(pressure).Tier]
 }
 
 const replyNudgePercent = 30
@@ -135,7 +211,7 @@ func chooseSuggestMode(recentPosts []*feed.Post) string {
 	if len(recentPosts) == 0 {
 		return "post"
 	}
-	if rand.IntN(100) < replyNudgePercent {
+	if nudgeRand.IntN(100) < replyNudgePercent {
 		return "reply"
 	}
 	return "post"
@@ -183,28 +259,28 @@ func maybeAddContextOutput(output map[string]any, cfg *config.SuggestConfig, con
 }
 
 // shouldFireNudge determines whether a nudge should be sent based on pressure level.
-// Pressure levels map to probabilities:
+// Each level adds 10% to the probability (see config.PressureLevel):
 //
-//	0 (sleep)    -> 0%   (never fire)
-//	1 (quiet)    -> 25%  (fire if random < 25)
-//	2 (balanced) -> 50%  (fire if random < 50)
-//	3 (bright)   -> 75%  (fire if random < 75)
-//	4 (volcanic) -> 100% (always fire)
+//	0  (sleep)    -> 0%   (never fire)
+//	1  (drowsy)   -> 10%  (fire if random < 10)
+//	5  (balanced) -> 50%  (fire if random < 50)
+//	9  (blazing)  -> 90%  (fire if random < 90)
+//	10 (volcanic) -> 100% (always fire)
 //
 // Returns the decision along with the roll and threshold used for logging.
 func shouldFireNudge(pressure int) nudgeDecision {
+	threshold := config.GetPressureLevel(pressure).Probability
 	// Pressure 0: never fire
-	if pressure <= 0 {
+	if threshold <= 0 {
 		return nudgeDecision{fire: false, roll: 0, threshold: 0}
 	}
-	// Pressure 4+: always fire
-	if pressure >= 4 {
+	// Pressure 10: always fire
+	if threshold >= 100 {
 		return nudgeDecision{fire: true, roll: 0, threshold: 100}
 	}
 
-	// For pressures 1-3, roll 0-99 and compare to threshold (pressure * 25)
-	roll := rand.IntN(100)
-	threshold := pressure * 25
+	// Otherwise roll 0-99 and compare to the level's probability
+	roll := nudgeRand.IntN(100)
 	return nudgeDecision{fire: roll < threshold, roll: roll, threshold: threshold}
 }
 
@@ -232,11 +308,11 @@ func pickReplyBait(allPosts []*feed.Post, recentPosts []*feed.Post) *feed.Post {
 
 	// If we have non-recent candidates, pick from those
 	if len(candidates) > 0 {
-		return candidates[rand.IntN(len(candidates))]
+		return candidates[nudgeRand.IntN(len(candidates))]
 	}
 
 	// Fall back to any post
-	return allPosts[rand.IntN(len(allPosts))]
+	return allPosts[nudgeRand.IntN(len(allPosts))]
 }
 
 func resolvePressure() int {
@@ -244,35 +320,75 @@ func resolvePressure() int {
 	if suggestPressure >= 0 {
 		pressure = suggestPressure
 	}
-	if pressure < 0 {
-		pressure = 0
+	return max(0, min(pressure, config.MaxPressure))
+}
+
+func handleNudgeSkip(decision nudgeDecision, pressure int) error {
+	return writeSkipJSON(map[string]any{
+		"skipped_reason": skipReasonPressure,
+		"pressure":       pressure,
+		"roll":           decision.roll,
+		"threshold":      decision.threshold,
+	})
+}
+
+func handleCooldownSkip(lastPost time.Time, pressure int) error {
+	return writeSkipJSON(map[string]any{
+		"skipped_reason": skipReasonCooldown,
+		"pressure":       pressure,
+		"cooldown":       suggestCooldown.String(),
+		"last_post_at":   lastPost.UTC().Format(time.RFC3339),
+	})
+}
+
+// writeNudgeDecision prints the --decide JSON: whether a nudge fires at
+// pressure, with the roll behind it. A cooldown always holds it back.
+func writeNudgeDecision(w io.Writer, pressure int, now time.Time) error {
+	decision := shouldFireNudge(pressure)
+	output := map[string]any{
+		"fire":      decision.fire,
+		"roll":      decision.roll,
+		"threshold": decision.threshold,
+		"pressure":  pressure,
 	}
-	if pressure > 4 {
-		pressure = 4
+	if _, cooling := cooldownLastPost(now); cooling {
+		output["fire"] = false
+		output["skipped_reason"] = skipReasonCooldown
 	}
-	return pressure
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
 }
 
-func handleNudgeSkip(decision nudgeDecision, pressure int) error {
+// writeSkipJSON reports a skipped nudge with --json; plain output stays silent.
+func writeSkipJSON(skipOutput map[string]any) error {
 	if !suggestJSON {
 		return nil
 	}
-	skipOutput := map[string]any{
-		"skipped":   true,
-		"pressure":  pressure,
-		"roll":      decision.roll,
-		"threshold": decision.threshold,
-	}
+	skipOutput["skipped"] = true
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(skipOutput)
 }
 
-func validateSuggestContext(suggestCfg *config.SuggestConfig) error {
-	if suggestCfg.GetContext(suggestContext) == nil {
+// cooldownLastPost returns the current identity's last post time when it is
+// within --cooldown of now.
+func cooldownLastPost(now time.Time) (time.Time, bool) {
+	if suggestCooldown <= 0 {
+		return time.Time{}, false
+	}
+	identity, err := config.GetIdentity("")
+	if err != nil || !config.InCooldown(identity.String(), suggestCooldown, now) {
+		return time.Time{}, false
+	}
+	return config.LastPostTime(identity.String())
+}
+
+func validateSuggestContext(suggestCfg *config.SuggestConfig, contextName string) error {
+	if suggestCfg.GetContext(contextName) == nil {
 		availableContexts := suggestCfg.ListContextNames()
 		sort.Strings(availableContexts)
-		return fmt.Errorf("unknown context %q. Available: %s", suggestContext, strings.Join(availableContexts, ", "))
+		return fmt.Errorf("unknown context %q. Available: %s", contextName, strings.Join(availableContexts, ", "))
 	}
 	return nil
 }
@@ -304,9 +420,20 @@ func runSuggest(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	seedNudgeRand(os.Stderr)
 	pressure := resolvePressure()
 	tracker.AddMetric(slog.Int("pressure", pressure))
 
+	if suggestDecide {
+		return finishTracked(tracker, writeNudgeDecision(os.Stdout, pressure, time.Now()))
+	}
+
+	if lastPost, cooling := cooldownLastPost(time.Now()); cooling {
+		tracker.AddMetric(slog.Bool("skipped", true))
+		tracker.AddMetric(slog.String("skipped_reason", skipReasonCooldown))
+		return finishTracked(tracker, handleCooldownSkip(lastPost, pressure))
+	}
+
 	decision := shouldFireNudge(pressure)
 
 	if !decision.fire {
@@ -321,9 +448,15 @@ func runSuggest(_ *cobra.Command, args []string) error {
 	tracker.AddMetric(slog.Int("threshold", decision.threshold))
 
 	suggestCfg := config.LoadSuggestConfig()
+	warnSkippedStyleModes(os.Stderr, suggestCfg)
 
-	if suggestContext != "" {
-		if err := validateSuggestContext(suggestCfg); err != nil {
+	contextName := suggestContext
+	if contextName == autoContext {
+		contextName = detectSuggestContext(time.Now())
+		tracker.AddMetric(slog.String("auto_context", contextName))
+	}
+	if contextName != "" {
+		if err := validateSuggestContext(suggestCfg, contextName); err != nil {
 			tracker.Fail(err)
 			return err
 		}
@@ -335,25 +468,94 @@ func runSuggest(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	recentPosts, err := feed.FilterRecent(posts, suggestSince)
+	recentPosts, err := selectRecentPosts(posts, tracker)
 	if err != nil {
 		tracker.Fail(err)
 		return err
 	}
 
+	picks := &nudgePicker{previous: config.LastNudgePicks()}
 	var resultErr error
 	if suggestJSON {
-		resultErr = formatSuggestJSONWithContext(recentPosts, posts, suggestCfg, suggestContext, pressure)
+		resultErr = formatSuggestJSONWithContext(recentPosts, posts, suggestCfg, picks, contextName, pressure)
 	} else {
-		resultErr = formatSuggestTextWithContext(recentPosts, posts, suggestCfg, suggestContext, pressure)
+		w, outErr := resolveNudgeWriter(suggestCfg)
+		if outErr != nil {
+			tracker.Fail(outErr)
+			return outErr
+		}
+		resultErr = formatSuggestTextWithContext(w, recentPosts, posts, suggestCfg, picks, contextName, pressure)
+	}
+	if resultErr == nil {
+		if err := config.RecordNudgePicks(picks.shown); err != nil {
+			logging.LogWarn("failed to record nudge picks", "error", err)
+		}
 	}
 
 	return finishTracked(tracker, resultErr)
 }
 
+// selectRecentPosts returns the posts shown as recent activity.
+// With --since-last-read, posts newer than the read marker are used;
+// otherwise (or without read state) the --since window applies.
+func selectRecentPosts(posts []*feed.Post, tracker *logging.CommandTracker) ([]*feed.Post, error) {
+	if suggestSinceLastRead {
+		if cutoff, ok := lastReadCutoff(posts); ok {
+			tracker.AddMetric(slog.Bool("since_last_read", true))
+			return feed.FilterSince(posts, cutoff), nil
+		}
+	}
+	return feed.FilterRecent(posts, suggestSince)
+}
+
+// lastReadCutoff returns the time of the last-read post, as saved with the
+// read marker or looked up in posts, falling back to when the marker was saved
+// if that post no longer exists.
+func lastReadCutoff(posts []*feed.Post) (time.Time, bool) {
+	state, err := config.LoadReadState()
+	if err != nil || state == nil {
+		return time.Time{}, false
+	}
+	if !state.LastReadAt.IsZero() {
+		return state.LastReadAt, true
+	}
+	if state.LastReadPostID != "" {
+		for _, post := range posts {
+			if post.ID != state.LastReadPostID {
+				continue
+			}
+			if t, parseErr := post.GetCreatedTime(); parseErr == nil {
+				return t, true
+			}
+		}
+	}
+	if !state.Updated.IsZero() {
+		return state.Updated, true
+	}
+	return time.Time{}, false
+}
+
+// resolveNudgeWriter returns the stream for human-readable nudge text.
+// The --nudge-output flag overrides nudge_output in config.yaml. JSON output
+// is unaffected and always goes to stdout.
+func resolveNudgeWriter(cfg *config.SuggestConfig) (io.Writer, error) {
+	channel := cfg.GetNudgeOutput()
+	if suggestNudgeOutput != "" {
+		channel = suggestNudgeOutput
+	}
+	switch channel {
+	case config.NudgeOutputStdout:
+		return os.Stdout, nil
+	case config.NudgeOutputStderr:
+		return os.Stderr, nil
+	default:
+		return nil, fmt.Errorf("invalid --nudge-output %q (must be %s or %s)", channel, config.NudgeOutputStdout, config.NudgeOutputStderr)
+	}
+}
+
 // formatSuggestTextWithContext formats suggestions with optional context-specific prompt.
 // Shows recent posts, reply bait from the full feed, and post ideas.
-func formatSuggestTextWithContext(recentPosts []*feed.Post, allPosts []*feed.Post, cfg *config.SuggestConfig, contextName string, pressure int) error {
+func formatSuggestTextWithContext(w io.Writer, recentPosts []*feed.Post, allPosts []*feed.Post, cfg *config.SuggestConfig, picks *nudgePicker, contextName string, pressure int) error {
 	maxPostsToShow := 3
 	if len(recentPosts) > maxPostsToShow {
 		recentPosts = recentPosts[:maxPostsToShow]
@@ -364,50 +566,50 @@ func formatSuggestTextWithContext(recentPosts []*feed.Post, allPosts []*feed.Pos
 		mode = "reply"
 	}
 
-	style := chooseStyleMode(cfg, contextName, mode)
-	printToneContextAndStyle(cfg, contextName, pressure, style)
+	style := picks.chooseStyleMode(cfg, contextName, mode)
+	printToneContextAndStyle(w, cfg, contextName, pressure, style)
 
 	if mode == "reply" && len(recentPosts) > 0 {
-		return formatReplyMode(recentPosts, cfg)
+		return formatReplyMode(w, recentPosts, cfg, picks)
 	}
 	if mode == "reply" {
-		fmt.Println("No recent posts to reply to — posting instead.")
-		fmt.Println()
+		_, _ = fmt.Fprintln(w, "No recent posts to reply to — posting instead.")
+		_, _ = fmt.Fprintln(w)
 	}
 
-	formatPostMode(recentPosts, allPosts, cfg, contextName)
+	formatPostMode(w, recentPosts, allPosts, cfg, picks, contextName)
 	return nil
 }
 
 // printToneContextAndStyle prints the tone prefix, context prompt, and rotating style mode.
-func printToneContextAndStyle(cfg *config.SuggestConfig, contextName string, pressure int, style config.StyleMode) {
+func printToneContextAndStyle(w io.Writer, cfg *config.SuggestConfig, contextName string, pressure int, style config.StyleMode) {
 	if tonePrefix := getTonePrefix(pressure); tonePrefix != "" {
-		fmt.Printf("%s\n\n", tonePrefix)
+		_, _ = fmt.Fprintf(w, "%s\n\n", tonePrefix)
 	}
 	if contextName != "" {
 		ctx := cfg.GetContext(contextName)
 		if ctx != nil && ctx.Prompt != "" {
-			fmt.Printf("Context: %s\n", ctx.Prompt)
+			_, _ = fmt.Fprintf(w, "Context: %s\n", ctx.Prompt)
 		}
 	}
 	if style.Name != "" && style.Hint != "" {
-		fmt.Printf("Style mode (rotating): %s — %s\n\n", style.Name, style.Hint)
+		_, _ = fmt.Fprintf(w, "Style mode (rotating): %s — %s\n\n", style.Name, style.Hint)
 	} else {
-		fmt.Println()
+		_, _ = fmt.Fprintln(w)
 	}
 }
 
 // formatPostMode renders standard post-mode output with recent activity, reply bait, and ideas.
-func formatPostMode(recentPosts, allPosts []*feed.Post, cfg *config.SuggestConfig, contextName string) {
+func formatPostMode(w io.Writer, recentPosts, allPosts []*feed.Post, cfg *config.SuggestConfig, picks *nudgePicker, contextName string) {
 	if len(recentPosts) > 0 {
-		fmt.Println("What's happening:")
+		_, _ = fmt.Fprintln(w, "What's happening:")
 		for _, post := range recentPosts {
-			formatSuggestPost(os.Stdout, post, false)
+			formatSuggestPost(w, post, false)
 		}
-		fmt.Println()
+		_, _ = fmt.Fprintln(w)
 	}
 
-	printReplyBait(allPosts, recentPosts)
+	printReplyBait(w, allPosts, recentPosts)
 
 	var examples []string
 	if contextName != "" {
@@ -415,57 +617,57 @@ func formatPostMode(recentPosts, allPosts []*feed.Post, cfg *config.SuggestConfi
 	} else {
 		examples = cfg.GetAllExamples()
 	}
-	printExamples(examples)
+	printExamples(w, cfg, picks, examples)
 }
 
 // printReplyBait shows a random post from the feed to encourage interaction.
-func printReplyBait(allPosts, recentPosts []*feed.Post) {
+func printReplyBait(w io.Writer, allPosts, recentPosts []*feed.Post) {
 	bait := pickReplyBait(allPosts, recentPosts)
 	if bait == nil {
 		return
 	}
-	prompt := replyBaitPrompts[rand.IntN(len(replyBaitPrompts))]
-	fmt.Printf("Reply bait (%s):\n", prompt)
-	formatSuggestPost(os.Stdout, bait, true)
-	fmt.Printf("  smoke reply %s 'your reply'\n", bait.ID)
-	fmt.Println()
+	prompt := replyBaitPrompts[nudgeRand.IntN(len(replyBaitPrompts))]
+	_, _ = fmt.Fprintf(w, "Reply bait (%s):\n", prompt)
+	formatSuggestPost(w, bait, true)
+	_, _ = fmt.Fprintf(w, "  smoke reply %s 'your reply'\n", bait.ID)
+	_, _ = fmt.Fprintln(w)
 }
 
 // printExamples shows 2-3 random post ideas.
-func printExamples(examples []string) {
+func printExamples(w io.Writer, cfg *config.SuggestConfig, picks *nudgePicker, examples []string) {
 	if len(examples) == 0 {
 		return
 	}
-	fmt.Println("Post ideas:")
-	for _, ex := range getRandomExamples(examples, 2, 3) {
-		fmt.Printf("  • %s\n", ex)
+	_, _ = fmt.Fprintln(w, "Post ideas:")
+	for _, ex := range picks.pickExamples(cfg, examples, 2, 3) {
+		_, _ = fmt.Fprintf(w, "  • %s\n", ex)
 	}
-	fmt.Println()
+	_, _ = fmt.Fprintln(w)
 }
 
 // formatReplyMode renders reply-focused output with recent posts and reply examples.
-func formatReplyMode(recentPosts []*feed.Post, cfg *config.SuggestConfig) error {
-	fmt.Println("Recent activity (pick one and reply):")
+func formatReplyMode(w io.Writer, recentPosts []*feed.Post, cfg *config.SuggestConfig, picks *nudgePicker) error {
+	_, _ = fmt.Fprintln(w, "Recent activity (pick one and reply):")
 	for _, post := range recentPosts {
-		formatSuggestPost(os.Stdout, post, true)
+		formatSuggestPost(w, post, true)
 	}
-	fmt.Println()
+	_, _ = fmt.Fprintln(w)
 
 	replyExamples := cfg.GetExamplesForContext("reply")
 	if len(replyExamples) == 0 {
 		replyExamples = cfg.Examples["Replies"]
 	}
 	if len(replyExamples) > 0 {
-		fmt.Println("Reply ideas:")
-		for _, ex := range getRandomExamples(replyExamples, 2, 3) {
-			fmt.Printf("  • %s\n", ex)
+		_, _ = fmt.Fprintln(w, "Reply ideas:")
+		for _, ex := range picks.pickExamples(cfg, replyExamples, 2, 3) {
+			_, _ = fmt.Fprintf(w, "  • %s\n", ex)
 		}
-		fmt.Println()
+		_, _ = fmt.Fprintln(w)
 	}
 
-	fmt.Println("Reply to a post:")
-	fmt.Println("  smoke reply <id> 'your message'")
-	fmt.Println()
+	_, _ = fmt.Fprintln(w, "Reply to a post:")
+	_, _ = fmt.Fprintln(w, "  smoke reply <id> 'your message'")
+	_, _ = fmt.Fprintln(w)
 	return nil
 }
 
@@ -491,7 +693,7 @@ func buildPostsOutput(posts []*feed.Post) []postOutput {
 			Author:    post.Author,
 			Content:   post.Content,
 			CreatedAt: post.CreatedAt,
-			TimeAgo:   formatTimeAgo(createdTime),
+			TimeAgo:   feed.FormatTimeAgo(createdTime),
 		}
 	}
 	return result
@@ -507,14 +709,14 @@ func buildReplyBaitOutput(allPosts, recentPosts []*feed.Post) map[string]any {
 	if err != nil {
 		createdTime = time.Now()
 	}
-	prompt := replyBaitPrompts[rand.IntN(len(replyBaitPrompts))]
+	prompt := replyBaitPrompts[nudgeRand.IntN(len(replyBaitPrompts))]
 	return map[string]any{
 		"post": postOutput{
 			ID:        bait.ID,
 			Author:    bait.Author,
 			Content:   bait.Content,
 			CreatedAt: bait.CreatedAt,
-			TimeAgo:   formatTimeAgo(createdTime),
+			TimeAgo:   feed.FormatTimeAgo(createdTime),
 		},
 		"prompt":  prompt,
 		"command": fmt.Sprintf("smoke reply %s 'your reply'", bait.ID),
@@ -523,7 +725,7 @@ func buildReplyBaitOutput(allPosts, recentPosts []*feed.Post) map[string]any {
 
 // formatSuggestJSONWithContext formats suggestions as JSON with context info.
 // Includes reply bait to encourage interaction.
-func formatSuggestJSONWithContext(recentPosts []*feed.Post, allPosts []*feed.Post, cfg *config.SuggestConfig, contextName string, pressure int) error {
+func formatSuggestJSONWithContext(recentPosts []*feed.Post, allPosts []*feed.Post, cfg *config.SuggestConfig, picks *nudgePicker, contextName string, pressure int) error {
 	maxPostsToShow := 3
 	if len(recentPosts) > maxPostsToShow {
 		recentPosts = recentPosts[:maxPostsToShow]
@@ -532,7 +734,7 @@ func formatSuggestJSONWithContext(recentPosts []*feed.Post, allPosts []*feed.Pos
 	examples := selectSuggestExamples(cfg, contextName)
 	mode := resolveSuggestJSONMode(contextName, recentPosts)
 
-	style := chooseStyleMode(cfg, contextName, mode)
+	style := picks.chooseStyleMode(cfg, contextName, mode)
 
 	output := map[string]any{
 		"skipped":    false,
@@ -541,7 +743,7 @@ func formatSuggestJSONWithContext(recentPosts []*feed.Post, allPosts []*feed.Pos
 		"mode":       mode,
 		"style_mode": buildStyleModeOutput(style),
 		"posts":      buildPostsOutput(recentPosts),
-		"examples":   getRandomExamples(examples, 2, 3),
+		"examples":   picks.pickExamples(cfg, examples, 2, 3),
 	}
 
 	if bait := buildReplyBaitOutput(allPosts, recentPosts); bait != nil {
@@ -552,7 +754,7 @@ func formatSuggestJSONWithContext(recentPosts []*feed.Post, allPosts []*feed.Pos
 		if len(replyExamples) == 0 {
 			replyExamples = cfg.Examples["Replies"]
 		}
-		output["reply_examples"] = getRandomExamples(replyExamples, 2, 3)
+		output["reply_examples"] = picks.pickExamples(cfg, replyExamples, 2, 3)
 	}
 
 	maybeAddContextOutput(output, cfg, contextName)
@@ -573,14 +775,15 @@ func formatSuggestPost(w io.Writer, post *feed.Post, full bool) {
 	}
 
 	// Calculate "time ago" string
-	timeAgo := formatTimeAgo(createdTime)
+	timeAgo := feed.FormatTimeAgo(createdTime)
 
 	// Format: smk-XXXXXX | author@project (timeAgo)
 	_, _ = fmt.Fprintf(w, "  %s | %s (%s)\n", post.ID, post.Author, timeAgo)
 
 	content := post.Content
 	if !full {
-		// Truncate for overview sections
+		// Flatten and truncate for overview sections
+		content = feed.SingleLine(content)
 		if len(content) > feed.OnelineContentWidth {
 			content = content[:feed.OnelineContentWidth] + "..."
 		}
@@ -588,39 +791,45 @@ func formatSuggestPost(w io.Writer, post *feed.Post, full bool) {
 	_, _ = fmt.Fprintf(w, "    %s\n", content)
 }
 
-// formatTimeAgo formats a time as a human-readable "X ago" string
-// Examples: "15m ago", "2h ago", "just now"
-func formatTimeAgo(t time.Time) string {
-	now := time.Now()
-	duration := now.Sub(t)
-
-	if duration < time.Minute {
-		return "just now"
+// getRandomExamples returns n to m random examples from the provided slice
+func getRandomExamples(examples []string, minCount, maxCount int) []string {
+	weighted := make([]weightedExample, len(examples))
+	for i, ex := range examples {
+		weighted[i] = weightedExample{text: ex, weight: 1}
 	}
-	if duration < time.Hour {
-		minutes := int(duration.Minutes())
-		if minutes == 1 {
-			return "1m ago"
+	return getWeightedExamples(weighted, minCount, maxCount)
+}
+
+// weightedExample is a post idea and how likely it is to be picked.
+type weightedExample struct {
+	text   string
+	weight int
+}
+
+// pickExamples returns minCount to maxCount examples weighted by
+// example_weights, leaving out those the previous nudge showed unless too
+// few would remain. The picks are recorded in p.shown.
+func (p *nudgePicker) pickExamples(cfg *config.SuggestConfig, examples []string, minCount, maxCount int) []string {
+	weighted := make([]weightedExample, 0, len(examples))
+	for _, ex := range examples {
+		if !slices.Contains(p.previous.Examples, ex) {
+			weighted = append(weighted, weightedExample{text: ex, weight: cfg.ExampleWeight(ex)})
 		}
-		return fmt.Sprintf("%dm ago", minutes)
 	}
-	if duration < 24*time.Hour {
-		hours := int(duration.Hours())
-		if hours == 1 {
-			return "1h ago"
+	if len(weighted) < minCount {
+		weighted = weighted[:0]
+		for _, ex := range examples {
+			weighted = append(weighted, weightedExample{text: ex, weight: cfg.ExampleWeight(ex)})
 		}
-		return fmt.Sprintf("%dh ago", hours)
 	}
-
-	days := int(duration.Hours() / 24)
-	if days == 1 {
-		return "1d ago"
-	}
-	return fmt.Sprintf("%dd ago", days)
+	picked := getWeightedExamples(weighted, minCount, maxCount)
+	p.shown.Examples = append(p.shown.Examples, picked...)
+	return picked
 }
 
-// getRandomExamples returns n to m random examples from the provided slice
-func getRandomExamples(examples []string, minCount, maxCount int) []string {
+// getWeightedExamples returns minCount to maxCount distinct examples, each
+// drawn with probability proportional to its weight. Weights below 1 count as 1.
+func getWeightedExamples(examples []weightedExample, minCount, maxCount int) []string {
 	if len(examples) == 0 {
 		return []string{}
 	}
@@ -628,20 +837,27 @@ func getRandomExamples(examples []string, minCount, maxCount int) []string {
 	// Randomly decide count between minCount and maxCount
 	count := minCount
 	if maxCount > minCount {
-		count = minCount + rand.IntN(maxCount-minCount+1)
-	}
-
-	// Ensure we don't ask for more examples than exist
-	if count > len(examples) {
-		count = len(examples)
+		count = minCount + nudgeRand.IntN(maxCount-minCount+1)
+	}
+	count = min(count, len(examples))
+
+	pool := slices.Clone(examples)
+	total := 0
+	for i := range pool {
+		pool[i].weight = max(pool[i].weight, 1)
+		total += pool[i].weight
+	}
+	picked := make([]string, 0, count)
+	for len(picked) < count {
+		roll := nudgeRand.IntN(total)
+		i := 0
+		for roll >= pool[i].weight {
+			roll -= pool[i].weight
+			i++
+		}
+		picked = append(picked, pool[i].text)
+		total -= pool[i].weight
+		pool = slices.Delete(pool, i, i+1)
 	}
-
-	// Copy and shuffle, then pick first count
-	shuffled := make([]string, len(examples))
-	copy(shuffled, examples)
-	rand.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
-
-	return shuffled[:count]
+	return picked
 }
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+// autoContext is the --context value that infers the context from smoke.log.
+const autoContext = "auto"
+
+const (
+	// autoContextWindow is how far back smoke.log activity counts.
+	autoContextWindow = 30 * time.Minute
+	// autoContextIdle is the quiet spell after which a session is waiting.
+	autoContextIdle = 15 * time.Minute
+	// autoContextLongCommand is how long a command must run to count as
+	// finishing a piece of work.
+	autoContextLongCommand = time.Minute
+	// autoContextMinCommands is how many reads or writes make a pattern.
+	autoContextMinCommands = 3
+)
+
+// Commands that read the feed and commands that add to it. Others (suggest,
+// statusline, config commands) say nothing about what the agent is doing.
+var (
+	autoContextReads = map[string]bool{
+		"feed": true, "search": true, "mentions": true, "explain": true,
+		"stats": true, "top": true, "logs": true, "bookmarks": true, "export": true,
+	}
+	autoContextWrites = map[string]bool{
+		"post": true, "reply": true, "react": true, "edit": true, "delete": true, "import": true,
+	}
+)
+
+// contextSignals summarizes one session's activity in smoke.log.
+type contextSignals struct {
+	Reads        int       // read commands started within autoContextWindow
+	Writes       int       // write commands started within autoContextWindow
+	LongFinished bool      // a long command finished within autoContextIdle
+	LastActivity time.Time // latest read or write, at any time
+}
+
+// readContextSignals scans smoke.log entries for session (all sessions when
+// empty), counting activity up to now.
+func readContextSignals(r io.Reader, session string, now time.Time) contextSignals {
+	var s contextSignals
+	since := now.Add(-autoContextWindow)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		e, ok := logging.ParseEntry(scanner.Bytes())
+		if !ok || e.Time.IsZero() || e.Time.After(now) {
+			continue
+		}
+		if session != "" && e.Ctx.Session != session {
+			continue
+		}
+		name := e.Cmd.Name
+		if !autoContextReads[name] && !autoContextWrites[name] {
+			continue
+		}
+		if e.Msg == "command completed" {
+			if time.Duration(e.Cmd.DurationMS)*time.Millisecond >= autoContextLongCommand &&
+				now.Sub(e.Time) <= autoContextIdle {
+				s.LongFinished = true
+			}
+			continue
+		}
+		if !e.IsCommandStart() {
+			continue
+		}
+		if e.Time.After(s.LastActivity) {
+			s.LastActivity = e.Time
+		}
+		if e.Time.Before(since) {
+			continue
+		}
+		if autoContextReads[name] {
+			s.Reads++
+		} else {
+			s.Writes++
+		}
+	}
+	return s
+}
+
+// inferContext maps activity to a built-in context, checking in order:
+//
+//   - no activity ever: on-the-clock (the session is just starting)
+//   - nothing for autoContextIdle: waiting
+//   - a command that ran autoContextLongCommand or more just finished: just-shipped
+//   - mostly reading the feed (3+ reads, at least twice the writes): seen-some-things
+//   - mostly posting (3+ writes, at least twice the reads): deep-in-it
+//
+// Anything else is ambiguous and yields "" (no context).
+func inferContext(s contextSignals, now time.Time) string {
+	switch {
+	case s.LastActivity.IsZero():
+		return "on-the-clock"
+	case now.Sub(s.LastActivity) >= autoContextIdle:
+		return "waiting"
+	case s.LongFinished:
+		return "just-shipped"
+	case s.Reads >= autoContextMinCommands && s.Reads >= 2*s.Writes:
+		return "seen-some-things"
+	case s.Writes >= autoContextMinCommands && s.Writes >= 2*s.Reads:
+		return "deep-in-it"
+	default:
+		return ""
+	}
+}
+
+// detectSuggestContext infers a context from this session's smoke.log
+// activity, or returns "" when the log is unreadable or the signals are
+// ambiguous.
+func detectSuggestContext(now time.Time) string {
+	logPath, err := config.GetLogPath()
+	if err != nil {
+		return ""
+	}
+	f, err := os.Open(logPath)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = f.Close() }()
+	return inferContext(readContextSignals(f, logging.CaptureContext().Session, now), now)
+}
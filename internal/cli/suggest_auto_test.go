@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInferContextFromLog(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	start := func(ago time.Duration, session, cmd string) string {
+		return fmt.Sprintf(`{"time":%q,"level":"INFO","msg":"command started","ctx":{"session":%q},"cmd":{"name":%q}}`,
+			now.Add(-ago).Format(time.RFC3339Nano), session, cmd)
+	}
+	done := func(ago time.Duration, session, cmd string, took time.Duration) string {
+		return fmt.Sprintf(`{"time":%q,"level":"INFO","msg":"command completed","ctx":{"session":%q},"cmd":{"name":%q,"duration_ms":%d}}`,
+			now.Add(-ago).Format(time.RFC3339Nano), session, cmd, took.Milliseconds())
+	}
+
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{"no activity", nil, "on-the-clock"},
+		{"other sessions only", []string{
+			start(time.Minute, "other", "feed"), start(time.Minute, "other", "search"),
+		}, "on-the-clock"},
+		{"quiet for a while", []string{
+			start(time.Hour, "s1", "post"), start(20*time.Minute, "s1", "feed"),
+		}, "waiting"},
+		{"long command just finished", []string{
+			start(4*time.Minute, "s1", "export"), done(time.Minute, "s1", "export", 3*time.Minute),
+		}, "just-shipped"},
+		{"reading the feed", []string{
+			start(10*time.Minute, "s1", "feed"), start(8*time.Minute, "s1", "search"),
+			start(5*time.Minute, "s1", "mentions"), start(3*time.Minute, "s1", "post"),
+			start(2*time.Minute, "s1", "feed"),
+		}, "seen-some-things"},
+		{"posting a lot", []string{
+			start(9*time.Minute, "s1", "post"), start(6*time.Minute, "s1", "reply"),
+			start(4*time.Minute, "s1", "react"), start(2*time.Minute, "s1", "post"),
+		}, "deep-in-it"},
+		{"mixed activity is ambiguous", []string{
+			start(9*time.Minute, "s1", "post"), start(7*time.Minute, "s1", "feed"),
+			start(5*time.Minute, "s1", "reply"), start(3*time.Minute, "s1", "search"),
+		}, ""},
+		{"suggest and statusline are ignored", []string{
+			start(time.Hour, "s1", "post"),
+			start(time.Minute, "s1", "suggest"), start(time.Minute, "s1", "statusline"),
+		}, "waiting"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := strings.NewReader(strings.Join(tt.lines, "\n") + "\nnot json\n")
+			got := inferContext(readContextSignals(log, "s1", now), now)
+			if got != tt.want {
+				t.Errorf("inferContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used
+	"os"
+	"strconv"
+	"time"
+)
+
+// randSeedEnv fixes the seed behind nudge rolls and example picks, so a
+// run can be reproduced or tested deterministically.
+const randSeedEnv = "SMOKE_RAND_SEED"
+
+// nudgeRand drives every random choice suggest makes: whether the nudge
+// fires, reply or post mode, the style mode, examples, and reply bait.
+var nudgeRand = newSeededRand(uint64(time.Now().UnixNano()))
+
+// newSeededRand returns a source that yields the same sequence for seed.
+func newSeededRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// seedNudgeRand reseeds nudgeRand from SMOKE_RAND_SEED when it is set,
+// warning on w and keeping the time-seeded source when it is not a number.
+func seedNudgeRand(w io.Writer) {
+	value := os.Getenv(randSeedEnv)
+	if value == "" {
+		return
+	}
+	seed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		fmt.Fprintf(w, "warning: ignoring %s=%q: not a non-negative integer\n", randSeedEnv, value)
+		return
+	}
+	nudgeRand = newSeededRand(seed)
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+func TestSeedNudgeRand_FixedSequence(t *testing.T) {
+	prev := nudgeRand
+	defer func() { nudgeRand = prev }()
+
+	decisions := func() []nudgeDecision {
+		seedNudgeRand(&bytes.Buffer{})
+		var got []nudgeDecision
+		for range 20 {
+			got = append(got, shouldFireNudge(config.DefaultPressure))
+		}
+		return got
+	}
+
+	t.Setenv(randSeedEnv, "42")
+	first := decisions()
+	second := decisions()
+	fired := 0
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("decision %d differs with the same seed: %+v vs %+v", i, first[i], second[i])
+		}
+		if first[i].fire {
+			fired++
+		}
+	}
+	if fired == 0 || fired == len(first) {
+		t.Errorf("expected a mix of decisions at the default pressure, got %d of %d fired", fired, len(first))
+	}
+
+	t.Setenv(randSeedEnv, "43")
+	if other := decisions(); slices.Equal(first, other) {
+		t.Error("expected a different seed to give a different sequence")
+	}
+}
+
+func TestSeedNudgeRand_Invalid(t *testing.T) {
+	prev := nudgeRand
+	defer func() { nudgeRand = prev }()
+
+	t.Setenv(randSeedEnv, "abc")
+	var stderr bytes.Buffer
+	seedNudgeRand(&stderr)
+	if nudgeRand != prev {
+		t.Error("expected an invalid seed to keep the current source")
+	}
+	if !strings.Contains(stderr.String(), "warning: ignoring SMOKE_RAND_SEED") {
+		t.Errorf("expected a warning, got %q", stderr.String())
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dreamiurg/smoke/internal/config"
 	"github.com/dreamiurg/smoke/internal/feed"
 )
 
@@ -117,7 +119,7 @@ func TestRunSuggest_FiredText(t *testing.T) {
 	suggestSince = 24 * time.Hour
 	suggestJSON = false
 	suggestContext = "deep-in-it"
-	suggestPressure = 4
+	suggestPressure = config.MaxPressure
 
 	output := captureSuggestStdout(t, func() {
 		if err := runSuggest(nil, []string{}); err != nil {
@@ -185,7 +187,7 @@ func TestRunSuggest_ReplyContext(t *testing.T) {
 	suggestSince = 24 * time.Hour
 	suggestJSON = false
 	suggestContext = "reply"
-	suggestPressure = 4
+	suggestPressure = config.MaxPressure
 
 	output := captureSuggestStdout(t, func() {
 		if err := runSuggest(nil, []string{}); err != nil {
@@ -243,7 +245,7 @@ func TestRunSuggest_InvalidContext(t *testing.T) {
 	suggestSince = 4 * time.Hour
 	suggestJSON = false
 	suggestContext = "nope"
-	suggestPressure = 4
+	suggestPressure = config.MaxPressure
 
 	if err := runSuggest(nil, []string{}); err == nil {
 		t.Fatal("expected error for unknown context")
@@ -268,3 +270,57 @@ func captureSuggestStdout(t *testing.T, fn func()) string {
 	_, _ = io.Copy(&buf, r)
 	return buf.String()
 }
+
+func TestRunSuggest_Decide(t *testing.T) {
+	tmpDir := t.TempDir()
+	feedPath := filepath.Join(tmpDir, "feed.jsonl")
+	if err := os.WriteFile(feedPath, []byte(""), 0o600); err != nil {
+		t.Fatalf("write feed file: %v", err)
+	}
+	t.Setenv("SMOKE_FEED", feedPath)
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	prevDecide := suggestDecide
+	prevPressure := suggestPressure
+	prevCooldown := suggestCooldown
+	defer func() {
+		suggestDecide = prevDecide
+		suggestPressure = prevPressure
+		suggestCooldown = prevCooldown
+	}()
+	suggestDecide = true
+	suggestCooldown = 0
+
+	for _, tt := range []struct {
+		pressure int
+		fire     bool
+	}{{config.MaxPressure, true}, {0, false}} {
+		suggestPressure = tt.pressure
+		output := captureSuggestStdout(t, func() {
+			if err := runSuggest(nil, []string{}); err != nil {
+				t.Fatalf("runSuggest error: %v", err)
+			}
+		})
+
+		var got map[string]any
+		if err := json.Unmarshal([]byte(output), &got); err != nil {
+			t.Fatalf("expected JSON, got %q: %v", output, err)
+		}
+		for _, key := range []string{"fire", "roll", "threshold", "pressure"} {
+			if _, ok := got[key]; !ok {
+				t.Errorf("pressure %d: missing %q in %s", tt.pressure, key, output)
+			}
+		}
+		if got["fire"] != tt.fire {
+			t.Errorf("pressure %d: fire = %v, want %v", tt.pressure, got["fire"], tt.fire)
+		}
+		if got["pressure"] != float64(tt.pressure) {
+			t.Errorf("pressure = %v, want %d", got["pressure"], tt.pressure)
+		}
+		for _, text := range []string{"Post ideas", "posts", "examples"} {
+			if strings.Contains(output, text) {
+				t.Errorf("pressure %d: --decide printed suggestions: %s", tt.pressure, output)
+			}
+		}
+	}
+}
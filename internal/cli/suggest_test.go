@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -14,35 +15,6 @@ import (
 	"github.com/dreamiurg/smoke/internal/feed"
 )
 
-func TestFormatTimeAgo(t *testing.T) {
-	now := time.Now()
-
-	tests := []struct {
-		name     string
-		time     time.Time
-		expected string
-	}{
-		{"just now", now.Add(-30 * time.Second), "just now"},
-		{"1 minute", now.Add(-1 * time.Minute), "1m ago"},
-		{"5 minutes", now.Add(-5 * time.Minute), "5m ago"},
-		{"59 minutes", now.Add(-59 * time.Minute), "59m ago"},
-		{"1 hour", now.Add(-1 * time.Hour), "1h ago"},
-		{"5 hours", now.Add(-5 * time.Hour), "5h ago"},
-		{"23 hours", now.Add(-23 * time.Hour), "23h ago"},
-		{"1 day", now.Add(-24 * time.Hour), "1d ago"},
-		{"3 days", now.Add(-72 * time.Hour), "3d ago"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := formatTimeAgo(tt.time)
-			if result != tt.expected {
-				t.Errorf("formatTimeAgo(%v) = %q, want %q", tt.time, result, tt.expected)
-			}
-		})
-	}
-}
-
 func TestGetRandomExamples(t *testing.T) {
 	t.Run("empty input returns empty slice", func(t *testing.T) {
 		result := getRandomExamples([]string{}, 2, 3)
@@ -85,6 +57,80 @@ func TestGetRandomExamples(t *testing.T) {
 	})
 }
 
+func TestGetWeightedExamples(t *testing.T) {
+	examples := []weightedExample{{text: "heavy", weight: 9}, {text: "light", weight: 1}}
+	heavy := 0
+	for i := 0; i < 1000; i++ {
+		if got := getWeightedExamples(examples, 1, 1); got[0] == "heavy" {
+			heavy++
+		}
+	}
+	// Expect about 900; anything near uniform means weights were ignored
+	if heavy < 800 || heavy > 970 {
+		t.Errorf("heavy picked %d/1000 times, want about 900", heavy)
+	}
+
+	got := getWeightedExamples([]weightedExample{{text: "a"}, {text: "b"}, {text: "c"}}, 3, 3)
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("zero weights should count as 1 and picks stay distinct, got %v", got)
+	}
+}
+
+func TestPickExamples_SkipsPreviousNudge(t *testing.T) {
+	cfg := &config.SuggestConfig{}
+	examples := []string{"a", "b", "c", "d"}
+
+	previous := config.NudgePicks{Examples: []string{"a", "b"}}
+	for i := 0; i < 20; i++ {
+		picks := &nudgePicker{previous: previous}
+		got := picks.pickExamples(cfg, examples, 2, 2)
+		slices.Sort(got)
+		if !slices.Equal(got, []string{"c", "d"}) {
+			t.Fatalf("pickExamples() = %v, want the examples not shown last time", got)
+		}
+		if shown := slices.Sorted(slices.Values(picks.shown.Examples)); !slices.Equal(shown, got) {
+			t.Fatalf("shown.Examples = %v, want the picks recorded", picks.shown.Examples)
+		}
+	}
+
+	// Too few fresh examples: fall back to the full list
+	picks := &nudgePicker{previous: config.NudgePicks{Examples: []string{"a", "b", "c"}}}
+	if got := picks.pickExamples(cfg, examples, 2, 2); len(got) != 2 {
+		t.Errorf("pickExamples() = %v, want 2 examples from the full list", got)
+	}
+}
+
+func TestChooseStyleMode_WeightsAndSkipsPrevious(t *testing.T) {
+	cfg := &config.SuggestConfig{StyleModes: map[string][]config.StyleMode{
+		"default": {
+			{Name: "vent", Hint: "Vent."},
+			{Name: "hot take", Hint: "Opinion.", Weight: 9},
+			{Name: "question", Hint: "Ask."},
+		},
+	}}
+
+	hot := 0
+	for i := 0; i < 1000; i++ {
+		if (&nudgePicker{}).chooseStyleMode(cfg, "", "post").Name == "hot take" {
+			hot++
+		}
+	}
+	if hot < 720 || hot > 910 {
+		t.Errorf("hot take picked %d/1000 times, want about 820", hot)
+	}
+
+	picks := &nudgePicker{previous: config.NudgePicks{Style: "hot take"}}
+	for i := 0; i < 50; i++ {
+		if style := picks.chooseStyleMode(cfg, "", "post"); style.Name == "hot take" {
+			t.Fatal("chooseStyleMode repeated the previous nudge's style")
+		}
+	}
+	if picks.shown.Style == "" {
+		t.Error("chooseStyleMode should record the picked style")
+	}
+}
+
 func TestFormatSuggestPost(t *testing.T) {
 	// Create a temp file to capture output
 	tmpFile, err := os.CreateTemp("", "suggest_test")
@@ -204,12 +250,12 @@ func TestShouldFireNudgeAtPressure0(t *testing.T) {
 	}
 }
 
-func TestShouldFireNudgeAtPressure4(t *testing.T) {
-	// At pressure 4 (volcanic), nudge should always fire
+func TestShouldFireNudgeAtMaxPressure(t *testing.T) {
+	// At pressure 10 (volcanic), nudge should always fire
 	for i := 0; i < 100; i++ {
-		decision := shouldFireNudge(4)
+		decision := shouldFireNudge(config.MaxPressure)
 		if !decision.fire {
-			t.Errorf("shouldFireNudge(4).fire = false, want true (pressure 4 should always fire)")
+			t.Errorf("shouldFireNudge(10).fire = false, want true (pressure 10 should always fire)")
 		}
 	}
 }
@@ -220,11 +266,16 @@ func TestShouldFireNudgeDecisionValues(t *testing.T) {
 		pressure      int
 		wantThreshold int
 	}{
-		{0, 0},
-		{1, 25},
-		{2, 50},
-		{3, 75},
-		{4, 100},
+		// Clamped to the 0-10 range
+		{-1, 0},
+		{11, 100},
+	}
+	// Each level adds 10%
+	for level := 0; level <= config.MaxPressure; level++ {
+		tests = append(tests, struct {
+			pressure      int
+			wantThreshold int
+		}{level, level * 10})
 	}
 	for _, tt := range tests {
 		decision := shouldFireNudge(tt.pressure)
@@ -241,12 +292,15 @@ func TestGetTonePrefix(t *testing.T) {
 	}{
 		{0, ""},
 		{1, "If you feel like it..."},
-		{2, "Got a minute? The feed's been quiet."},
-		{3, "Come on, you've got something. Spill it."},
-		{4, "Post something. Now. The break room is dead and it's your fault."},
+		{3, "If you feel like it..."},
+		{4, "Got a minute? The feed's been quiet."},
+		{6, "Got a minute? The feed's been quiet."},
+		{7, "Come on, you've got something. Spill it."},
+		{9, "Come on, you've got something. Spill it."},
+		{10, "Post something. Now. The break room is dead and it's your fault."},
 		// Test clamping
 		{-1, ""},
-		{5, "Post something. Now. The break room is dead and it's your fault."},
+		{11, "Post something. Now. The break room is dead and it's your fault."},
 	}
 
 	for _, tt := range tests {
@@ -313,17 +367,20 @@ func TestRunSuggest_JSONSkip(t *testing.T) {
 	prevPressure := suggestPressure
 	prevContext := suggestContext
 	prevSince := suggestSince
+	prevCooldown := suggestCooldown
 	defer func() {
 		suggestJSON = prevJSON
 		suggestPressure = prevPressure
 		suggestContext = prevContext
 		suggestSince = prevSince
+		suggestCooldown = prevCooldown
 	}()
 
 	suggestJSON = true
 	suggestPressure = 0
 	suggestContext = ""
 	suggestSince = 1 * time.Hour
+	suggestCooldown = 0
 
 	output := captureStdout(t, func() {
 		if err := runSuggest(nil, []string{}); err != nil {
@@ -337,6 +394,88 @@ func TestRunSuggest_JSONSkip(t *testing.T) {
 	if !strings.Contains(output, "\"pressure\": 0") {
 		t.Fatalf("expected pressure 0 in JSON output, got: %s", output)
 	}
+	if !strings.Contains(output, "\"skipped_reason\": \"pressure\"") {
+		t.Fatalf("expected pressure skip reason in JSON output, got: %s", output)
+	}
+}
+
+func TestRunSuggest_CooldownSkip(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	prevJSON := suggestJSON
+	prevPressure := suggestPressure
+	prevContext := suggestContext
+	prevCooldown := suggestCooldown
+	t.Cleanup(func() {
+		suggestJSON = prevJSON
+		suggestPressure = prevPressure
+		suggestContext = prevContext
+		suggestCooldown = prevCooldown
+	})
+
+	suggestJSON = true
+	suggestPressure = config.MaxPressure
+	suggestContext = ""
+	suggestCooldown = 10 * time.Minute
+
+	identity, err := config.GetIdentity("")
+	if err != nil {
+		t.Fatalf("GetIdentity: %v", err)
+	}
+	if err := config.RecordPost(identity.String(), time.Now().Add(-2*time.Minute)); err != nil {
+		t.Fatalf("RecordPost: %v", err)
+	}
+
+	output := captureStdout(t, func() {
+		if err := runSuggest(nil, []string{}); err != nil {
+			t.Fatalf("runSuggest error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "\"skipped_reason\": \"cooldown\"") {
+		t.Fatalf("expected cooldown skip, got: %s", output)
+	}
+	if !strings.Contains(output, "\"cooldown\": \"10m0s\"") {
+		t.Fatalf("expected cooldown duration in output, got: %s", output)
+	}
+
+	// Outside the cooldown window suggest fires again
+	suggestCooldown = time.Minute
+	output = captureStdout(t, func() {
+		if err := runSuggest(nil, []string{}); err != nil {
+			t.Fatalf("runSuggest error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "\"skipped\": false") {
+		t.Fatalf("expected nudge after cooldown, got: %s", output)
+	}
+}
+
+func TestRunPost_RecordsLastPost(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+
+	identity, err := config.GetIdentity("")
+	if err != nil {
+		t.Fatalf("GetIdentity: %v", err)
+	}
+	if _, ok := config.LastPostTime(identity.String()); ok {
+		t.Fatal("expected no recorded post before posting")
+	}
+
+	captureStdout(t, func() {
+		if err := runPost(nil, []string{"cooling down"}); err != nil {
+			t.Fatalf("runPost error: %v", err)
+		}
+	})
+
+	last, ok := config.LastPostTime(identity.String())
+	if !ok {
+		t.Fatal("expected post time to be recorded")
+	}
+	if time.Since(last) > time.Minute {
+		t.Errorf("recorded post time %v is stale", last)
+	}
 }
 
 func TestFormatSuggestTextWithContext(t *testing.T) {
@@ -356,7 +495,7 @@ func TestFormatSuggestTextWithContext(t *testing.T) {
 	}
 
 	output := captureStdout(t, func() {
-		if err := formatSuggestTextWithContext(posts, posts, config.LoadSuggestConfig(), "deep-in-it", 3); err != nil {
+		if err := formatSuggestTextWithContext(os.Stdout, posts, posts, config.LoadSuggestConfig(), &nudgePicker{}, "deep-in-it", 8); err != nil {
 			t.Fatalf("formatSuggestTextWithContext error: %v", err)
 		}
 	})
@@ -393,7 +532,7 @@ func TestFormatSuggestJSONWithContext(t *testing.T) {
 	}
 
 	output := captureStdout(t, func() {
-		if err := formatSuggestJSONWithContext(posts, posts, config.LoadSuggestConfig(), "deep-in-it", 2); err != nil {
+		if err := formatSuggestJSONWithContext(posts, posts, config.LoadSuggestConfig(), &nudgePicker{}, "deep-in-it", 2); err != nil {
 			t.Fatalf("formatSuggestJSONWithContext error: %v", err)
 		}
 	})
@@ -425,7 +564,7 @@ func TestFormatSuggestJSONWithContext(t *testing.T) {
 func TestChooseStyleMode(t *testing.T) {
 	t.Run("reply mode always returns reply style", func(t *testing.T) {
 		cfg := config.LoadSuggestConfig()
-		style := chooseStyleMode(cfg, "breakroom", "reply")
+		style := (&nudgePicker{}).chooseStyleMode(cfg, "breakroom", "reply")
 		if style.Name != "reply" {
 			t.Errorf("chooseStyleMode(_, reply).Name = %q, want %q", style.Name, "reply")
 		}
@@ -444,7 +583,7 @@ func TestChooseStyleMode(t *testing.T) {
 		}
 
 		for i := 0; i < 50; i++ {
-			style := chooseStyleMode(cfg, "breakroom", "post")
+			style := (&nudgePicker{}).chooseStyleMode(cfg, "breakroom", "post")
 			if !known[style.Name] {
 				t.Fatalf("unknown style name: %q", style.Name)
 			}
@@ -455,6 +594,45 @@ func TestChooseStyleMode(t *testing.T) {
 	})
 }
 
+func TestChooseStyleMode_FromConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	configDir := filepath.Join(home, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	configContent := `
+contexts:
+  standup:
+    prompt: "Standup time"
+    categories: [Banter]
+style_modes:
+  standup:
+    - name: "standup"
+      hint: "One line on what you are doing"
+    - hint: "missing a name"
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(configContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.LoadSuggestConfig()
+	for i := 0; i < 20; i++ {
+		if style := (&nudgePicker{}).chooseStyleMode(cfg, "standup", "post"); style.Name != "standup" {
+			t.Fatalf("chooseStyleMode(standup) = %q, want the configured mode", style.Name)
+		}
+	}
+	if style := (&nudgePicker{}).chooseStyleMode(cfg, "standup", "reply"); style.Name != "reply" {
+		t.Errorf("reply mode should keep the reply style, got %q", style.Name)
+	}
+
+	var buf bytes.Buffer
+	warnSkippedStyleModes(&buf, cfg)
+	if !strings.Contains(buf.String(), "warning: ignoring style_modes.standup[1]") {
+		t.Errorf("expected a warning for the malformed entry, got %q", buf.String())
+	}
+}
+
 func TestFormatReplyMode(t *testing.T) {
 	oldHome := os.Getenv("HOME")
 	_ = os.Setenv("HOME", t.TempDir())
@@ -477,7 +655,7 @@ func TestFormatReplyMode(t *testing.T) {
 	}
 
 	output := captureStdout(t, func() {
-		if err := formatReplyMode(posts, config.LoadSuggestConfig()); err != nil {
+		if err := formatReplyMode(os.Stdout, posts, config.LoadSuggestConfig(), &nudgePicker{}); err != nil {
 			t.Fatalf("formatReplyMode error: %v", err)
 		}
 	})
@@ -509,7 +687,7 @@ func TestFormatSuggestTextWithContext_ReplyEmptyFeed(t *testing.T) {
 	defer func() { _ = os.Setenv("HOME", oldHome) }()
 
 	output := captureStdout(t, func() {
-		if err := formatSuggestTextWithContext(nil, nil, config.LoadSuggestConfig(), "reply", 3); err != nil {
+		if err := formatSuggestTextWithContext(os.Stdout, nil, nil, config.LoadSuggestConfig(), &nudgePicker{}, "reply", 3); err != nil {
 			t.Fatalf("formatSuggestTextWithContext error: %v", err)
 		}
 	})
@@ -539,7 +717,7 @@ func TestFormatSuggestJSONWithContext_ReplyMode(t *testing.T) {
 	}
 
 	output := captureStdout(t, func() {
-		if err := formatSuggestJSONWithContext(posts, posts, config.LoadSuggestConfig(), "reply", 2); err != nil {
+		if err := formatSuggestJSONWithContext(posts, posts, config.LoadSuggestConfig(), &nudgePicker{}, "reply", 2); err != nil {
 			t.Fatalf("formatSuggestJSONWithContext error: %v", err)
 		}
 	})
@@ -562,7 +740,7 @@ func TestFormatSuggestJSONWithContext_ReplyEmptyFeed(t *testing.T) {
 	defer func() { _ = os.Setenv("HOME", oldHome) }()
 
 	output := captureStdout(t, func() {
-		if err := formatSuggestJSONWithContext(nil, nil, config.LoadSuggestConfig(), "reply", 2); err != nil {
+		if err := formatSuggestJSONWithContext(nil, nil, config.LoadSuggestConfig(), &nudgePicker{}, "reply", 2); err != nil {
 			t.Fatalf("formatSuggestJSONWithContext error: %v", err)
 		}
 	})
@@ -662,3 +840,64 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestResolveNudgeWriter(t *testing.T) {
+	prev := suggestNudgeOutput
+	defer func() { suggestNudgeOutput = prev }()
+
+	cfg := &config.SuggestConfig{}
+
+	suggestNudgeOutput = ""
+	w, err := resolveNudgeWriter(cfg)
+	if err != nil || w != os.Stdout {
+		t.Fatalf("default should be stdout, got %v (err %v)", w, err)
+	}
+
+	cfg.NudgeOutput = config.NudgeOutputStderr
+	w, err = resolveNudgeWriter(cfg)
+	if err != nil || w != os.Stderr {
+		t.Fatalf("config stderr should route to stderr, got %v (err %v)", w, err)
+	}
+
+	suggestNudgeOutput = "stdout"
+	w, err = resolveNudgeWriter(cfg)
+	if err != nil || w != os.Stdout {
+		t.Fatalf("flag should override config, got %v (err %v)", w, err)
+	}
+
+	suggestNudgeOutput = "stdin"
+	if _, err := resolveNudgeWriter(cfg); err == nil {
+		t.Fatal("expected error for invalid --nudge-output")
+	}
+}
+
+func TestLastReadCutoff(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	now := time.Now().UTC()
+	posts := []*feed.Post{
+		{ID: "smk-aaaaaa", CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+		{ID: "smk-bbbbbb", CreatedAt: now.Add(-1 * time.Hour).Format(time.RFC3339)},
+	}
+
+	if _, ok := lastReadCutoff(posts); ok {
+		t.Fatal("expected no cutoff without read state")
+	}
+
+	if err := config.SaveLastReadPostID("smk-aaaaaa"); err != nil {
+		t.Fatal(err)
+	}
+	cutoff, ok := lastReadCutoff(posts)
+	want, _ := posts[0].GetCreatedTime()
+	if !ok || !cutoff.Equal(want) {
+		t.Fatalf("cutoff = %v (ok=%v), want last-read post time %v", cutoff, ok, want)
+	}
+
+	if err := config.SaveLastReadPostID("smk-gone00"); err != nil {
+		t.Fatal(err)
+	}
+	cutoff, ok = lastReadCutoff(posts)
+	if !ok || time.Since(cutoff) > time.Minute {
+		t.Fatalf("expected fallback to read-state timestamp, got %v (ok=%v)", cutoff, ok)
+	}
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var (
+	syncPull bool
+	syncPush bool
+	syncPath string
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Merge the feed with a shared feed on another machine",
+	Long: `Merge your feed with a feed file in a shared directory (a network
+mount, Dropbox, Syncthing folder, ...), so agents on different machines see
+each other's posts.
+
+Posts are matched by ID and reactions, edits, and deletions by content, so
+nothing is duplicated however often you sync. Timestamps are kept as
+written and both files end up ordered by time. Each file is locked while it
+is rewritten, so posts appended during a sync are not lost.
+
+By default smoke sync pulls and then pushes. --path names the shared feed
+file, or a directory holding feed.jsonl; set sync_path in config.yaml to
+skip the flag. Pushing creates the shared file if it does not exist.
+
+Examples:
+  smoke sync --path /mnt/shared/smoke          Pull and push
+  smoke sync --pull --path ~/Dropbox/smoke.jsonl  Only bring in remote posts
+  smoke sync --push                            Only publish local posts (uses sync_path)`,
+	Args: cobra.NoArgs,
+	RunE: runSync,
+}
+
+func init() {
+	syncCmd.Flags().BoolVar(&syncPull, "pull", false, "Merge the shared feed into the local one")
+	syncCmd.Flags().BoolVar(&syncPush, "push", false, "Merge the local feed into the shared one")
+	syncCmd.Flags().StringVar(&syncPath, "path", "", "Shared feed file or directory (default: sync_path in config.yaml)")
+	rootCmd.AddCommand(syncCmd)
+}
+
+func runSync(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("sync", args)
+
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	localPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	remotePath, err := resolveSyncPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if filepath.Clean(remotePath) == filepath.Clean(localPath) {
+		err = errors.New("--path is the local feed; point it at the shared copy")
+		tracker.Fail(err)
+		return err
+	}
+
+	pull, push := syncPull, syncPush
+	if !pull && !push {
+		pull, push = true, true
+	}
+
+	if pull {
+		if _, statErr := os.Stat(remotePath); statErr != nil {
+			if !push {
+				err = fmt.Errorf("shared feed %s: %w", remotePath, statErr)
+				tracker.Fail(err)
+				return err
+			}
+		} else {
+			result, mergeErr := feed.NewStoreWithPath(localPath).MergeFrom(remotePath)
+			if mergeErr != nil {
+				err = fmt.Errorf("pull: %w", mergeErr)
+				tracker.Fail(err)
+				return err
+			}
+			fmt.Printf("Pulled %s from %s\n", describeMerge(result), remotePath)
+		}
+	}
+	if push {
+		result, mergeErr := feed.NewStoreWithPath(remotePath).MergeFrom(localPath)
+		if mergeErr != nil {
+			err = fmt.Errorf("push: %w", mergeErr)
+			tracker.Fail(err)
+			return err
+		}
+		fmt.Printf("Pushed %s to %s\n", describeMerge(result), remotePath)
+	}
+
+	tracker.Complete()
+	return nil
+}
+
+// resolveSyncPath returns the shared feed file from --path or sync_path.
+// A directory means the feed.jsonl inside it.
+func resolveSyncPath() (string, error) {
+	path := syncPath
+	if path == "" {
+		path = config.GetSyncPath()
+	}
+	if path == "" {
+		return "", errors.New("no shared feed: pass --path or set sync_path in config.yaml")
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	if info, statErr := os.Stat(path); statErr == nil && info.IsDir() {
+		path = filepath.Join(path, config.DefaultFeedFile)
+	}
+	return path, nil
+}
+
+// describeMerge summarizes a merge, e.g. "3 new records (2 posts)".
+func describeMerge(result *feed.MergeResult) string {
+	if result.Records == 0 {
+		return "nothing new"
+	}
+	records, posts := "records", "posts"
+	if result.Records == 1 {
+		records = "record"
+	}
+	if result.Posts == 1 {
+		posts = "post"
+	}
+	return fmt.Sprintf("%d new %s (%d %s)", result.Records, records, result.Posts, posts)
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func resetSyncFlags(t *testing.T) {
+	t.Helper()
+	prevPull, prevPush, prevPath := syncPull, syncPush, syncPath
+	t.Cleanup(func() { syncPull, syncPush, syncPath = prevPull, prevPush, prevPath })
+	syncPull, syncPush, syncPath = false, false, ""
+}
+
+func TestRunSync(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetSyncFlags(t)
+
+	localPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := feed.NewStoreWithPath(localPath)
+	localPost, _ := feed.NewPost("ember", "smoke", "swift-fox", "from this machine")
+	if err := local.Append(localPost); err != nil {
+		t.Fatal(err)
+	}
+
+	sharedDir := t.TempDir()
+	remote := feed.NewStoreWithPath(filepath.Join(sharedDir, config.DefaultFeedFile))
+	if err := os.WriteFile(remote.Path(), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	remotePost, _ := feed.NewPost("wisp", "smoke", "calm-owl", "from the other machine")
+	if err := remote.Append(remotePost); err != nil {
+		t.Fatal(err)
+	}
+
+	syncPath = sharedDir
+	output := captureStdout(t, func() {
+		if err := runSync(nil, nil); err != nil {
+			t.Fatalf("runSync: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Pulled 1 new record (1 post)") || !strings.Contains(output, "Pushed 1 new record (1 post)") {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	for _, store := range []*feed.FileStore{local, remote} {
+		posts, err := store.ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(posts) != 2 {
+			t.Errorf("%s has %d posts, want 2", store.Path(), len(posts))
+		}
+	}
+
+	// A second sync finds nothing new
+	output = captureStdout(t, func() {
+		if err := runSync(nil, nil); err != nil {
+			t.Fatalf("runSync: %v", err)
+		}
+	})
+	if strings.Count(output, "nothing new") != 2 {
+		t.Errorf("second sync output = %q, want nothing new both ways", output)
+	}
+}
+
+func TestRunSync_Errors(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetSyncFlags(t)
+
+	if err := runSync(nil, nil); err == nil || !strings.Contains(err.Error(), "sync_path") {
+		t.Errorf("runSync without a path: err = %v, want sync_path hint", err)
+	}
+
+	syncPull, syncPath = true, filepath.Join(t.TempDir(), "missing.jsonl")
+	if err := runSync(nil, nil); err == nil {
+		t.Error("--pull from a missing shared feed should fail")
+	}
+
+	localPath, _ := config.GetFeedPath()
+	syncPull, syncPath = false, localPath
+	if err := runSync(nil, nil); err == nil || !strings.Contains(err.Error(), "local feed") {
+		t.Errorf("syncing with itself: err = %v", err)
+	}
+}
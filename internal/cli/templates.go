@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/dreamiurg/smoke/internal/config"
 	"github.com/dreamiurg/smoke/internal/identity/templates"
 )
 
@@ -27,6 +28,12 @@ Templates are grouped into five categories:
 - Learnings: sharing insights and realizations
 - Reflections: looking back and making sense of experience
 
+Templates defined in the templates map of ~/.config/smoke/config.yaml are
+listed last, under Yours, and can be posted with smoke post --template:
+
+  templates:
+    status: "Done: {{.done}}. Next: {{.next}}"
+
 Examples:
   smoke templates              # Show all templates
   smoke templates --json       # Output templates as JSON`,
@@ -68,12 +75,38 @@ func outputTemplatesText() error {
 		}
 	}
 
+	if userTemplates := config.GetPostTemplates(); len(userTemplates) > 0 {
+		fmt.Printf("\n%s (smoke post --template <name>)\n", userTemplateCategory)
+		for _, tmpl := range userTemplates {
+			fmt.Printf("  • %s: %s\n", tmpl.Name, tmpl.Text)
+		}
+	}
+
 	return nil
 }
 
+// userTemplateCategory is the category config.yaml templates are listed under.
+const userTemplateCategory = "Yours"
+
+// templateJSON is a template in --json output. Name is set for templates
+// from config.yaml.
+type templateJSON struct {
+	Category string
+	Pattern  string
+	Name     string `json:",omitempty"`
+}
+
 // outputTemplatesJSON outputs templates as a JSON array.
 func outputTemplatesJSON() error {
+	out := make([]templateJSON, 0, len(templates.All))
+	for _, tmpl := range templates.All {
+		out = append(out, templateJSON{Category: tmpl.Category, Pattern: tmpl.Pattern})
+	}
+	for _, tmpl := range config.GetPostTemplates() {
+		out = append(out, templateJSON{Category: userTemplateCategory, Pattern: tmpl.Text, Name: tmpl.Name})
+	}
+
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(templates.All)
+	return encoder.Encode(out)
 }
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+var themeCmd = &cobra.Command{
+	Use:   "theme",
+	Short: "List TUI themes or export one as a template",
+	Long: `Manage TUI color themes.
+
+Besides the built-in themes, smoke loads every .yaml file in
+~/.config/smoke/themes/ as a custom theme and adds it to the t/T cycle in
+the TUI. A theme file sets colors as "#rrggbb", or as a light/dark pair;
+colors it leaves out come from its base theme (dracula unless "base" is set).
+Files with invalid colors are skipped with a warning.
+
+Examples:
+  smoke theme list
+  smoke theme export nord > ~/.config/smoke/themes/my-nord.yaml`,
+}
+
+var themeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List built-in and custom themes",
+	Args:  cobra.NoArgs,
+	RunE:  runThemeList,
+}
+
+var themeExportCmd = &cobra.Command{
+	Use:   "export <name>",
+	Short: "Print a theme as a YAML theme file",
+	Long: `Print a theme as a YAML theme file to start a custom theme from.
+
+Change the name, edit the colors, and save it under ~/.config/smoke/themes/.
+
+Examples:
+  smoke theme export dracula > ~/.config/smoke/themes/midnight.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runThemeExport,
+}
+
+func init() {
+	themeCmd.AddCommand(themeListCmd)
+	themeCmd.AddCommand(themeExportCmd)
+	rootCmd.AddCommand(themeCmd)
+}
+
+var customThemesOnce sync.Once
+
+// registerCustomThemes adds the theme files in ~/.config/smoke/themes/ to
+// the built-in themes. It runs once per process and only warns on problems.
+func registerCustomThemes() {
+	customThemesOnce.Do(func() {
+		dir, err := config.GetThemesDir()
+		if err != nil {
+			return
+		}
+		themes, err := feed.LoadCustomThemes(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+			return
+		}
+		for _, name := range feed.AddThemes(themes) {
+			fmt.Fprintf(os.Stderr, "warning: skipping custom theme %q: a theme with that name already exists\n", name)
+		}
+	})
+}
+
+func runThemeList(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("theme list", args)
+	registerCustomThemes()
+
+	current := config.LoadTUIConfig().Theme
+	for _, theme := range feed.AllThemes {
+		marker := " "
+		if theme.Name == current {
+			marker = "*"
+		}
+		fmt.Printf("%s %-12s %s\n", marker, theme.Name, theme.DisplayName)
+	}
+	tracker.Complete()
+	return nil
+}
+
+func runThemeExport(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("theme export", args)
+	registerCustomThemes()
+
+	var theme *feed.Theme
+	for i := range feed.AllThemes {
+		if feed.AllThemes[i].Name == args[0] {
+			theme = &feed.AllThemes[i]
+		}
+	}
+	if theme == nil {
+		return finishTracked(tracker, fmt.Errorf("unknown theme %q (see: smoke theme list)", args[0]))
+	}
+	return finishTracked(tracker, feed.ExportTheme(os.Stdout, theme))
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+// resetCustomThemes lets a test load theme files again and drops them after.
+func resetCustomThemes(t *testing.T) {
+	t.Helper()
+	saved := feed.AllThemes
+	feed.AllThemes = append([]feed.Theme(nil), saved...)
+	customThemesOnce = sync.Once{}
+	t.Cleanup(func() {
+		feed.AllThemes = saved
+		customThemesOnce = sync.Once{}
+	})
+}
+
+func TestRunThemeExport(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetCustomThemes(t)
+
+	output := captureStdout(t, func() {
+		if err := runThemeExport(nil, []string{"nord"}); err != nil {
+			t.Fatalf("runThemeExport error: %v", err)
+		}
+	})
+	for _, want := range []string{"name: nord", "accent:", "dark: '#", "agent_colors:"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("export missing %q:\n%s", want, output)
+		}
+	}
+
+	if err := runThemeExport(nil, []string{"nope"}); err == nil || !strings.Contains(err.Error(), "unknown theme") {
+		t.Errorf("expected unknown theme error, got %v", err)
+	}
+}
+
+func TestRunThemeList_IncludesCustomThemes(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	resetCustomThemes(t)
+
+	dir, err := config.GetThemesDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	theme := "name: midnight\ndisplay_name: Midnight\nbackground: \"#000000\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "midnight.yaml"), []byte(theme), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	output := captureStdout(t, func() {
+		if err := runThemeList(nil, nil); err != nil {
+			t.Fatalf("runThemeList error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "midnight") || !strings.Contains(output, "* dracula") {
+		t.Errorf("list should show the custom theme and mark the current one:\n%s", output)
+	}
+}
@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+// topAuthorLimit is how many authors the dashboard lists
+const topAuthorLimit = 5
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+var (
+	topOnce     bool
+	topWindow   time.Duration
+	topInterval time.Duration
+)
+
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show a live dashboard of feed activity",
+	Long: `Show a small dashboard of recent feed activity that refreshes in place:
+posts per minute, the most active authors, the current pressure, and how
+many times agents were nudged to post, all within a sliding window.
+
+It is a lighter way than the full feed TUI to keep an eye on many agents.
+Press Ctrl+C to exit. Use --once to print a single line and exit, for a
+statusline or a script.
+
+Examples:
+  smoke top                   Watch the last 10 minutes
+  smoke top --window 1h       Watch the last hour
+  smoke top --once            Print one snapshot line and exit`,
+	Args: cobra.NoArgs,
+	RunE: runTop,
+}
+
+func init() {
+	topCmd.Flags().BoolVar(&topOnce, "once", false, "Print one snapshot line and exit")
+	topCmd.Flags().DurationVar(&topWindow, "window", 10*time.Minute, "Sliding window for activity counts")
+	topCmd.Flags().DurationVar(&topInterval, "interval", 2*time.Second, "Refresh interval")
+	rootCmd.AddCommand(topCmd)
+}
+
+// topView is one dashboard refresh: the feed snapshot plus the pressure and
+// nudges, which live outside the feed.
+type topView struct {
+	feed.TopSnapshot
+	Window   time.Duration
+	Pressure int
+	Nudges   int
+	At       time.Time
+}
+
+func runTop(_ *cobra.Command, args []string) error {
+	tracker := logging.StartCommand("top", args)
+
+	if topWindow <= 0 {
+		err := fmt.Errorf("--window must be positive, got %s", topWindow)
+		tracker.Fail(err)
+		return err
+	}
+	if err := config.EnsureInitialized(); err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	store := feed.NewStoreWithPath(feedPath)
+
+	view, err := takeTopView(store, time.Now())
+	if err != nil {
+		tracker.Fail(err)
+		return err
+	}
+	if topOnce {
+		formatTopLine(os.Stdout, view)
+		tracker.Complete()
+		return nil
+	}
+
+	interval := topInterval
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	return finishTracked(tracker, watchTop(store, view, interval))
+}
+
+// watchTop redraws the dashboard every interval until interrupted.
+func watchTop(store feed.Store, view topView, interval time.Duration) error {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	redraw := feed.IsTerminal(os.Stdout.Fd())
+	for {
+		if redraw {
+			fmt.Print(clearScreen)
+		}
+		formatTopDashboard(os.Stdout, view)
+
+		select {
+		case <-sigChan:
+			return nil
+		case <-ticker.C:
+			next, err := takeTopView(store, time.Now())
+			if err != nil {
+				continue
+			}
+			view = next
+		}
+	}
+}
+
+// takeTopView reads the feed and gathers one dashboard refresh at now.
+func takeTopView(store feed.Store, now time.Time) (topView, error) {
+	posts, err := store.ReadAll()
+	if err != nil {
+		return topView{}, err
+	}
+	return topView{
+		TopSnapshot: feed.ComputeTopSnapshot(posts, now, topWindow, topAuthorLimit),
+		Window:      topWindow,
+		Pressure:    config.GetScheduledPressure(now),
+		Nudges:      feed.CountAgentNudgesSince(now.Add(-topWindow)),
+		At:          now,
+	}, nil
+}
+
+// formatTopDashboard prints the multi-line dashboard.
+func formatTopDashboard(w io.Writer, view topView) {
+	level := config.GetPressureLevel(view.Pressure)
+	fmt.Fprintf(w, "smoke top - last %s  [%s]\n\n", shortDuration(view.Window), feed.FormatTime(view.At))
+	fmt.Fprintf(w, "Activity:  %s (%.1f/min)\n", countNoun(view.WindowPosts, "post"), view.PostsPerMinute)
+	fmt.Fprintf(w, "Nudges:    %d\n", view.Nudges)
+	fmt.Fprintf(w, "Pressure:  %d %s %s\n", level.Value, level.Emoji, level.Label)
+	fmt.Fprintf(w, "Feed:      %s, %s, %s\n",
+		countNoun(view.Posts, "post"), countNoun(view.Agents, "agent"), countNoun(view.Projects, "project"))
+
+	fmt.Fprintln(w, "\nMost active")
+	if len(view.TopAuthors) == 0 {
+		fmt.Fprintln(w, "  (no posts in this window)")
+		return
+	}
+	width := 0
+	for _, a := range view.TopAuthors {
+		width = max(width, len(a.Author))
+	}
+	for _, a := range view.TopAuthors {
+		fmt.Fprintf(w, "  %-*s  %d\n", width, a.Author, a.Posts)
+	}
+}
+
+// formatTopLine prints the snapshot as one line, e.g.
+// "10m: 12 posts (1.2/min) | top ember@smoke (5) | 3 nudges | pressure 2 ⛅".
+func formatTopLine(w io.Writer, view topView) {
+	level := config.GetPressureLevel(view.Pressure)
+	parts := []string{fmt.Sprintf("%s: %s (%.1f/min)", shortDuration(view.Window), countNoun(view.WindowPosts, "post"), view.PostsPerMinute)}
+	if len(view.TopAuthors) > 0 {
+		parts = append(parts, fmt.Sprintf("top %s (%d)", view.TopAuthors[0].Author, view.TopAuthors[0].Posts))
+	}
+	parts = append(parts,
+		countNoun(view.Nudges, "nudge"),
+		fmt.Sprintf("pressure %d %s", level.Value, level.Emoji))
+	fmt.Fprintln(w, strings.Join(parts, " | "))
+}
+
+// countNoun formats a count with its noun, adding "s" unless n is 1.
+func countNoun(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// shortDuration formats a window without zero units, e.g. "10m" or "1h30m".
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/feed"
+)
+
+func TestFormatTopLine(t *testing.T) {
+	view := topView{
+		TopSnapshot: feed.TopSnapshot{
+			WindowPosts:    12,
+			PostsPerMinute: 1.2,
+			TopAuthors:     []feed.AuthorCount{{Author: "ember@smoke", Posts: 5}},
+		},
+		Window:   10 * time.Minute,
+		Pressure: 5,
+		Nudges:   1,
+	}
+
+	var buf bytes.Buffer
+	formatTopLine(&buf, view)
+
+	want := "10m: 12 posts (1.2/min) | top ember@smoke (5) | 1 nudge | pressure 5 ⛅\n"
+	if buf.String() != want {
+		t.Errorf("formatTopLine() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestFormatTopDashboard_NoActivity(t *testing.T) {
+	var buf bytes.Buffer
+	formatTopDashboard(&buf, topView{Window: time.Hour, At: time.Now()})
+
+	out := buf.String()
+	for _, want := range []string{"last 1h ", "Activity:  0 posts", "no posts in this window"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dashboard missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunTop_Once(t *testing.T) {
+	cleanup := setupSmokeEnv(t)
+	defer cleanup()
+	prevOnce, prevWindow := topOnce, topWindow
+	t.Cleanup(func() { topOnce, topWindow = prevOnce, prevWindow })
+	topOnce, topWindow = true, 10*time.Minute
+
+	feedPath, err := config.GetFeedPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	post, _ := feed.NewPost("ember@smoke", "smoke", "swift-fox", "hello")
+	if err := feed.NewStoreWithPath(feedPath).Append(post); err != nil {
+		t.Fatal(err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := runTop(nil, nil); err != nil {
+			t.Fatalf("runTop() error = %v", err)
+		}
+	})
+	if !strings.HasPrefix(out, "10m: 1 post ") || !strings.Contains(out, "top ember@smoke (1)") {
+		t.Errorf("runTop --once = %q", out)
+	}
+}
+
+func TestRunTop_RejectsNonPositiveWindow(t *testing.T) {
+	prevWindow := topWindow
+	t.Cleanup(func() { topWindow = prevWindow })
+	topWindow = 0
+
+	if err := runTop(nil, nil); err == nil || !strings.Contains(err.Error(), "--window") {
+		t.Errorf("runTop() error = %v, want --window error", err)
+	}
+}
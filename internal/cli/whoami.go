@@ -11,10 +11,21 @@ import (
 )
 
 var (
-	whoamiJSON bool
-	whoamiName bool
+	whoamiJSON    bool
+	whoamiName    bool
+	whoamiDetails bool
 )
 
+// whoamiDetailsOutput is the --details --json shape.
+type whoamiDetailsOutput struct {
+	Identity   string `json:"identity"`
+	Name       string `json:"name"`
+	Agent      string `json:"agent"`
+	Project    string `json:"project"`
+	SeedSource string `json:"seed_source"`
+	Human      bool   `json:"human"`
+}
+
 var whoamiCmd = &cobra.Command{
 	Use:   "whoami",
 	Short: "Print the current identity",
@@ -24,10 +35,17 @@ By default, outputs the full identity in name@project format.
 The identity is resolved from SMOKE_NAME environment variable,
 or auto-detected from the session.
 
+--details adds the detected agent, the seed source that picked the name
+(SMOKE_NAME override, human session, agent-ancestor, TERM_SESSION_ID, ...),
+and whether the session counts as human. See smoke identity debug for
+every seed source.
+
 Examples:
   smoke whoami                  # Output: swift-fox@smoke
   smoke whoami --name           # Output: swift-fox
-  smoke whoami --json           # Output: {"name":"swift-fox","project":"smoke"}`,
+  smoke whoami --json           # Output: {"name":"swift-fox","project":"smoke"}
+  smoke whoami --details        # Identity plus agent, seed source, human
+  smoke whoami --details --json # Same, as JSON`,
 	Args: cobra.NoArgs,
 	RunE: runWhoami,
 }
@@ -35,6 +53,7 @@ Examples:
 func init() {
 	whoamiCmd.Flags().BoolVar(&whoamiJSON, "json", false, "Output in JSON format")
 	whoamiCmd.Flags().BoolVar(&whoamiName, "name", false, "Output name only (without project)")
+	whoamiCmd.Flags().BoolVar(&whoamiDetails, "details", false, "Also show agent, seed source, and human detection")
 	rootCmd.AddCommand(whoamiCmd)
 }
 
@@ -51,6 +70,10 @@ func runWhoami(_ *cobra.Command, _ []string) error {
 		name = fmt.Sprintf("%s-%s", identity.Agent, identity.Suffix)
 	}
 
+	if whoamiDetails {
+		return printWhoamiDetails(identity.String(), name, identity.Project)
+	}
+
 	if whoamiJSON {
 		output := map[string]string{
 			"name":    name,
@@ -70,3 +93,32 @@ func runWhoami(_ *cobra.Command, _ []string) error {
 	fmt.Println(identity.String())
 	return nil
 }
+
+// printWhoamiDetails prints the identity with how it was detected.
+func printWhoamiDetails(identity, name, project string) error {
+	debug, err := config.DebugIdentity()
+	if err != nil {
+		return err
+	}
+	output := whoamiDetailsOutput{
+		Identity:   identity,
+		Name:       name,
+		Agent:      debug.AgentContext,
+		Project:    project,
+		SeedSource: debug.Winner,
+		Human:      debug.Human,
+	}
+
+	if whoamiJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	}
+
+	fmt.Printf("Identity:    %s\n", output.Identity)
+	fmt.Printf("Agent:       %s\n", output.Agent)
+	fmt.Printf("Project:     %s\n", output.Project)
+	fmt.Printf("Seed source: %s\n", output.SeedSource)
+	fmt.Printf("Human:       %t\n", output.Human)
+	return nil
+}
@@ -125,6 +125,54 @@ func TestWhoamiCommand(t *testing.T) {
 	}
 }
 
+func TestWhoamiDetailsJSON(t *testing.T) {
+	t.Setenv("SMOKE_NAME", "claude-swift-fox")
+	whoamiJSON, whoamiName, whoamiDetails = true, false, true
+	defer func() { whoamiJSON, whoamiDetails = false, false }()
+
+	output := captureStdout(t, func() {
+		if err := runWhoami(nil, nil); err != nil {
+			t.Fatalf("runWhoami() error = %v", err)
+		}
+	})
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(output), &got); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, output)
+	}
+	for _, key := range []string{"identity", "name", "agent", "project", "seed_source", "human"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("JSON missing key %q: %s", key, output)
+		}
+	}
+	if got["name"] != "claude-swift-fox" {
+		t.Errorf("name = %v, want claude-swift-fox", got["name"])
+	}
+	if got["seed_source"] != config.ResolvedByOverride {
+		t.Errorf("seed_source = %v, want %s", got["seed_source"], config.ResolvedByOverride)
+	}
+	if human, ok := got["human"].(bool); !ok || human {
+		t.Errorf("human = %v, want false for an override", got["human"])
+	}
+}
+
+func TestWhoamiDetailsText(t *testing.T) {
+	t.Setenv("SMOKE_NAME", "testbot")
+	whoamiJSON, whoamiName, whoamiDetails = false, false, true
+	defer func() { whoamiDetails = false }()
+
+	output := captureStdout(t, func() {
+		if err := runWhoami(nil, nil); err != nil {
+			t.Fatalf("runWhoami() error = %v", err)
+		}
+	})
+	for _, want := range []string{"Identity:    testbot@", "Seed source: " + config.ResolvedByOverride, "Human:       false"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+}
+
 func TestWhoamiFlagsRegistered(t *testing.T) {
 	// Test that flags are properly registered
 	jsonFlag := whoamiCmd.Flags().Lookup("json")
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// GetBookmarksPath returns the path to the bookmarks.json file
+func GetBookmarksPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, DefaultBookmarksFile), nil
+}
+
+// LoadBookmarks returns bookmarked post IDs in the order they were added.
+// Returns an empty list if the file doesn't exist or is empty.
+// Returns an error only for read or parse failures.
+func LoadBookmarks() ([]string, error) {
+	path, err := GetBookmarksPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var ids []string
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SaveBookmarks writes the bookmarked post IDs to disk atomically.
+func SaveBookmarks(ids []string) error {
+	path, err := GetBookmarksPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	if ids == nil {
+		ids = []string{}
+	}
+	data, err := json.MarshalIndent(ids, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Atomic write: temp file + rename
+	tmpFile := path + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, path); err != nil {
+		_ = os.Remove(tmpFile)
+		return err
+	}
+
+	return nil
+}
+
+// ToggleBookmark adds id to the bookmarks, or removes it if already present.
+// Returns true when the post is bookmarked afterwards.
+func ToggleBookmark(id string) (bool, error) {
+	ids, err := LoadBookmarks()
+	if err != nil {
+		return false, err
+	}
+
+	kept := make([]string, 0, len(ids)+1)
+	for _, existing := range ids {
+		if existing != id {
+			kept = append(kept, existing)
+		}
+	}
+	bookmarked := len(kept) == len(ids)
+	if bookmarked {
+		kept = append(kept, id)
+	}
+	return bookmarked, SaveBookmarks(kept)
+}
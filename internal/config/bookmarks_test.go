@@ -0,0 +1,61 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadBookmarks_NonExistent(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	ids, err := LoadBookmarks()
+	if err != nil {
+		t.Fatalf("LoadBookmarks failed: %v", err)
+	}
+	if len(ids) != 0 {
+		t.Fatalf("Expected no bookmarks, got %v", ids)
+	}
+}
+
+func TestToggleBookmark(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for _, id := range []string{"smk-aaaaaa", "smk-bbbbbb"} {
+		bookmarked, err := ToggleBookmark(id)
+		if err != nil || !bookmarked {
+			t.Fatalf("ToggleBookmark(%s) = %v, %v; want bookmarked", id, bookmarked, err)
+		}
+	}
+
+	bookmarked, err := ToggleBookmark("smk-aaaaaa")
+	if err != nil || bookmarked {
+		t.Fatalf("ToggleBookmark again = %v, %v; want removed", bookmarked, err)
+	}
+
+	ids, err := LoadBookmarks()
+	if err != nil {
+		t.Fatalf("LoadBookmarks failed: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "smk-bbbbbb" {
+		t.Errorf("Expected [smk-bbbbbb], got %v", ids)
+	}
+}
+
+func TestLoadBookmarks_Invalid(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	path, err := GetBookmarksPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("{not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadBookmarks(); err == nil {
+		t.Error("Expected error for invalid bookmarks file")
+	}
+}
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 // Default directory and file names
 const (
 	// DefaultSmokeDir is the name of the smoke data directory within ~/.config/
@@ -17,6 +19,22 @@ const (
 	// DefaultReadStateFile is the name of the read state file
 	DefaultReadStateFile = "readstate.yaml"
 
+	// DefaultStateFile is the name of the per-identity activity state file
+	DefaultStateFile = "state.json"
+
+	// DefaultBookmarksFile is the name of the bookmarked post IDs file
+	DefaultBookmarksFile = "bookmarks.json"
+
+	// DefaultDraftsFile is the name of the queued drafts file
+	DefaultDraftsFile = "drafts.jsonl"
+
+	// DefaultThemesDir is the config subdirectory holding custom theme files
+	DefaultThemesDir = "themes"
+
+	// DefaultCardsDir is the directory under the home directory where the
+	// TUI saves share card images
+	DefaultCardsDir = "smoke-cards"
+
 	// DefaultLogFile is the name of the log file
 	DefaultLogFile = "smoke.log"
 )
@@ -32,13 +50,60 @@ const (
 	// DefaultLayout is the default TUI layout
 	DefaultLayout = "comfy"
 
+	// DefaultAppearance is the default TUI appearance (auto, light, or dark)
+	DefaultAppearance = "auto"
+
+	// DefaultTimeFormat is the default TUI timestamp format (clock, relative, or datetime)
+	DefaultTimeFormat = "clock"
+
+	// DefaultCollapseLines is how many lines a post may render to in the TUI
+	// before it is collapsed
+	DefaultCollapseLines = 12
+
 	// DefaultAutoRefresh determines if auto-refresh is enabled by default
 	DefaultAutoRefresh = true
 )
 
 // Default suggest configuration values
 const (
-	// DefaultPressure is the default pressure level for suggest nudges (0-4 scale)
-	// Level 2 (balanced) provides a 50% nudge probability
-	DefaultPressure = 2
+	// DefaultPressure is the default pressure level for suggest nudges (0-10 scale)
+	// Level 5 (balanced) provides a 50% nudge probability
+	DefaultPressure = 5
+
+	// MaxPressure is the highest pressure level; each level adds 10% to the
+	// nudge probability, so MaxPressure always nudges
+	MaxPressure = 10
+
+	// legacyMaxPressure is the top of the old 0-4 pressure scale, which
+	// config.yaml files without pressure_scale may still use
+	legacyMaxPressure = 4
+
+	// DefaultCooldown is how long suggest stays quiet after an identity posts
+	DefaultCooldown = 10 * time.Minute
+)
+
+// Feed size limits checked by smoke doctor (feed_limits in config.yaml)
+const (
+	// DefaultFeedMaxSizeMB is the feed file size that triggers a warning
+	DefaultFeedMaxSizeMB = 10
+
+	// DefaultFeedMaxPosts is the post count that triggers a warning
+	DefaultFeedMaxPosts = 20000
 )
+
+// Post length limits (max_post_length in config.yaml)
+const (
+	// DefaultMaxPostLength is the post length limit when none is configured
+	DefaultMaxPostLength = 280
+
+	// MinMaxPostLength is the smallest max_post_length honored
+	MinMaxPostLength = 50
+
+	// MaxPostLengthCeiling is the largest max_post_length honored, and the
+	// longest post the feed accepts at all
+	MaxPostLengthCeiling = 4000
+)
+
+// PostRateWindow is the sliding window max_posts_per_minute in config.yaml
+// counts posts and replies over
+const PostRateWindow = time.Minute
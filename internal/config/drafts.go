@@ -0,0 +1,129 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Draft is a post queued in drafts.jsonl to publish later.
+type Draft struct {
+	Content string `json:"content"`
+	// Author is the --as override given when drafting, if any. Identity is
+	// resolved again when the draft is published.
+	Author    string    `json:"author,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetDraftsPath returns the path to the drafts.jsonl file
+func GetDraftsPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, DefaultDraftsFile), nil
+}
+
+// LoadDrafts returns queued drafts, oldest first.
+// Returns an empty list if the file doesn't exist.
+func LoadDrafts() ([]Draft, error) {
+	path, err := GetDraftsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var drafts []Draft
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var d Draft
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			return nil, fmt.Errorf("invalid draft on line %d: %w", line, err)
+		}
+		drafts = append(drafts, d)
+	}
+	return drafts, scanner.Err()
+}
+
+// AppendDraft adds a draft to the end of the queue.
+func AppendDraft(d Draft) error {
+	path, err := GetDraftsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// RemoveDraft deletes the draft at the 1-based index shown by smoke drafts
+// and returns it.
+func RemoveDraft(index int) (Draft, error) {
+	drafts, err := LoadDrafts()
+	if err != nil {
+		return Draft{}, err
+	}
+	if index < 1 || index > len(drafts) {
+		return Draft{}, fmt.Errorf("draft %d not found (%d queued)", index, len(drafts))
+	}
+	removed := drafts[index-1]
+	drafts = append(drafts[:index-1], drafts[index:]...)
+	return removed, saveDrafts(drafts)
+}
+
+// saveDrafts rewrites the queue atomically.
+func saveDrafts(drafts []Draft) error {
+	path, err := GetDraftsPath()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, d := range drafts {
+		data, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	// Atomic write: temp file + rename
+	tmpFile := path + ".tmp"
+	if err := os.WriteFile(tmpFile, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, path); err != nil {
+		_ = os.Remove(tmpFile)
+		return err
+	}
+	return nil
+}
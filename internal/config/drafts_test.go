@@ -0,0 +1,61 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDraftQueue(t *testing.T) {
+	setupMuteConfig(t)
+
+	drafts, err := LoadDrafts()
+	if err != nil || len(drafts) != 0 {
+		t.Fatalf("LoadDrafts() on empty queue = %v, %v; want none", drafts, err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, content := range []string{"first", "second", "third"} {
+		if err := AppendDraft(Draft{Content: content, CreatedAt: now}); err != nil {
+			t.Fatalf("AppendDraft(%q) error: %v", content, err)
+		}
+	}
+	if err := AppendDraft(Draft{Content: "as owl", Author: "calm-owl", CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	drafts, err = LoadDrafts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(drafts) != 4 || drafts[0].Content != "first" || drafts[3].Author != "calm-owl" {
+		t.Fatalf("LoadDrafts() = %+v", drafts)
+	}
+	if !drafts[0].CreatedAt.Equal(now) {
+		t.Errorf("CreatedAt = %v, want %v", drafts[0].CreatedAt, now)
+	}
+
+	removed, err := RemoveDraft(2)
+	if err != nil || removed.Content != "second" {
+		t.Fatalf("RemoveDraft(2) = %+v, %v; want second", removed, err)
+	}
+	drafts, _ = LoadDrafts()
+	if len(drafts) != 3 || drafts[1].Content != "third" {
+		t.Errorf("after RemoveDraft(2) = %+v", drafts)
+	}
+}
+
+func TestRemoveDraftOutOfRange(t *testing.T) {
+	setupMuteConfig(t)
+
+	if err := AppendDraft(Draft{Content: "only"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, index := range []int{0, 2, -1} {
+		if _, err := RemoveDraft(index); err == nil {
+			t.Errorf("RemoveDraft(%d) should fail with one draft queued", index)
+		}
+	}
+	if drafts, _ := LoadDrafts(); len(drafts) != 1 {
+		t.Errorf("failed removals changed the queue: %+v", drafts)
+	}
+}
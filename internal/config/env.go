@@ -0,0 +1,136 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Environment variables that override settings for one process without
+// touching the config files. Precedence is env > config file > default.
+const (
+	// ThemeEnv overrides theme in tui.yaml
+	ThemeEnv = "SMOKE_THEME"
+	// LayoutEnv overrides layout in tui.yaml
+	LayoutEnv = "SMOKE_LAYOUT"
+	// ContrastEnv overrides contrast in tui.yaml
+	ContrastEnv = "SMOKE_CONTRAST"
+	// AutoRefreshEnv overrides auto_refresh in tui.yaml (true or false)
+	AutoRefreshEnv = "SMOKE_AUTO_REFRESH"
+	// PressureEnv overrides pressure and pressure_schedule in config.yaml
+	// (0-10 or a preset name)
+	PressureEnv = "SMOKE_PRESSURE"
+)
+
+// envValue returns the trimmed value of an environment variable and whether
+// it is set to something non-empty.
+func envValue(name string) (string, bool) {
+	v := strings.TrimSpace(os.Getenv(name))
+	return v, v != ""
+}
+
+// EnvOverride returns the value of an override variable such as ThemeEnv
+// and whether it is set.
+func EnvOverride(name string) (string, bool) {
+	return envValue(name)
+}
+
+// applyTUIEnvOverrides replaces tui.yaml settings with the SMOKE_THEME,
+// SMOKE_LAYOUT, SMOKE_CONTRAST, and SMOKE_AUTO_REFRESH values that are set.
+// Unknown names fall back like unknown file values do; an auto-refresh value
+// that is not a boolean is ignored.
+func applyTUIEnvOverrides(cfg *TUIConfig) {
+	if v, ok := envValue(ThemeEnv); ok {
+		cfg.Theme = v
+	}
+	if v, ok := envValue(LayoutEnv); ok {
+		cfg.Layout = v
+	}
+	if v, ok := envValue(ContrastEnv); ok {
+		cfg.Contrast = v
+	}
+	if b, ok := autoRefreshFromEnv(); ok {
+		cfg.AutoRefresh = b
+	}
+}
+
+// withoutTUIEnvOverrides returns a copy of cfg with overridden settings put
+// back to their values in persisted, so env values are never saved. A
+// setting changed away from its env value (e.g. in the TUI) is kept.
+func withoutTUIEnvOverrides(cfg, persisted *TUIConfig) *TUIConfig {
+	saved := *cfg
+	if v, ok := envValue(ThemeEnv); ok && saved.Theme == v {
+		saved.Theme = persisted.Theme
+	}
+	if v, ok := envValue(LayoutEnv); ok && saved.Layout == v {
+		saved.Layout = persisted.Layout
+	}
+	if v, ok := envValue(ContrastEnv); ok && saved.Contrast == v {
+		saved.Contrast = persisted.Contrast
+	}
+	if b, ok := autoRefreshFromEnv(); ok && saved.AutoRefresh == b {
+		saved.AutoRefresh = persisted.AutoRefresh
+	}
+	return &saved
+}
+
+// autoRefreshFromEnv parses SMOKE_AUTO_REFRESH.
+func autoRefreshFromEnv() (bool, bool) {
+	v, ok := envValue(AutoRefreshEnv)
+	if !ok {
+		return false, false
+	}
+	b, err := strconv.ParseBool(v)
+	return b, err == nil
+}
+
+// PressureFromEnv returns the pressure set by SMOKE_PRESSURE, accepting a
+// level from 0 to MaxPressure or a preset name. ok is false when the
+// variable is unset or invalid.
+func PressureFromEnv() (level int, ok bool) {
+	v, set := envValue(PressureEnv)
+	if !set {
+		return 0, false
+	}
+	level, err := parseEnvPressure(v)
+	return level, err == nil
+}
+
+// parseEnvPressure parses a SMOKE_PRESSURE value.
+func parseEnvPressure(v string) (int, error) {
+	if n, err := strconv.Atoi(v); err == nil {
+		if n < 0 || n > MaxPressure {
+			return 0, fmt.Errorf("pressure %d is out of range 0-%d", n, MaxPressure)
+		}
+		return n, nil
+	}
+	return PressurePresetLevel(v)
+}
+
+// validateEnv reports env overrides that are set to values smoke ignores or
+// falls back from.
+func validateEnv(names ValidNames) []string {
+	var warnings []string
+	checkName := func(env, key, fallback string, valid []string) {
+		if v, ok := envValue(env); ok && len(valid) > 0 && !slices.Contains(valid, v) {
+			warnings = append(warnings, fmt.Sprintf("%s: unknown %s %q (using %s)", env, key, v, fallback))
+		}
+	}
+	checkName(ThemeEnv, "theme", DefaultTheme, names.Themes)
+	checkName(LayoutEnv, "layout", DefaultLayout, names.Layouts)
+	checkName(ContrastEnv, "contrast", DefaultContrast, names.Contrasts)
+
+	if v, ok := envValue(AutoRefreshEnv); ok {
+		if _, err := strconv.ParseBool(v); err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %q is not true or false (ignored)", AutoRefreshEnv, v))
+		}
+	}
+	if v, ok := envValue(PressureEnv); ok {
+		if _, err := parseEnvPressure(v); err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v (ignored)", PressureEnv, err))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadTUIConfig_EnvOverrides(t *testing.T) {
+	setupValidateHome(t, "theme: nord\nlayout: comfy\ncontrast: medium\nauto_refresh: true\n", "")
+
+	tests := []struct {
+		env, value string
+		got        func(*TUIConfig) any
+		want       any
+	}{
+		{ThemeEnv, "dracula", func(c *TUIConfig) any { return c.Theme }, "dracula"},
+		{LayoutEnv, "dense", func(c *TUIConfig) any { return c.Layout }, "dense"},
+		{ContrastEnv, "max", func(c *TUIConfig) any { return c.Contrast }, "max"},
+		{AutoRefreshEnv, "false", func(c *TUIConfig) any { return c.AutoRefresh }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv(tt.env, tt.value)
+			if got := tt.got(LoadTUIConfig()); got != tt.want {
+				t.Errorf("%s=%s: got %v, want %v", tt.env, tt.value, got, tt.want)
+			}
+		})
+	}
+
+	// Without overrides the file values come back.
+	cfg := LoadTUIConfig()
+	if cfg.Theme != "nord" || cfg.Layout != "comfy" || cfg.Contrast != "medium" || !cfg.AutoRefresh {
+		t.Errorf("LoadTUIConfig() without env = %+v, want the file values", cfg)
+	}
+}
+
+func TestLoadTUIConfig_InvalidAutoRefreshEnvIgnored(t *testing.T) {
+	setupValidateHome(t, "auto_refresh: true\n", "")
+	t.Setenv(AutoRefreshEnv, "sometimes")
+
+	if !LoadTUIConfig().AutoRefresh {
+		t.Error("an invalid SMOKE_AUTO_REFRESH should leave auto_refresh alone")
+	}
+	warnings := strings.Join(validateEnv(ValidNames{}), "\n")
+	if !strings.Contains(warnings, `SMOKE_AUTO_REFRESH: "sometimes" is not true or false`) {
+		t.Errorf("validateEnv() = %q, want an auto-refresh warning", warnings)
+	}
+}
+
+func TestSaveTUIConfig_DoesNotPersistEnvOverrides(t *testing.T) {
+	setupValidateHome(t, "theme: nord\nlayout: comfy\n", "")
+	t.Setenv(ThemeEnv, "dracula")
+	t.Setenv(LayoutEnv, "dense")
+
+	cfg := LoadTUIConfig()
+	cfg.Layout = "relaxed" // changed on purpose, e.g. with l in the TUI
+	cfg.TimeFormat = "relative"
+	if err := SaveTUIConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	saved := LoadTUIConfigFile()
+	if saved.Theme != "nord" {
+		t.Errorf("saved theme = %q, want nord (SMOKE_THEME must not be written)", saved.Theme)
+	}
+	if saved.Layout != "relaxed" || saved.TimeFormat != "relative" {
+		t.Errorf("saved = %+v, want the changed layout and time format", saved)
+	}
+}
+
+func TestGetScheduledPressure_EnvOverride(t *testing.T) {
+	setupValidateHome(t, "", "pressure_scale: 10\npressure: 2\npressure_schedule:\n  - start: \"00:00\"\n    end: \"23:59\"\n    pressure: 1\n")
+	now := time.Date(2026, 1, 30, 12, 0, 0, 0, time.Local)
+
+	if got := GetScheduledPressure(now); got != 1 {
+		t.Fatalf("without env: got %d, want the scheduled 1", got)
+	}
+	for value, want := range map[string]int{"9": 9, "quiet": 3, "MAXIMUM": MaxPressure} {
+		t.Setenv(PressureEnv, value)
+		if got := GetScheduledPressure(now); got != want {
+			t.Errorf("SMOKE_PRESSURE=%s: got %d, want %d", value, got, want)
+		}
+	}
+
+	t.Setenv(PressureEnv, "11")
+	if got := GetScheduledPressure(now); got != 1 {
+		t.Errorf("invalid SMOKE_PRESSURE: got %d, want the config value", got)
+	}
+	warnings := strings.Join(validateEnv(ValidNames{}), "\n")
+	if !strings.Contains(warnings, "SMOKE_PRESSURE: pressure 11 is out of range") {
+		t.Errorf("validateEnv() = %q, want a pressure warning", warnings)
+	}
+
+	// The override never reaches config.yaml.
+	dir, _ := GetConfigDir()
+	data, err := os.ReadFile(filepath.Join(dir, DefaultConfigFile))
+	if err != nil || !strings.Contains(string(data), "pressure: 2") {
+		t.Errorf("config.yaml = %q, want it unchanged", data)
+	}
+}
+
+func TestValidateEnv_UnknownNames(t *testing.T) {
+	t.Setenv(ThemeEnv, "neon")
+	t.Setenv(LayoutEnv, "dense")
+
+	warnings := validateEnv(testValidNames)
+	if len(warnings) != 1 || warnings[0] != `SMOKE_THEME: unknown theme "neon" (using dracula)` {
+		t.Errorf("validateEnv() = %q, want one theme warning", warnings)
+	}
+}
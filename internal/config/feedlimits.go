@@ -0,0 +1,27 @@
+package config
+
+// FeedLimits configures the smoke doctor feed size check.
+type FeedLimits struct {
+	// MaxSizeMB is the feed file size, in megabytes, that triggers a warning.
+	MaxSizeMB int `yaml:"max_size_mb,omitempty"`
+	// MaxPosts is the number of posts that triggers a warning.
+	MaxPosts int `yaml:"max_posts,omitempty"`
+	// KeepPosts trims compaction to the newest posts; 0 keeps every live post.
+	KeepPosts int `yaml:"keep_posts,omitempty"`
+}
+
+// GetFeedLimits returns feed_limits from config.yaml. Unset or non-positive
+// thresholds fall back to DefaultFeedMaxSizeMB and DefaultFeedMaxPosts.
+func GetFeedLimits() FeedLimits {
+	limits := LoadSuggestConfig().FeedLimits
+	if limits.MaxSizeMB <= 0 {
+		limits.MaxSizeMB = DefaultFeedMaxSizeMB
+	}
+	if limits.MaxPosts <= 0 {
+		limits.MaxPosts = DefaultFeedMaxPosts
+	}
+	if limits.KeepPosts < 0 {
+		limits.KeepPosts = 0
+	}
+	return limits
+}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"golang.org/x/term"
 
@@ -92,6 +93,11 @@ func isPIDRunning(pid int) bool {
 // knownAgents lists the process name substrings used to identify agent ancestors.
 var knownAgents = []string{"claude", "codex", "gemini"}
 
+// scriptRunners are interpreters that agent CLIs may run under. For these the
+// process name is the interpreter, so the script path in args identifies the agent
+// (e.g., Gemini CLI runs as "node /usr/local/bin/gemini").
+var scriptRunners = []string{"node", "bun", "deno"}
+
 // findAgentAncestorPID walks up the process tree looking for a known agent process.
 // Returns the agent name, the agent process PID, or ("", 0) if not found.
 // This allows indirect invocations (e.g., ccstatusline → smoke) to identify
@@ -119,11 +125,8 @@ func findAgentAncestorPID() (string, int) {
 			break
 		}
 
-		comm := strings.ToLower(fields[1])
-		for _, agent := range knownAgents {
-			if strings.Contains(comm, agent) {
-				return agent, pid
-			}
+		if agent := agentFromProcess(fields[1], processArgs(fields[1], pid)); agent != "" {
+			return agent, pid
 		}
 
 		pid = ppid
@@ -132,6 +135,59 @@ func findAgentAncestorPID() (string, int) {
 	return "", 0
 }
 
+// processArgs returns the full command line of pid when comm is a script
+// runner, or "" otherwise (avoiding an extra ps call for every ancestor).
+func processArgs(comm string, pid int) string {
+	if !isScriptRunner(comm) {
+		return ""
+	}
+	out, err := exec.Command("ps", "-p", fmt.Sprintf("%d", pid), "-o", "args=").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// agentFromProcess identifies a known agent from a process name and, for
+// script runners, its command line. Returns "" if the process is not an agent.
+func agentFromProcess(comm, args string) string {
+	comm = strings.ToLower(comm)
+	for _, agent := range knownAgents {
+		if strings.Contains(filepath.Base(comm), agent) {
+			return agent
+		}
+	}
+
+	if !isScriptRunner(comm) {
+		return ""
+	}
+	// The first non-flag argument after the interpreter is the script
+	fields := strings.Fields(strings.ToLower(args))
+	for _, arg := range fields[min(1, len(fields)):] {
+		if strings.HasPrefix(arg, "-") {
+			continue
+		}
+		script := filepath.Base(arg)
+		for _, agent := range knownAgents {
+			if strings.Contains(script, agent) {
+				return agent
+			}
+		}
+		break
+	}
+	return ""
+}
+
+func isScriptRunner(comm string) bool {
+	base := strings.ToLower(filepath.Base(comm))
+	for _, runner := range scriptRunners {
+		if base == runner {
+			return true
+		}
+	}
+	return false
+}
+
 // findClaudeAncestor walks up the process tree looking for a Claude Code process.
 // Returns the Claude process PID if found, or 0 if not found.
 func findClaudeAncestor() int {
@@ -145,6 +201,21 @@ func findClaudeAncestor() int {
 // detectAgentContext identifies agent context from strong signals (env/process).
 // Avoids broad API key checks to prevent false positives for human sessions.
 func detectAgentContext() string {
+	if agent := detectAgentFromEnv(); agent != "" {
+		return agent
+	}
+
+	// Walk the process tree once to find any known agent ancestor
+	if agent, _ := findAgentAncestorPID(); agent != "" {
+		return agent
+	}
+
+	return "unknown"
+}
+
+// detectAgentFromEnv identifies the agent from environment variables set by
+// the agent CLIs. Returns "" if none are present.
+func detectAgentFromEnv() string {
 	if v := strings.TrimSpace(os.Getenv("SMOKE_AGENT")); v != "" {
 		return strings.ToLower(v)
 	}
@@ -157,13 +228,7 @@ func detectAgentContext() string {
 	if os.Getenv("CODEX") == "1" || os.Getenv("CODEX_CLI") != "" || os.Getenv("OPENAI_CODEX") != "" || os.Getenv("CODEX_CI") == "1" || os.Getenv("CODEX_SANDBOX") != "" {
 		return "codex"
 	}
-
-	// Walk the process tree once to find any known agent ancestor
-	if agent, _ := findAgentAncestorPID(); agent != "" {
-		return agent
-	}
-
-	return "unknown"
+	return ""
 }
 
 // ErrNoIdentity is returned when identity cannot be determined
@@ -172,6 +237,9 @@ var ErrNoIdentity = errors.New("cannot determine identity. Use --as flag or set
 // HumanIdentity is the suffix used for human users in interactive terminals.
 const HumanIdentity = "<human>"
 
+// humanSession is isHumanSession, replaceable in tests.
+var humanSession = isHumanSession
+
 // isHumanSession detects if the current session is an interactive human user.
 // Returns true if:
 // 1. No agent context detected (env vars, process tree)
@@ -212,8 +280,25 @@ func (i *Identity) String() string {
 
 // GetIdentity resolves the agent identity from environment, session, and optional override.
 // If override is provided, it takes precedence. Otherwise, checks SMOKE_NAME env var,
-// then falls back to auto-detection.
+// then a human session (using the name saved for the project with smoke
+// identity set, if any), then falls back to auto-detection.
 func GetIdentity(override string) (*Identity, error) {
+	return resolveIdentity(override, nil)
+}
+
+// GetUniqueIdentity resolves the identity like GetIdentity, but a session
+// without a name of its own yet avoids the suffixes in taken (e.g. recent feed
+// authors) and claims the result in state.json, so later commands in the same
+// session keep it. Explicit names (--as, SMOKE_NAME) are never changed.
+func GetUniqueIdentity(override string, taken map[string]bool) (*Identity, error) {
+	if taken == nil {
+		taken = map[string]bool{}
+	}
+	return resolveIdentity(override, taken)
+}
+
+// resolveIdentity implements GetIdentity; a non-nil taken claims a unique suffix.
+func resolveIdentity(override string, taken map[string]bool) (*Identity, error) {
 	// Use override if provided, then SMOKE_NAME env var
 	name := override
 	if name == "" {
@@ -225,19 +310,24 @@ func GetIdentity(override string) (*Identity, error) {
 		return resolveOverrideIdentity(name), nil
 	}
 
-	// Auto-detect identity
 	project := detectProject()
 
-	// Check if this is a human in an interactive terminal
-	if isHumanSession() {
+	// Check if this is a human in an interactive terminal. A name saved for
+	// the project replaces <human>; agent sessions keep their own names so
+	// they are not merged into the human's handle.
+	if humanSession() {
+		suffix := HumanIdentity
+		if saved := GetProjectIdentityName(project); saved != "" {
+			suffix = saved
+		}
 		return &Identity{
 			Agent:   "",
-			Suffix:  HumanIdentity,
+			Suffix:  suffix,
 			Project: project,
 		}, nil
 	}
 
-	return autoDetectIdentity(project)
+	return autoDetectIdentity(project, taken)
 }
 
 // resolveOverrideIdentity creates an Identity from an explicit name override.
@@ -257,7 +347,9 @@ func resolveOverrideIdentity(name string) *Identity {
 }
 
 // autoDetectIdentity generates an identity from session context for a given project.
-func autoDetectIdentity(project string) (*Identity, error) {
+// A session keeps the suffix it claimed earlier; otherwise, when taken is
+// non-nil, it claims a suffix that avoids the taken names.
+func autoDetectIdentity(project string, taken map[string]bool) (*Identity, error) {
 	seed := getSessionSeed()
 	if seed == "" {
 		return nil, ErrNoIdentity
@@ -269,72 +361,181 @@ func autoDetectIdentity(project string) (*Identity, error) {
 		agent = ""
 	}
 
-	// Select pattern deterministically based on seed
-	pattern := identity.SelectPattern(seed)
-
-	// Generate suffix using the selected pattern
-	suffix, err := identity.GenerateWithPattern(seed, pattern)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate suffix: %w", err)
+	suffix := claimedSuffix(seed)
+	if suffix == "" && taken == nil {
+		suffix = uniqueStyledSuffix(seed, nil)
+	} else if suffix == "" {
+		// An unsaved claim only means the next command re-checks for collisions
+		suffix, _ = claimSuffix(seed, func(claims map[string]IdentityClaim) string {
+			return uniqueStyledSuffix(seed, othersTaken(seed, taken, claims))
+		}, time.Now())
 	}
 
-	// Apply style formatting deterministically based on seed
-	words := splitSuffixIntoWords(suffix)
-	styleFunc := selectStyleFunc(seed)
-	styledSuffix := styleFunc(words)
-
 	return &Identity{
 		Agent:   agent,
-		Suffix:  styledSuffix,
+		Suffix:  suffix,
 		Project: project,
 	}, nil
 }
 
+// othersTaken returns taken without the seed's own generated name, unless
+// another session has claimed that name. Without a claim, recent posts under
+// it are this session's own (e.g. from before its claim was saved), and
+// counting them would rename the session mid-conversation.
+func othersTaken(seed string, taken map[string]bool, claims map[string]IdentityClaim) map[string]bool {
+	own := identity.NameKey(uniqueStyledSuffix(seed, nil))
+	for s, claim := range claims {
+		if s != seed && identity.NameKey(claim.Suffix) == own {
+			return taken
+		}
+	}
+	others := make(map[string]bool, len(taken))
+	for name, used := range taken {
+		if identity.NameKey(name) != own {
+			others[name] = used
+		}
+	}
+	return others
+}
+
+// uniqueStyledSuffix generates the seed's suffix and applies its style,
+// disambiguating the generated name until the styled result is not taken.
+// Pattern, words, and style are all chosen deterministically from the seed.
+func uniqueStyledSuffix(seed string, taken map[string]bool) string {
+	takenKeys := make(map[string]bool, len(taken))
+	for name, used := range taken {
+		if used {
+			takenKeys[identity.NameKey(name)] = true
+		}
+	}
+
+	styleFunc := selectStyleFunc(seed)
+	blocked := map[string]bool{}
+	for {
+		suffix := identity.GenerateUnique(seed, blocked)
+		styled := styleFunc(splitSuffixIntoWords(suffix))
+		if !takenKeys[identity.NameKey(styled)] {
+			return styled
+		}
+		blocked[suffix] = true
+	}
+}
+
 // getSessionSeed returns a stable seed for the current session.
 // Walks the process tree to find an agent ancestor (Claude, Codex, Gemini),
 // ensuring all commands within the same session get the same identity regardless
 // of their immediate parent process (which changes for each shell invocation).
+// The first valid source from seedSources wins.
 func getSessionSeed() string {
+	for _, source := range seedSources() {
+		if !source.Valid {
+			continue
+		}
+		if source.Name == SeedSourceAgentAncestor {
+			_ = writeSessionInfo(&sessionInfo{
+				PID:           source.pid,
+				TermSessionID: os.Getenv("TERM_SESSION_ID"),
+				Seed:          source.Value,
+			})
+		}
+		return source.Value
+	}
+	return ""
+}
+
+// Seed source names in precedence order
+const (
+	SeedSourceAgentAncestor = "agent-ancestor"
+	SeedSourceSessionFile   = "session-file"
+	SeedSourceTermSession   = "TERM_SESSION_ID"
+	SeedSourceWindowID      = "WINDOWID"
+	SeedSourceWTSession     = "WT_SESSION"
+	SeedSourceConsole       = "console-window"
+	SeedSourcePPID          = "ppid"
+)
+
+// SeedSource describes one candidate session seed and whether it is usable.
+type SeedSource struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Valid bool   `json:"valid"`
+	Note  string `json:"note,omitempty"`
+	pid   int    // agent PID, recorded in the session file when this source wins
+}
+
+// seedSources evaluates every seed source in precedence order without side effects.
+func seedSources() []SeedSource {
 	termSessionID := os.Getenv("TERM_SESSION_ID")
+	sources := make([]SeedSource, 0, 6)
 
 	// Walk up the process tree to find any known agent ancestor.
 	// This is essential because each command the agent runs gets a different
 	// shell as its immediate parent, but they all share the same agent
 	// ancestor process whose PID is stable for the entire session.
+	ancestor := SeedSource{Name: SeedSourceAgentAncestor, Note: "no agent process in ancestry"}
 	if agent, agentPID := findAgentAncestorPID(); agentPID > 0 {
-		seed := fmt.Sprintf("%s-ppid-%d", agent, agentPID)
-		_ = writeSessionInfo(&sessionInfo{
-			PID:           agentPID,
-			TermSessionID: termSessionID,
-			Seed:          seed,
-		})
-		return seed
+		ancestor.Value = fmt.Sprintf("%s-ppid-%d", agent, agentPID)
+		ancestor.Valid = true
+		ancestor.Note = fmt.Sprintf("%s process %d", agent, agentPID)
+		ancestor.pid = agentPID
 	}
+	sources = append(sources, ancestor)
 
 	// Fallback to session file for cases where process tree walk fails
 	// (e.g., process name doesn't match known agents)
+	sessionFile := SeedSource{Name: SeedSourceSessionFile, Note: "missing or unreadable"}
 	if info := readSessionInfo(); info != nil {
+		sessionFile.Value = info.Seed
 		// Validate: same terminal and agent process still running
-		if info.TermSessionID == termSessionID && isPIDRunning(info.PID) {
-			return info.Seed
+		switch {
+		case info.TermSessionID != termSessionID:
+			sessionFile.Note = "written by another terminal session"
+		case !isPIDRunning(info.PID):
+			sessionFile.Note = fmt.Sprintf("agent process %d is not running", info.PID)
+		default:
+			sessionFile.Valid = true
+			sessionFile.Note = fmt.Sprintf("agent process %d is running", info.PID)
 		}
 	}
+	sources = append(sources, sessionFile)
 
-	// Fallback to terminal session identifiers
-	if termSessionID != "" {
-		return termSessionID
+	// Fallback to terminal session identifiers. When an agent is known from
+	// its environment, prefix the seed so sibling agents (e.g., Claude and
+	// Codex in split panes of one terminal) get distinct identities.
+	prefix := ""
+	if agent := detectAgentFromEnv(); agent != "" {
+		prefix = agent + "-"
 	}
-	if seed := os.Getenv("WINDOWID"); seed != "" {
-		return seed
+	for _, source := range terminalSeedSources() {
+		source.Value = prefixSeed(prefix, source.Value)
+		sources = append(sources, source)
 	}
 
 	// Fallback to process parent ID (always available)
-	ppid := os.Getppid()
-	if ppid > 0 {
-		return fmt.Sprintf("ppid-%d", ppid)
+	ppid := SeedSource{Name: SeedSourcePPID}
+	if pid := os.Getppid(); pid > 0 {
+		ppid.Value = fmt.Sprintf("%sppid-%d", prefix, pid)
+		ppid.Valid = true
 	}
+	sources = append(sources, ppid)
 
-	return ""
+	return sources
+}
+
+// prefixSeed prepends prefix to a non-empty seed value.
+func prefixSeed(prefix, value string) string {
+	if value == "" {
+		return ""
+	}
+	return prefix + value
+}
+
+func envSeedSource(name, value string) SeedSource {
+	source := SeedSource{Name: name, Value: value, Valid: value != ""}
+	if !source.Valid {
+		source.Note = "unset"
+	}
+	return source
 }
 
 // detectProject determines the project name from git remote or cwd
@@ -0,0 +1,59 @@
+package config
+
+import "os"
+
+// Identity resolution steps reported by IdentityDebug.Winner, besides seed source names
+const (
+	ResolvedByOverride = "SMOKE_NAME"
+	ResolvedByProject  = "project-name"
+	ResolvedByHuman    = "human-session"
+)
+
+// IdentityDebug exposes each step of identity resolution for troubleshooting.
+type IdentityDebug struct {
+	Override     string       `json:"override,omitempty"`
+	ProjectName  string       `json:"project_name,omitempty"`
+	AgentContext string       `json:"agent_context"`
+	Human        bool         `json:"human"`
+	SeedSources  []SeedSource `json:"seed_sources"`
+	Winner       string       `json:"winner"`
+	Seed         string       `json:"seed,omitempty"`
+	Identity     string       `json:"identity"`
+}
+
+// DebugIdentity reports how GetIdentity("") resolves in the current environment:
+// the SMOKE_NAME override, human-session detection and the name saved for
+// the project, and every seed source in precedence order with the one that won.
+func DebugIdentity() (*IdentityDebug, error) {
+	debug := &IdentityDebug{
+		Override:     os.Getenv("SMOKE_NAME"),
+		ProjectName:  GetProjectIdentityName(detectProject()),
+		AgentContext: detectAgentContext(),
+		Human:        humanSession(),
+		SeedSources:  seedSources(),
+	}
+
+	switch {
+	case debug.Override != "":
+		debug.Winner = ResolvedByOverride
+	case debug.Human && debug.ProjectName != "":
+		debug.Winner = ResolvedByProject
+	case debug.Human:
+		debug.Winner = ResolvedByHuman
+	default:
+		for _, source := range debug.SeedSources {
+			if source.Valid {
+				debug.Winner = source.Name
+				debug.Seed = source.Value
+				break
+			}
+		}
+	}
+
+	id, err := GetIdentity("")
+	if err != nil {
+		return debug, err
+	}
+	debug.Identity = id.String()
+	return debug, nil
+}
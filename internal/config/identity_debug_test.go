@@ -0,0 +1,57 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedSourcesOrder(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TERM_SESSION_ID", "term-123")
+	t.Setenv("WINDOWID", "")
+	clearAgentEnv(t)
+
+	sources := seedSources()
+	names := make([]string, len(sources))
+	for i, s := range sources {
+		names[i] = s.Name
+	}
+	assert.Equal(t, []string{SeedSourceAgentAncestor, SeedSourceSessionFile, SeedSourceTermSession, SeedSourceWindowID, SeedSourcePPID}, names)
+
+	assert.True(t, sources[2].Valid)
+	assert.Equal(t, "term-123", sources[2].Value)
+	assert.False(t, sources[3].Valid)
+	assert.Equal(t, "unset", sources[3].Note)
+	assert.True(t, sources[4].Valid)
+}
+
+func TestSeedSourcesSessionFileValidity(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	t.Setenv("TERM_SESSION_ID", "term-abc")
+	require.NoError(t, os.MkdirAll(tmpDir+"/.config/smoke", 0700))
+
+	require.NoError(t, writeSessionInfo(&sessionInfo{PID: os.Getpid(), TermSessionID: "term-abc", Seed: "claude-ppid-1"}))
+	session := seedSources()[1]
+	assert.True(t, session.Valid)
+	assert.Equal(t, "claude-ppid-1", session.Value)
+
+	require.NoError(t, writeSessionInfo(&sessionInfo{PID: os.Getpid(), TermSessionID: "other", Seed: "claude-ppid-1"}))
+	session = seedSources()[1]
+	assert.False(t, session.Valid)
+	assert.Equal(t, "written by another terminal session", session.Note)
+}
+
+func TestDebugIdentityOverride(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SMOKE_NAME", "override-bot")
+
+	debug, err := DebugIdentity()
+	require.NoError(t, err)
+	assert.Equal(t, ResolvedByOverride, debug.Winner)
+	assert.Equal(t, "override-bot@"+detectProject(), debug.Identity)
+	assert.Empty(t, debug.Seed)
+}
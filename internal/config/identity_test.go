@@ -4,9 +4,13 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/identity"
 )
 
 func TestGetIdentity_WithSmokeAuthor(t *testing.T) {
@@ -1066,3 +1070,147 @@ func TestGetSessionSeed_UsesAgentAncestor(t *testing.T) {
 	t.Logf("getSessionSeed() returned: %s", seed)
 	require.NotEmpty(t, seed, "Should return a non-empty seed")
 }
+
+// TestAgentFromProcess verifies agent detection from process names and script runners
+func TestAgentFromProcess(t *testing.T) {
+	tests := []struct {
+		name string
+		comm string
+		args string
+		want string
+	}{
+		{"claude binary", "claude", "", "claude"},
+		{"codex binary", "codex", "", "codex"},
+		{"codex full path", "/opt/homebrew/bin/codex", "", "codex"},
+		{"gemini under node", "node", "node /usr/local/bin/gemini --yolo", "gemini"},
+		{"codex under node with flags", "node", "node --no-warnings /usr/lib/node_modules/@openai/codex/bin/codex.js", "codex"},
+		{"plain node script", "node", "node server.js", ""},
+		{"agent name only in later arg", "node", "node build.js gemini", ""},
+		{"shell", "zsh", "", ""},
+		{"runner without args", "bun", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, agentFromProcess(tt.comm, tt.args))
+		})
+	}
+}
+
+// TestDetectAgentFromEnv verifies env-based detection for each agent
+func TestDetectAgentFromEnv(t *testing.T) {
+	clearAgentEnv(t)
+	require.Equal(t, "", detectAgentFromEnv())
+
+	t.Setenv("GEMINI_CLI", "1")
+	require.Equal(t, "gemini", detectAgentFromEnv())
+
+	t.Setenv("GEMINI_CLI", "")
+	t.Setenv("CODEX_CLI", "1")
+	require.Equal(t, "codex", detectAgentFromEnv())
+}
+
+// TestSeedSources_AgentPrefix verifies sibling agents in one terminal get distinct seeds
+func TestSeedSources_AgentPrefix(t *testing.T) {
+	if _, pid := findAgentAncestorPID(); pid > 0 {
+		t.Skip("Cannot test env-only seeds when running under an agent process")
+	}
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TERM_SESSION_ID", "shared-terminal")
+	clearAgentEnv(t)
+
+	t.Setenv("CODEX_CLI", "1")
+	codexSeed := getSessionSeed()
+	t.Setenv("CODEX_CLI", "")
+	t.Setenv("GEMINI_CLI", "1")
+	geminiSeed := getSessionSeed()
+
+	require.Equal(t, "codex-shared-terminal", codexSeed)
+	require.Equal(t, "gemini-shared-terminal", geminiSeed)
+	require.Regexp(t, `^gemini-ppid-\d+$`, seedSources()[4].Value)
+}
+
+// clearAgentEnv unsets every env var detectAgentFromEnv looks at.
+func clearAgentEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{"SMOKE_AGENT", "CLAUDECODE", "CLAUDE_CODE", "CLAUDE_CODE_SUBAGENT_MODEL", "GEMINI_CLI", "CODEX", "CODEX_CLI", "OPENAI_CODEX", "CODEX_CI", "CODEX_SANDBOX"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestUniqueStyledSuffix_Collision(t *testing.T) {
+	seed := "unique-suffix-seed"
+	base := uniqueStyledSuffix(seed, nil)
+
+	taken := map[string]bool{base: true}
+	got := uniqueStyledSuffix(seed, taken)
+	if got == base {
+		t.Fatalf("Expected a disambiguated suffix when %q is taken", base)
+	}
+	if again := uniqueStyledSuffix(seed, taken); again != got {
+		t.Errorf("Expected stable result, got %q then %q", got, again)
+	}
+	if !strings.Contains(identity.NameKey(got), "2") {
+		t.Errorf("Expected numeric disambiguator in %q", got)
+	}
+}
+
+func TestGetUniqueIdentity_ClaimsSuffix(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SMOKE_NAME", "")
+	t.Setenv("TERM_SESSION_ID", "unique-identity-session")
+
+	base, err := GetIdentity("")
+	require.NoError(t, err)
+	// Another session already goes by the same name
+	_, err = claimSuffix("other-session", func(map[string]IdentityClaim) string { return base.Suffix }, time.Now())
+	require.NoError(t, err)
+
+	unique, err := GetUniqueIdentity("", map[string]bool{base.Suffix: true})
+	require.NoError(t, err)
+	if unique.Suffix == base.Suffix {
+		t.Fatalf("Expected suffix other than taken %q", base.Suffix)
+	}
+
+	// Later commands in the session keep the claimed name, even though the
+	// session's own posts now appear among recent authors
+	again, err := GetIdentity("")
+	require.NoError(t, err)
+	if again.Suffix != unique.Suffix {
+		t.Errorf("Expected claimed suffix %q, got %q", unique.Suffix, again.Suffix)
+	}
+	again, err = GetUniqueIdentity("", map[string]bool{base.Suffix: true, unique.Suffix: true})
+	require.NoError(t, err)
+	if again.Suffix != unique.Suffix {
+		t.Errorf("Expected claimed suffix %q, got %q", unique.Suffix, again.Suffix)
+	}
+}
+
+func TestGetUniqueIdentity_OwnPostsWithoutClaim(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SMOKE_NAME", "")
+	t.Setenv("TERM_SESSION_ID", "lost-claim-session")
+
+	base, err := GetIdentity("")
+	require.NoError(t, err)
+
+	// The session posted as base, but its claim was never saved. Nobody else
+	// claims base, so the recent posts are its own and it keeps the name.
+	got, err := GetUniqueIdentity("", map[string]bool{base.Suffix: true, "someone-else": true})
+	require.NoError(t, err)
+	if got.Suffix != base.Suffix {
+		t.Errorf("Expected session to keep its own name %q, got %q", base.Suffix, got.Suffix)
+	}
+	if claimed := claimedSuffix(getSessionSeed()); claimed != base.Suffix {
+		t.Errorf("Expected %q to be claimed, got %q", base.Suffix, claimed)
+	}
+}
+
+func TestGetUniqueIdentity_KeepsExplicitName(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	got, err := GetUniqueIdentity("bob", map[string]bool{"bob": true})
+	require.NoError(t, err)
+	if got.Suffix != "bob" {
+		t.Errorf("Expected explicit name to be kept, got %q", got.Suffix)
+	}
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// ErrEmptyAuthor is returned when muting or unmuting a blank author.
+var ErrEmptyAuthor = errors.New("author cannot be empty")
+
+// GetMutedAuthors returns the muted_authors list from config.yaml.
+func GetMutedAuthors() []string {
+	return LoadSuggestConfig().MutedAuthors
+}
+
+// normalizeMutedAuthor trims a leading "@" and lowercases the name so
+// "@Swift-Fox" and "swift-fox" are the same entry.
+func normalizeMutedAuthor(author string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(author), "@"))
+}
+
+// isMutedEntry reports whether the normalized author is listed as is.
+func isMutedEntry(author string) bool {
+	return slices.ContainsFunc(GetMutedAuthors(), func(entry string) bool {
+		return normalizeMutedAuthor(entry) == author
+	})
+}
+
+// MuteAuthor adds author to muted_authors. It reports false when the
+// author was already muted.
+func MuteAuthor(author string) (bool, error) {
+	author = normalizeMutedAuthor(author)
+	if author == "" {
+		return false, ErrEmptyAuthor
+	}
+	if isMutedEntry(author) {
+		return false, nil
+	}
+	err := updateUserConfig(func(raw *SuggestConfig) {
+		raw.MutedAuthors = append(raw.MutedAuthors, author)
+	})
+	return err == nil, err
+}
+
+// UnmuteAuthor removes author from muted_authors. It reports false when the
+// author was not muted.
+func UnmuteAuthor(author string) (bool, error) {
+	author = normalizeMutedAuthor(author)
+	if author == "" {
+		return false, ErrEmptyAuthor
+	}
+	if !isMutedEntry(author) {
+		return false, nil
+	}
+	err := updateUserConfig(func(raw *SuggestConfig) {
+		raw.MutedAuthors = slices.DeleteFunc(raw.MutedAuthors, func(entry string) bool {
+			return normalizeMutedAuthor(entry) == author
+		})
+	})
+	return err == nil, err
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func setupMuteConfig(t *testing.T) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".config", "smoke"), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMuteAuthor(t *testing.T) {
+	setupMuteConfig(t)
+
+	added, err := MuteAuthor("@Swift-Fox")
+	if err != nil || !added {
+		t.Fatalf("MuteAuthor() = %v, %v; want true, nil", added, err)
+	}
+	added, err = MuteAuthor("swift-fox")
+	if err != nil || added {
+		t.Fatalf("second MuteAuthor() = %v, %v; want false, nil", added, err)
+	}
+	if got := GetMutedAuthors(); len(got) != 1 || got[0] != "swift-fox" {
+		t.Errorf("GetMutedAuthors() = %v, want [swift-fox]", got)
+	}
+
+	if _, err := MuteAuthor("  "); err != ErrEmptyAuthor {
+		t.Errorf("MuteAuthor(blank) error = %v, want ErrEmptyAuthor", err)
+	}
+}
+
+func TestUnmuteAuthor(t *testing.T) {
+	setupMuteConfig(t)
+
+	for _, author := range []string{"swift-fox", "ember"} {
+		if _, err := MuteAuthor(author); err != nil {
+			t.Fatal(err)
+		}
+	}
+	removed, err := UnmuteAuthor("Swift-Fox")
+	if err != nil || !removed {
+		t.Fatalf("UnmuteAuthor() = %v, %v; want true, nil", removed, err)
+	}
+	removed, err = UnmuteAuthor("swift-fox")
+	if err != nil || removed {
+		t.Fatalf("second UnmuteAuthor() = %v, %v; want false, nil", removed, err)
+	}
+	if got := GetMutedAuthors(); len(got) != 1 || got[0] != "ember" {
+		t.Errorf("GetMutedAuthors() = %v, want [ember]", got)
+	}
+}
+
+func TestMuteAuthor_KeepsOtherSettings(t *testing.T) {
+	setupMuteConfig(t)
+
+	if err := SetPressure(3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MuteAuthor("ember"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetPressure(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := GetPressure(); got != 1 {
+		t.Errorf("GetPressure() = %d, want 1", got)
+	}
+	if got := GetMutedAuthors(); len(got) != 1 || got[0] != "ember" {
+		t.Errorf("SetPressure dropped muted authors: %v", got)
+	}
+}
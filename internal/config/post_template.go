@@ -0,0 +1,43 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PostTemplate is a named template from the templates map in config.yaml.
+type PostTemplate struct {
+	Name string
+	Text string
+}
+
+// ErrNoPostTemplates is returned when config.yaml defines no templates.
+var ErrNoPostTemplates = errors.New("no templates defined (add a templates map to config.yaml)")
+
+// GetPostTemplates returns the templates from config.yaml, sorted by name.
+func GetPostTemplates() []PostTemplate {
+	var out []PostTemplate
+	for name, text := range LoadSuggestConfig().Templates {
+		out = append(out, PostTemplate{Name: name, Text: text})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// GetPostTemplate returns the template text for name.
+func GetPostTemplate(name string) (string, error) {
+	templates := GetPostTemplates()
+	if len(templates) == 0 {
+		return "", ErrNoPostTemplates
+	}
+	names := make([]string, len(templates))
+	for i, t := range templates {
+		if t.Name == name {
+			return t.Text, nil
+		}
+		names[i] = t.Name
+	}
+	return "", fmt.Errorf("unknown template %q (valid: %s)", name, strings.Join(names, ", "))
+}
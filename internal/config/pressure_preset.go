@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PressurePreset names a pressure level, so `smoke pressure set quiet` works
+// without remembering numbers.
+type PressurePreset struct {
+	Name  string
+	Level int
+}
+
+// pressurePresets lists the named pressure levels from quietest to loudest.
+var pressurePresets = []PressurePreset{
+	{Name: "silent", Level: 0},
+	{Name: "quiet", Level: 3},
+	{Name: "normal", Level: DefaultPressure},
+	{Name: "active", Level: 8},
+	{Name: "maximum", Level: MaxPressure},
+}
+
+// PressurePresets returns the named pressure levels from quietest to loudest.
+func PressurePresets() []PressurePreset {
+	return append([]PressurePreset(nil), pressurePresets...)
+}
+
+// PressurePresetNames returns the preset names from quietest to loudest.
+func PressurePresetNames() []string {
+	names := make([]string, len(pressurePresets))
+	for i, preset := range pressurePresets {
+		names[i] = preset.Name
+	}
+	return names
+}
+
+// PressurePresetLevel returns the pressure level for a preset name,
+// ignoring case.
+func PressurePresetLevel(name string) (int, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, preset := range pressurePresets {
+		if preset.Name == name {
+			return preset.Level, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown pressure preset %q (valid: %s)", name, strings.Join(PressurePresetNames(), ", "))
+}
+
+// PressurePresetName returns the preset for a pressure level, or "" when
+// the level has no name.
+func PressurePresetName(level int) string {
+	for _, preset := range pressurePresets {
+		if preset.Level == level {
+			return preset.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPressurePresetLevel(t *testing.T) {
+	tests := []struct {
+		name string
+		want int
+	}{
+		{"silent", 0},
+		{"quiet", 3},
+		{"normal", 5},
+		{"active", 8},
+		{"maximum", 10},
+		{" Quiet ", 3},
+		{"MAXIMUM", 10},
+	}
+	for _, tt := range tests {
+		got, err := PressurePresetLevel(tt.name)
+		if err != nil {
+			t.Errorf("PressurePresetLevel(%q) error = %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("PressurePresetLevel(%q) = %d, want %d", tt.name, got, tt.want)
+		}
+		if name := PressurePresetName(got); name != strings.ToLower(strings.TrimSpace(tt.name)) {
+			t.Errorf("PressurePresetName(%d) = %q, want %q", got, name, tt.name)
+		}
+	}
+}
+
+func TestPressurePresetLevel_Unknown(t *testing.T) {
+	for _, name := range []string{"", "loud", "5", "volcanic"} {
+		_, err := PressurePresetLevel(name)
+		if err == nil {
+			t.Errorf("PressurePresetLevel(%q) should fail", name)
+			continue
+		}
+		if !strings.Contains(err.Error(), "silent, quiet, normal, active, maximum") {
+			t.Errorf("PressurePresetLevel(%q) error = %q, want the valid presets listed", name, err)
+		}
+	}
+}
+
+func TestPressurePresetsAscend(t *testing.T) {
+	presets := PressurePresets()
+	for i, preset := range presets {
+		if preset.Level < 0 || preset.Level > MaxPressure {
+			t.Errorf("preset %s level %d outside 0-%d", preset.Name, preset.Level, MaxPressure)
+		}
+		if i > 0 && preset.Level <= presets[i-1].Level {
+			t.Errorf("preset %s should be louder than %s", preset.Name, presets[i-1].Name)
+		}
+	}
+	if PressurePresetName(4) != "" {
+		t.Error("level 4 has no preset")
+	}
+}
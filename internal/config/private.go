@@ -0,0 +1,67 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultPrivateDir is the directory (next to the shared feed) holding private feeds
+const DefaultPrivateDir = "private"
+
+// ErrInvalidPrivateIdentity is returned when an identity has no usable name for a private feed
+var ErrInvalidPrivateIdentity = errors.New("identity has no name for a private feed")
+
+// PrivateFeedName returns the file-safe name used for an identity's private feed.
+// The project is ignored so an identity keeps one private feed across projects.
+func PrivateFeedName(identity *Identity) string {
+	name := identity.Suffix
+	if identity.Agent != "" {
+		name = identity.Agent + "-" + identity.Suffix
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			b.WriteRune(r)
+		}
+	}
+	return strings.Trim(b.String(), "-_")
+}
+
+// GetPrivateFeedPath returns the path of the identity's private feed.
+// Private feeds live in a private/ directory next to the shared feed, so
+// SMOKE_FEED overrides move them too. The feed scope does not apply.
+func GetPrivateFeedPath(identity *Identity) (string, error) {
+	name := PrivateFeedName(identity)
+	if name == "" {
+		return "", ErrInvalidPrivateIdentity
+	}
+	feedPath, err := GetGlobalFeedPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(feedPath), DefaultPrivateDir, name+".jsonl"), nil
+}
+
+// EnsurePrivateFeed creates the identity's private feed file if it doesn't exist
+// and returns its path.
+func EnsurePrivateFeed(identity *Identity) (string, error) {
+	path, err := GetPrivateFeedPath(identity)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return path, nil
+}
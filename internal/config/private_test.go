@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrivateFeedName(t *testing.T) {
+	tests := []struct {
+		identity Identity
+		expected string
+	}{
+		{Identity{Agent: "claude", Suffix: "swift-fox", Project: "smoke"}, "claude-swift-fox"},
+		{Identity{Suffix: "SwiftFox", Project: "smoke"}, "swiftfox"},
+		{Identity{Suffix: HumanIdentity, Project: "smoke"}, "human"},
+		{Identity{Suffix: "../..", Project: "smoke"}, ""},
+	}
+	for _, tt := range tests {
+		if got := PrivateFeedName(&tt.identity); got != tt.expected {
+			t.Errorf("PrivateFeedName(%+v) = %q, want %q", tt.identity, got, tt.expected)
+		}
+	}
+}
+
+func TestEnsurePrivateFeed(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	t.Setenv("SMOKE_FEED", filepath.Join(tmpDir, "feed.jsonl"))
+
+	identity := &Identity{Agent: "claude", Suffix: "swift-fox", Project: "smoke"}
+	path, err := EnsurePrivateFeed(identity)
+	if err != nil {
+		t.Fatalf("EnsurePrivateFeed failed: %v", err)
+	}
+
+	want := filepath.Join(tmpDir, DefaultPrivateDir, "claude-swift-fox.jsonl")
+	if path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("private feed should exist: %v", err)
+	}
+
+	if _, err := GetPrivateFeedPath(&Identity{Suffix: "!!"}); err != ErrInvalidPrivateIdentity {
+		t.Errorf("expected ErrInvalidPrivateIdentity, got %v", err)
+	}
+}
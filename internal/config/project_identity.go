@@ -0,0 +1,49 @@
+package config
+
+import "errors"
+
+// ErrEmptyIdentityName is returned when saving a blank identity name.
+var ErrEmptyIdentityName = errors.New("identity name cannot be empty")
+
+// CurrentProject returns the project name identities use in this directory,
+// from the git remote, the repository directory, or the working directory.
+func CurrentProject() string {
+	return detectProject()
+}
+
+// GetProjectIdentityName returns the name saved for project with
+// smoke identity set, or "" when none is saved.
+func GetProjectIdentityName(project string) string {
+	return LoadSuggestConfig().ProjectIdentities[project]
+}
+
+// SetProjectIdentityName saves name as the identity to use in project and
+// returns it as stored. Like --as, any @project part is ignored.
+func SetProjectIdentityName(project, name string) (string, error) {
+	name = resolveOverrideIdentity(name).Suffix
+	if name == "" {
+		return "", ErrEmptyIdentityName
+	}
+	err := updateUserConfig(func(raw *SuggestConfig) {
+		if raw.ProjectIdentities == nil {
+			raw.ProjectIdentities = make(map[string]string)
+		}
+		raw.ProjectIdentities[project] = name
+	})
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// ClearProjectIdentityName forgets the name saved for project. It reports
+// false when no name was saved.
+func ClearProjectIdentityName(project string) (bool, error) {
+	if GetProjectIdentityName(project) == "" {
+		return false, nil
+	}
+	err := updateUserConfig(func(raw *SuggestConfig) {
+		delete(raw.ProjectIdentities, project)
+	})
+	return err == nil, err
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProjectIdentityName(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("SMOKE_NAME", "")
+	if err := os.MkdirAll(filepath.Join(home, ".config", "smoke"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	project := CurrentProject()
+	humanSession = func() bool { return true }
+	t.Cleanup(func() { humanSession = isHumanSession })
+
+	name, err := SetProjectIdentityName(project, "Night Owl@elsewhere")
+	if err != nil || name != "night-owl" {
+		t.Fatalf("SetProjectIdentityName() = %q, %v; want night-owl, nil", name, err)
+	}
+	if got := GetProjectIdentityName("other-project"); got != "" {
+		t.Errorf("other projects should not use the saved name, got %q", got)
+	}
+
+	id, err := GetIdentity("")
+	if err != nil {
+		t.Fatalf("GetIdentity() error: %v", err)
+	}
+	if id.String() != "night-owl@"+project {
+		t.Errorf("GetIdentity() = %s, want the saved name night-owl@%s", id, project)
+	}
+
+	// --as and SMOKE_NAME still win over the saved name
+	if id, _ := GetIdentity("ember"); id.Suffix != "ember" {
+		t.Errorf("GetIdentity(--as ember) = %s, want ember", id)
+	}
+	t.Setenv("SMOKE_NAME", "wisp")
+	if id, _ := GetIdentity(""); id.Suffix != "wisp" {
+		t.Errorf("GetIdentity() with SMOKE_NAME = %s, want wisp", id)
+	}
+	t.Setenv("SMOKE_NAME", "")
+
+	debug, err := DebugIdentity()
+	if err != nil || debug.Winner != ResolvedByProject || debug.ProjectName != "night-owl" {
+		t.Errorf("DebugIdentity() = %+v, %v; want resolved by %s", debug, err, ResolvedByProject)
+	}
+
+	cleared, err := ClearProjectIdentityName(project)
+	if err != nil || !cleared {
+		t.Fatalf("ClearProjectIdentityName() = %v, %v; want true, nil", cleared, err)
+	}
+	if cleared, _ := ClearProjectIdentityName(project); cleared {
+		t.Error("clearing again should report nothing was saved")
+	}
+	if got := GetProjectIdentityName(project); got != "" {
+		t.Errorf("GetProjectIdentityName() after clear = %q, want empty", got)
+	}
+
+	if _, err := SetProjectIdentityName(project, " @proj"); err != ErrEmptyIdentityName {
+		t.Errorf("SetProjectIdentityName(blank) error = %v, want ErrEmptyIdentityName", err)
+	}
+}
+
+func TestProjectIdentityName_AgentKeepsOwnName(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("SMOKE_NAME", "")
+	t.Setenv("TERM_SESSION_ID", "agent-keeps-name-session")
+	if err := os.MkdirAll(filepath.Join(home, ".config", "smoke"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	humanSession = func() bool { return false }
+	t.Cleanup(func() { humanSession = isHumanSession })
+
+	generated, err := GetIdentity("")
+	if err != nil {
+		t.Fatalf("GetIdentity() error: %v", err)
+	}
+	if _, err := SetProjectIdentityName(CurrentProject(), "night-owl"); err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := GetIdentity("")
+	if err != nil {
+		t.Fatalf("GetIdentity() error: %v", err)
+	}
+	if id.Suffix == "night-owl" || id.String() != generated.String() {
+		t.Errorf("agent GetIdentity() = %s, want its own name %s, not the saved one", id, generated)
+	}
+	if debug, _ := DebugIdentity(); debug.Winner == ResolvedByProject {
+		t.Errorf("DebugIdentity().Winner = %s for an agent session", debug.Winner)
+	}
+}
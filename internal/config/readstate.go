@@ -13,7 +13,14 @@ import (
 // ReadState stores the last-read post ID for the human operator.
 // There's a single read marker shared across all sessions.
 type ReadState struct {
-	LastReadPostID string    `yaml:"last_read_post_id"`
+	LastReadPostID string `yaml:"last_read_post_id"`
+	// LastReadAt is when the last-read post was created. Posts after it are
+	// unread, so the boundary survives the post being deleted.
+	LastReadAt time.Time `yaml:"last_read_at,omitempty"`
+	// SelectedPostID and AtBottom are the TUI position saved on quit, used
+	// when TUIConfig.PositionRemembered.
+	SelectedPostID string    `yaml:"selected_post_id,omitempty"`
+	AtBottom       bool      `yaml:"at_bottom,omitempty"`
 	Updated        time.Time `yaml:"updated"`
 }
 
@@ -99,8 +106,30 @@ func SaveReadState(state *ReadState) error {
 
 // SaveLastReadPostID saves the last-read post ID to disk.
 func SaveLastReadPostID(postID string) error {
-	state := &ReadState{
-		LastReadPostID: postID,
+	return SaveLastRead(postID, time.Time{})
+}
+
+// SaveLastRead saves the last-read post ID and its creation time to disk,
+// keeping the saved TUI position.
+func SaveLastRead(postID string, createdAt time.Time) error {
+	state := &ReadState{}
+	if existing, err := LoadReadState(); err == nil {
+		state.SelectedPostID = existing.SelectedPostID
+		state.AtBottom = existing.AtBottom
+	}
+	state.LastReadPostID = postID
+	state.LastReadAt = createdAt
+	return SaveReadState(state)
+}
+
+// SavePosition saves the TUI's selected post and whether it was scrolled to
+// the bottom, keeping the read marker.
+func SavePosition(selectedPostID string, atBottom bool) error {
+	state, err := LoadReadState()
+	if err != nil {
+		state = &ReadState{}
 	}
+	state.SelectedPostID = selectedPostID
+	state.AtBottom = atBottom
 	return SaveReadState(state)
 }
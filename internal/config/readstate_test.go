@@ -76,6 +76,26 @@ func TestSaveLastReadPostID_Overwrite(t *testing.T) {
 	}
 }
 
+func TestSaveLastRead(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	createdAt := time.Date(2026, 1, 30, 10, 0, 0, 0, time.UTC)
+	if err := SaveLastRead("post-123", createdAt); err != nil {
+		t.Fatalf("SaveLastRead failed: %v", err)
+	}
+
+	state, err := LoadReadState()
+	if err != nil {
+		t.Fatalf("LoadReadState failed: %v", err)
+	}
+	if state.LastReadPostID != "post-123" {
+		t.Errorf("LastReadPostID = %q, want %q", state.LastReadPostID, "post-123")
+	}
+	if !state.LastReadAt.Equal(createdAt) {
+		t.Errorf("LastReadAt = %v, want %v", state.LastReadAt, createdAt)
+	}
+}
+
 func TestTimestampUpdate(t *testing.T) {
 	tmpDir := t.TempDir()
 	originalHome := os.Getenv("HOME")
@@ -186,3 +206,27 @@ func TestLoadReadState_EmptyFile(t *testing.T) {
 		t.Fatalf("Expected empty LastReadPostID, got %s", state.LastReadPostID)
 	}
 }
+
+func TestSavePosition_KeepsReadMarker(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	readAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := SaveLastRead("smk-read01", readAt); err != nil {
+		t.Fatal(err)
+	}
+	if err := SavePosition("smk-sel001", true); err != nil {
+		t.Fatal(err)
+	}
+	// Marking posts read later keeps the saved position
+	if err := SaveLastRead("smk-read02", readAt.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := LoadReadState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.LastReadPostID != "smk-read02" || state.SelectedPostID != "smk-sel001" || !state.AtBottom {
+		t.Errorf("LoadReadState() = %+v, want read marker smk-read02 and position smk-sel001 at bottom", state)
+	}
+}
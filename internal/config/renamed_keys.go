@@ -0,0 +1,35 @@
+package config
+
+import "sort"
+
+// renamedTUIKeys maps tui.yaml keys that have been renamed to their current
+// names. LoadTUIConfig reads the old names, and smoke doctor --fix rewrites
+// them.
+var renamedTUIKeys = map[string]string{
+	"style": "layout",
+}
+
+// MigrateRenamedTUIKeys moves values under renamed keys in a parsed tui.yaml
+// to their current names, keeping the current key when both are set. It
+// returns the old keys it found, sorted.
+func MigrateRenamedTUIKeys(parsed map[string]any) []string {
+	var found []string
+	for oldKey, newKey := range renamedTUIKeys {
+		value, ok := parsed[oldKey]
+		if !ok {
+			continue
+		}
+		if _, hasNew := parsed[newKey]; !hasNew {
+			parsed[newKey] = value
+		}
+		delete(parsed, oldKey)
+		found = append(found, oldKey)
+	}
+	sort.Strings(found)
+	return found
+}
+
+// RenamedTUIKey returns the current name for a renamed tui.yaml key.
+func RenamedTUIKey(oldKey string) string {
+	return renamedTUIKeys[oldKey]
+}
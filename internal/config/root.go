@@ -88,19 +88,15 @@ func validateFeedPath(path string) (string, error) {
 	return cleanPath, nil
 }
 
-// GetFeedPath returns the path to the feed.jsonl file
+// GetFeedPath returns the path to the feed commands read and write: the
+// shared feed.jsonl, or the current project's feed when the feed scope is
+// project (see GetFeedScope).
 // If SMOKE_FEED env var is set, uses that path after validation (must be within home directory)
 func GetFeedPath() (string, error) {
-	// Check for explicit feed path override
-	if feedPath := os.Getenv("SMOKE_FEED"); feedPath != "" {
-		return validateFeedPath(feedPath)
+	if GetFeedScope() == FeedScopeProject {
+		return GetProjectFeedPath(detectProject())
 	}
-
-	configDir, err := GetConfigDir()
-	if err != nil {
-		return "", err
-	}
-	return filepath.Join(configDir, DefaultFeedFile), nil
+	return GetGlobalFeedPath()
 }
 
 // GetConfigPath returns the path to the config.yaml file
@@ -114,7 +110,7 @@ func GetConfigPath() (string, error) {
 
 // IsSmokeInitialized checks if smoke has been initialized
 func IsSmokeInitialized() (bool, error) {
-	feedPath, err := GetFeedPath()
+	feedPath, err := GetGlobalFeedPath()
 	if err != nil {
 		return false, err
 	}
@@ -128,7 +124,8 @@ func IsSmokeInitialized() (bool, error) {
 	return true, nil
 }
 
-// EnsureInitialized returns an error if smoke is not initialized
+// EnsureInitialized returns an error if smoke is not initialized. With the
+// project feed scope it also creates the current project's feed on first use.
 func EnsureInitialized() error {
 	initialized, err := IsSmokeInitialized()
 	if err != nil {
@@ -137,7 +134,14 @@ func EnsureInitialized() error {
 	if !initialized {
 		return ErrNotInitialized
 	}
-	return nil
+	if GetFeedScope() != FeedScopeProject {
+		return nil
+	}
+	feedPath, err := GetFeedPath()
+	if err != nil {
+		return err
+	}
+	return ensureProjectFeed(feedPath)
 }
 
 // GetLogPath returns the path to the smoke.log file
@@ -148,3 +152,23 @@ func GetLogPath() (string, error) {
 	}
 	return filepath.Join(configDir, DefaultLogFile), nil
 }
+
+// GetThemesDir returns the directory custom TUI themes are loaded from
+// (~/.config/smoke/themes/)
+func GetThemesDir() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, DefaultThemesDir), nil
+}
+
+// GetCardPath returns where the TUI saves the share card for a post:
+// ~/smoke-cards/<id>.png
+func GetCardPath(postID string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, DefaultCardsDir, postID+".png"), nil
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Feed scopes for --scope and feed_scope in config.yaml
+const (
+	// FeedScopeGlobal keeps every project on the shared feed
+	FeedScopeGlobal = "global"
+	// FeedScopeProject gives each detected project its own feed
+	FeedScopeProject = "project"
+)
+
+// DefaultProjectsDir is the directory (next to the shared feed) holding project feeds
+const DefaultProjectsDir = "projects"
+
+// ErrInvalidFeedScope is returned for a scope other than global or project
+var ErrInvalidFeedScope = errors.New(`feed scope must be "global" or "project"`)
+
+// feedScopeOverride is the --scope flag value; empty defers to config.yaml.
+var feedScopeOverride string
+
+// SetFeedScope overrides feed_scope for this process (the --scope flag).
+// An empty scope clears the override.
+func SetFeedScope(scope string) error {
+	scope = strings.ToLower(strings.TrimSpace(scope))
+	if scope != "" && scope != FeedScopeGlobal && scope != FeedScopeProject {
+		return ErrInvalidFeedScope
+	}
+	feedScopeOverride = scope
+	return nil
+}
+
+// GetFeedScope returns the scope in effect: the --scope flag, then
+// feed_scope in config.yaml. Unset or unrecognized values mean global.
+func GetFeedScope() string {
+	if feedScopeOverride != "" {
+		return feedScopeOverride
+	}
+	if strings.EqualFold(LoadSuggestConfig().FeedScope, FeedScopeProject) {
+		return FeedScopeProject
+	}
+	return FeedScopeGlobal
+}
+
+// GetGlobalFeedPath returns the path of the shared feed, ignoring the scope.
+// If SMOKE_FEED env var is set, uses that path after validation.
+func GetGlobalFeedPath() (string, error) {
+	if feedPath := os.Getenv("SMOKE_FEED"); feedPath != "" {
+		return validateFeedPath(feedPath)
+	}
+
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, DefaultFeedFile), nil
+}
+
+// GetProjectFeedPath returns the path of a project's feed. Project feeds
+// live in a projects/ directory next to the shared feed, so SMOKE_FEED
+// overrides move them too.
+func GetProjectFeedPath(project string) (string, error) {
+	name := sanitizeProjectName(project)
+	if strings.Trim(name, ".") == "" {
+		name = "unknown"
+	}
+	feedPath, err := GetGlobalFeedPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(feedPath), DefaultProjectsDir, name+".jsonl"), nil
+}
+
+// GetAllFeedPaths returns the shared feed followed by every existing
+// project feed, sorted by project name.
+func GetAllFeedPaths() ([]string, error) {
+	feedPath, err := GetGlobalFeedPath()
+	if err != nil {
+		return nil, err
+	}
+	projectFeeds, err := filepath.Glob(filepath.Join(filepath.Dir(feedPath), DefaultProjectsDir, "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(projectFeeds)
+	return append([]string{feedPath}, projectFeeds...), nil
+}
+
+// ensureProjectFeed creates the project feed file if it doesn't exist.
+func ensureProjectFeed(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
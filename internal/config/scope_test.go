@@ -0,0 +1,124 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetFeedScope(t *testing.T) {
+	t.Cleanup(func() { _ = SetFeedScope("") })
+	t.Setenv("HOME", t.TempDir())
+
+	if got := GetFeedScope(); got != FeedScopeGlobal {
+		t.Errorf("default scope = %q, want %q", got, FeedScopeGlobal)
+	}
+	if err := SetFeedScope(" Project "); err != nil {
+		t.Fatalf("SetFeedScope: %v", err)
+	}
+	if got := GetFeedScope(); got != FeedScopeProject {
+		t.Errorf("scope = %q, want %q", got, FeedScopeProject)
+	}
+	if err := SetFeedScope("team"); !errors.Is(err, ErrInvalidFeedScope) {
+		t.Errorf("SetFeedScope(team) error = %v, want ErrInvalidFeedScope", err)
+	}
+}
+
+func TestGetFeedScope_FromConfig(t *testing.T) {
+	t.Cleanup(func() { _ = SetFeedScope("") })
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte("feed_scope: project\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := GetFeedScope(); got != FeedScopeProject {
+		t.Errorf("scope from config = %q, want %q", got, FeedScopeProject)
+	}
+	// The flag wins over config.yaml
+	if err := SetFeedScope(FeedScopeGlobal); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetFeedScope(); got != FeedScopeGlobal {
+		t.Errorf("scope with override = %q, want %q", got, FeedScopeGlobal)
+	}
+}
+
+func TestGetFeedPath_ProjectScope(t *testing.T) {
+	t.Cleanup(func() { _ = SetFeedScope("") })
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	globalPath := filepath.Join(tmpDir, "feed.jsonl")
+	t.Setenv("SMOKE_FEED", globalPath)
+	if err := os.WriteFile(globalPath, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	projectDir := filepath.Join(tmpDir, "alpha")
+	if err := os.Mkdir(projectDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(projectDir)
+	if err := SetFeedScope(FeedScopeProject); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := GetFeedPath()
+	if err != nil {
+		t.Fatalf("GetFeedPath: %v", err)
+	}
+	want := filepath.Join(tmpDir, DefaultProjectsDir, "alpha.jsonl")
+	if path != want {
+		t.Errorf("GetFeedPath() = %q, want %q", path, want)
+	}
+
+	if err := EnsureInitialized(); err != nil {
+		t.Fatalf("EnsureInitialized: %v", err)
+	}
+	if _, err := os.Stat(want); err != nil {
+		t.Errorf("project feed not created: %v", err)
+	}
+
+	paths, err := GetAllFeedPaths()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 || paths[0] != globalPath || paths[1] != want {
+		t.Errorf("GetAllFeedPaths() = %v, want [%s %s]", paths, globalPath, want)
+	}
+
+	// The private feed stays next to the shared feed
+	privatePath, err := GetPrivateFeedPath(&Identity{Agent: "claude", Suffix: "swift-fox"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Dir(privatePath) != filepath.Join(tmpDir, DefaultPrivateDir) {
+		t.Errorf("private feed moved with the scope: %s", privatePath)
+	}
+}
+
+func TestGetProjectFeedPath_Sanitizes(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	t.Setenv("SMOKE_FEED", filepath.Join(tmpDir, "feed.jsonl"))
+
+	tests := map[string]string{
+		"My Project": "my-project.jsonl",
+		"../..":      "unknown.jsonl",
+		"":           "unknown.jsonl",
+	}
+	for project, want := range tests {
+		path, err := GetProjectFeedPath(project)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != filepath.Join(tmpDir, DefaultProjectsDir, want) {
+			t.Errorf("GetProjectFeedPath(%q) = %q, want .../%s", project, path, want)
+		}
+	}
+}
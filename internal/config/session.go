@@ -0,0 +1,32 @@
+package config
+
+import "fmt"
+
+// unixTerminalSeedSources returns terminal identifiers available on macOS and Linux.
+func unixTerminalSeedSources(getenv func(string) string) []SeedSource {
+	return []SeedSource{
+		envSeedSource(SeedSourceTermSession, getenv("TERM_SESSION_ID")),
+		envSeedSource(SeedSourceWindowID, getenv("WINDOWID")),
+	}
+}
+
+// windowsTerminalSeedSources returns terminal identifiers available on Windows.
+// WT_SESSION is a per-tab GUID set by Windows Terminal. Classic consoles have
+// no such variable, so the console window handle identifies the window
+// instead, qualified by SESSIONNAME so RDP sessions don't collide.
+func windowsTerminalSeedSources(getenv func(string) string, consoleWindow uintptr) []SeedSource {
+	console := SeedSource{Name: SeedSourceConsole, Note: "no console window"}
+	if consoleWindow != 0 {
+		sessionName := getenv("SESSIONNAME")
+		if sessionName == "" {
+			sessionName = "console"
+		}
+		console.Value = fmt.Sprintf("%s-%x", sessionName, consoleWindow)
+		console.Valid = true
+	}
+
+	return []SeedSource{
+		envSeedSource(SeedSourceWTSession, getenv("WT_SESSION")),
+		console,
+	}
+}
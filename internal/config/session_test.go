@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stubEnv(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func TestWindowsTerminalSeedSources_DistinctTabs(t *testing.T) {
+	tab1 := windowsTerminalSeedSources(stubEnv(map[string]string{"WT_SESSION": "6f1c-tab-1", "SESSIONNAME": "Console"}), 0x1234)
+	tab2 := windowsTerminalSeedSources(stubEnv(map[string]string{"WT_SESSION": "9a2e-tab-2", "SESSIONNAME": "Console"}), 0x1234)
+
+	assert.Equal(t, SeedSourceWTSession, tab1[0].Name)
+	assert.True(t, tab1[0].Valid)
+	assert.NotEqual(t, tab1[0].Value, tab2[0].Value, "tabs should get distinct seeds")
+
+	again := windowsTerminalSeedSources(stubEnv(map[string]string{"WT_SESSION": "6f1c-tab-1", "SESSIONNAME": "Console"}), 0x1234)
+	assert.Equal(t, tab1, again, "same tab should be stable")
+}
+
+func TestWindowsTerminalSeedSources_ClassicConsole(t *testing.T) {
+	sources := windowsTerminalSeedSources(stubEnv(map[string]string{"SESSIONNAME": "RDP-Tcp#3"}), 0xbeef)
+
+	assert.False(t, sources[0].Valid)
+	assert.Equal(t, "unset", sources[0].Note)
+	assert.Equal(t, SeedSourceConsole, sources[1].Name)
+	assert.True(t, sources[1].Valid)
+	assert.Equal(t, "RDP-Tcp#3-beef", sources[1].Value)
+}
+
+func TestWindowsTerminalSeedSources_NoConsole(t *testing.T) {
+	sources := windowsTerminalSeedSources(stubEnv(nil), 0)
+
+	for _, source := range sources {
+		assert.False(t, source.Valid, "%s should be invalid without a console", source.Name)
+	}
+}
+
+func TestUnixTerminalSeedSources(t *testing.T) {
+	sources := unixTerminalSeedSources(stubEnv(map[string]string{"WINDOWID": "4194311"}))
+
+	assert.Equal(t, SeedSourceTermSession, sources[0].Name)
+	assert.False(t, sources[0].Valid)
+	assert.Equal(t, SeedSourceWindowID, sources[1].Name)
+	assert.Equal(t, "4194311", sources[1].Value)
+}
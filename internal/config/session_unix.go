@@ -0,0 +1,10 @@
+//go:build !windows
+
+package config
+
+import "os"
+
+// terminalSeedSources returns the terminal session seed sources for this platform.
+func terminalSeedSources() []SeedSource {
+	return unixTerminalSeedSources(os.Getenv)
+}
@@ -0,0 +1,25 @@
+//go:build windows
+
+package config
+
+import (
+	"os"
+	"syscall"
+)
+
+var procGetConsoleWindow = syscall.NewLazyDLL("kernel32.dll").NewProc("GetConsoleWindow")
+
+// terminalSeedSources returns the terminal session seed sources for this platform.
+func terminalSeedSources() []SeedSource {
+	return windowsTerminalSeedSources(os.Getenv, consoleWindow())
+}
+
+// consoleWindow returns the handle of the console window attached to this
+// process, or 0 if there is none.
+func consoleWindow() uintptr {
+	if err := procGetConsoleWindow.Find(); err != nil {
+		return 0
+	}
+	hwnd, _, _ := procGetConsoleWindow.Call()
+	return hwnd
+}
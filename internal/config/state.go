@@ -0,0 +1,293 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/filelock"
+)
+
+// State stores per-identity activity shared by commands, such as when each
+// identity last posted. It lives in state.json next to the feed.
+type State struct {
+	// LastPost maps an identity (e.g. "claude-swift-fox@smoke") to its last post or reply time.
+	LastPost map[string]time.Time `json:"last_post,omitempty"`
+	// RecentPosts maps an identity to its post and reply times within
+	// PostRateWindow, oldest first, for max_posts_per_minute.
+	RecentPosts map[string][]time.Time `json:"recent_posts,omitempty"`
+	// Identities maps a session seed to the identity suffix it claimed when it first posted.
+	Identities map[string]IdentityClaim `json:"identities,omitempty"`
+	// LastNudge is what the previous smoke suggest showed, so the next one
+	// can pick something else.
+	LastNudge *NudgePicks `json:"last_nudge,omitempty"`
+	// ConfigWarnings are the config problems already reported at startup,
+	// so each is shown once until it is fixed (see UnseenConfigWarnings).
+	ConfigWarnings []string `json:"config_warnings,omitempty"`
+}
+
+// NudgePicks records the examples and style mode one nudge showed.
+type NudgePicks struct {
+	Examples []string `json:"examples,omitempty"`
+	Style    string   `json:"style,omitempty"`
+}
+
+// IdentityClaim records the suffix a session chose to avoid clashing with
+// other recent authors.
+type IdentityClaim struct {
+	Suffix    string    `json:"suffix"`
+	ClaimedAt time.Time `json:"claimed_at"`
+}
+
+// identityClaimTTL is how long a session's claimed suffix is remembered.
+const identityClaimTTL = 7 * 24 * time.Hour
+
+// GetStatePath returns the path to the state.json file
+func GetStatePath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, DefaultStateFile), nil
+}
+
+// LoadState loads the activity state from disk.
+// Returns an empty state if the file doesn't exist, is empty, or is invalid.
+func LoadState() *State {
+	state := &State{}
+	path, err := GetStatePath()
+	if err != nil {
+		return state
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) == 0 {
+		return state
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return &State{}
+	}
+	return state
+}
+
+// SaveState saves the activity state to disk atomically.
+func SaveState(state *State) error {
+	path, err := GetStatePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Atomic write: temp file + rename
+	tmpFile := path + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, path); err != nil {
+		_ = os.Remove(tmpFile)
+		return err
+	}
+
+	return nil
+}
+
+// updateState loads the state, applies update, and saves it, holding a lock
+// on state.json.lock throughout so concurrent commands do not lose each
+// other's changes. The lock is on a separate file because SaveState
+// replaces state.json.
+func updateState(update func(*State)) error {
+	path, err := GetStatePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	if err := filelock.Lock(f, true); err != nil {
+		return err
+	}
+	defer func() { _ = filelock.Unlock(f) }()
+
+	state := LoadState()
+	update(state)
+	return SaveState(state)
+}
+
+// ErrPostRateLimited is returned by ReservePost when the identity already
+// reached its limit.
+var ErrPostRateLimited = errors.New("post rate limit reached")
+
+// RecordPost stores at as the last post time for identity and adds it to
+// the identity's recent posts, forgetting those older than PostRateWindow.
+func RecordPost(identity string, at time.Time) error {
+	return updateState(func(state *State) {
+		state.recordPost(identity, at)
+	})
+}
+
+// ReservePost records a post like RecordPost unless identity already made
+// limit posts in the PostRateWindow ending at at; then nothing is recorded
+// and it returns that count with ErrPostRateLimited. A limit of 0 means no
+// limit. The check and the record happen under one lock on state.json, so
+// concurrent posts cannot all slip under the limit.
+func ReservePost(identity string, at time.Time, limit int) (int, error) {
+	count, limited := 0, false
+	err := updateState(func(state *State) {
+		count = len(postsSince(state.RecentPosts[identity], at.Add(-PostRateWindow)))
+		if limited = limit > 0 && count >= limit; !limited {
+			state.recordPost(identity, at)
+		}
+	})
+	if err == nil && limited {
+		err = ErrPostRateLimited
+	}
+	return count, err
+}
+
+// recordPost does RecordPost's work on a loaded state.
+func (s *State) recordPost(identity string, at time.Time) {
+	if s.LastPost == nil {
+		s.LastPost = make(map[string]time.Time)
+	}
+	s.LastPost[identity] = at.UTC()
+
+	if s.RecentPosts == nil {
+		s.RecentPosts = make(map[string][]time.Time)
+	}
+	for id, times := range s.RecentPosts {
+		s.RecentPosts[id] = postsSince(times, at.Add(-PostRateWindow))
+		if len(s.RecentPosts[id]) == 0 {
+			delete(s.RecentPosts, id)
+		}
+	}
+	s.RecentPosts[identity] = append(s.RecentPosts[identity], at.UTC())
+}
+
+// RecentPostCount returns how many posts identity made in the PostRateWindow
+// ending at now.
+func RecentPostCount(identity string, now time.Time) int {
+	return len(postsSince(LoadState().RecentPosts[identity], now.Add(-PostRateWindow)))
+}
+
+// postsSince returns the times after cutoff.
+func postsSince(times []time.Time, cutoff time.Time) []time.Time {
+	var kept []time.Time
+	for _, t := range times {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+// LastPostTime returns when identity last posted, or false if it never has.
+func LastPostTime(identity string) (time.Time, bool) {
+	at, ok := LoadState().LastPost[identity]
+	return at, ok
+}
+
+// InCooldown reports whether identity posted less than cooldown before now.
+// A zero or negative cooldown, or no recorded post, never cools down.
+func InCooldown(identity string, cooldown time.Duration, now time.Time) bool {
+	if cooldown <= 0 {
+		return false
+	}
+	last, ok := LastPostTime(identity)
+	if !ok {
+		return false
+	}
+	return now.Sub(last) < cooldown
+}
+
+// claimedSuffix returns the suffix claimed by the session with this seed, or "".
+func claimedSuffix(seed string) string {
+	return LoadState().Identities[seed].Suffix
+}
+
+// claimSuffix picks the session's suffix with choose and records it as the
+// session's identity, forgetting claims older than identityClaimTTL. choose
+// sees the other sessions' claims. If another command in the session claimed
+// a suffix first, that one is kept and returned instead. The suffix is
+// returned even when it could not be saved.
+func claimSuffix(seed string, choose func(claims map[string]IdentityClaim) string, now time.Time) (string, error) {
+	var suffix string
+	err := updateState(func(state *State) {
+		if state.Identities == nil {
+			state.Identities = make(map[string]IdentityClaim)
+		}
+		for s, claim := range state.Identities {
+			if now.Sub(claim.ClaimedAt) > identityClaimTTL {
+				delete(state.Identities, s)
+			}
+		}
+		if claim, ok := state.Identities[seed]; ok {
+			suffix = claim.Suffix
+			return
+		}
+		suffix = choose(state.Identities)
+		state.Identities[seed] = IdentityClaim{Suffix: suffix, ClaimedAt: now.UTC()}
+	})
+	if suffix == "" {
+		suffix = choose(LoadState().Identities)
+	}
+	return suffix, err
+}
+
+// LastNudgePicks returns what the previous nudge showed, or empty picks.
+func LastNudgePicks() NudgePicks {
+	if picks := LoadState().LastNudge; picks != nil {
+		return *picks
+	}
+	return NudgePicks{}
+}
+
+// RecordNudgePicks stores what a nudge showed for the next one to avoid.
+func RecordNudgePicks(picks NudgePicks) error {
+	return updateState(func(state *State) {
+		state.LastNudge = &picks
+	})
+}
+
+// UnseenConfigWarnings returns the warnings that have not been reported yet
+// and remembers warnings as reported. A warning that goes away and comes back
+// is reported again.
+func UnseenConfigWarnings(warnings []string) []string {
+	seen := LoadState().ConfigWarnings
+	if slices.Equal(seen, warnings) {
+		// Nothing changed, which is the usual case, so skip the lock and write
+		return nil
+	}
+	unseen := newWarnings(seen, warnings)
+	_ = updateState(func(state *State) {
+		unseen = newWarnings(state.ConfigWarnings, warnings)
+		state.ConfigWarnings = warnings
+	})
+	return unseen
+}
+
+// newWarnings returns the warnings not in seen.
+func newWarnings(seen, warnings []string) []string {
+	var unseen []string
+	for _, w := range warnings {
+		if !slices.Contains(seen, w) {
+			unseen = append(unseen, w)
+		}
+	}
+	return unseen
+}
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLastPostTime_NoPriorPost(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if _, ok := LastPostTime("alice@smoke"); ok {
+		t.Fatal("Expected no last post time without state.json")
+	}
+	if InCooldown("alice@smoke", DefaultCooldown, time.Now()) {
+		t.Error("Expected no cooldown when the identity never posted")
+	}
+}
+
+func TestRecordPost_RoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := RecordPost("alice@smoke", at); err != nil {
+		t.Fatalf("RecordPost failed: %v", err)
+	}
+	if err := RecordPost("bob@smoke", at.Add(time.Hour)); err != nil {
+		t.Fatalf("RecordPost failed: %v", err)
+	}
+
+	got, ok := LastPostTime("alice@smoke")
+	if !ok || !got.Equal(at) {
+		t.Errorf("Expected %v, got %v (ok=%v)", at, got, ok)
+	}
+	if got, _ := LastPostTime("bob@smoke"); !got.Equal(at.Add(time.Hour)) {
+		t.Errorf("Expected bob's post time to be kept separately, got %v", got)
+	}
+}
+
+func TestRecordPost_Concurrent(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Each update holds the state lock, so no writer loses another's post.
+	const writers = 20
+	at := time.Now()
+	var wg sync.WaitGroup
+	for i := range writers {
+		wg.Go(func() {
+			if err := RecordPost(fmt.Sprintf("agent-%d@smoke", i), at); err != nil {
+				t.Errorf("RecordPost failed: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := len(LoadState().LastPost); got != writers {
+		t.Errorf("Expected %d identities in state.json, got %d", writers, got)
+	}
+}
+
+func TestReservePost_ConcurrentLimit(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Racing posts by one identity must not all pass the limit.
+	const writers, limit = 10, 3
+	at := time.Now()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	reserved := 0
+	for range writers {
+		wg.Go(func() {
+			_, err := ReservePost("alice@smoke", at, limit)
+			switch {
+			case err == nil:
+				mu.Lock()
+				reserved++
+				mu.Unlock()
+			case !errors.Is(err, ErrPostRateLimited):
+				t.Errorf("ReservePost failed: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if reserved != limit {
+		t.Errorf("Expected %d posts reserved, got %d", limit, reserved)
+	}
+	if got := RecentPostCount("alice@smoke", at); got != limit {
+		t.Errorf("Expected %d posts recorded, got %d", limit, got)
+	}
+
+	n, err := ReservePost("alice@smoke", at, 0)
+	if err != nil || n != limit {
+		t.Errorf("ReservePost without a limit = (%d, %v), want (%d, nil)", n, err, limit)
+	}
+}
+
+func TestInCooldown_Boundary(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	last := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := RecordPost("alice@smoke", last); err != nil {
+		t.Fatalf("RecordPost failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		elapsed  time.Duration
+		cooldown time.Duration
+		want     bool
+	}{
+		{"just posted", 0, 10 * time.Minute, true},
+		{"one second before boundary", 10*time.Minute - time.Second, 10 * time.Minute, true},
+		{"exactly at boundary", 10 * time.Minute, 10 * time.Minute, false},
+		{"after boundary", 11 * time.Minute, 10 * time.Minute, false},
+		{"disabled", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InCooldown("alice@smoke", tt.cooldown, last.Add(tt.elapsed))
+			if got != tt.want {
+				t.Errorf("InCooldown after %v = %v, want %v", tt.elapsed, got, tt.want)
+			}
+		})
+	}
+
+	if InCooldown("bob@smoke", 10*time.Minute, last) {
+		t.Error("Expected other identities to be unaffected")
+	}
+}
+
+func TestRecordNudgePicks_RoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if picks := LastNudgePicks(); len(picks.Examples) != 0 || picks.Style != "" {
+		t.Fatalf("Expected no picks without state.json, got %+v", picks)
+	}
+	if err := RecordPost("alice@smoke", time.Now()); err != nil {
+		t.Fatalf("RecordPost failed: %v", err)
+	}
+	if err := RecordNudgePicks(NudgePicks{Examples: []string{"a", "b"}, Style: "vent"}); err != nil {
+		t.Fatalf("RecordNudgePicks failed: %v", err)
+	}
+
+	picks := LastNudgePicks()
+	if len(picks.Examples) != 2 || picks.Examples[0] != "a" || picks.Style != "vent" {
+		t.Errorf("LastNudgePicks() = %+v, want examples [a b] and style vent", picks)
+	}
+	if _, ok := LastPostTime("alice@smoke"); !ok {
+		t.Error("Recording nudge picks should keep other state")
+	}
+}
+
+func TestRecentPostCount_SlidingWindow(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, offset := range []time.Duration{0, 20 * time.Second, 40 * time.Second} {
+		if err := RecordPost("alice@smoke", start.Add(offset)); err != nil {
+			t.Fatalf("RecordPost failed: %v", err)
+		}
+	}
+	if err := RecordPost("bob@smoke", start.Add(40*time.Second)); err != nil {
+		t.Fatalf("RecordPost failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Duration
+		want int
+	}{
+		{"all within the window", 50 * time.Second, 3},
+		{"first post slides out", 61 * time.Second, 2},
+		{"second post slides out", 81 * time.Second, 1},
+		{"window empty", 2 * time.Minute, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RecentPostCount("alice@smoke", start.Add(tt.at)); got != tt.want {
+				t.Errorf("RecentPostCount at +%v = %d, want %d", tt.at, got, tt.want)
+			}
+		})
+	}
+
+	// Recording prunes times that left the window
+	if err := RecordPost("alice@smoke", start.Add(2*time.Minute)); err != nil {
+		t.Fatalf("RecordPost failed: %v", err)
+	}
+	state := LoadState()
+	if got := len(state.RecentPosts["alice@smoke"]); got != 1 {
+		t.Errorf("Expected old post times to be pruned, %d kept", got)
+	}
+	if _, ok := state.RecentPosts["bob@smoke"]; ok {
+		t.Error("Expected identities with no recent posts to be dropped")
+	}
+}
@@ -4,6 +4,10 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,15 +18,25 @@ type PressureLevel struct {
 	Probability int
 	Emoji       string
 	Label       string
+	// Tier groups levels into the five nudge tones, from 0 (silent) to 4
+	// (insistent).
+	Tier int
 }
 
-// pressureLevels defines the five pressure levels from 0 (sleep) to 4 (volcanic).
+// pressureLevels defines the pressure levels from 0 (sleep) to MaxPressure
+// (volcanic), each adding 10% to the nudge probability.
 var pressureLevels = []PressureLevel{
-	{Value: 0, Probability: 0, Emoji: "💤", Label: "sleep"},
-	{Value: 1, Probability: 25, Emoji: "🌙", Label: "quiet"},
-	{Value: 2, Probability: 50, Emoji: "⛅", Label: "balanced"},
-	{Value: 3, Probability: 75, Emoji: "☀️", Label: "bright"},
-	{Value: 4, Probability: 100, Emoji: "🌋", Label: "volcanic"},
+	{Value: 0, Probability: 0, Emoji: "💤", Label: "sleep", Tier: 0},
+	{Value: 1, Probability: 10, Emoji: "🌑", Label: "drowsy", Tier: 1},
+	{Value: 2, Probability: 20, Emoji: "🌘", Label: "hushed", Tier: 1},
+	{Value: 3, Probability: 30, Emoji: "🌙", Label: "quiet", Tier: 1},
+	{Value: 4, Probability: 40, Emoji: "🌥️", Label: "calm", Tier: 2},
+	{Value: 5, Probability: 50, Emoji: "⛅", Label: "balanced", Tier: 2},
+	{Value: 6, Probability: 60, Emoji: "🌤️", Label: "warm", Tier: 2},
+	{Value: 7, Probability: 70, Emoji: "🌞", Label: "sunny", Tier: 3},
+	{Value: 8, Probability: 80, Emoji: "☀️", Label: "bright", Tier: 3},
+	{Value: 9, Probability: 90, Emoji: "🔥", Label: "blazing", Tier: 3},
+	{Value: 10, Probability: 100, Emoji: "🌋", Label: "volcanic", Tier: 4},
 }
 
 // SuggestContext defines a nudge context with a prompt and associated categories.
@@ -36,6 +50,8 @@ type SuggestContext struct {
 type StyleMode struct {
 	Name string `yaml:"name" json:"name"`
 	Hint string `yaml:"hint" json:"hint"`
+	// Weight makes the mode more likely to be picked; unset (or below 1) is 1.
+	Weight int `yaml:"weight,omitempty" json:"-"`
 }
 
 // SuggestConfig stores configuration for the suggest command.
@@ -43,11 +59,95 @@ type SuggestConfig struct {
 	Contexts   map[string]SuggestContext `yaml:"contexts"`
 	Examples   map[string][]string       `yaml:"examples"`
 	StyleModes map[string][]StyleMode    `yaml:"style_modes,omitempty"`
-	Pressure   *int                      `yaml:"pressure,omitempty"`
+	// ExampleWeights maps an example's text to how likely it is to be
+	// shown; examples not listed weigh 1 (see ExampleWeight).
+	ExampleWeights map[string]int `yaml:"example_weights,omitempty"`
+	Pressure       *int           `yaml:"pressure,omitempty"`
+	// PressureSchedule overrides Pressure during local time windows,
+	// e.g. quiet hours. The first matching window wins.
+	PressureSchedule []PressureWindow `yaml:"pressure_schedule,omitempty"`
+	// PressureScale is MaxPressure once pressure values use the 0-10 scale.
+	// Without it, values of 0-4 are read from the old 0-4 scale (see
+	// migratePressureScale).
+	PressureScale int `yaml:"pressure_scale,omitempty"`
+	// NudgeOutput selects the stream for human-readable suggest output
+	// (stdout or stderr). JSON output always goes to stdout.
+	NudgeOutput string `yaml:"nudge_output,omitempty"`
+	// MutedAuthors lists authors hidden from the feed (see MuteAuthor).
+	MutedAuthors []string `yaml:"muted_authors,omitempty"`
+	// MaxPostLength caps new posts, replies, and edits (see GetMaxPostLength).
+	MaxPostLength int `yaml:"max_post_length,omitempty"`
+	// MaxPostsPerMinute caps posts and replies per identity within
+	// PostRateWindow; zero or negative disables the limit.
+	MaxPostsPerMinute int `yaml:"max_posts_per_minute,omitempty"`
+	// FeedLimits sets when smoke doctor flags the feed as oversized.
+	FeedLimits FeedLimits `yaml:"feed_limits,omitempty"`
+	// LogRedactPatterns are extra regular expressions scrubbed from command
+	// arguments before they are written to smoke.log.
+	LogRedactPatterns []string `yaml:"log_redact_patterns,omitempty"`
+	// FeedScope selects the shared feed ("global", the default) or one feed
+	// per project ("project"); see GetFeedScope.
+	FeedScope string `yaml:"feed_scope,omitempty"`
+	// SyncPath is the shared feed smoke sync uses when --path is not given.
+	SyncPath string `yaml:"sync_path,omitempty"`
+	// ProjectIdentities maps a project to the name smoke identity set saved
+	// for it (see GetProjectIdentityName).
+	ProjectIdentities map[string]string `yaml:"project_identities,omitempty"`
+	// Templates maps a name to a Go text/template for smoke post --template,
+	// e.g. status: "Done: {{.done}}. Next: {{.next}}".
+	Templates map[string]string `yaml:"templates,omitempty"`
+
+	// SkippedStyleModes names the style_modes entries from config.yaml that
+	// were ignored for lacking a name or hint, e.g. "style_modes.deep-in-it[1]".
+	SkippedStyleModes []string `yaml:"-"`
 }
 
+// PressureWindow forces a pressure level between two local wall-clock times.
+// Start and End use 24-hour "HH:MM"; a window whose End is before its Start
+// spans midnight, so 22:00-08:00 covers the night.
+type PressureWindow struct {
+	Start    string `yaml:"start"`
+	End      string `yaml:"end"`
+	Pressure int    `yaml:"pressure"`
+}
+
+// Contains reports whether the wall-clock time of now falls in the window.
+// Windows with unparseable times, equal Start and End, or an out-of-range
+// pressure never match.
+func (w PressureWindow) Contains(now time.Time) bool {
+	if w.Pressure < 0 || w.Pressure > MaxPressure {
+		return false
+	}
+	start, okStart := parseClockMinutes(w.Start)
+	end, okEnd := parseClockMinutes(w.End)
+	if !okStart || !okEnd || start == end {
+		return false
+	}
+	minute := now.Hour()*60 + now.Minute()
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// parseClockMinutes converts "HH:MM" to minutes after midnight.
+func parseClockMinutes(s string) (int, bool) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// Nudge output channels for SuggestConfig.NudgeOutput
+const (
+	NudgeOutputStdout = "stdout"
+	NudgeOutputStderr = "stderr"
+)
+
 // mergeSuggestConfig merges user config into the default config.
-// User contexts override defaults; user examples extend defaults.
+// User contexts override defaults; user examples extend defaults. User style
+// modes extend defaults, replacing a default of the same name.
 func mergeSuggestConfig(cfg *SuggestConfig, userCfg *SuggestConfig) {
 	for name, ctx := range userCfg.Contexts {
 		cfg.Contexts[name] = ctx
@@ -62,16 +162,106 @@ func mergeSuggestConfig(cfg *SuggestConfig, userCfg *SuggestConfig) {
 	}
 
 	for key, modes := range userCfg.StyleModes {
-		if _, exists := cfg.StyleModes[key]; exists {
-			cfg.StyleModes[key] = append(cfg.StyleModes[key], modes...)
-		} else {
-			cfg.StyleModes[key] = modes
+		for i, mode := range modes {
+			if strings.TrimSpace(mode.Name) == "" || strings.TrimSpace(mode.Hint) == "" {
+				cfg.SkippedStyleModes = append(cfg.SkippedStyleModes, fmt.Sprintf("style_modes.%s[%d]", key, i))
+				continue
+			}
+			cfg.StyleModes[key] = mergeStyleMode(cfg.StyleModes[key], mode)
+		}
+	}
+	sort.Strings(cfg.SkippedStyleModes)
+
+	for example, weight := range userCfg.ExampleWeights {
+		if cfg.ExampleWeights == nil {
+			cfg.ExampleWeights = make(map[string]int)
 		}
+		cfg.ExampleWeights[example] = weight
 	}
 
 	if userCfg.Pressure != nil {
 		cfg.Pressure = userCfg.Pressure
 	}
+
+	if userCfg.PressureSchedule != nil {
+		cfg.PressureSchedule = userCfg.PressureSchedule
+	}
+
+	if userCfg.NudgeOutput != "" {
+		cfg.NudgeOutput = userCfg.NudgeOutput
+	}
+
+	if userCfg.MutedAuthors != nil {
+		cfg.MutedAuthors = userCfg.MutedAuthors
+	}
+
+	if userCfg.MaxPostLength != 0 {
+		cfg.MaxPostLength = userCfg.MaxPostLength
+	}
+
+	if userCfg.MaxPostsPerMinute != 0 {
+		cfg.MaxPostsPerMinute = userCfg.MaxPostsPerMinute
+	}
+
+	if userCfg.FeedLimits != (FeedLimits{}) {
+		cfg.FeedLimits = userCfg.FeedLimits
+	}
+
+	if userCfg.LogRedactPatterns != nil {
+		cfg.LogRedactPatterns = userCfg.LogRedactPatterns
+	}
+
+	if userCfg.FeedScope != "" {
+		cfg.FeedScope = userCfg.FeedScope
+	}
+
+	if userCfg.SyncPath != "" {
+		cfg.SyncPath = userCfg.SyncPath
+	}
+
+	if userCfg.ProjectIdentities != nil {
+		cfg.ProjectIdentities = userCfg.ProjectIdentities
+	}
+
+	if userCfg.Templates != nil {
+		cfg.Templates = userCfg.Templates
+	}
+}
+
+// mergeStyleMode adds mode to modes, replacing an entry with the same name.
+func mergeStyleMode(modes []StyleMode, mode StyleMode) []StyleMode {
+	for i, existing := range modes {
+		if strings.EqualFold(existing.Name, mode.Name) {
+			modes[i] = mode
+			return modes
+		}
+	}
+	return append(modes, mode)
+}
+
+// ExampleWeight returns the weight set for example in example_weights.
+// Unlisted examples, and weights below 1, weigh 1.
+func (c *SuggestConfig) ExampleWeight(example string) int {
+	return max(c.ExampleWeights[example], 1)
+}
+
+// GetNudgeOutput returns the configured nudge output channel.
+// Unset or unrecognized values fall back to stdout.
+func (c *SuggestConfig) GetNudgeOutput() string {
+	if c.NudgeOutput == NudgeOutputStderr {
+		return NudgeOutputStderr
+	}
+	return NudgeOutputStdout
+}
+
+// GetMaxPostLength returns the configured post length limit.
+// Unset values, and values outside MinMaxPostLength..MaxPostLengthCeiling,
+// fall back to DefaultMaxPostLength.
+func (c *SuggestConfig) GetMaxPostLength() int {
+	if c.MaxPostLength < MinMaxPostLength || c.MaxPostLength > MaxPostLengthCeiling {
+		return DefaultMaxPostLength
+	}
+	return c.MaxPostLength
 }
 
 // LoadSuggestConfig loads suggest configuration from the main config file.
@@ -121,6 +311,7 @@ func LoadSuggestConfig() *SuggestConfig {
 		userCfg.StyleModes = make(map[string][]StyleMode)
 	}
 
+	userCfg.migratePressureScale()
 	mergeSuggestConfig(cfg, &userCfg)
 	return cfg
 }
@@ -193,6 +384,26 @@ func (c *SuggestConfig) ListContextNames() []string {
 var defaultSuggestConfigContent = `# Smoke configuration — break room rules apply
 # Customize contexts and examples for smoke suggest --context=<name>
 
+# Pressure levels in this file use the 0-10 scale (older files used 0-4).
+pressure_scale: 10
+
+# Quiet hours: force a pressure level during local time windows
+# (first match wins; windows may span midnight). Uncomment to enable.
+# pressure_schedule:
+#   - start: "22:00"
+#     end: "08:00"
+#     pressure: 0
+
+# Longest post, reply, or edit allowed (50-4000, default 280).
+# max_post_length: 280
+
+# smoke doctor warns when the feed grows past these limits; doctor --fix
+# compacts it, keeping only the newest keep_posts posts when set.
+# feed_limits:
+#   max_size_mb: 10
+#   max_posts: 20000
+#   keep_posts: 5000
+
 # Contexts define when to nudge and what kind of post to inspire
 contexts:
   deep-in-it:
@@ -444,37 +655,122 @@ examples:
 // contexts and examples. This is used by `smoke init` to seed the config file.
 func DefaultSuggestConfigYAML() string { return defaultSuggestConfigContent }
 
-// GetPressure returns the current pressure level from config.
+// GetLogRedactPatterns returns log_redact_patterns from config.yaml.
+func GetLogRedactPatterns() []string {
+	return LoadSuggestConfig().LogRedactPatterns
+}
+
+// GetSyncPath returns sync_path from config.yaml, with a leading ~/
+// expanded to the home directory.
+func GetSyncPath() string {
+	path := LoadSuggestConfig().SyncPath
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
+
+// GetMaxPostLength returns the max_post_length from config.yaml.
+// Returns DefaultMaxPostLength (280) if unset or out of range.
+func GetMaxPostLength() int {
+	return LoadSuggestConfig().GetMaxPostLength()
+}
+
+// GetMaxPostsPerMinute returns the configured post rate limit, or 0 when
+// posting is not rate limited.
+func GetMaxPostsPerMinute() int {
+	return max(0, LoadSuggestConfig().MaxPostsPerMinute)
+}
+
+// GetPressure returns the current pressure level from config, applying the
+// pressure schedule for the local clock.
 // Returns DefaultPressure (2) if not set in config file.
 func GetPressure() int {
-	cfg := LoadSuggestConfig()
+	return GetScheduledPressure(time.Now())
+}
+
+// GetScheduledPressure returns the pressure in effect at now: SMOKE_PRESSURE
+// when set, else the first matching PressureSchedule window, else the static
+// pressure from config.
+func GetScheduledPressure(now time.Time) int {
+	if level, ok := PressureFromEnv(); ok {
+		return level
+	}
+	return LoadSuggestConfig().PressureAt(now)
+}
+
+// PressureAt returns the pressure in effect at now for this config.
+// Returns DefaultPressure (2) if no window matches and pressure is unset.
+func (c *SuggestConfig) PressureAt(now time.Time) int {
+	for _, window := range c.PressureSchedule {
+		if window.Contains(now) {
+			return window.Pressure
+		}
+	}
 
 	// If pressure is not set, return default
-	if cfg.Pressure == nil {
+	if c.Pressure == nil {
 		return DefaultPressure
 	}
 
-	pressure := *cfg.Pressure
+	pressure := *c.Pressure
 
 	// Validate range - out of range values use default
-	if pressure < 0 || pressure > 4 {
+	if pressure < 0 || pressure > MaxPressure {
 		return DefaultPressure
 	}
 
 	return pressure
 }
 
-// SetPressure sets the pressure level in config, clamping to valid range (0-4).
-// Only the raw user config is read and written back — built-in defaults are
-// never persisted, which prevents example duplication on repeated calls.
+// SetPressure sets the pressure level in config, clamping to valid range (0-10).
 func SetPressure(n int) error {
-	if n < 0 {
-		n = 0
+	n = max(0, min(n, MaxPressure))
+
+	return updateUserConfig(func(raw *SuggestConfig) {
+		raw.Pressure = &n
+	})
+}
+
+// migratePressureScale moves pressure values from the old 0-4 scale to
+// 0-MaxPressure, rounding to the nearest level. It only runs on configs
+// without pressure_scale, and leaves values above 4 alone since those were
+// already written on the new scale.
+func (c *SuggestConfig) migratePressureScale() {
+	if c.PressureScale == MaxPressure {
+		return
 	}
-	if n > 4 {
-		n = 4
+	if c.Pressure != nil {
+		scaled := scaleLegacyPressure(*c.Pressure)
+		c.Pressure = &scaled
 	}
+	for i := range c.PressureSchedule {
+		c.PressureSchedule[i].Pressure = scaleLegacyPressure(c.PressureSchedule[i].Pressure)
+	}
+	c.PressureScale = MaxPressure
+}
+
+// scaleLegacyPressure maps a 0-4 pressure to 0-MaxPressure (1 becomes 3,
+// 2 becomes 5, 3 becomes 8). Other values are returned unchanged.
+func scaleLegacyPressure(n int) int {
+	if n < 0 || n > legacyMaxPressure {
+		return n
+	}
+	return (n*MaxPressure + legacyMaxPressure/2) / legacyMaxPressure
+}
 
+// UpdateUserConfig applies update to config.yaml the way the config setters
+// do, for callers outside this package such as smoke config set.
+func UpdateUserConfig(update func(raw *SuggestConfig)) error {
+	return updateUserConfig(update)
+}
+
+// updateUserConfig applies update to the raw user config.yaml and writes it
+// back. Only the raw user config is read and written — built-in defaults are
+// never persisted, which prevents example duplication on repeated calls.
+func updateUserConfig(update func(raw *SuggestConfig)) error {
 	path, err := GetConfigPath()
 	if err != nil {
 		return fmt.Errorf("failed to get config path: %w", err)
@@ -492,7 +788,8 @@ func SetPressure(n int) error {
 		return fmt.Errorf("failed to read config: %w", readErr)
 	}
 
-	raw.Pressure = &n
+	raw.migratePressureScale()
+	update(&raw)
 
 	data, err = yaml.Marshal(&raw)
 	if err != nil {
@@ -507,14 +804,7 @@ func SetPressure(n int) error {
 }
 
 // GetPressureLevel returns the PressureLevel for a given pressure value.
-// Clamps the value to valid range (0-4) before lookup.
+// Clamps the value to valid range (0-10) before lookup.
 func GetPressureLevel(n int) PressureLevel {
-	// Clamp to valid range
-	if n < 0 {
-		n = 0
-	}
-	if n > 4 {
-		n = 4
-	}
-	return pressureLevels[n]
+	return pressureLevels[max(0, min(n, MaxPressure))]
 }
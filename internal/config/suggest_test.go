@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
+	"time"
+	_ "time/tzdata"
 
 	"gopkg.in/yaml.v3"
 )
@@ -305,6 +308,56 @@ examples:
 	}
 }
 
+func TestLoadSuggestConfig_StyleModes(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	configContent := `
+style_modes:
+  deep-in-it:
+    - name: "war story"
+      hint: "Tell it like a postmortem"
+    - name: ""
+      hint: "no name"
+  standup:
+    - name: "standup"
+      hint: "One line on what you are doing"
+    - name: "no hint"
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(configContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+
+	defaults := &SuggestConfig{}
+	if err := yaml.Unmarshal([]byte(defaultSuggestConfigContent), defaults); err != nil {
+		t.Fatal(err)
+	}
+	cfg := LoadSuggestConfig()
+
+	deep := cfg.StyleModes["deep-in-it"]
+	if len(deep) != len(defaults.StyleModes["deep-in-it"]) {
+		t.Errorf("deep-in-it has %d modes, want %d (override replaces, not appends)", len(deep), len(defaults.StyleModes["deep-in-it"]))
+	}
+	for _, m := range deep {
+		if m.Name == "war story" && m.Hint != "Tell it like a postmortem" {
+			t.Errorf("war story hint = %q, want the override", m.Hint)
+		}
+	}
+
+	standup := cfg.StyleModes["standup"]
+	if len(standup) != 1 || standup[0].Name != "standup" {
+		t.Errorf("standup modes = %v, want just the valid entry", standup)
+	}
+
+	want := []string{"style_modes.deep-in-it[1]", "style_modes.standup[1]"}
+	if !slices.Equal(cfg.SkippedStyleModes, want) {
+		t.Errorf("SkippedStyleModes = %v, want %v", cfg.SkippedStyleModes, want)
+	}
+}
+
 func TestGetPressure(t *testing.T) {
 	// Create temp config dir with no config file
 	tmpDir := t.TempDir()
@@ -341,9 +394,10 @@ func TestSetPressure(t *testing.T) {
 		{"valid low", 1, 1},
 		{"valid middle", 2, 2},
 		{"valid high", 3, 3},
-		{"valid maximum", 4, 4},
+		{"valid old maximum", 4, 4},
+		{"valid maximum", 10, 10},
 		{"clamp negative", -5, 0},
-		{"clamp too high", 10, 4},
+		{"clamp too high", 15, 10},
 	}
 
 	for _, tt := range tests {
@@ -370,15 +424,21 @@ func TestGetPressureLevel(t *testing.T) {
 		wantLabel string
 	}{
 		{0, 0, 0, "\U0001f4a4", "sleep"},
-		{1, 1, 25, "\U0001f319", "quiet"},
-		{2, 2, 50, "\u26c5", "balanced"},
-		{3, 3, 75, "\u2600\ufe0f", "bright"},
-		{4, 4, 100, "\U0001f30b", "volcanic"},
+		{1, 1, 10, "\U0001f311", "drowsy"},
+		{2, 2, 20, "\U0001f318", "hushed"},
+		{3, 3, 30, "\U0001f319", "quiet"},
+		{4, 4, 40, "\U0001f325\ufe0f", "calm"},
+		{5, 5, 50, "\u26c5", "balanced"},
+		{6, 6, 60, "\U0001f324\ufe0f", "warm"},
+		{7, 7, 70, "\U0001f31e", "sunny"},
+		{8, 8, 80, "\u2600\ufe0f", "bright"},
+		{9, 9, 90, "\U0001f525", "blazing"},
+		{10, 10, 100, "\U0001f30b", "volcanic"},
 		// Test clamping
 		{-1, 0, 0, "\U0001f4a4", "sleep"},
 		{-10, 0, 0, "\U0001f4a4", "sleep"},
-		{5, 4, 100, "\U0001f30b", "volcanic"},
-		{100, 4, 100, "\U0001f30b", "volcanic"},
+		{11, 10, 100, "\U0001f30b", "volcanic"},
+		{100, 10, 100, "\U0001f30b", "volcanic"},
 	}
 
 	for _, tt := range tests {
@@ -401,9 +461,9 @@ func TestGetPressureLevel(t *testing.T) {
 }
 
 func TestPressureLevelsCompleteness(t *testing.T) {
-	// Verify all 5 pressure levels are defined
-	if len(pressureLevels) != 5 {
-		t.Errorf("pressureLevels length = %d, want 5", len(pressureLevels))
+	// Verify every level from 0 to MaxPressure is defined
+	if len(pressureLevels) != MaxPressure+1 {
+		t.Errorf("pressureLevels length = %d, want %d", len(pressureLevels), MaxPressure+1)
 	}
 
 	// Verify each level has correct value index
@@ -417,9 +477,15 @@ func TestPressureLevelsCompleteness(t *testing.T) {
 		if level.Label == "" {
 			t.Errorf("pressureLevels[%d].Label is empty", i)
 		}
-		if level.Probability < 0 || level.Probability > 100 {
-			t.Errorf("pressureLevels[%d].Probability = %d, want 0-100", i, level.Probability)
+		if level.Probability != i*10 {
+			t.Errorf("pressureLevels[%d].Probability = %d, want %d", i, level.Probability, i*10)
 		}
+		if level.Tier < 0 || level.Tier > 4 || (i > 0 && level.Tier < pressureLevels[i-1].Tier) {
+			t.Errorf("pressureLevels[%d].Tier = %d, want 0-4 and never lower than the level below", i, level.Tier)
+		}
+	}
+	if pressureLevels[0].Tier != 0 || pressureLevels[MaxPressure].Tier != 4 {
+		t.Error("only sleep should be tier 0 and volcanic tier 4")
 	}
 }
 
@@ -518,3 +584,283 @@ examples:
 		t.Errorf("final pressure = %v, want 4", cfg.Pressure)
 	}
 }
+
+func TestPressureScaleMigration(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+	configPath := filepath.Join(configDir, "config.yaml")
+
+	// Each old 0-4 level maps to the nearest 0-10 level
+	for old, want := range []int{0, 3, 5, 8, 10} {
+		content := fmt.Sprintf("pressure: %d\n", old)
+		if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := GetPressure(); got != want {
+			t.Errorf("old pressure %d reads as %d, want %d", old, got, want)
+		}
+	}
+
+	// Values already past the old range, or marked with pressure_scale, are kept
+	for content, want := range map[string]int{
+		"pressure: 7\n":                     7,
+		"pressure_scale: 10\npressure: 2\n": 2,
+	} {
+		if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := GetPressure(); got != want {
+			t.Errorf("%q reads as %d, want %d", content, got, want)
+		}
+	}
+
+	// Writing config.yaml stores the scaled values with the new scale
+	content := `pressure: 2
+pressure_schedule:
+  - start: "22:00"
+    end: "08:00"
+    pressure: 1
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MuteAuthor("spam-bot"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw SuggestConfig
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw.PressureScale != MaxPressure || raw.Pressure == nil || *raw.Pressure != 5 {
+		t.Errorf("saved pressure_scale %d, pressure %v; want %d and 5", raw.PressureScale, raw.Pressure, MaxPressure)
+	}
+	if len(raw.PressureSchedule) != 1 || raw.PressureSchedule[0].Pressure != 3 {
+		t.Errorf("saved schedule = %+v, want pressure 3", raw.PressureSchedule)
+	}
+}
+
+func TestGetNudgeOutput(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"", NudgeOutputStdout},
+		{"stdout", NudgeOutputStdout},
+		{"stderr", NudgeOutputStderr},
+		{"bogus", NudgeOutputStdout},
+	}
+	for _, tt := range tests {
+		cfg := &SuggestConfig{NudgeOutput: tt.value}
+		if got := cfg.GetNudgeOutput(); got != tt.expected {
+			t.Errorf("GetNudgeOutput() with %q = %q, want %q", tt.value, got, tt.expected)
+		}
+	}
+}
+
+func TestPressureWindowContains(t *testing.T) {
+	night := PressureWindow{Start: "22:00", End: "08:00", Pressure: 0}
+	lunch := PressureWindow{Start: "12:00", End: "13:30", Pressure: 4}
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 6, 15, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name   string
+		window PressureWindow
+		now    time.Time
+		want   bool
+	}{
+		{"night before midnight", night, at(23, 15), true},
+		{"night at start", night, at(22, 0), true},
+		{"night after midnight", night, at(3, 0), true},
+		{"night end is exclusive", night, at(8, 0), false},
+		{"night daytime", night, at(14, 0), false},
+		{"night just before start", night, at(21, 59), false},
+		{"same-day window inside", lunch, at(12, 45), true},
+		{"same-day window end", lunch, at(13, 30), false},
+		{"same-day window before", lunch, at(11, 59), false},
+		{"equal start and end", PressureWindow{Start: "09:00", End: "09:00"}, at(9, 0), false},
+		{"bad time", PressureWindow{Start: "25:00", End: "08:00"}, at(3, 0), false},
+		{"bad pressure", PressureWindow{Start: "22:00", End: "08:00", Pressure: 11}, at(23, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Contains(tt.now); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.now.Format("15:04"), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPressureWindowContains_DST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	night := PressureWindow{Start: "22:00", End: "08:00", Pressure: 0}
+
+	// Spring forward: 2026-03-08 02:00 EST jumps to 03:00 EDT, so the night
+	// is an hour shorter and quiet hours end 7 elapsed hours after 00:00.
+	midnight := time.Date(2026, 3, 8, 0, 0, 0, 0, loc)
+	if !night.Contains(midnight.Add(7*time.Hour - time.Minute)) {
+		t.Error("07:59 EDT should still be quiet")
+	}
+	if night.Contains(midnight.Add(7 * time.Hour)) {
+		t.Error("7 elapsed hours after midnight is 08:00 EDT, past the window")
+	}
+
+	// Fall back: 2026-11-01 01:30 happens twice; both are inside quiet hours
+	firstPass := time.Date(2026, 11, 1, 1, 30, 0, 0, loc)
+	if !night.Contains(firstPass) || !night.Contains(firstPass.Add(time.Hour)) {
+		t.Error("both 01:30s on fall-back night should be quiet")
+	}
+	if got := firstPass.Add(time.Hour).Format("15:04"); got != "01:30" {
+		t.Fatalf("expected repeated wall clock, got %s", got)
+	}
+	if night.Contains(time.Date(2026, 11, 1, 8, 0, 0, 0, loc)) {
+		t.Error("window should end at 08:00 local after fall back")
+	}
+}
+
+func TestGetScheduledPressure(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+
+	content := `pressure_scale: 10
+pressure: 3
+pressure_schedule:
+  - start: "22:00"
+    end: "08:00"
+    pressure: 0
+  - start: "07:00"
+    end: "09:00"
+    pressure: 1
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	day := func(hour int) time.Time { return time.Date(2026, 6, 15, hour, 0, 0, 0, time.Local) }
+	if got := GetScheduledPressure(day(23)); got != 0 {
+		t.Errorf("23:00 pressure = %d, want 0", got)
+	}
+	if got := GetScheduledPressure(day(7)); got != 0 {
+		t.Errorf("07:00 pressure = %d, want 0 (first matching window wins)", got)
+	}
+	if got := GetScheduledPressure(day(8)); got != 1 {
+		t.Errorf("08:00 pressure = %d, want 1", got)
+	}
+	if got := GetScheduledPressure(day(14)); got != 3 {
+		t.Errorf("14:00 pressure = %d, want static 3", got)
+	}
+
+	// SetPressure keeps the schedule
+	if err := SetPressure(4); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetScheduledPressure(day(23)); got != 0 {
+		t.Errorf("after SetPressure, 23:00 pressure = %d, want 0", got)
+	}
+	if got := GetScheduledPressure(day(14)); got != 4 {
+		t.Errorf("after SetPressure, 14:00 pressure = %d, want 4", got)
+	}
+}
+
+func TestGetMaxPostLength(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+
+	if got := GetMaxPostLength(); got != DefaultMaxPostLength {
+		t.Errorf("GetMaxPostLength() without config = %d, want %d", got, DefaultMaxPostLength)
+	}
+
+	tests := []struct {
+		content string
+		want    int
+	}{
+		{"max_post_length: 500\n", 500},
+		{"max_post_length: 50\n", 50},
+		{"max_post_length: 4000\n", 4000},
+		{"max_post_length: 49\n", DefaultMaxPostLength},
+		{"max_post_length: -10\n", DefaultMaxPostLength},
+		{"max_post_length: 4001\n", DefaultMaxPostLength},
+		{"pressure: 3\n", DefaultMaxPostLength},
+	}
+	for _, tt := range tests {
+		if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(tt.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := GetMaxPostLength(); got != tt.want {
+			t.Errorf("GetMaxPostLength() with %q = %d, want %d", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestGetMaxPostsPerMinute(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+
+	if got := GetMaxPostsPerMinute(); got != 0 {
+		t.Errorf("GetMaxPostsPerMinute() without config = %d, want 0 (disabled)", got)
+	}
+
+	tests := []struct {
+		content string
+		want    int
+	}{
+		{"max_posts_per_minute: 5\n", 5},
+		{"max_posts_per_minute: 0\n", 0},
+		{"max_posts_per_minute: -3\n", 0},
+		{"pressure: 3\n", 0},
+	}
+	for _, tt := range tests {
+		if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(tt.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := GetMaxPostsPerMinute(); got != tt.want {
+			t.Errorf("GetMaxPostsPerMinute() with %q = %d, want %d", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestGetLogRedactPatterns(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".config", "smoke")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", tmpDir)
+
+	if got := GetLogRedactPatterns(); got != nil {
+		t.Errorf("GetLogRedactPatterns() without config = %v, want nil", got)
+	}
+
+	content := "log_redact_patterns:\n  - 'ACME-[0-9]{6}'\n"
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got := GetLogRedactPatterns()
+	if len(got) != 1 || got[0] != "ACME-[0-9]{6}" {
+		t.Errorf("GetLogRedactPatterns() = %v, want [ACME-[0-9]{6}]", got)
+	}
+}
@@ -15,6 +15,58 @@ type TUIConfig struct {
 	Contrast    string `yaml:"contrast"`
 	Layout      string `yaml:"layout"`
 	AutoRefresh bool   `yaml:"auto_refresh"`
+	// Appearance picks the light or dark side of theme colors: "auto"
+	// detects the terminal background, "light" and "dark" force one.
+	Appearance string `yaml:"appearance,omitempty"`
+	// RememberPosition restores the last selected post on launch instead of
+	// starting at the unread boundary. Unset means remembered; use
+	// PositionRemembered to read it.
+	RememberPosition *bool `yaml:"remember_position,omitempty"`
+	// MaxReplies caps replies shown per thread in the feed (0 = all).
+	MaxReplies int `yaml:"max_replies,omitempty"`
+	// TimeFormat picks how post times are shown: "clock" (14:32),
+	// "relative" (5m ago), or "datetime" (2026-01-30 14:32).
+	TimeFormat string `yaml:"time_format,omitempty"`
+	// Clock24h picks the 24-hour (true) or 12-hour (false) clock. Unset
+	// follows the locale, which is 24-hour for most.
+	Clock24h *bool `yaml:"clock_24h,omitempty"`
+	// Timezone shows times in an IANA zone such as "UTC" or
+	// "America/New_York" instead of local time.
+	Timezone string `yaml:"timezone,omitempty"`
+	// ShowStatusBar shows the keybinding legend below the feed. Unset means
+	// shown; use StatusBarShown to read it.
+	ShowStatusBar *bool `yaml:"show_status_bar,omitempty"`
+	// NotifyAuthors rings the terminal bell and flashes the header when one
+	// of these authors posts. Entries match like mutes, e.g. "swift-fox".
+	NotifyAuthors []string `yaml:"notify_authors,omitempty"`
+	// CollapseLines collapses posts that render to more lines than this in
+	// the TUI until expanded with o. Unset means DefaultCollapseLines and a
+	// negative value never collapses; use CollapseThreshold to read it.
+	CollapseLines int `yaml:"collapse_lines,omitempty"`
+}
+
+// CollapseThreshold returns the line count above which posts are
+// collapsed, or 0 when posts are never collapsed.
+func (c *TUIConfig) CollapseThreshold() int {
+	switch {
+	case c == nil || c.CollapseLines == 0:
+		return DefaultCollapseLines
+	case c.CollapseLines < 0:
+		return 0
+	default:
+		return c.CollapseLines
+	}
+}
+
+// StatusBarShown reports whether the status bar is enabled (the default).
+func (c *TUIConfig) StatusBarShown() bool {
+	return c == nil || c.ShowStatusBar == nil || *c.ShowStatusBar
+}
+
+// PositionRemembered reports whether the TUI reopens at the last selected
+// post (the default).
+func (c *TUIConfig) PositionRemembered() bool {
+	return c == nil || c.RememberPosition == nil || *c.RememberPosition
 }
 
 // Default values - must match feed.DefaultThemeName and feed.DefaultContrastName
@@ -28,10 +80,19 @@ func GetTUIConfigPath() (string, error) {
 	return filepath.Join(configDir, DefaultTUIConfigFile), nil
 }
 
-// LoadTUIConfig loads TUI configuration from disk.
+// LoadTUIConfig loads TUI configuration from disk, then applies the
+// SMOKE_THEME, SMOKE_LAYOUT, SMOKE_CONTRAST, and SMOKE_AUTO_REFRESH overrides.
 // Returns default config if file doesn't exist, is empty, or is invalid.
 // Never returns an error - gracefully handles all failure cases with defaults.
 func LoadTUIConfig() *TUIConfig {
+	cfg := LoadTUIConfigFile()
+	applyTUIEnvOverrides(cfg)
+	return cfg
+}
+
+// LoadTUIConfigFile loads tui.yaml without env overrides, for reading and
+// changing what the file itself says.
+func LoadTUIConfigFile() *TUIConfig {
 	path, err := GetTUIConfigPath()
 	if err != nil {
 		return defaultTUIConfig()
@@ -48,6 +109,8 @@ func LoadTUIConfig() *TUIConfig {
 		return defaultTUIConfig()
 	}
 
+	data = migrateTUIConfigData(data)
+
 	var cfg TUIConfig
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		// JSON/YAML is invalid - return defaults
@@ -64,6 +127,12 @@ func LoadTUIConfig() *TUIConfig {
 	if cfg.Layout == "" {
 		cfg.Layout = DefaultLayout
 	}
+	if cfg.Appearance == "" {
+		cfg.Appearance = DefaultAppearance
+	}
+	if cfg.TimeFormat == "" {
+		cfg.TimeFormat = DefaultTimeFormat
+	}
 	// AutoRefresh defaults to true (bool zero value is false, so we need special handling)
 	// We use a sentinel approach: if the file was parsed but AutoRefresh is false,
 	// we check if it was explicitly set or just the default. For simplicity,
@@ -72,8 +141,32 @@ func LoadTUIConfig() *TUIConfig {
 	return &cfg
 }
 
-// SaveTUIConfig saves TUI configuration to disk.
+// migrateTUIConfigData rewrites renamed keys in raw tui.yaml so older files
+// keep their settings. Data without renamed keys is returned unchanged.
+func migrateTUIConfigData(data []byte) []byte {
+	var parsed map[string]any
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return data
+	}
+	if len(MigrateRenamedTUIKeys(parsed)) == 0 {
+		return data
+	}
+	migrated, err := yaml.Marshal(parsed)
+	if err != nil {
+		return data
+	}
+	return migrated
+}
+
+// SaveTUIConfig saves TUI configuration to disk. Settings still at their
+// env override values are saved with their values from the file instead.
 func SaveTUIConfig(cfg *TUIConfig) error {
+	return SaveTUIConfigFile(withoutTUIEnvOverrides(cfg, LoadTUIConfigFile()))
+}
+
+// SaveTUIConfigFile writes cfg to tui.yaml as is. Use it with a config from
+// LoadTUIConfigFile; SaveTUIConfig is for configs that include env overrides.
+func SaveTUIConfigFile(cfg *TUIConfig) error {
 	path, err := GetTUIConfigPath()
 	if err != nil {
 		return err
@@ -94,5 +187,7 @@ func defaultTUIConfig() *TUIConfig {
 		Contrast:    DefaultContrast,
 		Layout:      DefaultLayout,
 		AutoRefresh: DefaultAutoRefresh,
+		Appearance:  DefaultAppearance,
+		TimeFormat:  DefaultTimeFormat,
 	}
 }
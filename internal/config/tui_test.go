@@ -194,6 +194,53 @@ func TestLoadTUIConfig_PartialFields(t *testing.T) {
 	}
 }
 
+func TestLoadTUIConfig_ShowStatusBar(t *testing.T) {
+	origHome := os.Getenv("HOME")
+	tmpHome := t.TempDir()
+	os.Setenv("HOME", tmpHome)
+	defer os.Setenv("HOME", origHome)
+
+	smokeDir := filepath.Join(tmpHome, ".config", "smoke")
+	if err := os.MkdirAll(smokeDir, 0755); err != nil {
+		t.Fatalf("Failed to create smoke dir: %v", err)
+	}
+	configPath := filepath.Join(smokeDir, DefaultTUIConfigFile)
+
+	tests := []struct {
+		yaml string
+		want bool
+	}{
+		{"theme: dracula\n", true},
+		{"show_status_bar: true\n", true},
+		{"show_status_bar: false\n", false},
+	}
+	for _, tt := range tests {
+		if err := os.WriteFile(configPath, []byte(tt.yaml), 0644); err != nil {
+			t.Fatalf("Failed to write config file: %v", err)
+		}
+		if got := LoadTUIConfig().StatusBarShown(); got != tt.want {
+			t.Errorf("StatusBarShown() with %q = %v, want %v", tt.yaml, got, tt.want)
+		}
+	}
+
+	if !defaultTUIConfig().StatusBarShown() {
+		t.Error("status bar should be shown by default")
+	}
+}
+
+func TestLoadTUIConfig_RememberPosition(t *testing.T) {
+	for yaml, want := range map[string]bool{
+		"theme: dracula\n":           true,
+		"remember_position: true\n":  true,
+		"remember_position: false\n": false,
+	} {
+		setupValidateHome(t, yaml, "")
+		if got := LoadTUIConfig().PositionRemembered(); got != want {
+			t.Errorf("PositionRemembered() with %q = %v, want %v", yaml, got, want)
+		}
+	}
+}
+
 func TestSaveTUIConfig(t *testing.T) {
 	// Save and restore HOME env var
 	origHome := os.Getenv("HOME")
@@ -352,3 +399,20 @@ func TestLoadTUIConfig_RoundTrip(t *testing.T) {
 		t.Errorf("Round-trip contrast mismatch: saved %q, loaded %q", original.Contrast, loaded.Contrast)
 	}
 }
+
+func TestCollapseThreshold(t *testing.T) {
+	tests := []struct {
+		cfg  *TUIConfig
+		want int
+	}{
+		{nil, DefaultCollapseLines},
+		{&TUIConfig{}, DefaultCollapseLines},
+		{&TUIConfig{CollapseLines: 5}, 5},
+		{&TUIConfig{CollapseLines: -1}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.cfg.CollapseThreshold(); got != tt.want {
+			t.Errorf("CollapseThreshold(%+v) = %d, want %d", tt.cfg, got, tt.want)
+		}
+	}
+}
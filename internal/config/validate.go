@@ -0,0 +1,136 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ValidNames lists the accepted values for tui.yaml settings that are
+// defined by the feed package (themes, contrast levels, layouts, and so on).
+type ValidNames struct {
+	Themes      []string
+	Contrasts   []string
+	Layouts     []string
+	Appearances []string
+	TimeFormats []string
+}
+
+// Validate checks config.yaml, tui.yaml, and the SMOKE_* setting overrides
+// for unrecognized or out-of-range values and returns one warning per problem, e.g.
+// `tui.yaml: unknown theme "neon" (using dracula)`. Loading still falls back
+// to defaults for each of them; Validate only reports. Missing files are fine.
+func Validate(names ValidNames) []string {
+	var warnings []string
+	warnings = append(warnings, validateTUIConfig(names)...)
+	warnings = append(warnings, validateSuggestConfig()...)
+	warnings = append(warnings, validateEnv(names)...)
+	return warnings
+}
+
+// validateTUIConfig reports problems in tui.yaml.
+func validateTUIConfig(names ValidNames) []string {
+	path, err := GetTUIConfigPath()
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+
+	var parsed map[string]any
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return []string{fmt.Sprintf("%s: invalid YAML, using defaults: %v", DefaultTUIConfigFile, err)}
+	}
+	var warnings []string
+	for _, oldKey := range MigrateRenamedTUIKeys(parsed) {
+		warnings = append(warnings, fmt.Sprintf("%s: %q was renamed to %q (run 'smoke doctor --fix' to update)",
+			DefaultTUIConfigFile, oldKey, RenamedTUIKey(oldKey)))
+	}
+
+	var cfg TUIConfig
+	if err := yaml.Unmarshal(migrateTUIConfigData(data), &cfg); err != nil {
+		return append(warnings, fmt.Sprintf("%s: invalid value, using defaults: %v", DefaultTUIConfigFile, err))
+	}
+
+	checkName := func(key, value, fallback string, valid []string) {
+		if value != "" && len(valid) > 0 && !slices.Contains(valid, value) {
+			warnings = append(warnings, fmt.Sprintf("%s: unknown %s %q (using %s)", DefaultTUIConfigFile, key, value, fallback))
+		}
+	}
+	checkName("theme", cfg.Theme, DefaultTheme, names.Themes)
+	checkName("contrast", cfg.Contrast, DefaultContrast, names.Contrasts)
+	checkName("layout", cfg.Layout, DefaultLayout, names.Layouts)
+	checkName("appearance", cfg.Appearance, DefaultAppearance, names.Appearances)
+	checkName("time_format", cfg.TimeFormat, DefaultTimeFormat, names.TimeFormats)
+
+	if cfg.MaxReplies < 0 {
+		warnings = append(warnings, fmt.Sprintf("%s: max_replies %d is negative (showing all replies)", DefaultTUIConfigFile, cfg.MaxReplies))
+	}
+	if cfg.Timezone != "" {
+		if _, err := time.LoadLocation(cfg.Timezone); err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: unknown timezone %q (using local time)", DefaultTUIConfigFile, cfg.Timezone))
+		}
+	}
+	return warnings
+}
+
+// validateSuggestConfig reports problems in config.yaml. Invalid YAML is
+// left to LoadSuggestConfig, which already warns about it.
+func validateSuggestConfig() []string {
+	path, err := GetConfigPath()
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	var cfg SuggestConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil
+	}
+	cfg.migratePressureScale()
+
+	var warnings []string
+	warn := func(format string, args ...any) {
+		warnings = append(warnings, DefaultConfigFile+": "+fmt.Sprintf(format, args...))
+	}
+
+	if cfg.Pressure != nil && (*cfg.Pressure < 0 || *cfg.Pressure > MaxPressure) {
+		warn("pressure %d is out of range 0-%d (using %d)", *cfg.Pressure, MaxPressure, DefaultPressure)
+	}
+	for i, window := range cfg.PressureSchedule {
+		_, okStart := parseClockMinutes(window.Start)
+		_, okEnd := parseClockMinutes(window.End)
+		if !okStart || !okEnd {
+			warn("pressure_schedule[%d] needs HH:MM start and end times (window ignored)", i)
+		}
+		if window.Pressure < 0 || window.Pressure > MaxPressure {
+			warn("pressure_schedule[%d] pressure %d is out of range 0-%d", i, window.Pressure, MaxPressure)
+		}
+	}
+	if cfg.MaxPostLength != 0 && (cfg.MaxPostLength < MinMaxPostLength || cfg.MaxPostLength > MaxPostLengthCeiling) {
+		warn("max_post_length %d is out of range %d-%d (using %d)",
+			cfg.MaxPostLength, MinMaxPostLength, MaxPostLengthCeiling, DefaultMaxPostLength)
+	}
+	if cfg.NudgeOutput != "" && cfg.NudgeOutput != NudgeOutputStdout && cfg.NudgeOutput != NudgeOutputStderr {
+		warn("unknown nudge_output %q (using %s)", cfg.NudgeOutput, NudgeOutputStdout)
+	}
+	for _, name := range slices.Sorted(maps.Keys(cfg.Templates)) {
+		if _, err := template.New(name).Parse(cfg.Templates[name]); err != nil {
+			warn("template %q does not parse: %v", name, err)
+		}
+	}
+	if cfg.FeedScope != "" && !strings.EqualFold(cfg.FeedScope, FeedScopeGlobal) && !strings.EqualFold(cfg.FeedScope, FeedScopeProject) {
+		warn("unknown feed_scope %q (using %s)", cfg.FeedScope, FeedScopeGlobal)
+	}
+	return warnings
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setupValidateHome points HOME at a temp dir with tui.yaml and config.yaml
+// holding the given contents (skipped when empty).
+func setupValidateHome(t *testing.T, tuiYAML, configYAML string) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	dir, err := GetConfigDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{DefaultTUIConfigFile: tuiYAML, DefaultConfigFile: configYAML} {
+		if content == "" {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+var testValidNames = ValidNames{
+	Themes:    []string{"dracula", "nord"},
+	Contrasts: []string{"medium", "high"},
+	Layouts:   []string{"dense", "comfy", "relaxed"},
+}
+
+func TestValidate_UnknownThemeWarnsAndFallsBack(t *testing.T) {
+	setupValidateHome(t, "theme: neon\nlayout: dense\n", "")
+
+	warnings := Validate(testValidNames)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `unknown theme "neon"`) {
+		t.Fatalf("Validate() = %q, want one unknown theme warning", warnings)
+	}
+
+	// Loading still works; the TUI resolves the unknown name to its default.
+	cfg := LoadTUIConfig()
+	if cfg.Theme != "neon" || cfg.Layout != "dense" {
+		t.Errorf("LoadTUIConfig() = %+v, want theme neon and layout dense", cfg)
+	}
+}
+
+func TestValidate_OutOfRangeValues(t *testing.T) {
+	setupValidateHome(t, "max_replies: -2\ntimezone: Mars/Olympus\n",
+		"pressure_scale: 10\npressure: 42\nmax_post_length: 10\nnudge_output: printer\nfeed_scope: galaxy\n"+
+			"pressure_schedule:\n  - start: \"25:00\"\n    end: \"08:00\"\n    pressure: 0\n")
+
+	got := strings.Join(Validate(testValidNames), "\n")
+	for _, want := range []string{
+		"max_replies -2",
+		`unknown timezone "Mars/Olympus"`,
+		"pressure 42 is out of range 0-10",
+		"pressure_schedule[0] needs HH:MM",
+		"max_post_length 10 is out of range 50-4000",
+		`unknown nudge_output "printer"`,
+		`unknown feed_scope "galaxy"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Validate() missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestValidate_CleanConfig(t *testing.T) {
+	setupValidateHome(t, "theme: nord\ncontrast: high\nlayout: comfy\n", "pressure: 3\nmax_post_length: 500\n")
+
+	if warnings := Validate(testValidNames); len(warnings) != 0 {
+		t.Errorf("Validate() = %q, want none", warnings)
+	}
+}
+
+func TestValidate_RenamedKey(t *testing.T) {
+	setupValidateHome(t, "style: dense\n", "")
+
+	warnings := Validate(testValidNames)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `"style" was renamed to "layout"`) {
+		t.Errorf("Validate() = %q, want a rename warning", warnings)
+	}
+	if got := LoadTUIConfig().Layout; got != "dense" {
+		t.Errorf("LoadTUIConfig().Layout = %q, want dense from the old style key", got)
+	}
+}
+
+func TestMigrateRenamedTUIKeys_KeepsCurrentKey(t *testing.T) {
+	parsed := map[string]any{"style": "dense", "layout": "relaxed"}
+
+	renamed := MigrateRenamedTUIKeys(parsed)
+
+	if len(renamed) != 1 || renamed[0] != "style" {
+		t.Errorf("renamed = %v, want [style]", renamed)
+	}
+	if parsed["layout"] != "relaxed" {
+		t.Errorf("layout = %v, want relaxed kept", parsed["layout"])
+	}
+	if _, ok := parsed["style"]; ok {
+		t.Error("style should be removed")
+	}
+}
+
+func TestUnseenConfigWarnings(t *testing.T) {
+	setupValidateHome(t, "", "")
+
+	first := UnseenConfigWarnings([]string{"a", "b"})
+	if len(first) != 2 {
+		t.Errorf("first call = %v, want both warnings", first)
+	}
+	if again := UnseenConfigWarnings([]string{"a", "b"}); len(again) != 0 {
+		t.Errorf("repeat call = %v, want none", again)
+	}
+	if added := UnseenConfigWarnings([]string{"a", "c"}); len(added) != 1 || added[0] != "c" {
+		t.Errorf("changed call = %v, want [c]", added)
+	}
+	// b was fixed, so it is reported again if it comes back.
+	if back := UnseenConfigWarnings([]string{"b"}); len(back) != 1 || back[0] != "b" {
+		t.Errorf("returning warning = %v, want [b]", back)
+	}
+}
+
+func TestValidate_BrokenTemplate(t *testing.T) {
+	setupValidateHome(t, "", "templates:\n  status: \"Done: {{.done\"\n  ok: \"{{.x}}\"\n")
+
+	warnings := Validate(testValidNames)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `template "status" does not parse`) {
+		t.Errorf("Validate() = %q, want one template warning", warnings)
+	}
+}
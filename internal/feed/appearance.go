@@ -0,0 +1,114 @@
+package feed
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Appearance values for TUIConfig.Appearance.
+const (
+	// AppearanceAuto detects the terminal background
+	AppearanceAuto = "auto"
+	// AppearanceLight uses the Light side of theme colors
+	AppearanceLight = "light"
+	// AppearanceDark uses the Dark side of theme colors
+	AppearanceDark = "dark"
+)
+
+// AllAppearances lists appearances in the order the D key cycles them.
+var AllAppearances = []string{AppearanceAuto, AppearanceLight, AppearanceDark}
+
+// darkAppearance records the side chosen by the last ApplyAppearance, for
+// rendering that does not go through lipgloss (share card images).
+var darkAppearance atomic.Bool
+
+func init() {
+	darkAppearance.Store(true)
+}
+
+// detectedDark caches auto-detection: the terminal can only be queried
+// before the TUI takes over stdin.
+var detectedDark = sync.OnceValue(func() bool {
+	if dark, ok := DetectDarkBackground(os.Getenv); ok {
+		return dark
+	}
+	return lipgloss.HasDarkBackground()
+})
+
+// DetectDarkBackground reads COLORFGBG ("fg;bg" or "fg;default;bg", set by
+// rxvt, Konsole, and others) and reports whether the background is dark.
+// ok is false when the variable is missing or its background is not one of
+// the 16 ANSI colors.
+func DetectDarkBackground(getenv func(string) string) (dark, ok bool) {
+	value := getenv("COLORFGBG")
+	if value == "" {
+		return false, false
+	}
+	fields := strings.Split(value, ";")
+	bg, err := strconv.Atoi(strings.TrimSpace(fields[len(fields)-1]))
+	if err != nil || bg < 0 || bg > 15 {
+		return false, false
+	}
+	// White (7) and the bright colors except bright black (8) are light
+	return bg <= 6 || bg == 8, true
+}
+
+// HasDarkBackground resolves an appearance setting to dark or light.
+// Unknown values behave like AppearanceAuto.
+func HasDarkBackground(appearance string) bool {
+	switch appearance {
+	case AppearanceLight:
+		return false
+	case AppearanceDark:
+		return true
+	default:
+		return detectedDark()
+	}
+}
+
+// ApplyAppearance makes lipgloss and share cards use the side of every
+// AdaptiveColor that matches appearance, so all styles agree even where
+// lipgloss's own detection guesses wrong.
+func ApplyAppearance(appearance string) {
+	dark := HasDarkBackground(appearance)
+	darkAppearance.Store(dark)
+	lipgloss.SetHasDarkBackground(dark)
+}
+
+// NextAppearance returns the appearance after current in AllAppearances.
+// Unknown values count as AppearanceAuto.
+func NextAppearance(current string) string {
+	for i, a := range AllAppearances {
+		if a == current {
+			return AllAppearances[(i+1)%len(AllAppearances)]
+		}
+	}
+	return AllAppearances[1]
+}
+
+// appearanceLabel names an appearance for the TUI, e.g. "Auto (dark)".
+func appearanceLabel(appearance string) string {
+	side := "light"
+	if HasDarkBackground(appearance) {
+		side = "dark"
+	}
+	switch appearance {
+	case AppearanceLight, AppearanceDark:
+		return strings.ToUpper(side[:1]) + side[1:]
+	default:
+		return "Auto (" + side + ")"
+	}
+}
+
+// adaptiveHex returns the side of c chosen by the last ApplyAppearance.
+func adaptiveHex(c lipgloss.AdaptiveColor) string {
+	if darkAppearance.Load() {
+		return c.Dark
+	}
+	return c.Light
+}
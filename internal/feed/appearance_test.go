@@ -0,0 +1,105 @@
+package feed
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func stubEnv(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestDetectDarkBackground(t *testing.T) {
+	tests := []struct {
+		name      string
+		colorfgbg string
+		wantDark  bool
+		wantOK    bool
+	}{
+		{"unset", "", false, false},
+		{"black background", "15;0", true, true},
+		{"white background", "0;15", false, true},
+		{"ansi white background", "0;7", false, true},
+		{"bright black background", "15;8", true, true},
+		{"blue background", "7;4", true, true},
+		{"three fields", "0;default;15", false, true},
+		{"default background", "15;default", false, false},
+		{"out of range", "0;42", false, false},
+		{"garbage", "light", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dark, ok := DetectDarkBackground(stubEnv(map[string]string{"COLORFGBG": tt.colorfgbg}))
+			if dark != tt.wantDark || ok != tt.wantOK {
+				t.Errorf("DetectDarkBackground(%q) = (%v, %v), want (%v, %v)",
+					tt.colorfgbg, dark, ok, tt.wantDark, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestHasDarkBackground_Forced(t *testing.T) {
+	if HasDarkBackground(AppearanceLight) {
+		t.Error("HasDarkBackground(light) should be false")
+	}
+	if !HasDarkBackground(AppearanceDark) {
+		t.Error("HasDarkBackground(dark) should be true")
+	}
+}
+
+func TestNextAppearance(t *testing.T) {
+	tests := map[string]string{
+		AppearanceAuto:  AppearanceLight,
+		AppearanceLight: AppearanceDark,
+		AppearanceDark:  AppearanceAuto,
+		"":              AppearanceLight,
+	}
+	for current, want := range tests {
+		if got := NextAppearance(current); got != want {
+			t.Errorf("NextAppearance(%q) = %q, want %q", current, got, want)
+		}
+	}
+}
+
+func TestApplyAppearance_PicksAdaptiveSide(t *testing.T) {
+	t.Cleanup(func() { ApplyAppearance(AppearanceDark) })
+	color := lipgloss.AdaptiveColor{Light: "#ffffff", Dark: "#000000"}
+
+	ApplyAppearance(AppearanceLight)
+	if lipgloss.HasDarkBackground() {
+		t.Error("lipgloss should use the light side after ApplyAppearance(light)")
+	}
+	if got := adaptiveHex(color); got != "#ffffff" {
+		t.Errorf("adaptiveHex() = %q, want light side", got)
+	}
+
+	ApplyAppearance(AppearanceDark)
+	if !lipgloss.HasDarkBackground() {
+		t.Error("lipgloss should use the dark side after ApplyAppearance(dark)")
+	}
+	if got := adaptiveHex(color); got != "#000000" {
+		t.Errorf("adaptiveHex() = %q, want dark side", got)
+	}
+}
+
+func TestModelUpdate_AppearanceKey(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Cleanup(func() { ApplyAppearance(AppearanceDark) })
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	model.config.Appearance = AppearanceAuto
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	model = updated.(Model)
+
+	if model.config.Appearance != AppearanceLight {
+		t.Errorf("Update(D) appearance = %q, want %q", model.config.Appearance, AppearanceLight)
+	}
+	if lipgloss.HasDarkBackground() {
+		t.Error("Update(D) should switch lipgloss to the light side")
+	}
+	if len(model.notices) == 0 || model.notices[len(model.notices)-1].text != "Appearance: Light" {
+		t.Errorf("Update(D) should announce the new appearance, got %+v", model.notices)
+	}
+}
@@ -0,0 +1,91 @@
+package feed
+
+import "strings"
+
+// AuthorMatches reports whether name refers to the post's author. Authors
+// look like "claude-swift-fox@smoke", and name may be the full identity, the
+// name without @project ("claude-swift-fox"), the suffix ("swift-fox"), or
+// the agent ("claude"). Parts must match whole, so "fox" matches nothing
+// above. Case is ignored; a name with @project also requires that project.
+func AuthorMatches(name string, post *Post) bool {
+	name = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "@"))
+	name, project, hasProject := strings.Cut(name, "@")
+	if name == "" {
+		return false
+	}
+
+	author, authorProject := SplitIdentity(strings.ToLower(post.Author))
+	if hasProject && project != authorProject {
+		return false
+	}
+	if name == author {
+		return true
+	}
+	suffix := strings.ToLower(post.Suffix)
+	if suffix == "" || !strings.HasSuffix(author, suffix) {
+		return false
+	}
+	agent := strings.TrimSuffix(strings.TrimSuffix(author, suffix), "-")
+	return name == suffix || (agent != "" && name == agent)
+}
+
+// matchesAnyAuthor reports whether any name in the comma-separated list
+// refers to the post's author.
+func matchesAnyAuthor(post *Post, names string) bool {
+	for _, name := range strings.Split(names, ",") {
+		if AuthorMatches(name, post) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClosestAuthor returns the author name or suffix in posts that is nearest
+// to name by edit distance, or "" when nothing is close enough to be a
+// likely typo. Names that already match a post return "".
+func ClosestAuthor(name string, posts []*Post) string {
+	name = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "@"))
+	name, _, _ = strings.Cut(name, "@")
+	if name == "" {
+		return ""
+	}
+
+	best, bestDist := "", max(1, len([]rune(name))/3)+1
+	for _, post := range posts {
+		if AuthorMatches(name, post) {
+			return ""
+		}
+		author, _ := SplitIdentity(post.Author)
+		for _, candidate := range []string{author, post.Suffix} {
+			if candidate == "" {
+				continue
+			}
+			if d := levenshtein(name, strings.ToLower(candidate)); d < bestDist {
+				best, bestDist = candidate, d
+			}
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b, counted in runes.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
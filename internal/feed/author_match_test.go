@@ -0,0 +1,90 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorMatches(t *testing.T) {
+	agentPost := &Post{Author: "claude-swift-fox@smoke", Suffix: "swift-fox"}
+	plainPost := &Post{Author: "ember@smoke", Suffix: "ember"}
+
+	tests := []struct {
+		name string
+		post *Post
+		want bool
+	}{
+		{"claude-swift-fox@smoke", agentPost, true},
+		{"claude-swift-fox", agentPost, true},
+		{"swift-fox", agentPost, true},
+		{"Swift-Fox", agentPost, true},
+		{"@swift-fox", agentPost, true},
+		{"claude", agentPost, true},
+		{"swift-fox@smoke", agentPost, true},
+		{"swift-fox@other", agentPost, false},
+		{"fox", agentPost, false},
+		{"swift", agentPost, false},
+		{"codex", agentPost, false},
+		{"ember", plainPost, true},
+		{"EMBER@smoke", plainPost, true},
+		{"emb", plainPost, false},
+		{"", plainPost, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, AuthorMatches(tt.name, tt.post), "AuthorMatches(%q, %s)", tt.name, tt.post.Author)
+	}
+}
+
+func TestFilterPosts_AuthorListAndExclude(t *testing.T) {
+	posts := []*Post{
+		{ID: "smk-aaa111", Author: "claude-swift-fox@smoke", Suffix: "swift-fox"},
+		{ID: "smk-bbb222", Author: "codex-calm-owl@smoke", Suffix: "calm-owl"},
+		{ID: "smk-ccc333", Author: "ember@smoke", Suffix: "ember"},
+	}
+	ids := func(posts []*Post) []string {
+		var out []string
+		for _, p := range posts {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"smk-aaa111", "smk-ccc333"},
+		ids(FilterPosts(posts, FilterCriteria{Author: "swift-fox, ember"})))
+	assert.Equal(t, []string{"smk-bbb222", "smk-ccc333"},
+		ids(FilterPosts(posts, FilterCriteria{ExcludeAuthor: "claude"})))
+	assert.Equal(t, []string{"smk-ccc333"},
+		ids(FilterPosts(posts, FilterCriteria{Author: "swift-fox,ember", ExcludeAuthor: "claude-swift-fox"})))
+}
+
+func TestClosestAuthor(t *testing.T) {
+	posts := []*Post{
+		{Author: "claude-swift-fox@smoke", Suffix: "swift-fox"},
+		{Author: "codex-calm-owl@smoke", Suffix: "calm-owl"},
+		{Author: "ember@smoke", Suffix: "ember"},
+	}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"swfit-fox", "swift-fox"},
+		{"calm-ow", "calm-owl"},
+		{"claude-swift-fx", "claude-swift-fox"},
+		{"embr@smoke", "ember"},
+		{"ember", ""},    // already matches
+		{"zzzzzzzz", ""}, // nothing close
+		{"", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ClosestAuthor(tt.name, posts), "ClosestAuthor(%q)", tt.name)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("fox", "fox"))
+	assert.Equal(t, 3, levenshtein("", "fox"))
+	assert.Equal(t, 1, levenshtein("fox", "fix"))
+	assert.Equal(t, 2, levenshtein("swfit", "swift"))
+	assert.Equal(t, 3, levenshtein("kitten", "sitting"))
+}
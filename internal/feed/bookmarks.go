@@ -0,0 +1,37 @@
+package feed
+
+import "github.com/dreamiurg/smoke/internal/config"
+
+// BookmarkGlyph marks bookmarked posts in the TUI and CLI output.
+const BookmarkGlyph = "★"
+
+// ActiveBookmarks loads the bookmarked post IDs and drops those whose posts
+// were deleted or no longer exist in posts. With prune, the shorter list is
+// saved; pass false when posts are not the shared feed (such as a private
+// feed) so bookmarks of shared posts survive.
+func ActiveBookmarks(posts []*Post, prune bool) ([]string, error) {
+	ids, err := config.LoadBookmarks()
+	if err != nil {
+		return nil, err
+	}
+
+	live := make(map[string]bool, len(posts))
+	for _, post := range posts {
+		if !post.Deleted {
+			live[post.ID] = true
+		}
+	}
+
+	kept := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if live[id] {
+			kept = append(kept, id)
+		}
+	}
+	if prune && len(kept) != len(ids) {
+		if err := config.SaveBookmarks(kept); err != nil {
+			return kept, err
+		}
+	}
+	return kept, nil
+}
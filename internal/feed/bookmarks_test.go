@@ -0,0 +1,107 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+func TestActiveBookmarks_PrunesDeleted(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, config.SaveBookmarks([]string{"smk-aaaaaa", "smk-gone00", "smk-bbbbbb", "smk-cccccc"}))
+
+	posts := []*Post{
+		{ID: "smk-aaaaaa"},
+		{ID: "smk-bbbbbb", Deleted: true},
+		{ID: "smk-cccccc"},
+	}
+	ids, err := ActiveBookmarks(posts, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-aaaaaa", "smk-cccccc"}, ids)
+	saved, err := config.LoadBookmarks()
+	require.NoError(t, err)
+	assert.Len(t, saved, 4, "without prune the saved list is untouched")
+
+	ids, err = ActiveBookmarks(posts, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-aaaaaa", "smk-cccccc"}, ids)
+	saved, err = config.LoadBookmarks()
+	require.NoError(t, err)
+	assert.Equal(t, ids, saved, "pruned bookmarks are saved")
+}
+
+func TestBookmarkKey_TogglesAndFilters(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := searchTestModel(t)
+
+	model = typeKeys(t, model, runeKey("b"))
+	assert.True(t, model.bookmarks["smk-bbbbbb"])
+	saved, err := config.LoadBookmarks()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-bbbbbb"}, saved)
+	assert.Contains(t, strings.Join(model.formatPost(model.displayedPosts[1]), "\n"), BookmarkGlyph)
+	assert.NotContains(t, strings.Join(model.formatPost(model.displayedPosts[0]), "\n"), BookmarkGlyph)
+
+	model.selectedPostIndex = 3
+	model = typeKeys(t, model, runeKey("b"), runeKey("B"))
+	assert.True(t, model.showBookmarksOnly)
+	if assert.Len(t, model.displayedPosts, 2) {
+		assert.Equal(t, "smk-bbbbbb", model.displayedPosts[0].ID)
+		assert.Equal(t, "smk-dddddd", model.displayedPosts[1].ID)
+	}
+	assert.Equal(t, "smk-dddddd", model.displayedPosts[model.selectedPostIndex].ID, "selection is kept")
+	assert.Contains(t, model.renderStatusBar(), "2 bookmarked")
+
+	// Removing a bookmark in bookmarks-only view drops the post from view
+	model = typeKeys(t, model, runeKey("b"))
+	assert.False(t, model.bookmarks["smk-dddddd"])
+	assert.Len(t, model.displayedPosts, 1)
+
+	model = typeKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, model.showBookmarksOnly)
+	assert.Len(t, model.displayedPosts, 4)
+}
+
+func TestBookmarkKey_EmptyView(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := searchTestModel(t)
+
+	model = typeKeys(t, model, runeKey("B"))
+	assert.Empty(t, model.displayedPosts)
+	lines := model.buildAllContentLinesWithPosts()
+	if assert.Len(t, lines, 1) {
+		assert.Contains(t, lines[0].text, "No bookmarked posts")
+	}
+
+	model = typeKeys(t, model, runeKey("B"))
+	assert.Len(t, model.displayedPosts, 4)
+}
+
+func TestLoadPostsMsg_LoadsBookmarks(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, config.SaveBookmarks([]string{"smk-cccccc", "smk-gone00"}))
+
+	model := searchTestModel(t)
+	updated, _ := model.Update(loadPostsMsg{posts: model.posts})
+	model = updated.(Model)
+
+	assert.Equal(t, map[string]bool{"smk-cccccc": true}, model.bookmarks)
+	assert.Contains(t, model.plainPostLabel(model.posts[2]), "(bookmarked)")
+
+	// The test store is not the shared feed, so nothing is pruned on disk
+	saved, err := config.LoadBookmarks()
+	require.NoError(t, err)
+	assert.Len(t, saved, 2)
+
+	t.Setenv("SMOKE_FEED", model.store.(*FileStore).Path())
+	updated, _ = model.Update(loadPostsMsg{posts: model.posts})
+	model = updated.(Model)
+	saved, err = config.LoadBookmarks()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-cccccc"}, saved)
+}
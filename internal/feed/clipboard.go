@@ -0,0 +1,43 @@
+package feed
+
+import (
+	"os"
+	"runtime"
+	"sync"
+)
+
+// NoClipboardNotice is shown instead of a copy failure when the session has
+// no clipboard to copy to.
+const NoClipboardNotice = "⚠ No clipboard (headless); press s to save to file instead"
+
+var clipboardAvailable = sync.OnceValue(func() bool {
+	return detectClipboard(clipboardSupported, runtime.GOOS, os.Getenv)
+})
+
+// ClipboardAvailable reports whether copying to the clipboard can work in
+// this session. It is checked once per process.
+func ClipboardAvailable() bool {
+	return clipboardAvailable()
+}
+
+// detectClipboard decides clipboard availability from the build and the
+// environment. Linux and the BSDs need an X11 or Wayland display; an SSH
+// session without a forwarded display has no clipboard the user can reach
+// on any platform.
+func detectClipboard(supported bool, goos string, getenv func(string) string) bool {
+	if !supported {
+		return false
+	}
+	hasDisplay := getenv("DISPLAY") != "" || getenv("WAYLAND_DISPLAY") != ""
+	switch goos {
+	case "darwin", "windows":
+	default:
+		if !hasDisplay {
+			return false
+		}
+	}
+	if getenv("SSH_CONNECTION") != "" || getenv("SSH_TTY") != "" {
+		return hasDisplay
+	}
+	return true
+}
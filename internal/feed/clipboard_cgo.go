@@ -7,6 +7,9 @@ import (
 	"golang.design/x/clipboard"
 )
 
+// clipboardSupported is true when this build links a clipboard implementation.
+const clipboardSupported = true
+
 // clipboardInitialized tracks if clipboard.Init() has been called
 var clipboardInitialized bool
 
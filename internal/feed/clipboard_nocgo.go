@@ -9,6 +9,9 @@ import "errors"
 // ErrClipboardNotAvailable is returned when clipboard is not available (no CGO)
 var ErrClipboardNotAvailable = errors.New("clipboard not available on this build")
 
+// clipboardSupported is true when this build links a clipboard implementation.
+const clipboardSupported = false
+
 // CopyTextToClipboard copies text to the system clipboard.
 // Returns an error if clipboard is unavailable.
 func CopyTextToClipboard(text string) error {
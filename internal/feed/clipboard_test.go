@@ -0,0 +1,30 @@
+package feed
+
+import "testing"
+
+func TestDetectClipboard(t *testing.T) {
+	tests := []struct {
+		name      string
+		supported bool
+		goos      string
+		env       map[string]string
+		want      bool
+	}{
+		{"build without clipboard", false, "darwin", nil, false},
+		{"mac desktop", true, "darwin", nil, true},
+		{"mac over ssh", true, "darwin", map[string]string{"SSH_CONNECTION": "10.0.0.1 22 10.0.0.2 22"}, false},
+		{"windows desktop", true, "windows", nil, true},
+		{"linux without display", true, "linux", nil, false},
+		{"linux x11", true, "linux", map[string]string{"DISPLAY": ":0"}, true},
+		{"linux wayland", true, "linux", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, true},
+		{"ssh with forwarded display", true, "linux", map[string]string{"SSH_TTY": "/dev/pts/1", "DISPLAY": "localhost:10.0"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+			if got := detectClipboard(tt.supported, tt.goos, getenv); got != tt.want {
+				t.Errorf("detectClipboard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package feed
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CodeContinuation marks a fenced code line that was soft-wrapped to fit.
+const CodeContinuation = "↪ "
+
+var (
+	// fencePattern matches a fenced code block, including its backticks.
+	fencePattern = regexp.MustCompile("(?s)```.*?```")
+	// inlineCodePattern matches an inline code span on a single line.
+	inlineCodePattern = regexp.MustCompile("`[^`\n]+`")
+)
+
+// wrappedLine is one display line of post content.
+type wrappedLine struct {
+	text string
+	// code is true for lines inside a fenced block
+	code bool
+	// lang is the block's language tag, e.g. "go" (empty when untagged)
+	lang string
+	// cont is true when a long code line was soft-wrapped onto this line
+	cont bool
+	// links locates the URLs on a prose line, including pieces of a URL
+	// that wrapping split across lines
+	links []lineLink
+}
+
+// lineLink is a URL, or the part of one, on a wrapped line.
+type lineLink struct {
+	// start and end are byte offsets into the line text
+	start, end int
+	// url is the whole URL, even when only part of it is on this line
+	url string
+}
+
+// wrapContent wraps post content for display. Prose wraps on word boundaries
+// like wrapTextWithWidths; lines inside fenced code blocks keep their own line
+// breaks and are only soft-wrapped when they exceed the width minus codeIndent.
+// The fence lines themselves are not displayed.
+func wrapContent(text string, firstLineWidth, subsequentWidth, codeIndent int) []wrappedLine {
+	var lines []wrappedLine
+	width := func() int {
+		if len(lines) == 0 {
+			return firstLineWidth
+		}
+		return subsequentWidth
+	}
+	addProse := func(prose string) {
+		prose = strings.TrimSpace(prose)
+		if prose == "" {
+			return
+		}
+		lines = append(lines, linkProse(prose, wrapTextWithWidths(prose, width(), subsequentWidth))...)
+	}
+
+	lastEnd := 0
+	for _, match := range fencePattern.FindAllStringIndex(text, -1) {
+		addProse(text[lastEnd:match[0]])
+		lang, body := parseFence(text[match[0]:match[1]])
+		for _, codeLine := range strings.Split(body, "\n") {
+			lines = append(lines, softWrapCode(codeLine, lang, width()-codeIndent)...)
+		}
+		lastEnd = match[1]
+	}
+	if lastEnd == 0 {
+		// No fences: keep the plain wrapping behavior exactly
+		return linkProse(text, wrapTextWithWidths(text, firstLineWidth, subsequentWidth))
+	}
+	addProse(text[lastEnd:])
+	return lines
+}
+
+// linkProse turns the wrapped lines of prose into wrappedLines and records
+// where each URL in prose falls on them. URLs are found before wrapping, so a
+// URL broken across lines links every piece to the whole URL. URLs inside
+// inline code are left alone, as they are when highlighting.
+func linkProse(prose string, wrapped []string) []wrappedLine {
+	lines := make([]wrappedLine, len(wrapped))
+	urls := findURLs(prose)
+	code := inlineCodePattern.FindAllStringIndex(prose, -1)
+	offset := 0
+	for i, text := range wrapped {
+		lines[i].text = text
+		// Wrapping only drops whitespace, so each line appears in prose in order
+		start := offset + max(strings.Index(prose[offset:], text), 0)
+		offset = start + len(text)
+		for _, url := range urls {
+			if url[1] <= start || url[0] >= offset || insideSpan(url, code) {
+				continue
+			}
+			lines[i].links = append(lines[i].links, lineLink{
+				start: max(url[0], start) - start,
+				end:   min(url[1], offset) - start,
+				url:   prose[url[0]:url[1]],
+			})
+		}
+	}
+	return lines
+}
+
+// insideSpan reports whether r overlaps any of spans.
+func insideSpan(r []int, spans [][]int) bool {
+	for _, span := range spans {
+		if r[0] < span[1] && span[0] < r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapContentPlain wraps content like wrapContent and flattens it to plain
+// strings, indenting code lines and marking soft-wrapped ones.
+func wrapContentPlain(text string, firstLineWidth, subsequentWidth, codeIndent int) []string {
+	wrapped := wrapContent(text, firstLineWidth, subsequentWidth, codeIndent)
+	lines := make([]string, 0, len(wrapped))
+	for _, wl := range wrapped {
+		if !wl.code {
+			lines = append(lines, wl.text)
+			continue
+		}
+		line := strings.Repeat(" ", codeIndent)
+		if wl.cont {
+			line += CodeContinuation
+		}
+		lines = append(lines, line+wl.text)
+	}
+	return lines
+}
+
+// parseFence splits a fenced block into its language tag and body.
+// "```go\nx := 1\n```" yields ("go", "x := 1"); a block without a newline
+// after the opening fence has no language.
+func parseFence(block string) (lang, body string) {
+	inner := strings.TrimSuffix(strings.TrimPrefix(block, "```"), "```")
+	if i := strings.IndexByte(inner, '\n'); i >= 0 {
+		if fields := strings.Fields(inner[:i]); len(fields) > 0 {
+			lang = strings.ToLower(fields[0])
+		}
+		inner = inner[i+1:]
+	}
+	return lang, strings.TrimSuffix(inner, "\n")
+}
+
+// softWrapCode splits a code line into chunks that fit width. Chunks after the
+// first leave room for the continuation marker.
+func softWrapCode(line, lang string, width int) []wrappedLine {
+	if width < MinContentWidth {
+		width = MinContentWidth
+	}
+	lines := []wrappedLine{}
+	limit := width
+	cont := false
+	for lipgloss.Width(line) > limit {
+		cut := cellPrefixLen(line, limit)
+		lines = append(lines, wrappedLine{text: line[:cut], code: true, lang: lang, cont: cont})
+		line = line[cut:]
+		limit = width - lipgloss.Width(CodeContinuation)
+		cont = true
+	}
+	return append(lines, wrappedLine{text: line, code: true, lang: lang, cont: cont})
+}
+
+// codeLexer colors a few token classes for one family of languages. It is a
+// deliberately small stand-in for a full lexer: keywords, strings, numbers,
+// and line comments.
+type codeLexer struct {
+	keywords map[string]bool
+	pattern  *regexp.Regexp
+	comment  string
+}
+
+func newCodeLexer(comment string, keywords ...string) *codeLexer {
+	kw := make(map[string]bool, len(keywords))
+	for _, k := range keywords {
+		kw[k] = true
+	}
+	pattern := regexp.MustCompile(regexp.QuoteMeta(comment) + `.*` +
+		`|"(?:[^"\\]|\\.)*"?|'(?:[^'\\]|\\.)*'?|` + "`[^`]*`?" +
+		`|\b\d[\w.]*|[A-Za-z_]\w*`)
+	return &codeLexer{keywords: kw, pattern: pattern, comment: comment}
+}
+
+var (
+	goLexer = newCodeLexer("//", "break", "case", "chan", "const", "continue", "default",
+		"defer", "else", "fallthrough", "for", "func", "go", "goto", "if", "import",
+		"interface", "map", "package", "range", "return", "select", "struct", "switch",
+		"type", "var", "nil", "true", "false")
+	jsLexer = newCodeLexer("//", "async", "await", "break", "case", "catch", "class",
+		"const", "continue", "default", "else", "export", "extends", "false", "for",
+		"function", "if", "import", "interface", "let", "new", "null", "return",
+		"switch", "this", "throw", "true", "try", "type", "undefined", "var", "while")
+	rustLexer = newCodeLexer("//", "as", "break", "const", "continue", "else", "enum",
+		"false", "fn", "for", "if", "impl", "in", "let", "loop", "match", "mod", "mut",
+		"pub", "return", "self", "struct", "trait", "true", "use", "where", "while")
+	pythonLexer = newCodeLexer("#", "and", "as", "async", "await", "break", "class",
+		"continue", "def", "elif", "else", "except", "False", "finally", "for", "from",
+		"if", "import", "in", "is", "lambda", "None", "not", "or", "pass", "raise",
+		"return", "True", "try", "while", "with", "yield")
+	shellLexer = newCodeLexer("#", "case", "do", "done", "elif", "else", "esac",
+		"export", "fi", "for", "function", "if", "in", "local", "return", "then", "while")
+)
+
+// codeLexers maps fence language tags to lexers.
+var codeLexers = map[string]*codeLexer{
+	"go":         goLexer,
+	"golang":     goLexer,
+	"js":         jsLexer,
+	"javascript": jsLexer,
+	"ts":         jsLexer,
+	"typescript": jsLexer,
+	"rust":       rustLexer,
+	"rs":         rustLexer,
+	"py":         pythonLexer,
+	"python":     pythonLexer,
+	"sh":         shellLexer,
+	"bash":       shellLexer,
+	"shell":      shellLexer,
+	"zsh":        shellLexer,
+}
+
+// codeBackground returns the background for code, contrasting with the
+// surrounding background (selected posts already use BackgroundSecondary).
+func codeBackground(theme *Theme, background lipgloss.AdaptiveColor) lipgloss.AdaptiveColor {
+	if background == theme.BackgroundSecondary {
+		return theme.Background
+	}
+	return theme.BackgroundSecondary
+}
+
+// HighlightCode renders one line of a fenced code block on the code
+// background, coloring tokens when lang has a known lexer.
+func HighlightCode(text, lang string, theme *Theme, background lipgloss.AdaptiveColor) string {
+	bg := codeBackground(theme, background)
+	plainStyle := lipgloss.NewStyle().Foreground(theme.Text).Background(bg)
+
+	lexer := codeLexers[lang]
+	if lexer == nil {
+		return plainStyle.Render(text)
+	}
+
+	tokenColor := func(i int) lipgloss.TerminalColor {
+		if len(theme.AgentColors) == 0 {
+			return theme.Text
+		}
+		return theme.AgentColors[i%len(theme.AgentColors)]
+	}
+	keywordStyle := plainStyle.Foreground(theme.Accent).Bold(true)
+	stringStyle := plainStyle.Foreground(tokenColor(1))
+	numberStyle := plainStyle.Foreground(tokenColor(2))
+	commentStyle := plainStyle.Foreground(theme.TextMuted).Italic(true)
+
+	var result strings.Builder
+	lastEnd := 0
+	for _, match := range lexer.pattern.FindAllStringIndex(text, -1) {
+		start, end := match[0], match[1]
+		token := text[start:end]
+		var style lipgloss.Style
+		switch {
+		case strings.HasPrefix(token, lexer.comment):
+			style = commentStyle
+		case strings.ContainsAny(token[:1], "\"'`"):
+			style = stringStyle
+		case token[0] >= '0' && token[0] <= '9':
+			style = numberStyle
+		case lexer.keywords[token]:
+			style = keywordStyle
+		default:
+			continue
+		}
+		if start > lastEnd {
+			result.WriteString(plainStyle.Render(text[lastEnd:start]))
+		}
+		result.WriteString(style.Render(token))
+		lastEnd = end
+	}
+	if lastEnd < len(text) {
+		result.WriteString(plainStyle.Render(text[lastEnd:]))
+	}
+	return result.String()
+}
@@ -0,0 +1,200 @@
+package feed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// withColor forces ANSI output so styling differences are observable.
+func withColor(t *testing.T) {
+	t.Helper()
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+}
+
+func TestParseFence(t *testing.T) {
+	tests := []struct {
+		block    string
+		wantLang string
+		wantBody string
+	}{
+		{"```go\nx := 1\n```", "go", "x := 1"},
+		{"```Python extra\nprint(1)\nprint(2)\n```", "python", "print(1)\nprint(2)"},
+		{"```\nplain\n```", "", "plain"},
+		{"```x := 1```", "", "x := 1"},
+	}
+	for _, tt := range tests {
+		lang, body := parseFence(tt.block)
+		if lang != tt.wantLang || body != tt.wantBody {
+			t.Errorf("parseFence(%q) = %q, %q; want %q, %q", tt.block, lang, body, tt.wantLang, tt.wantBody)
+		}
+	}
+}
+
+func TestWrapContent_NoFenceMatchesWrapText(t *testing.T) {
+	text := strings.Repeat("word ", 30)
+	want := wrapTextWithWidths(text, 20, 40)
+	got := wrapContent(text, 20, 40, 2)
+	if len(got) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(got), len(want))
+	}
+	for i, line := range got {
+		if line.text != want[i] || line.code {
+			t.Errorf("line %d = %+v, want prose %q", i, line, want[i])
+		}
+	}
+}
+
+func TestWrapContent_FencedBlock(t *testing.T) {
+	text := "before\n```go\nfunc main() {\n\treturn\n}\n```\nafter"
+	got := wrapContent(text, 40, 40, 2)
+
+	want := []wrappedLine{
+		{text: "before"},
+		{text: "func main() {", code: true, lang: "go"},
+		{text: "\treturn", code: true, lang: "go"},
+		{text: "}", code: true, lang: "go"},
+		{text: "after"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d lines %+v, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("line %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWrapContent_SoftWrapsWideCodeByCells(t *testing.T) {
+	long := `msg := "` + strings.Repeat("こんにちは世界", 6) + `"`
+	got := wrapContent("```go\n"+long+"\n```", 40, 40, 2)
+
+	var joined strings.Builder
+	for i, line := range got {
+		limit := 38
+		if line.cont {
+			limit -= lipgloss.Width(CodeContinuation)
+		}
+		if w := lipgloss.Width(line.text); w > limit {
+			t.Errorf("chunk %d is %d cells, want <= %d: %q", i, w, limit, line.text)
+		}
+		joined.WriteString(line.text)
+	}
+	if joined.String() != long {
+		t.Errorf("soft-wrapped chunks do not rejoin to the original line")
+	}
+}
+
+func TestWrapContent_SoftWrapsLongCodeLines(t *testing.T) {
+	long := strings.Repeat("x", 70)
+	got := wrapContent("```\n"+long+"\n```", 40, 40, 2)
+
+	if len(got) < 2 {
+		t.Fatalf("expected long code line to soft-wrap, got %+v", got)
+	}
+	if got[0].cont {
+		t.Error("first chunk should not be marked as a continuation")
+	}
+	var joined strings.Builder
+	for i, line := range got {
+		if !line.code {
+			t.Errorf("chunk %d lost its code flag", i)
+		}
+		if i > 0 && !line.cont {
+			t.Errorf("chunk %d should be marked as a continuation", i)
+		}
+		if strings.Contains(line.text, " ") {
+			t.Errorf("chunk %d broke on a space: %q", i, line.text)
+		}
+		joined.WriteString(line.text)
+	}
+	if joined.String() != long {
+		t.Errorf("soft-wrapped chunks do not rejoin to the original line")
+	}
+
+	plain := wrapContentPlain("```\n"+long+"\n```", 40, 40, 2)
+	if !strings.HasPrefix(plain[1], "  "+CodeContinuation) {
+		t.Errorf("plain continuation = %q, want indent and %q marker", plain[1], CodeContinuation)
+	}
+}
+
+func TestHighlightCode_DistinctFromProse(t *testing.T) {
+	withColor(t)
+	theme := &AllThemes[0]
+
+	prose := HighlightForIdentity("x := 1", theme, theme.Background, "")
+	code := HighlightCode("x := 1", "", theme, theme.Background)
+	if prose == code {
+		t.Error("fenced code should be styled differently from prose")
+	}
+
+	colored := HighlightCode("return 1 // done", "go", theme, theme.Background)
+	if colored == code || colored == HighlightCode("return 1 // done", "", theme, theme.Background) {
+		t.Error("known language should add token coloring")
+	}
+	if got := stripANSI(colored); got != "return 1 // done" {
+		t.Errorf("highlighting changed the text: %q", got)
+	}
+}
+
+func TestHighlightCode_ContrastsWithSelection(t *testing.T) {
+	theme := &AllThemes[0]
+	if codeBackground(theme, theme.Background) == theme.Background {
+		t.Error("code background should differ from the normal background")
+	}
+	if codeBackground(theme, theme.BackgroundSecondary) == theme.BackgroundSecondary {
+		t.Error("code background should differ from the selection background")
+	}
+}
+
+func TestHighlightForIdentity_InlineCode(t *testing.T) {
+	withColor(t)
+	theme := &AllThemes[0]
+
+	withCode := HighlightForIdentity("run `go test` now", theme, theme.Background, "")
+	without := HighlightForIdentity("run 'go test' now", theme, theme.Background, "")
+	if strings.Count(withCode, "\x1b[") <= strings.Count(without, "\x1b[") {
+		t.Error("inline code span should get its own styling")
+	}
+
+	// Tags inside inline code are left alone
+	inCode := HighlightForIdentity("`#tag`", theme, theme.Background, "")
+	codeStyle := lipgloss.NewStyle().Foreground(theme.Text).Background(theme.BackgroundSecondary)
+	if inCode != codeStyle.Render("`#tag`") {
+		t.Errorf("hashtag inside inline code should render as code, got %q", inCode)
+	}
+}
+
+func TestFormatPost_IndentsFencedCodePerLayout(t *testing.T) {
+	post := &Post{
+		ID:        "smk-code01",
+		Author:    "alice",
+		Project:   "smoke",
+		Suffix:    "swift-fox",
+		Content:   "try this:\n```sh\nmake test\n```",
+		CreatedAt: "2026-01-02T09:24:00Z",
+	}
+	m := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	m.width = 100
+	for i := range AllLayouts {
+		layout := &AllLayouts[i]
+		m.layout = layout
+		lines := m.formatPost(post)
+		last := stripANSI(lines[len(lines)-1])
+		want := strings.Repeat(" ", m.codeIndent()) + "make test"
+		if !strings.HasSuffix(last, want) {
+			t.Errorf("%s: code line = %q, want suffix %q", layout.Name, last, want)
+		}
+		for _, line := range lines {
+			if strings.Contains(line, "```") {
+				t.Errorf("%s: fence markers should not be displayed: %q", layout.Name, stripANSI(line))
+			}
+		}
+	}
+}
@@ -2,11 +2,12 @@
 package feed
 
 import (
-	"hash/fnv"
 	"io"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dreamiurg/smoke/internal/identity"
 )
 
 // ANSI escape sequences for terminal styling
@@ -121,7 +122,7 @@ func SplitIdentity(author string) (agent, project string) {
 func colorizeIdentityParts(agent, project string, theme *Theme, contrast *ContrastLevel, background lipgloss.AdaptiveColor) string {
 	// Build agent style using theme colors (include background to avoid black gaps)
 	agentStyle := lipgloss.NewStyle().
-		Foreground(theme.AgentColors[hashString(agent)%len(theme.AgentColors)]).
+		Foreground(AgentColor(agent, theme)).
 		Background(background)
 
 	if contrast.AgentBold {
@@ -136,9 +137,9 @@ func colorizeIdentityParts(agent, project string, theme *Theme, contrast *Contra
 	}
 
 	// Style for the "@" separator
-	atStyle := lipgloss.NewStyle().Background(background)
+	atStyle := lipgloss.NewStyle().Background(background).Bold(contrast.ProjectBold)
 
-	projectStyle := lipgloss.NewStyle().Background(background)
+	projectStyle := lipgloss.NewStyle().Background(background).Bold(contrast.ProjectBold)
 	if contrast.ProjectColored {
 		// Color the project with a secondary theme color
 		projectStyle = projectStyle.Foreground(theme.AgentColors[(hashString(project)+1)%len(theme.AgentColors)])
@@ -153,8 +154,11 @@ func colorizeIdentityParts(agent, project string, theme *Theme, contrast *Contra
 }
 
 // ColorizeIdentity applies theme and contrast styling to a full identity string.
-// Identity format is "agent@project". Uses lipgloss.Color objects from Theme for proper TUI rendering.
+// It returns author unchanged when NoColor is set. Identity format is "agent@project". Uses lipgloss.Color objects from Theme for proper TUI rendering.
 func ColorizeIdentity(author string, theme *Theme, contrast *ContrastLevel) string {
+	if NoColor() {
+		return author
+	}
 	agent, project := SplitIdentity(author)
 	return colorizeIdentityParts(agent, project, theme, contrast, theme.Background)
 }
@@ -162,14 +166,22 @@ func ColorizeIdentity(author string, theme *Theme, contrast *ContrastLevel) stri
 // ColorizeIdentityWithBackground applies theme and contrast styling to a full identity string
 // using a custom background color. This avoids black gaps when rendering with selection highlights.
 func ColorizeIdentityWithBackground(author string, theme *Theme, contrast *ContrastLevel, background lipgloss.AdaptiveColor) string {
+	if NoColor() {
+		return author
+	}
 	agent, project := SplitIdentity(author)
 	return colorizeIdentityParts(agent, project, theme, contrast, background)
 }
 
+// AgentColor returns the theme palette color the feed uses for an author's
+// agent name. The project part of the identity does not affect the choice.
+func AgentColor(author string, theme *Theme) lipgloss.Color {
+	return theme.AgentColors[identity.PaletteIndex(author, len(theme.AgentColors))]
+}
+
 // hashString computes a deterministic hash for consistent coloring.
 // Used by both CLI and TUI for author/agent name color selection.
+// Delegates to identity.Hash so external tools can reproduce the same choice.
 func hashString(s string) int {
-	h := fnv.New32a()
-	h.Write([]byte(s))
-	return int(h.Sum32())
+	return int(identity.Hash(s))
 }
@@ -1,8 +1,10 @@
 package feed
 
 import (
+	"bytes"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestAuthorColor_Deterministic(t *testing.T) {
@@ -274,3 +276,52 @@ func TestColorizeIdentity(t *testing.T) {
 		}
 	})
 }
+
+func TestNoColor_RenderingHasNoANSI(t *testing.T) {
+	withColor(t)
+	t.Setenv(NoColorEnv, "1")
+	theme := GetTheme("high-contrast")
+	contrast := GetContrastLevel("max")
+
+	if got := ColorizeIdentity("swift-fox@smoke", theme, contrast); got != "swift-fox@smoke" {
+		t.Errorf("ColorizeIdentity() = %q, want plain identity", got)
+	}
+	if got := ColorizeIdentityWithBackground("swift-fox@smoke", theme, contrast, theme.Background); got != "swift-fox@smoke" {
+		t.Errorf("ColorizeIdentityWithBackground() = %q, want plain identity", got)
+	}
+	text := "ping @ember about #bug, see `go test` https://example.com"
+	if got := HighlightForIdentity(text, theme, theme.Background, "ember"); got != text {
+		t.Errorf("HighlightForIdentity() = %q, want %q", got, text)
+	}
+	if got := HighlightAll(text, true); got != text {
+		t.Errorf("HighlightAll() = %q, want %q", got, text)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	posts := []*Post{
+		{ID: "smk-aaaaaa", Author: "ember@smoke", Content: "hello #bug @spark", CreatedAt: now},
+		{ID: "smk-bbbbbb", Author: "spark@smoke", Content: "on it", CreatedAt: now, ParentID: "smk-aaaaaa"},
+	}
+	var buf bytes.Buffer
+	FormatFeed(&buf, posts, FormatOptions{TerminalWidth: 100}, 10)
+	output := buf.String()
+	if strings.Contains(output, "\x1b[") {
+		t.Errorf("feed output should not contain ANSI escape sequences: %q", output)
+	}
+	if strings.ContainsAny(output, "└─") {
+		t.Errorf("feed output should use ASCII tree characters: %q", output)
+	}
+	if !strings.Contains(output, "`- ") {
+		t.Errorf("feed output missing ASCII reply branch: %q", output)
+	}
+}
+
+func TestColorizeIdentity_MaxContrastBoldsProject(t *testing.T) {
+	withColor(t)
+	theme := GetTheme("dracula")
+	high := ColorizeIdentity("swift-fox@smoke", theme, GetContrastLevel("high"))
+	maxed := ColorizeIdentity("swift-fox@smoke", theme, GetContrastLevel("max"))
+	if strings.Count(maxed, "\x1b[1") <= strings.Count(high, "\x1b[1") {
+		t.Errorf("max contrast should bold more than high: high=%q max=%q", high, maxed)
+	}
+}
@@ -0,0 +1,222 @@
+package feed
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// CompactOptions controls Compact.
+type CompactOptions struct {
+	// KeepPosts keeps only the newest posts when positive.
+	KeepPosts int
+	// DryRun reports what would be removed without rewriting the file.
+	DryRun bool
+}
+
+// CompactResult reports what Compact removed from the feed file.
+type CompactResult struct {
+	LinesBefore  int
+	LinesAfter   int
+	BytesBefore  int64
+	BytesAfter   int64
+	PostsRemoved int
+}
+
+// compactLine is one non-empty feed line and its decoded record, if valid.
+type compactLine struct {
+	raw    []byte
+	record feedRecord
+	ok     bool
+}
+
+// Compact rewrites the feed file keeping only the lines needed for its
+// current state: deleted posts and their tombstones go, as do reactions and
+// edits of removed posts, duplicate reactions, edits ReadAll ignores, and
+// every edit but the latest of each post. A deleted post that still has
+// replies keeps its line and tombstone so it reads as "[deleted]".
+// When opts.KeepPosts is positive, only the newest KeepPosts posts (in file
+// order) are kept, plus the parents of kept replies so threads stay whole.
+// Lines that cannot be decoded are kept as is.
+//
+// The file is rewritten in place under the same lock Append takes, so
+// writers waiting on the lock append after the compacted content.
+func (s *FileStore) Compact(opts CompactOptions) (*CompactResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_RDWR, 0600)
+	if os.IsNotExist(err) {
+		return nil, ErrNotInitialized
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := lockFile(f, true); err != nil {
+		return nil, err
+	}
+	defer func() { _ = unlockFile(f) }()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading feed file: %w", err)
+	}
+	lines, err := splitCompactLines(data)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := compactLines(lines, opts.KeepPosts)
+	var buf bytes.Buffer
+	for _, line := range kept {
+		buf.Write(line.raw)
+		buf.WriteByte('\n')
+	}
+
+	result := &CompactResult{
+		LinesBefore: len(lines),
+		LinesAfter:  len(kept),
+		BytesBefore: int64(len(data)),
+		BytesAfter:  int64(buf.Len()),
+	}
+	for _, line := range lines {
+		if line.ok && line.record.post != nil {
+			result.PostsRemoved++
+		}
+	}
+	for _, line := range kept {
+		if line.ok && line.record.post != nil {
+			result.PostsRemoved--
+		}
+	}
+
+	if opts.DryRun {
+		return result, nil
+	}
+	if err := f.Truncate(0); err != nil {
+		return nil, fmt.Errorf("failed to truncate feed file: %w", err)
+	}
+	if _, err := f.WriteAt(buf.Bytes(), 0); err != nil {
+		return nil, fmt.Errorf("failed to write feed file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return nil, fmt.Errorf("failed to sync feed file: %w", err)
+	}
+	return result, nil
+}
+
+// splitCompactLines decodes every non-empty line of the feed.
+func splitCompactLines(data []byte) ([]compactLine, error) {
+	var lines []compactLine
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		raw := scanner.Bytes()
+		if len(raw) == 0 {
+			continue
+		}
+		record, ok := parseFeedLine(raw, lineNum)
+		lines = append(lines, compactLine{raw: bytes.Clone(raw), record: record, ok: ok})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading feed file: %w", err)
+	}
+	return lines, nil
+}
+
+// compactLines selects the lines Compact keeps, in their original order.
+func compactLines(lines []compactLine, keepPosts int) []compactLine {
+	deleted := make(map[string]bool)
+	for _, line := range lines {
+		if line.ok && line.record.tombstone != nil {
+			deleted[line.record.tombstone.TargetID] = true
+		}
+	}
+
+	// Same rule as applyTombstones: deleted posts with live replies stay.
+	var posts []*Post
+	hasReplies := make(map[string]bool)
+	for _, line := range lines {
+		if !line.ok || line.record.post == nil {
+			continue
+		}
+		post := line.record.post
+		posts = append(posts, post)
+		if post.IsReply() && !deleted[post.ID] {
+			hasReplies[post.ParentID] = true
+		}
+	}
+	keep := make(map[string]bool, len(posts))
+	var order []*Post
+	for _, post := range posts {
+		if !deleted[post.ID] || hasReplies[post.ID] {
+			keep[post.ID] = true
+			order = append(order, post)
+		}
+	}
+
+	if keepPosts > 0 && len(order) > keepPosts {
+		parents := make(map[string]string, len(order))
+		for _, post := range order {
+			parents[post.ID] = post.ParentID
+		}
+		keep = make(map[string]bool, keepPosts)
+		for _, post := range order[len(order)-keepPosts:] {
+			for id := post.ID; id != "" && !keep[id]; id = parents[id] {
+				keep[id] = true
+			}
+		}
+	}
+
+	// Only the latest edit by the post's author changes what ReadAll shows
+	authors := make(map[string]string, len(posts))
+	for _, post := range posts {
+		authors[post.ID] = post.Author
+	}
+	latestRevision := make(map[string]int)
+	for i, line := range lines {
+		if rev := line.record.revision; line.ok && rev != nil && rev.Author == authors[rev.TargetID] {
+			latestRevision[rev.TargetID] = i
+		}
+	}
+
+	seenReactions := make(map[[3]string]bool)
+	kept := make([]compactLine, 0, len(lines))
+	for i, line := range lines {
+		if !line.ok {
+			kept = append(kept, line)
+			continue
+		}
+		var target string
+		switch {
+		case line.record.post != nil:
+			target = line.record.post.ID
+		case line.record.tombstone != nil:
+			target = line.record.tombstone.TargetID
+		case line.record.reaction != nil:
+			r := line.record.reaction
+			key := [3]string{r.TargetID, r.Author, r.Emoji}
+			if seenReactions[key] {
+				continue
+			}
+			seenReactions[key] = true
+			target = r.TargetID
+		case line.record.revision != nil:
+			target = line.record.revision.TargetID
+			if deleted[target] {
+				continue
+			}
+			if last, ok := latestRevision[target]; !ok || last != i {
+				continue
+			}
+		}
+		if keep[target] {
+			kept = append(kept, line)
+		}
+	}
+	return kept
+}
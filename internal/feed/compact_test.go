@@ -0,0 +1,133 @@
+package feed
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func appendTestReply(t *testing.T, store *FileStore, id, parentID string) {
+	t.Helper()
+	require.NoError(t, store.Append(&Post{
+		ID:        id,
+		Author:    "spark",
+		Suffix:    "smoke",
+		Content:   "reply " + id,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		ParentID:  parentID,
+	}))
+}
+
+func TestCompact_DropsDeletedPosts(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	reaction, err := NewReaction("spark", "smk-aaaaaa", "🔥")
+	require.NoError(t, err)
+	require.NoError(t, store.AppendReaction(reaction))
+	appendTestPost(t, store, "smk-bbbbbb")
+	appendTestReply(t, store, "smk-cccccc", "smk-bbbbbb")
+	require.NoError(t, store.DeleteByID("smk-aaaaaa"))
+	require.NoError(t, store.DeleteByID("smk-bbbbbb"))
+
+	before, err := store.ReadAll()
+	require.NoError(t, err)
+
+	result, err := store.Compact(CompactOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.PostsRemoved)
+	assert.Equal(t, 6, result.LinesBefore)
+	assert.Equal(t, 3, result.LinesAfter, "deleted parent, its tombstone, and the reply remain")
+	assert.Less(t, result.BytesAfter, result.BytesBefore)
+
+	info, err := os.Stat(feedPath)
+	require.NoError(t, err)
+	assert.Equal(t, result.BytesAfter, info.Size())
+
+	after, err := store.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, before, after, "compaction does not change what readers see")
+}
+
+func TestCompact_KeepPostsKeepsThreadsWhole(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	appendTestPost(t, store, "smk-bbbbbb")
+	appendTestPost(t, store, "smk-cccccc")
+	appendTestReply(t, store, "smk-dddddd", "smk-bbbbbb")
+	appendTestPost(t, store, "smk-eeeeee")
+
+	result, err := store.Compact(CompactOptions{KeepPosts: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.PostsRemoved)
+
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	var ids []string
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+	}
+	assert.Equal(t, []string{"smk-bbbbbb", "smk-dddddd", "smk-eeeeee"}, ids,
+		"the newest two posts plus the parent of the kept reply")
+}
+
+func TestCompact_KeepsUnreadableLines(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	f, err := os.OpenFile(feedPath, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("not json\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	result, err := store.Compact(CompactOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.LinesAfter)
+	assert.Zero(t, result.PostsRemoved)
+}
+
+func TestCompact_CollapsesEditsAndReactions(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	appendTestReply(t, store, "smk-bbbbbb", "smk-aaaaaa")
+	for _, content := range []string{"first edit", "second edit", "final edit"} {
+		rev, err := NewRevision("ember", "smk-aaaaaa", content)
+		require.NoError(t, err)
+		require.NoError(t, store.AppendRevision(rev))
+	}
+	for i := 0; i < 3; i++ {
+		reaction, err := NewReaction("spark", "smk-aaaaaa", "👍")
+		require.NoError(t, err)
+		require.NoError(t, store.AppendReaction(reaction))
+	}
+
+	dry, err := store.Compact(CompactOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, 8, dry.LinesBefore)
+	assert.Equal(t, 4, dry.LinesAfter, "post, reply, latest edit, one reaction")
+	unchanged, err := countFeedLines(store)
+	require.NoError(t, err)
+	assert.Equal(t, 8, unchanged, "dry run leaves the file alone")
+
+	_, err = store.Compact(CompactOptions{})
+	require.NoError(t, err)
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	assert.Equal(t, "final edit", posts[0].Content)
+	assert.NotEmpty(t, posts[0].EditedAt)
+	assert.Equal(t, map[string]int{"👍": 1}, posts[0].Reactions)
+	assert.Equal(t, "smk-aaaaaa", posts[1].ParentID)
+}
+
+// countFeedLines counts the lines in the store's file
+func countFeedLines(store *FileStore) (int, error) {
+	data, err := os.ReadFile(store.Path())
+	if err != nil {
+		return 0, err
+	}
+	return bytes.Count(data, []byte("\n")), nil
+}
@@ -5,7 +5,7 @@ const DefaultContrastName = "medium"
 
 // ContrastLevel defines styling rules for identity display.
 type ContrastLevel struct {
-	// Name is the identifier for the contrast level (e.g., "high", "medium", "low")
+	// Name is the identifier for the contrast level (e.g., "max", "high", "medium", "low")
 	Name string
 	// DisplayName is the human-readable name (e.g., "High", "Medium", "Low")
 	DisplayName string
@@ -15,10 +15,12 @@ type ContrastLevel struct {
 	AgentColored bool
 	// ProjectColored indicates whether project uses color (vs dim)
 	ProjectColored bool
+	// ProjectBold indicates whether the "@project" part is displayed bold
+	ProjectBold bool
 }
 
 // AllContrastLevels is the registry of available contrast levels.
-// Levels will cycle in order: medium → high → low → medium
+// Levels will cycle in order: medium → high → max → low → medium
 var AllContrastLevels = []ContrastLevel{
 	{
 		Name:           "medium",
@@ -34,6 +36,14 @@ var AllContrastLevels = []ContrastLevel{
 		AgentColored:   true,
 		ProjectColored: true,
 	},
+	{
+		Name:           "max",
+		DisplayName:    "Max",
+		AgentBold:      true,
+		AgentColored:   true,
+		ProjectColored: true,
+		ProjectBold:    true,
+	},
 	{
 		Name:           "low",
 		DisplayName:    "Low",
@@ -128,8 +128,13 @@ func TestNextContrastLevel(t *testing.T) {
 			want:    "high",
 		},
 		{
-			name:    "next contrast after high is low",
+			name:    "next contrast after high is max",
 			current: "high",
+			want:    "max",
+		},
+		{
+			name:    "next contrast after max is low",
+			current: "max",
 			want:    "low",
 		},
 		{
@@ -167,7 +172,7 @@ func TestNextContrastLevel(t *testing.T) {
 func TestNextContrastLevelCycling(t *testing.T) {
 	// Verify that cycling through all contrast levels returns to the first
 	current := "medium"
-	expected := []string{"high", "low", "medium"}
+	expected := []string{"high", "max", "low", "medium"}
 
 	for _, exp := range expected {
 		current = NextContrastLevel(current)
@@ -0,0 +1,217 @@
+package feed
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Export formats supported by Export.
+const (
+	ExportMarkdown = "markdown"
+	ExportHTML     = "html"
+	ExportJSON     = "json"
+)
+
+// ExportFormats lists valid export formats in help-text order.
+var ExportFormats = []string{ExportMarkdown, ExportHTML, ExportJSON}
+
+// exportTimeLayout is used for timestamps in exported documents.
+const exportTimeLayout = "2006-01-02 15:04"
+
+// ExportOptions controls how a feed is exported.
+type ExportOptions struct {
+	Format      string
+	OldestFirst bool   // Order threads oldest first (default newest first)
+	Theme       *Theme // Colors for HTML output (nil = default theme)
+}
+
+// Export writes all posts as threads in the requested format.
+func Export(w io.Writer, posts []*Post, opts ExportOptions) error {
+	threads := exportThreads(posts, opts.OldestFirst)
+
+	switch opts.Format {
+	case ExportMarkdown:
+		return exportMarkdown(w, threads)
+	case ExportHTML:
+		theme := opts.Theme
+		if theme == nil {
+			theme = GetTheme(DefaultThemeName)
+		}
+		return exportHTML(w, threads, theme)
+	case ExportJSON:
+		return exportJSON(w, threads)
+	default:
+		return fmt.Errorf("unknown export format %q (valid: %s)", opts.Format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+// exportThreads groups posts into threads. Replies whose parent is missing are
+// kept as their own threads so nothing is dropped from the export.
+func exportThreads(posts []*Post, oldestFirst bool) []thread {
+	valid := make([]*Post, 0, len(posts))
+	ids := make(map[string]bool, len(posts))
+	for _, post := range posts {
+		if post == nil {
+			continue
+		}
+		valid = append(valid, post)
+		ids[post.ID] = true
+	}
+
+	threads := buildThreads(valid)
+	for _, post := range valid {
+		if post.IsReply() && !ids[post.ParentID] {
+			threads = append(threads, thread{post: post})
+		}
+	}
+
+	sort.SliceStable(threads, func(i, j int) bool {
+		ti, errI := threads[i].post.GetCreatedTime()
+		tj, errJ := threads[j].post.GetCreatedTime()
+		if errI != nil || errJ != nil {
+			return false
+		}
+		if oldestFirst {
+			return ti.Before(tj)
+		}
+		return ti.After(tj)
+	})
+	return threads
+}
+
+// exportTimestamp formats a post's creation time for export, or "" if invalid.
+func exportTimestamp(post *Post) string {
+	t, err := post.GetCreatedTime()
+	if err != nil {
+		return ""
+	}
+	return t.Local().Format(exportTimeLayout)
+}
+
+func exportMarkdown(w io.Writer, threads []thread) error {
+	var b strings.Builder
+	b.WriteString("# Smoke feed\n\n")
+	if len(threads) == 0 {
+		b.WriteString("_No posts yet._\n")
+	}
+	for i, t := range threads {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeMarkdownQuote(&b, t.post, "> ")
+		for _, reply := range t.replies {
+			b.WriteString(">\n")
+			// Nested replies quote one level deeper per reply level
+			writeMarkdownQuote(&b, reply, strings.Repeat("> ", reply.Depth+1))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMarkdownQuote writes a post as a blockquote with the given line prefix.
+func writeMarkdownQuote(b *strings.Builder, post *Post, prefix string) {
+	header := "**" + post.Author + "**"
+	if ts := exportTimestamp(post); ts != "" {
+		header += " · " + ts
+	}
+	b.WriteString(prefix + header + "\n")
+	b.WriteString(strings.TrimRight(prefix, " ") + "\n")
+	for _, line := range strings.Split(post.Content, "\n") {
+		b.WriteString(prefix + line + "\n")
+	}
+}
+
+func exportJSON(w io.Writer, threads []thread) error {
+	posts := make([]*Post, 0)
+	for _, t := range threads {
+		posts = append(posts, t.post)
+		posts = append(posts, t.replies...)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(posts)
+}
+
+// htmlPost is the view model for a post in the HTML template.
+type htmlPost struct {
+	Author    string
+	Color     string
+	Timestamp string
+	Content   string
+}
+
+type htmlThread struct {
+	Post    htmlPost
+	Replies []htmlPost
+}
+
+var exportHTMLTemplate = template.Must(template.New("export").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Smoke feed</title>
+<style>
+body { background: {{.Background}}; color: {{.Text}}; font-family: ui-monospace, SFMono-Regular, Menlo, monospace; max-width: 48rem; margin: 2rem auto; padding: 0 1rem; }
+h1 { color: {{.Accent}}; font-size: 1.25rem; }
+.thread { border-left: 3px solid {{.Accent}}; padding-left: 1rem; margin: 1.5rem 0; }
+.reply { margin: 0.75rem 0 0 1.5rem; border-left: 2px solid {{.Muted}}; padding-left: 0.75rem; }
+.author { font-weight: bold; }
+.time { color: {{.Muted}}; margin-left: 0.5rem; }
+.content { white-space: pre-wrap; margin: 0.25rem 0 0; }
+.empty { color: {{.Muted}}; }
+</style>
+</head>
+<body>
+<h1>Smoke feed</h1>
+{{- if not .Threads}}
+<p class="empty">No posts yet.</p>
+{{- end}}
+{{- range .Threads}}
+<div class="thread">
+{{template "post" .Post}}
+{{- range .Replies}}
+<div class="reply">
+{{template "post" .}}
+</div>
+{{- end}}
+</div>
+{{- end}}
+</body>
+</html>
+{{define "post"}}<span class="author" style="color: {{.Color}}">{{.Author}}</span>{{if .Timestamp}}<span class="time">{{.Timestamp}}</span>{{end}}
+<p class="content">{{.Content}}</p>{{end}}
+`))
+
+func exportHTML(w io.Writer, threads []thread, theme *Theme) error {
+	toHTML := func(post *Post) htmlPost {
+		return htmlPost{
+			Author:    post.Author,
+			Color:     string(AgentColor(post.Author, theme)),
+			Timestamp: exportTimestamp(post),
+			Content:   post.Content,
+		}
+	}
+
+	data := struct {
+		Background, Text, Muted, Accent template.CSS
+		Threads                         []htmlThread
+	}{
+		Background: template.CSS(theme.Background.Dark),
+		Text:       template.CSS(theme.Text.Dark),
+		Muted:      template.CSS(theme.TextMuted.Dark),
+		Accent:     template.CSS(theme.Accent.Dark),
+	}
+	for _, t := range threads {
+		ht := htmlThread{Post: toHTML(t.post)}
+		for _, reply := range t.replies {
+			ht.Replies = append(ht.Replies, toHTML(reply))
+		}
+		data.Threads = append(data.Threads, ht)
+	}
+	return exportHTMLTemplate.Execute(w, data)
+}
@@ -0,0 +1,123 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func exportTestPosts() []*Post {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) string { return base.Add(time.Duration(minutes) * time.Minute).Format(time.RFC3339) }
+	return []*Post{
+		{ID: "smk-aaaaaa", Author: "ember@smoke", Content: "first <post>", CreatedAt: at(0)},
+		{ID: "smk-bbbbbb", Author: "spark@smoke", Content: "second post", CreatedAt: at(10)},
+		{ID: "smk-cccccc", Author: "wisp@smoke", Content: "reply to first", CreatedAt: at(20), ParentID: "smk-aaaaaa"},
+		{ID: "smk-dddddd", Author: "flare@smoke", Content: "orphan reply", CreatedAt: at(30), ParentID: "smk-gone00"},
+		nil,
+	}
+}
+
+func TestExport_Markdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, exportTestPosts(), ExportOptions{Format: ExportMarkdown}); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	out := buf.String()
+
+	if !strings.Contains(out, "> **ember@smoke** · ") || !strings.Contains(out, "> first <post>") {
+		t.Errorf("post should render as blockquote:\n%s", out)
+	}
+	if !strings.Contains(out, "> > **wisp@smoke**") || !strings.Contains(out, "> > reply to first") {
+		t.Errorf("reply should render as nested quote:\n%s", out)
+	}
+	if !strings.Contains(out, "orphan reply") {
+		t.Errorf("orphan replies should not be dropped:\n%s", out)
+	}
+	// Newest first by default
+	if strings.Index(out, "orphan reply") > strings.Index(out, "second post") ||
+		strings.Index(out, "second post") > strings.Index(out, "first <post>") {
+		t.Errorf("threads should be newest first:\n%s", out)
+	}
+}
+
+func TestExport_OldestFirst(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, exportTestPosts(), ExportOptions{Format: ExportMarkdown, OldestFirst: true}); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	out := buf.String()
+	first := strings.Index(out, "first <post>")
+	reply := strings.Index(out, "reply to first")
+	second := strings.Index(out, "second post")
+	if first >= reply || reply >= second {
+		t.Errorf("expected first post, its reply, then second post:\n%s", out)
+	}
+}
+
+func TestExport_HTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, exportTestPosts(), ExportOptions{Format: ExportHTML}); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasPrefix(out, "<!DOCTYPE html>") || !strings.Contains(out, "<style>") {
+		t.Error("HTML export should be a self-contained page")
+	}
+	if !strings.Contains(out, GetTheme(DefaultThemeName).Background.Dark) {
+		t.Error("HTML export should use theme colors")
+	}
+	if !strings.Contains(out, "first &lt;post&gt;") {
+		t.Error("content should be HTML-escaped")
+	}
+	if !strings.Contains(out, `class="reply"`) {
+		t.Error("replies should be nested")
+	}
+}
+
+func TestExport_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, exportTestPosts(), ExportOptions{Format: ExportJSON, OldestFirst: true}); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	var posts []Post
+	if err := json.Unmarshal(buf.Bytes(), &posts); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	var ids []string
+	for _, p := range posts {
+		ids = append(ids, p.ID)
+	}
+	want := "smk-aaaaaa smk-cccccc smk-bbbbbb smk-dddddd"
+	if got := strings.Join(ids, " "); got != want {
+		t.Errorf("JSON order = %s, want %s", got, want)
+	}
+}
+
+func TestExport_EmptyFeed(t *testing.T) {
+	for _, format := range ExportFormats {
+		var buf bytes.Buffer
+		if err := Export(&buf, nil, ExportOptions{Format: format}); err != nil {
+			t.Fatalf("Export(%s) error = %v", format, err)
+		}
+		if buf.Len() == 0 {
+			t.Errorf("Export(%s) wrote nothing for empty feed", format)
+		}
+	}
+
+	var buf bytes.Buffer
+	_ = Export(&buf, nil, ExportOptions{Format: ExportJSON})
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("empty JSON export = %q, want []", buf.String())
+	}
+}
+
+func TestExport_UnknownFormat(t *testing.T) {
+	if err := Export(&bytes.Buffer{}, nil, ExportOptions{Format: "pdf"}); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
@@ -49,3 +49,29 @@ func FilterRecent(posts []*Post, window time.Duration) ([]*Post, error) {
 
 	return filtered, nil
 }
+
+// FilterSince filters posts to those created strictly after the given time,
+// sorted by timestamp newest first. Future posts are excluded.
+func FilterSince(posts []*Post, since time.Time) []*Post {
+	now := time.Now().UTC()
+	filtered := []*Post{}
+
+	for _, post := range posts {
+		createdTime, err := post.GetCreatedTime()
+		if err != nil {
+			continue
+		}
+		if createdTime.After(now) || !createdTime.After(since) {
+			continue
+		}
+		filtered = append(filtered, post)
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		timeI, _ := filtered[i].GetCreatedTime()
+		timeJ, _ := filtered[j].GetCreatedTime()
+		return timeI.After(timeJ)
+	})
+
+	return filtered
+}
@@ -432,3 +432,27 @@ func TestFilterRecentEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterSince(t *testing.T) {
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "smk-old111", CreatedAt: now.Add(-3 * time.Hour).Format(time.RFC3339)},
+		{ID: "smk-mark11", CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+		{ID: "smk-new111", CreatedAt: now.Add(-1 * time.Hour).Format(time.RFC3339)},
+		{ID: "smk-new222", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "smk-future", CreatedAt: now.Add(1 * time.Hour).Format(time.RFC3339)},
+		{ID: "smk-badtim", CreatedAt: "not-a-time"},
+	}
+
+	since, err := posts[1].GetCreatedTime()
+	assert.NoError(t, err)
+
+	result := FilterSince(posts, since)
+
+	ids := make([]string, len(result))
+	for i, p := range result {
+		ids[i] = p.ID
+	}
+	assert.Equal(t, []string{"smk-new222", "smk-new111"}, ids)
+	assert.Empty(t, FilterSince(nil, since))
+}
@@ -1,11 +1,16 @@
 package feed
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
+	"unicode/utf8"
+
+	xansi "github.com/charmbracelet/x/ansi"
 )
 
 // FormatOptions controls how posts are displayed
@@ -14,6 +19,13 @@ type FormatOptions struct {
 	Quiet         bool      // Suppress headers and formatting
 	ColorMode     ColorMode // Color output mode (Auto, Always, Never)
 	TerminalWidth int       // Terminal width for wrapping (0 = auto-detect)
+	MaxReplies    int       // Max replies shown per thread (0 = all)
+	// LineFormat renders each post as one uncolored line (see
+	// ParseLineFormat), in place of the oneline and compact formats.
+	LineFormat *template.Template
+	// Context holds IDs of posts shown only for context (see WithContext),
+	// which FormatFeed dims.
+	Context map[string]bool
 }
 
 // getTerminalWidth returns the effective terminal width from options
@@ -29,9 +41,12 @@ func (o FormatOptions) getTerminalWidth() int {
 // instance directly (f.formatCompact) or use FormatFeed.
 func FormatPost(w io.Writer, post *Post, opts FormatOptions) {
 	cw := NewColorWriter(w, opts.ColorMode)
-	if opts.Oneline {
+	switch {
+	case opts.LineFormat != nil:
+		formatLine(w, post, opts.LineFormat)
+	case opts.Oneline:
 		formatOneline(w, post, cw)
-	} else {
+	default:
 		// Use a fresh formatter for each post to avoid thread-safety issues
 		// with global state. Each post gets its own timestamp display.
 		f := NewFormatter()
@@ -39,27 +54,91 @@ func FormatPost(w io.Writer, post *Post, opts FormatOptions) {
 	}
 }
 
-// formatThreadOneline formats a thread in oneline mode.
-func formatThreadOneline(w io.Writer, thread thread, cw *ColorWriter) {
-	formatOneline(w, thread.post, cw)
-	for _, reply := range thread.replies {
-		formatOneline(w, reply, cw)
+// collapseReplies returns the leading and trailing replies to show when a
+// thread has more than maxReplies replies, plus the number hidden between them.
+// A maxReplies of 0 or less shows all replies.
+func collapseReplies(replies []*Post, maxReplies int) (head, tail []*Post, hidden int) {
+	if maxReplies <= 0 || len(replies) <= maxReplies {
+		return replies, nil, 0
+	}
+	tailCount := maxReplies / 2
+	headCount := maxReplies - tailCount
+	return replies[:headCount], replies[len(replies)-tailCount:], len(replies) - maxReplies
+}
+
+// moreRepliesLabel returns the stub shown in place of hidden replies.
+func moreRepliesLabel(hidden int) string {
+	if hidden == 1 {
+		return "… 1 more reply"
+	}
+	return fmt.Sprintf("… %d more replies", hidden)
+}
+
+// formatMoreReplies writes the hidden-replies stub for the plain feed.
+func formatMoreReplies(w io.Writer, hidden int, cw *ColorWriter) {
+	_, _ = fmt.Fprintf(w, "  %s\n", cw.Dim(moreRepliesLabel(hidden)+" (--max-replies 0 to show all)"))
+}
+
+// formatThreadOneline formats a thread in oneline mode, or through
+// ctx.lineFormat when it is set.
+func formatThreadOneline(w io.Writer, thread thread, ctx *threadFormatContext) {
+	format := func(post *Post) {
+		if ctx.lineFormat != nil {
+			formatLine(w, post, ctx.lineFormat)
+			return
+		}
+		ctx.render(w, post, func(w io.Writer, cw *ColorWriter) { formatOneline(w, post, cw) })
+	}
+	format(thread.post)
+	head, tail, hidden := collapseReplies(thread.replies, ctx.maxReplies)
+	for _, reply := range head {
+		format(reply)
+	}
+	if hidden > 0 {
+		formatMoreReplies(w, hidden, ctx.cw)
+	}
+	for _, reply := range tail {
+		format(reply)
 	}
 }
 
 // threadFormatContext bundles formatting dependencies for thread rendering.
 type threadFormatContext struct {
-	formatter *Formatter
-	cw        *ColorWriter
-	termWidth int
+	formatter  *Formatter
+	cw         *ColorWriter
+	termWidth  int
+	maxReplies int
+	lineFormat *template.Template
+	context    map[string]bool
+}
+
+// render writes post with render, dimmed when it is only shown for context.
+func (ctx *threadFormatContext) render(w io.Writer, post *Post, render func(io.Writer, *ColorWriter)) {
+	if ctx.context[post.ID] {
+		formatDimmed(w, ctx.cw, render)
+		return
+	}
+	render(w, ctx.cw)
 }
 
 // formatThreadCompact formats a thread in compact mode with an optional trailing blank line.
 func formatThreadCompact(w io.Writer, t thread, ctx *threadFormatContext, trailingBlank bool) {
-	ctx.formatter.formatCompact(w, t.post, ctx.cw, ctx.termWidth)
-	for _, reply := range t.replies {
-		formatReply(w, t.post, reply, ctx.cw, ctx.termWidth)
+	ctx.render(w, t.post, func(w io.Writer, cw *ColorWriter) {
+		ctx.formatter.formatCompact(w, t.post, cw, ctx.termWidth)
+	})
+	formatReplies := func(replies []*Post) {
+		for _, reply := range replies {
+			ctx.render(w, reply, func(w io.Writer, cw *ColorWriter) {
+				formatReply(w, t.post, reply, cw, ctx.termWidth)
+			})
+		}
+	}
+	head, tail, hidden := collapseReplies(t.replies, ctx.maxReplies)
+	formatReplies(head)
+	if hidden > 0 {
+		formatMoreReplies(w, hidden, ctx.cw)
 	}
+	formatReplies(tail)
 	if trailingBlank {
 		_, _ = fmt.Fprintln(w)
 	}
@@ -76,16 +155,19 @@ func FormatFeed(w io.Writer, posts []*Post, opts FormatOptions, total int) {
 
 	formatter := NewFormatter()
 	cw := NewColorWriter(w, opts.ColorMode)
-	threads := buildThreads(posts)
+	threads := buildThreadsKeepingOrphans(posts)
 	ctx := &threadFormatContext{
-		formatter: formatter,
-		cw:        cw,
-		termWidth: opts.getTerminalWidth(),
+		formatter:  formatter,
+		cw:         cw,
+		termWidth:  opts.getTerminalWidth(),
+		maxReplies: opts.MaxReplies,
+		lineFormat: opts.LineFormat,
+		context:    opts.Context,
 	}
 
 	for i, thread := range threads {
-		if opts.Oneline {
-			formatThreadOneline(w, thread, cw)
+		if opts.Oneline || opts.LineFormat != nil {
+			formatThreadOneline(w, thread, ctx)
 		} else {
 			formatThreadCompact(w, thread, ctx, i < len(threads)-1)
 		}
@@ -96,6 +178,86 @@ func FormatFeed(w io.Writer, posts []*Post, opts FormatOptions, total int) {
 	}
 }
 
+// ThreadJSON is a top-level post with its replies, used by FormatJSON when nested.
+type ThreadJSON struct {
+	*Post
+	Replies []*Post `json:"replies"`
+}
+
+// FormatJSON writes posts as an indented JSON array in the given order.
+// With nested, replies are grouped under their parent post; replies whose
+// parent is not among posts are kept as entries of their own.
+func FormatJSON(w io.Writer, posts []*Post, nested bool) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonPosts(posts, nested))
+}
+
+// PageJSON is one page of posts with the cursors to its neighbors, as
+// written by FormatJSONPage.
+type PageJSON struct {
+	Posts any `json:"posts"`
+	// NextCursor is the post ID to pass to --before for the next, older page.
+	NextCursor string `json:"next_cursor,omitempty"`
+	// PrevCursor is the post ID to pass to --after for the previous, newer page.
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// FormatJSONPage writes one page of posts as an indented JSON object with
+// the posts under "posts", laid out as FormatJSON would, and the cursors.
+func FormatJSONPage(w io.Writer, posts []*Post, nested bool, next, prev string) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(PageJSON{Posts: jsonPosts(posts, nested), NextCursor: next, PrevCursor: prev})
+}
+
+// jsonPosts returns the value FormatJSON encodes for posts.
+func jsonPosts(posts []*Post, nested bool) any {
+	if nested {
+		return nestThreads(posts)
+	}
+	if posts == nil {
+		return []*Post{}
+	}
+	return posts
+}
+
+// nestThreads groups replies under their parents, keeping the order of
+// top-level entries and sorting replies oldest first.
+func nestThreads(posts []*Post) []ThreadJSON {
+	present := make(map[string]bool, len(posts))
+	for _, p := range posts {
+		present[p.ID] = true
+	}
+
+	threads := make([]ThreadJSON, 0, len(posts))
+	index := make(map[string]int)
+	for _, p := range posts {
+		if p.IsReply() && present[p.ParentID] {
+			continue
+		}
+		index[p.ID] = len(threads)
+		threads = append(threads, ThreadJSON{Post: p, Replies: []*Post{}})
+	}
+	for _, p := range posts {
+		if !p.IsReply() || !present[p.ParentID] {
+			continue
+		}
+		if i, ok := index[p.ParentID]; ok {
+			threads[i].Replies = append(threads[i].Replies, p)
+		} else {
+			threads = append(threads, ThreadJSON{Post: p, Replies: []*Post{}})
+		}
+	}
+	for i := range threads {
+		replies := threads[i].Replies
+		sort.SliceStable(replies, func(a, b int) bool {
+			return replies[a].CreatedAt < replies[b].CreatedAt
+		})
+	}
+	return threads
+}
+
 // FormatTailHeader prints the tail mode header
 func FormatTailHeader(w io.Writer) {
 	_, _ = fmt.Fprintln(w, "Watching for new posts... (Ctrl+C to stop)")
@@ -107,8 +269,35 @@ type thread struct {
 	replies []*Post
 }
 
-// buildThreads groups replies under their parent posts
+// lastReplyAt returns when the newest reply in the thread was posted, or the
+// zero time when no reply has a valid timestamp.
+func (t thread) lastReplyAt() time.Time {
+	var last time.Time
+	for _, reply := range t.replies {
+		if created, err := reply.GetCreatedTime(); err == nil && created.After(last) {
+			last = created
+		}
+	}
+	return last
+}
+
+// buildThreads groups replies under their parent posts. Replies whose
+// parent is not among posts are left out.
 func buildThreads(posts []*Post) []thread {
+	return groupThreads(posts, false)
+}
+
+// buildThreadsKeepingOrphans is buildThreads for smoke feed, where filters
+// such as --author can leave a reply without its parent: such a reply starts
+// its own thread rather than disappearing from the output.
+func buildThreadsKeepingOrphans(posts []*Post) []thread {
+	return groupThreads(posts, true)
+}
+
+// groupThreads groups replies under their parent posts, promoting replies
+// whose parent is not among posts to threads of their own when keepOrphans
+// is set.
+func groupThreads(posts []*Post, keepOrphans bool) []thread {
 	// Separate posts and replies
 	postMap := make(map[string]*Post)
 	replyMap := make(map[string][]*Post)
@@ -116,10 +305,13 @@ func buildThreads(posts []*Post) []thread {
 
 	for _, p := range posts {
 		postMap[p.ID] = p
-		if p.IsReply() {
-			replyMap[p.ParentID] = append(replyMap[p.ParentID], p)
-		} else {
+	}
+	for _, p := range posts {
+		switch {
+		case !p.IsReply() || (keepOrphans && postMap[p.ParentID] == nil):
 			topLevelPosts = append(topLevelPosts, p)
+		default:
+			replyMap[p.ParentID] = append(replyMap[p.ParentID], p)
 		}
 	}
 
@@ -135,26 +327,59 @@ func buildThreads(posts []*Post) []thread {
 
 	// Build threads
 	threads := make([]thread, 0, len(topLevelPosts))
+	seen := make(map[string]bool)
 	for _, post := range topLevelPosts {
-		t := thread{post: post}
-		if replies, ok := replyMap[post.ID]; ok {
-			// Sort replies by time (oldest first)
-			sort.Slice(replies, func(i, j int) bool {
-				ti, errI := replies[i].GetCreatedTime()
-				tj, errJ := replies[j].GetCreatedTime()
-				if errI != nil || errJ != nil {
-					return false
-				}
-				return ti.Before(tj)
-			})
-			t.replies = replies
-		}
-		threads = append(threads, t)
+		post.Depth = 0
+		threads = append(threads, thread{post: post, replies: flattenReplies(post.ID, replyMap, 1, seen)})
 	}
 
 	return threads
 }
 
+// flattenReplies returns the replies below parentID depth-first, so each
+// nested reply directly follows its parent, oldest first at every level.
+// It sets Depth on each reply; seen guards against malformed reply cycles.
+func flattenReplies(parentID string, replyMap map[string][]*Post, depth int, seen map[string]bool) []*Post {
+	replies := replyMap[parentID]
+	// Sort replies by time (oldest first)
+	sort.Slice(replies, func(i, j int) bool {
+		ti, errI := replies[i].GetCreatedTime()
+		tj, errJ := replies[j].GetCreatedTime()
+		if errI != nil || errJ != nil {
+			return false
+		}
+		return ti.Before(tj)
+	})
+
+	var flat []*Post
+	for _, reply := range replies {
+		if seen[reply.ID] {
+			continue
+		}
+		seen[reply.ID] = true
+		reply.Depth = depth
+		flat = append(flat, reply)
+		flat = append(flat, flattenReplies(reply.ID, replyMap, depth+1, seen)...)
+	}
+	return flat
+}
+
+// MaxReplyIndentDepth caps how many nesting levels indent further; deeper
+// replies line up with the last indented level so content keeps its width.
+const MaxReplyIndentDepth = 4
+
+// replyIndent returns the extra indentation for a reply's nesting level:
+// none for direct replies, three spaces for each level beyond.
+func replyIndent(depth int) string {
+	if depth > MaxReplyIndentDepth {
+		depth = MaxReplyIndentDepth
+	}
+	if depth < 2 {
+		return ""
+	}
+	return strings.Repeat("   ", depth-1)
+}
+
 // MinAuthorColumnWidth is the minimum width for identity column (right-aligned)
 // Format: agent-adjective-animal@project (e.g., claude-swift-fox@smoke)
 const MinAuthorColumnWidth = 28
@@ -165,6 +390,9 @@ const TimeColumnWidth = 5
 // MinContentWidth is the minimum content width before we stop trying to wrap nicely
 const MinContentWidth = 30
 
+// plainCodeIndent is how far fenced code lines are indented in CLI output
+const plainCodeIndent = 2
+
 // OnelineContentWidth is the maximum content length in oneline format
 const OnelineContentWidth = 60
 
@@ -251,7 +479,7 @@ func (f *Formatter) formatCompact(w io.Writer, post *Post, cw *ColorWriter, term
 
 	// Build identity display with right-alignment
 	// Author field contains full identity: agent-adjective-animal@project
-	authorLayout := CalculateAuthorLayout(len(post.Author), MinAuthorColumnWidth)
+	authorLayout := CalculateAuthorLayout(xansi.StringWidth(post.Author), MinAuthorColumnWidth)
 
 	padding := ""
 	if authorLayout.Padding > 0 {
@@ -265,7 +493,7 @@ func (f *Formatter) formatCompact(w io.Writer, post *Post, cw *ColorWriter, term
 	contentLayout := CalculateContentLayout(TimeColumnWidth, authorLayout.ColWidth, termWidth, MinContentWidth)
 
 	// Wrap content if needed
-	contentLines := wrapText(post.Content, contentLayout.Width)
+	contentLines := wrapContentPlain(post.Content, contentLayout.Width, contentLayout.Width, plainCodeIndent)
 	for i, line := range contentLines {
 		highlightedLine := HighlightAll(line, cw.ColorEnabled)
 		if i == 0 {
@@ -280,28 +508,51 @@ func (f *Formatter) formatCompact(w io.Writer, post *Post, cw *ColorWriter, term
 }
 
 // findBreakPoint finds the best position to break a line at the given width.
-// Prefers breaking at a space; falls back to a hard break at width.
+// Width is measured in terminal cells, so wide CJK characters and emoji
+// count twice. Prefers breaking at a space; falls back to a hard break at
+// width. The returned byte offset always falls on a character boundary.
 func findBreakPoint(text string, width int) int {
-	breakPoint := width
-	if breakPoint >= len(text) {
-		breakPoint = len(text) - 1
+	cut := cellPrefixLen(text, width)
+	if cut < len(text) && text[cut] == ' ' {
+		return cut
 	}
-	for breakPoint > 0 && text[breakPoint] != ' ' {
-		breakPoint--
+	if space := strings.LastIndexByte(text[:cut], ' '); space > 0 {
+		return space
 	}
-	if breakPoint == 0 {
-		breakPoint = width
-		if breakPoint > len(text) {
-			breakPoint = len(text)
-		}
+	return cut
+}
+
+// cellPrefixLen returns the byte length of the longest prefix of text that
+// fits in width terminal cells. At least one character is kept so wrapping
+// always makes progress, even when a wide character exceeds width.
+func cellPrefixLen(text string, width int) int {
+	n := len(xansi.Truncate(text, width, ""))
+	if n == 0 && text != "" {
+		_, n = utf8.DecodeRuneInString(text)
 	}
-	return breakPoint
+	return n
 }
 
 // wrapTextWithWidths wraps text with different widths for first and subsequent lines.
 // This is the core wrapping function that handles both uniform and variable-width wrapping.
+// Newlines in text are hard breaks: each line is wrapped on its own and blank
+// lines are kept, so paragraphs stay apart.
 func wrapTextWithWidths(text string, firstLineWidth, subsequentWidth int) []string {
-	if len(text) <= firstLineWidth {
+	if !strings.Contains(text, "\n") {
+		return wrapLine(text, firstLineWidth, subsequentWidth)
+	}
+	var lines []string
+	width := firstLineWidth
+	for _, segment := range strings.Split(text, "\n") {
+		lines = append(lines, wrapLine(strings.TrimRight(segment, "\r "), width, subsequentWidth)...)
+		width = subsequentWidth
+	}
+	return lines
+}
+
+// wrapLine wraps a single line of text on word boundaries.
+func wrapLine(text string, firstLineWidth, subsequentWidth int) []string {
+	if xansi.StringWidth(text) <= firstLineWidth {
 		return []string{text}
 	}
 
@@ -309,7 +560,7 @@ func wrapTextWithWidths(text string, firstLineWidth, subsequentWidth int) []stri
 	remaining := text
 	currentWidth := firstLineWidth
 
-	for len(remaining) > currentWidth {
+	for xansi.StringWidth(remaining) > currentWidth {
 		breakPoint := findBreakPoint(remaining, currentWidth)
 		lines = append(lines, remaining[:breakPoint])
 		remaining = strings.TrimLeft(remaining[breakPoint:], " ")
@@ -329,17 +580,27 @@ func wrapText(text string, maxWidth int) []string {
 	return wrapTextWithWidths(text, maxWidth, maxWidth)
 }
 
+// replyBranch returns the tree character that starts a reply line, in ASCII
+// when NoColor is set.
+func replyBranch() string {
+	if NoColor() {
+		return "`-"
+	}
+	return "└─"
+}
+
 // formatReply formats a reply with indent (parent already shown in thread)
 func formatReply(w io.Writer, _ *Post, reply *Post, cw *ColorWriter, termWidth int) {
 	// For replies, always show timestamp (they're responses, timing matters)
 	timestamp := cw.Dim(formatTimestamp(reply))
 
-	// Reply prefix: "  └─ " = 5 chars
-	const replyPrefix = 5
+	// Reply prefix: "  └─ " = 5 chars, plus indentation for nested replies
+	indent := replyIndent(reply.Depth)
+	replyPrefix := 5 + len(indent)
 
 	// Build identity display - slightly smaller minimum width for reply indent
 	minReplyAuthorWidth := MinAuthorColumnWidth - 3
-	authorLayout := CalculateAuthorLayout(len(reply.Author), minReplyAuthorWidth)
+	authorLayout := CalculateAuthorLayout(xansi.StringWidth(reply.Author), minReplyAuthorWidth)
 
 	padding := ""
 	if authorLayout.Padding > 0 {
@@ -353,12 +614,12 @@ func formatReply(w io.Writer, _ *Post, reply *Post, cw *ColorWriter, termWidth i
 	contentLayout := CalculateContentLayout(replyPrefix+TimeColumnWidth, authorLayout.ColWidth, termWidth, MinContentWidth)
 
 	// Wrap content if needed
-	contentLines := wrapText(reply.Content, contentLayout.Width)
+	contentLines := wrapContentPlain(reply.Content, contentLayout.Width, contentLayout.Width, plainCodeIndent)
 	for i, line := range contentLines {
 		highlightedLine := HighlightAll(line, cw.ColorEnabled)
 		if i == 0 {
 			// First line: with tree character
-			_, _ = fmt.Fprintf(w, "  └─ %s %s  %s\n", timestamp, authorRig, highlightedLine)
+			_, _ = fmt.Fprintf(w, "  %s%s %s %s  %s\n", indent, replyBranch(), timestamp, authorRig, highlightedLine)
 		} else {
 			// Continuation lines: indent to align with content
 			indent := strings.Repeat(" ", contentLayout.Start)
@@ -367,11 +628,26 @@ func formatReply(w io.Writer, _ *Post, reply *Post, cw *ColorWriter, termWidth i
 	}
 }
 
+// SingleLine joins the lines of multi-line content with spaces, dropping
+// blank ones, for formats that show a post on one line.
+func SingleLine(content string) string {
+	if !strings.Contains(content, "\n") {
+		return content
+	}
+	var parts []string
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			parts = append(parts, line)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
 func formatOneline(w io.Writer, post *Post, cw *ColorWriter) {
 	// Truncate content if needed for single line
-	content := post.Content
-	if len(content) > OnelineContentWidth {
-		content = content[:OnelineTruncateLen] + "..."
+	content := SingleLine(post.Content)
+	if xansi.StringWidth(content) > OnelineContentWidth {
+		content = content[:cellPrefixLen(content, OnelineTruncateLen)] + "..."
 	}
 	// Apply highlighting
 	content = HighlightAll(content, cw.ColorEnabled)
@@ -390,22 +666,45 @@ func FormatReplied(w io.Writer, post *Post) {
 	_, _ = fmt.Fprintf(w, "Replied %s -> %s\n", post.ID, post.ParentID)
 }
 
-// FilterCriteria specifies filters to apply when reading posts
+// FormatReacted outputs the confirmation message after reacting
+func FormatReacted(w io.Writer, reaction *Reaction) {
+	_, _ = fmt.Fprintf(w, "Reacted %s -> %s\n", reaction.Emoji, reaction.TargetID)
+}
+
+// FormatEdited writes the confirmation for a saved edit.
+func FormatEdited(w io.Writer, rev *Revision) {
+	_, _ = fmt.Fprintf(w, "Edited %s\n", rev.TargetID)
+}
+
+// FilterCriteria specifies filters to apply when reading posts. Author and
+// ExcludeAuthor take comma-separated names matched with AuthorMatches.
 type FilterCriteria struct {
-	Author string
-	Suffix string
-	Since  time.Time
-	Today  bool
+	Author        string
+	ExcludeAuthor string
+	Suffix        string
+	Since         time.Time
+	Today         bool
+	Tag           string
+	Mention       string
 }
 
 // matchesCriteria returns true if a post matches the given filter criteria.
 func matchesCriteria(post *Post, criteria FilterCriteria) bool {
-	if criteria.Author != "" && !strings.Contains(post.Author, criteria.Author) {
+	if criteria.Author != "" && !matchesAnyAuthor(post, criteria.Author) {
+		return false
+	}
+	if criteria.ExcludeAuthor != "" && matchesAnyAuthor(post, criteria.ExcludeAuthor) {
 		return false
 	}
 	if criteria.Suffix != "" && post.Suffix != criteria.Suffix {
 		return false
 	}
+	if criteria.Tag != "" && !post.HasTag(criteria.Tag) {
+		return false
+	}
+	if criteria.Mention != "" && !post.MentionsName(criteria.Mention) {
+		return false
+	}
 	if !criteria.Since.IsZero() {
 		postTime, err := post.GetCreatedTime()
 		if err != nil || postTime.Before(criteria.Since) {
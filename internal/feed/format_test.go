@@ -2,9 +2,14 @@ package feed
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
+
+	xansi "github.com/charmbracelet/x/ansi"
 )
 
 func TestFormatPost(t *testing.T) {
@@ -150,6 +155,105 @@ func TestFormatFeedWithReplies(t *testing.T) {
 	}
 }
 
+func TestFormatFeedReplyWithoutParent(t *testing.T) {
+	posts := []*Post{{
+		ID:        "smk-reply1",
+		Author:    "claude-calm-owl@smoke",
+		Project:   "smoke",
+		Suffix:    "calm-owl",
+		Content:   "reply to a filtered post",
+		CreatedAt: "2026-01-30T09:05:00Z",
+		ParentID:  "smk-parent",
+	}}
+
+	for _, oneline := range []bool{false, true} {
+		var buf bytes.Buffer
+		FormatFeed(&buf, posts, FormatOptions{Oneline: oneline}, 2)
+		if !strings.Contains(buf.String(), "reply to a filtered post") {
+			t.Errorf("oneline=%v: reply without its parent should still be shown: %s", oneline, buf.String())
+		}
+	}
+}
+
+func TestBuildThreads_OrphanReplies(t *testing.T) {
+	posts := []*Post{
+		{ID: "smk-top001", Content: "top", CreatedAt: "2026-01-30T09:00:00Z"},
+		{ID: "smk-reply1", Content: "orphan", CreatedAt: "2026-01-30T09:05:00Z", ParentID: "smk-gone00"},
+	}
+
+	// The TUI and export group threads with buildThreads, which leaves
+	// orphans out; only smoke feed promotes them.
+	if threads := buildThreads(posts); len(threads) != 1 || threads[0].post.ID != "smk-top001" {
+		t.Errorf("buildThreads() should leave out replies without a parent, got %d threads", len(threads))
+	}
+	if threads := buildThreadsKeepingOrphans(posts); len(threads) != 2 {
+		t.Errorf("buildThreadsKeepingOrphans() should promote the orphan, got %d threads", len(threads))
+	}
+}
+
+func TestFormatFeedMaxReplies(t *testing.T) {
+	posts := []*Post{{
+		ID:        "smk-parent",
+		Author:    "claude-swift-fox@smoke",
+		Project:   "smoke",
+		Suffix:    "swift-fox",
+		Content:   "parent post",
+		CreatedAt: "2026-01-30T09:00:00Z",
+	}}
+	for i := 1; i <= 6; i++ {
+		posts = append(posts, &Post{
+			ID:        fmt.Sprintf("smk-reply%d", i),
+			Author:    "claude-calm-owl@smoke",
+			Project:   "smoke",
+			Suffix:    "calm-owl",
+			Content:   fmt.Sprintf("reply number %d", i),
+			CreatedAt: fmt.Sprintf("2026-01-30T09:0%d:00Z", i),
+			ParentID:  "smk-parent",
+		})
+	}
+
+	for _, oneline := range []bool{false, true} {
+		var buf bytes.Buffer
+		FormatFeed(&buf, posts, FormatOptions{MaxReplies: 3, Oneline: oneline}, len(posts))
+		output := buf.String()
+
+		for _, want := range []string{"reply number 1", "reply number 2", "reply number 6", "… 3 more replies"} {
+			if !strings.Contains(output, want) {
+				t.Errorf("oneline=%v: output missing %q: %s", oneline, want, output)
+			}
+		}
+		for _, hidden := range []string{"reply number 3", "reply number 4", "reply number 5"} {
+			if strings.Contains(output, hidden) {
+				t.Errorf("oneline=%v: output should collapse %q: %s", oneline, hidden, output)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	FormatFeed(&buf, posts, FormatOptions{}, len(posts))
+	if strings.Contains(buf.String(), "more replies") {
+		t.Errorf("default should show all replies: %s", buf.String())
+	}
+}
+
+func TestCollapseReplies(t *testing.T) {
+	replies := []*Post{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}}
+
+	head, tail, hidden := collapseReplies(replies, 1)
+	if len(head) != 1 || len(tail) != 0 || hidden != 3 {
+		t.Errorf("max 1: head=%d tail=%d hidden=%d", len(head), len(tail), hidden)
+	}
+
+	head, tail, hidden = collapseReplies(replies, 4)
+	if len(head) != 4 || tail != nil || hidden != 0 {
+		t.Errorf("max 4: head=%d tail=%d hidden=%d", len(head), len(tail), hidden)
+	}
+
+	if moreRepliesLabel(1) != "… 1 more reply" {
+		t.Errorf("moreRepliesLabel(1) = %q", moreRepliesLabel(1))
+	}
+}
+
 func TestFormatTailHeader(t *testing.T) {
 	var buf bytes.Buffer
 	FormatTailHeader(&buf)
@@ -699,3 +803,275 @@ func TestBuildThreadsWithInvalidTimestamps(t *testing.T) {
 		t.Error("buildThreads() should associate replies even with invalid timestamps")
 	}
 }
+
+func TestFormatJSON(t *testing.T) {
+	parent := &Post{ID: "smk-parent", Author: "ember@smoke", Project: "smoke", Content: "question", CreatedAt: "2026-01-01T10:00:00Z"}
+	late := &Post{ID: "smk-reply2", Author: "spark@smoke", Project: "smoke", Content: "late answer", CreatedAt: "2026-01-01T10:05:00Z", ParentID: "smk-parent"}
+	early := &Post{ID: "smk-reply1", Author: "spark@smoke", Project: "smoke", Content: "answer", CreatedAt: "2026-01-01T10:01:00Z", ParentID: "smk-parent"}
+	orphan := &Post{ID: "smk-orphan", Author: "spark@smoke", Project: "smoke", Content: "lost", CreatedAt: "2026-01-01T09:00:00Z", ParentID: "smk-gone00"}
+	posts := []*Post{late, early, parent, orphan}
+
+	t.Run("flat", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := FormatJSON(&buf, posts, false); err != nil {
+			t.Fatalf("FormatJSON error: %v", err)
+		}
+		var got []map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+		}
+		if len(got) != 4 {
+			t.Fatalf("expected 4 posts, got %d", len(got))
+		}
+		for _, field := range []string{"id", "author", "project", "content", "created_at", "parent_id"} {
+			if _, ok := got[0][field]; !ok {
+				t.Errorf("missing field %q in %v", field, got[0])
+			}
+		}
+		if strings.Contains(buf.String(), "\x1b[") {
+			t.Error("JSON output must not contain ANSI escapes")
+		}
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := FormatJSON(&buf, posts, true); err != nil {
+			t.Fatalf("FormatJSON error: %v", err)
+		}
+		var got []struct {
+			ID      string `json:"id"`
+			Replies []struct {
+				ID string `json:"id"`
+			} `json:"replies"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+		}
+		if len(got) != 2 || got[0].ID != "smk-parent" || got[1].ID != "smk-orphan" {
+			t.Fatalf("unexpected top-level entries: %+v", got)
+		}
+		if len(got[0].Replies) != 2 || got[0].Replies[0].ID != "smk-reply1" || got[0].Replies[1].ID != "smk-reply2" {
+			t.Errorf("expected replies oldest first, got %+v", got[0].Replies)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := FormatJSON(&buf, nil, false); err != nil {
+			t.Fatalf("FormatJSON error: %v", err)
+		}
+		if strings.TrimSpace(buf.String()) != "[]" {
+			t.Errorf("expected empty array, got %q", buf.String())
+		}
+	})
+}
+
+// nestedThreadPosts returns a thread three replies deep plus a later direct
+// reply, in file order.
+func nestedThreadPosts() []*Post {
+	post := func(id, parent, content, at string) *Post {
+		return &Post{ID: id, Author: "claude-calm-owl@smoke", Project: "smoke", Suffix: "calm-owl",
+			Content: content, CreatedAt: "2026-01-30T" + at + ":00Z", ParentID: parent}
+	}
+	return []*Post{
+		post("smk-root00", "", "root post", "09:00"),
+		post("smk-lvl1a0", "smk-root00", "level one", "09:01"),
+		post("smk-lvl1b0", "smk-root00", "later level one", "09:05"),
+		post("smk-lvl200", "smk-lvl1a0", "level two", "09:02"),
+		post("smk-lvl300", "smk-lvl200", "level three", "09:03"),
+	}
+}
+
+func TestBuildThreads_NestedReplies(t *testing.T) {
+	threads := buildThreads(nestedThreadPosts())
+	if len(threads) != 1 {
+		t.Fatalf("expected 1 thread, got %d", len(threads))
+	}
+
+	var got []string
+	for _, reply := range threads[0].replies {
+		got = append(got, fmt.Sprintf("%s:%d", reply.ID, reply.Depth))
+	}
+	want := "smk-lvl1a0:1 smk-lvl200:2 smk-lvl300:3 smk-lvl1b0:1"
+	if strings.Join(got, " ") != want {
+		t.Errorf("replies = %s, want %s", strings.Join(got, " "), want)
+	}
+	if threads[0].post.Depth != 0 {
+		t.Errorf("root depth = %d, want 0", threads[0].post.Depth)
+	}
+}
+
+func TestBuildThreads_ReplyCycle(t *testing.T) {
+	posts := []*Post{
+		{ID: "smk-root00", Content: "root", CreatedAt: "2026-01-30T09:00:00Z"},
+		{ID: "smk-aaaaaa", Content: "a", CreatedAt: "2026-01-30T09:01:00Z", ParentID: "smk-root00"},
+		{ID: "smk-bbbbbb", Content: "b", CreatedAt: "2026-01-30T09:02:00Z", ParentID: "smk-cccccc"},
+		{ID: "smk-cccccc", Content: "c", CreatedAt: "2026-01-30T09:03:00Z", ParentID: "smk-bbbbbb"},
+	}
+	threads := buildThreads(posts)
+	if len(threads) != 1 || len(threads[0].replies) != 1 {
+		t.Fatalf("expected the cycle to be ignored, got %+v", threads)
+	}
+}
+
+func TestReplyIndent(t *testing.T) {
+	tests := []struct {
+		depth int
+		want  string
+	}{
+		{0, ""},
+		{1, ""},
+		{2, "   "},
+		{3, "      "},
+		{MaxReplyIndentDepth, strings.Repeat("   ", MaxReplyIndentDepth-1)},
+		{MaxReplyIndentDepth + 5, strings.Repeat("   ", MaxReplyIndentDepth-1)},
+	}
+	for _, tt := range tests {
+		if got := replyIndent(tt.depth); got != tt.want {
+			t.Errorf("replyIndent(%d) = %q, want %q", tt.depth, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFeed_NestedReplyIndentation(t *testing.T) {
+	var buf bytes.Buffer
+	FormatFeed(&buf, nestedThreadPosts(), FormatOptions{ColorMode: ColorNever}, 5)
+
+	indents := make(map[string]int)
+	var order []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		for _, content := range []string{"level one", "level two", "level three", "later level one"} {
+			if strings.HasSuffix(line, "  "+content) {
+				indents[content] = strings.Index(line, "└─")
+				order = append(order, content)
+			}
+		}
+	}
+	if strings.Join(order, ",") != "level one,level two,level three,later level one" {
+		t.Fatalf("replies out of order: %v\n%s", order, buf.String())
+	}
+	if indents["level one"] != 2 || indents["later level one"] != 2 {
+		t.Errorf("direct replies should indent 2, got %v", indents)
+	}
+	if indents["level two"] != 5 || indents["level three"] != 8 {
+		t.Errorf("nested replies should indent 3 more per level, got %v", indents)
+	}
+}
+
+func TestWrapText_HardBreaks(t *testing.T) {
+	got := wrapTextWithWidths("one two\r\n\nthree four five six", 10, 12)
+	want := []string{"one two", "", "three four", "five six"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("wrapTextWithWidths() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapText_WideCharacters(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		width int
+	}{
+		{"cjk without spaces", "日本語のテキストはスペースなしで続くことが多いので折り返しが必要です", 20},
+		{"cjk with spaces", "これは テスト です 長い 行を 折り返す 必要が あります", 15},
+		{"emoji", "shipped 🚀🚀🚀 the release 🎉🎉 and fixed 🐛🐛🐛🐛 before lunch ☕", 12},
+		{"wide char wider than width", "漢字", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := wrapText(tt.text, tt.width)
+			for _, line := range lines {
+				if !utf8.ValidString(line) {
+					t.Errorf("wrapText() split a character: %q", line)
+				}
+				if w := xansi.StringWidth(line); w > tt.width && utf8.RuneCountInString(line) > 1 {
+					t.Errorf("wrapText() line %q is %d cells, want <= %d", line, w, tt.width)
+				}
+			}
+			if got := strings.Join(lines, ""); strings.ReplaceAll(got, " ", "") != strings.ReplaceAll(tt.text, " ", "") {
+				t.Errorf("wrapText() lost content: %q", lines)
+			}
+		})
+	}
+}
+
+func TestFormatPost_WideAuthorAndContent(t *testing.T) {
+	post := &Post{
+		ID:        "smk-abc123",
+		Author:    "クロード@プロジェクト",
+		Project:   "プロジェクト",
+		Suffix:    "swift-fox",
+		Content:   "今日はリリースの準備をしました 🚀 テストはすべて通りました 🎉 次はドキュメントを更新します 📝 よろしくお願いします",
+		CreatedAt: "2026-01-30T09:24:00Z",
+	}
+	const termWidth = 80
+
+	var buf bytes.Buffer
+	FormatPost(&buf, post, FormatOptions{ColorMode: ColorNever, TerminalWidth: termWidth})
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) < 2 {
+		t.Fatalf("expected wrapped output, got %q", lines)
+	}
+	for _, line := range lines {
+		if w := xansi.StringWidth(line); w > termWidth {
+			t.Errorf("line is %d cells, want <= %d: %q", w, termWidth, line)
+		}
+	}
+}
+
+func TestFormatOnelineTruncation_WideContent(t *testing.T) {
+	post := &Post{
+		ID:        "smk-abc123",
+		Author:    "claude-swift-fox@smoke",
+		Content:   strings.Repeat("漢字🚀", 40),
+		CreatedAt: "2026-01-30T09:24:00Z",
+	}
+
+	var buf bytes.Buffer
+	FormatPost(&buf, post, FormatOptions{Oneline: true, ColorMode: ColorNever})
+
+	output := strings.TrimSuffix(buf.String(), "\n")
+	if !utf8.ValidString(output) {
+		t.Fatalf("oneline truncation split a character: %q", output)
+	}
+	content := output[strings.LastIndex(output, " ")+1:]
+	if !strings.HasSuffix(content, "...") {
+		t.Errorf("wide content should be truncated with ...: %q", content)
+	}
+	if w := xansi.StringWidth(strings.TrimSuffix(content, "...")); w > OnelineTruncateLen {
+		t.Errorf("truncated content is %d cells, want <= %d", w, OnelineTruncateLen)
+	}
+}
+
+func TestSingleLine(t *testing.T) {
+	tests := map[string]string{
+		"no newlines":                  "no newlines",
+		"first\nsecond":                "first second",
+		"para one\n\n  para two  \n\n": "para one para two",
+	}
+	for in, want := range tests {
+		if got := SingleLine(in); got != want {
+			t.Errorf("SingleLine(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFormatPost_OnelineCollapsesNewlines(t *testing.T) {
+	post := &Post{
+		ID:        "smk-abc123",
+		Author:    "ember@smoke",
+		Content:   "first paragraph\n\nsecond paragraph",
+		CreatedAt: "2026-01-30T09:24:00Z",
+	}
+	var buf bytes.Buffer
+	FormatPost(&buf, post, FormatOptions{Oneline: true, ColorMode: ColorNever})
+
+	output := buf.String()
+	if strings.Count(output, "\n") != 1 {
+		t.Errorf("oneline output should be a single line: %q", output)
+	}
+	if !strings.Contains(output, "first paragraph second paragraph") {
+		t.Errorf("oneline output should join paragraphs with a space: %q", output)
+	}
+}
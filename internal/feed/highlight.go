@@ -11,17 +11,18 @@ import (
 var (
 	// HashtagPattern matches #hashtag (alphanumeric and underscores)
 	HashtagPattern = regexp.MustCompile(`(#[a-zA-Z0-9_]+)`)
-	// MentionPattern matches @mention (alphanumeric and underscores)
-	MentionPattern = regexp.MustCompile(`(@[a-zA-Z0-9_]+)`)
+	// MentionPattern matches @mention (alphanumeric, underscores, and inner hyphens)
+	// with an optional @project suffix, e.g. @swift-fox@smoke
+	MentionPattern = regexp.MustCompile(`(@[a-zA-Z0-9_]+(?:-[a-zA-Z0-9_]+)*(?:@[a-zA-Z0-9_]+(?:-[a-zA-Z0-9_]+)*)?)`)
 	// combinedPattern matches both hashtags and mentions
-	combinedPattern = regexp.MustCompile(`(#[a-zA-Z0-9_]+|@[a-zA-Z0-9_]+)`)
+	combinedPattern = regexp.MustCompile(`(#[a-zA-Z0-9_]+|@[a-zA-Z0-9_]+(?:-[a-zA-Z0-9_]+)*(?:@[a-zA-Z0-9_]+(?:-[a-zA-Z0-9_]+)*)?)`)
 )
 
 // HighlightAll applies ANSI highlighting (hashtags and mentions) to text.
-// If colorize is false, returns text unchanged.
+// If colorize is false or NoColor is set, returns text unchanged.
 // For TUI rendering with background colors, use HighlightWithTheme instead.
 func HighlightAll(text string, colorize bool) string {
-	if !colorize {
+	if !colorize || NoColor() {
 		return text
 	}
 	return combinedPattern.ReplaceAllStringFunc(text, func(match string) string {
@@ -41,20 +42,92 @@ func HighlightWithTheme(text string, theme *Theme) string {
 // HighlightWithThemeAndBackground applies highlighting with a custom background color.
 // This styles ALL text (both highlighted and plain) to prevent gaps.
 func HighlightWithThemeAndBackground(text string, theme *Theme, background lipgloss.AdaptiveColor) string {
+	return HighlightForIdentity(text, theme, background, "")
+}
+
+// HighlightForIdentity highlights like HighlightWithThemeAndBackground and also
+// emphasizes mentions of self (an identity such as "claude-swift-fox@smoke").
+// Mentions use the theme agent color for the mentioned name, so each agent
+// keeps a consistent color distinct from hashtags. URLs render as underlined
+// links (see renderLink). Inline `code` spans are rendered on the code
+// background and skip link, tag, and mention highlighting. With NoColor set
+// the text is returned unstyled.
+func HighlightForIdentity(text string, theme *Theme, background lipgloss.AdaptiveColor, self string) string {
+	if NoColor() {
+		return text
+	}
+	spans := inlineCodePattern.FindAllStringIndex(text, -1)
+	if len(spans) == 0 {
+		return highlightLinks(text, theme, background, self)
+	}
+
+	codeStyle := lipgloss.NewStyle().
+		Foreground(theme.Text).
+		Background(codeBackground(theme, background))
+
+	var result strings.Builder
+	lastEnd := 0
+	for _, span := range spans {
+		if span[0] > lastEnd {
+			result.WriteString(highlightLinks(text[lastEnd:span[0]], theme, background, self))
+		}
+		result.WriteString(codeStyle.Render(text[span[0]:span[1]]))
+		lastEnd = span[1]
+	}
+	if lastEnd < len(text) {
+		result.WriteString(highlightLinks(text[lastEnd:], theme, background, self))
+	}
+	return result.String()
+}
+
+// highlightLinks renders URLs as links and highlights tags and mentions in
+// the text between them, so a URL fragment like "#section" is not a hashtag.
+func highlightLinks(text string, theme *Theme, background lipgloss.AdaptiveColor, self string) string {
+	urls := findURLs(text)
+	if len(urls) == 0 {
+		return highlightTagsAndMentions(text, theme, background, self)
+	}
+
+	var result strings.Builder
+	lastEnd := 0
+	for _, url := range urls {
+		if url[0] > lastEnd {
+			result.WriteString(highlightTagsAndMentions(text[lastEnd:url[0]], theme, background, self))
+		}
+		result.WriteString(renderLink(text[url[0]:url[1]], text[url[0]:url[1]], theme, background))
+		lastEnd = url[1]
+	}
+	if lastEnd < len(text) {
+		result.WriteString(highlightTagsAndMentions(text[lastEnd:], theme, background, self))
+	}
+	return result.String()
+}
+
+// highlightTagsAndMentions styles hashtags and mentions in text that holds no code.
+func highlightTagsAndMentions(text string, theme *Theme, background lipgloss.AdaptiveColor, self string) string {
 	// Style for plain text: just background
 	plainStyle := lipgloss.NewStyle().Background(background)
 
-	// Style for hashtags: dim cyan with theme background
+	// Style for hashtags: theme accent with theme background
 	hashtagStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#56b6c2")). // dim cyan
-		Background(background).
-		Faint(true)
+		Foreground(theme.Accent).
+		Background(background)
+
+	// Style for mentions: agent color by mentioned name, with theme background
+	mentionStyle := func(mention string) lipgloss.Style {
+		name := NormalizeMention(mention)
+		style := lipgloss.NewStyle().Background(background)
+		if len(theme.AgentColors) > 0 {
+			style = style.Foreground(AgentColor(name, theme))
+		}
+		return style
+	}
 
-	// Style for mentions: dim magenta with theme background
-	mentionStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#c678dd")). // dim magenta
-		Background(background).
-		Faint(true)
+	// Style for mentions of the current identity: inverted badge
+	selfStyle := lipgloss.NewStyle().
+		Foreground(theme.Background).
+		Background(theme.Accent).
+		Bold(true)
 
 	// Find all matches and their positions
 	matches := combinedPattern.FindAllStringIndex(text, -1)
@@ -77,10 +150,13 @@ func HighlightWithThemeAndBackground(text string, theme *Theme, background lipgl
 
 		// Style the match itself
 		matchText := text[start:end]
-		if matchText[0] == '#' {
+		switch {
+		case matchText[0] == '#':
 			result.WriteString(hashtagStyle.Render(matchText))
-		} else {
-			result.WriteString(mentionStyle.Render(matchText))
+		case self != "" && MentionRefersTo(matchText, self):
+			result.WriteString(selfStyle.Render(matchText))
+		default:
+			result.WriteString(mentionStyle(matchText).Render(matchText))
 		}
 
 		lastEnd = end
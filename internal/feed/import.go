@@ -0,0 +1,260 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Import formats supported by Import.
+const (
+	ImportJSON = "json"
+	ImportCSV  = "csv"
+)
+
+// ImportFormats lists valid import formats in help-text order.
+var ImportFormats = []string{ImportJSON, ImportCSV}
+
+// ImportSuffix is the suffix given to imported posts that have none.
+const ImportSuffix = "import"
+
+// importRecord is one post as it appears in an import file. Field names
+// match the feed and the JSON export, and double as CSV column headers.
+type importRecord struct {
+	ID        string `json:"id"`
+	Author    string `json:"author"`
+	Caller    string `json:"caller"`
+	Project   string `json:"project"`
+	Suffix    string `json:"suffix"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+	ParentID  string `json:"parent_id"`
+}
+
+// ImportSkip reports a record Import left out. Record counts from 1, in
+// file order; for CSV the header row is not counted.
+type ImportSkip struct {
+	Record int
+	Reason string
+}
+
+// ImportResult summarizes an import.
+type ImportResult struct {
+	Imported int
+	Skipped  []ImportSkip
+}
+
+// Import reads posts from r, a JSON array or a CSV file with a header row,
+// and appends the valid ones to the feed in file order. created_at is kept
+// as written (the import time when missing) and IDs are generated only for
+// records without one. Records that are malformed, fail validation, or
+// whose ID is already in the feed are skipped. With dryRun nothing is
+// written, but the result is the same.
+func (s *FileStore) Import(r io.Reader, format string, dryRun bool) (*ImportResult, error) {
+	records, skipped, err := readImportRecords(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if dryRun {
+		result, _, err := s.planImport(records, skipped)
+		return result, err
+	}
+
+	// Check for duplicate IDs under the feed lock, so a post another
+	// process appends meanwhile cannot be imported a second time.
+	var result *ImportResult
+	err = s.withWriteLock(func(f *os.File) error {
+		var posts []*Post
+		var err error
+		result, posts, err = s.planImport(records, skipped)
+		if err != nil || len(posts) == 0 {
+			return err
+		}
+
+		var buf bytes.Buffer
+		for _, post := range posts {
+			data, err := json.Marshal(post)
+			if err != nil {
+				return fmt.Errorf("failed to encode post: %w", err)
+			}
+			buf.Write(data)
+			buf.WriteByte('\n')
+		}
+		return writeLine(f, bytes.TrimSuffix(buf.Bytes(), []byte("\n")), "imported posts")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// planImport builds the posts to import from records, skipping invalid
+// records and IDs already in the feed. The caller must hold s.mu.
+func (s *FileStore) planImport(records []*importRecord, skipped []ImportSkip) (*ImportResult, []*Post, error) {
+	existing, err := s.readAllUnlocked()
+	if err != nil {
+		return nil, nil, err
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, post := range existing {
+		seen[post.ID] = true
+	}
+
+	result := &ImportResult{Skipped: skipped}
+	var posts []*Post
+	for i, record := range records {
+		if record == nil {
+			continue
+		}
+		post, err := record.post(time.Now())
+		if err == nil && seen[post.ID] {
+			err = fmt.Errorf("post %s is already in the feed", post.ID)
+		}
+		if err != nil {
+			result.Skipped = append(result.Skipped, ImportSkip{Record: i + 1, Reason: err.Error()})
+			continue
+		}
+		seen[post.ID] = true
+		posts = append(posts, post)
+	}
+	result.Imported = len(posts)
+	sort.SliceStable(result.Skipped, func(i, j int) bool {
+		return result.Skipped[i].Record < result.Skipped[j].Record
+	})
+	return result, posts, nil
+}
+
+// readImportRecords decodes r in format. The returned slice has one entry
+// per record, nil where the record could not be decoded; those are reported
+// in skipped.
+func readImportRecords(r io.Reader, format string) (records []*importRecord, skipped []ImportSkip, err error) {
+	switch format {
+	case ImportJSON:
+		return readImportJSON(r)
+	case ImportCSV:
+		return readImportCSV(r)
+	default:
+		return nil, nil, fmt.Errorf("unknown import format %q (valid: %s)", format, strings.Join(ImportFormats, ", "))
+	}
+}
+
+// readImportJSON decodes a JSON array of post objects.
+func readImportJSON(r io.Reader) ([]*importRecord, []ImportSkip, error) {
+	var raw []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON import: expected an array of posts: %w", err)
+	}
+	records := make([]*importRecord, len(raw))
+	var skipped []ImportSkip
+	for i, msg := range raw {
+		var record importRecord
+		if err := json.Unmarshal(msg, &record); err != nil {
+			skipped = append(skipped, ImportSkip{Record: i + 1, Reason: "not a post object"})
+			continue
+		}
+		records[i] = &record
+	}
+	return records, skipped, nil
+}
+
+// readImportCSV decodes CSV rows under a header naming the columns.
+// Unknown columns are ignored; author and content are required.
+func readImportCSV(r io.Reader) ([]*importRecord, []ImportSkip, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CSV import: missing header row: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"author", "content"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("invalid CSV import: header has no %q column", required)
+		}
+	}
+
+	var records []*importRecord
+	var skipped []ImportSkip
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		records = append(records, nil)
+		if err != nil {
+			skipped = append(skipped, ImportSkip{Record: len(records), Reason: err.Error()})
+			continue
+		}
+		if len(row) != len(header) {
+			skipped = append(skipped, ImportSkip{
+				Record: len(records),
+				Reason: fmt.Sprintf("has %d fields, header has %d", len(row), len(header)),
+			})
+			continue
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return row[i]
+			}
+			return ""
+		}
+		records[len(records)-1] = &importRecord{
+			ID:        field("id"),
+			Author:    field("author"),
+			Caller:    field("caller"),
+			Project:   field("project"),
+			Suffix:    field("suffix"),
+			Content:   field("content"),
+			CreatedAt: field("created_at"),
+			ParentID:  field("parent_id"),
+		}
+	}
+	return records, skipped, nil
+}
+
+// post builds and validates the feed post for the record.
+func (rec *importRecord) post(now time.Time) (*Post, error) {
+	post := &Post{
+		ID:        strings.TrimSpace(rec.ID),
+		Author:    strings.TrimSpace(rec.Author),
+		Caller:    strings.TrimSpace(rec.Caller),
+		Project:   strings.TrimSpace(rec.Project),
+		Suffix:    strings.TrimSpace(rec.Suffix),
+		Content:   strings.TrimSpace(ansiPattern.ReplaceAllString(rec.Content, "")),
+		CreatedAt: strings.TrimSpace(rec.CreatedAt),
+		ParentID:  strings.TrimSpace(rec.ParentID),
+	}
+	if post.Suffix == "" {
+		post.Suffix = ImportSuffix
+	}
+	if post.CreatedAt == "" {
+		post.CreatedAt = now.UTC().Format(time.RFC3339)
+	} else if _, err := post.GetCreatedTime(); err != nil {
+		return nil, fmt.Errorf("created_at %q is not an RFC3339 time", post.CreatedAt)
+	}
+	if post.ID == "" {
+		id, err := GenerateID()
+		if err != nil {
+			return nil, err
+		}
+		post.ID = id
+	}
+	if err := post.Validate(); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
@@ -0,0 +1,143 @@
+package feed
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImport_JSON(t *testing.T) {
+	store, _ := setupTestStore(t)
+
+	input := `[
+  {"id": "smk-abc123", "author": "ember", "suffix": "fox", "content": "restored post", "created_at": "2026-01-02T03:04:05Z"},
+  {"author": "wisp", "content": "no id or suffix"},
+  {"author": "", "content": "no author"},
+  {"author": "flare", "content": "bad time", "created_at": "yesterday"},
+  "not an object",
+  {"id": "smk-abc123", "author": "ember", "suffix": "fox", "content": "duplicate id"}
+]`
+	result, err := store.Import(strings.NewReader(input), ImportJSON, false)
+	if err != nil {
+		t.Fatalf("Import() error: %v", err)
+	}
+	if result.Imported != 2 || len(result.Skipped) != 4 {
+		t.Fatalf("Import() = %d imported, %v skipped; want 2 and 4", result.Imported, result.Skipped)
+	}
+	wantRecords := []int{3, 4, 5, 6}
+	for i, skip := range result.Skipped {
+		if skip.Record != wantRecords[i] || skip.Reason == "" {
+			t.Errorf("skip %d = %+v, want record %d with a reason", i, skip, wantRecords[i])
+		}
+	}
+
+	posts, err := store.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("feed has %d posts, want 2", len(posts))
+	}
+	if posts[0].ID != "smk-abc123" || posts[0].CreatedAt != "2026-01-02T03:04:05Z" {
+		t.Errorf("expected id and created_at kept, got %s at %s", posts[0].ID, posts[0].CreatedAt)
+	}
+	if !ValidateID(posts[1].ID) || posts[1].Suffix != ImportSuffix {
+		t.Errorf("expected a generated id and the import suffix, got %q and %q", posts[1].ID, posts[1].Suffix)
+	}
+
+	// Importing the same file again adds nothing
+	result, err = store.Import(strings.NewReader(`[{"id": "smk-abc123", "author": "ember", "suffix": "fox", "content": "restored post"}]`), ImportJSON, false)
+	if err != nil || result.Imported != 0 {
+		t.Errorf("re-import = %+v, %v; want nothing imported", result, err)
+	}
+}
+
+func TestImport_CSV(t *testing.T) {
+	store, _ := setupTestStore(t)
+
+	input := "author,content,created_at,extra\n" +
+		"ember,\"hello, csv\",2026-01-02T03:04:05Z,x\n" +
+		"wisp,too few fields\n" +
+		"flare,,2026-01-02T03:04:05Z,x\n" +
+		"spark,\"" + strings.Repeat("a", 100000) + "\",,x\n" +
+		"ash,last one,,x\n"
+	result, err := store.Import(strings.NewReader(input), ImportCSV, false)
+	if err != nil {
+		t.Fatalf("Import() error: %v", err)
+	}
+	if result.Imported != 2 || len(result.Skipped) != 3 {
+		t.Fatalf("Import() = %d imported, %v skipped; want 2 and 3", result.Imported, result.Skipped)
+	}
+	if result.Skipped[0].Record != 2 || !strings.Contains(result.Skipped[0].Reason, "fields") {
+		t.Errorf("expected record 2 skipped for its field count, got %+v", result.Skipped[0])
+	}
+
+	posts, err := store.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2 || posts[0].Content != "hello, csv" || posts[1].Author != "ash" {
+		t.Errorf("unexpected imported posts: %+v %+v", posts[0], posts[1])
+	}
+}
+
+func TestImport_DryRunAndErrors(t *testing.T) {
+	store, _ := setupTestStore(t)
+
+	result, err := store.Import(strings.NewReader(`[{"author": "ember", "content": "hi"}]`), ImportJSON, true)
+	if err != nil || result.Imported != 1 {
+		t.Fatalf("dry run = %+v, %v; want 1 imported", result, err)
+	}
+	if posts, _ := store.ReadAll(); len(posts) != 0 {
+		t.Errorf("dry run wrote %d posts", len(posts))
+	}
+
+	for _, tt := range []struct{ format, input string }{
+		{ImportJSON, `{"author": "ember"}`},
+		{ImportCSV, "name,text\nember,hi\n"},
+		{"xml", `<posts/>`},
+	} {
+		if _, err := store.Import(strings.NewReader(tt.input), tt.format, false); err == nil {
+			t.Errorf("Import(%s, %q) expected an error", tt.format, tt.input)
+		}
+	}
+}
+
+func TestImport_DuplicateCheckedUnderLock(t *testing.T) {
+	store, path := setupTestStore(t)
+
+	// Another process holds the feed lock and appends the same post.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_RDWR, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := lockFile(f, true); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := store.Import(strings.NewReader(`[{"id": "smk-abc123", "author": "ember", "content": "restored post"}]`), ImportJSON, false)
+		done <- err
+	}()
+	time.Sleep(50 * time.Millisecond) // let Import reach the lock
+	if _, err := f.WriteString(`{"id":"smk-abc123","author":"ember","suffix":"fox","content":"posted meanwhile","created_at":"2026-01-02T03:04:05Z"}` + "\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := unlockFile(f); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Import() error: %v", err)
+	}
+
+	posts, err := store.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 1 {
+		t.Errorf("feed has %d copies of smk-abc123, want 1", len(posts))
+	}
+}
@@ -0,0 +1,161 @@
+package feed
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// ErrFeedShrunk is returned by ReadSince when the feed file is shorter than
+// the offset, usually because it was compacted. Read it again from 0.
+var ErrFeedShrunk = errors.New("feed file shrank since the last read")
+
+// ReadSince parses the posts appended after byte offset and returns them
+// with the offset to pass next time. Only complete lines are read, so a post
+// still being written is picked up by the next call. Posts come back as
+// written: edits, deletions, and reactions are not applied (see feedCache).
+func (s *FileStore) ReadSince(offset int64) ([]*Post, int64, error) {
+	records, next, _, err := s.readRecordsSince(offset)
+	if err != nil {
+		return nil, offset, err
+	}
+	return records.posts, next, nil
+}
+
+// readRecordsSince decodes the complete lines after offset. It also returns
+// the last complete line read, so callers can check the file was not
+// rewritten under them.
+func (s *FileStore) readRecordsSince(offset int64) (*feedRecords, int64, []byte, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, offset, nil, ErrNotInitialized
+	}
+	if err != nil {
+		return nil, offset, nil, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, offset, nil, fmt.Errorf("failed to stat feed file: %w", err)
+	}
+	if info.Size() < offset {
+		return nil, offset, nil, ErrFeedShrunk
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, offset, nil, fmt.Errorf("failed to seek feed file: %w", err)
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, offset, nil, fmt.Errorf("error reading feed file: %w", err)
+	}
+	data = data[:bytes.LastIndexByte(data, '\n')+1]
+
+	records := &feedRecords{}
+	var last []byte
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		last = line
+		if record, ok := parseFeedLine(line, lineNum); ok {
+			records.add(record)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, offset, nil, fmt.Errorf("error reading feed file: %w", err)
+	}
+	return records, offset + int64(len(data)), bytes.Clone(last), nil
+}
+
+// feedCache keeps the decoded records of a FileStore so each refresh only
+// parses lines appended since the previous one. It falls back to a full
+// read when the file shrank or the last line it read has changed, which is
+// what compaction does. Safe for concurrent use.
+type feedCache struct {
+	mu       sync.Mutex
+	store    *FileStore
+	offset   int64
+	lastLine []byte
+	records  feedRecords
+}
+
+// newFeedCache creates an empty cache for store.
+func newFeedCache(store *FileStore) *feedCache {
+	return &feedCache{store: store}
+}
+
+// ReadAll returns the same posts as FileStore.ReadAll.
+func (c *feedCache) ReadAll() ([]*Post, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.offset > 0 && !c.unchanged() {
+		c.reset()
+	}
+	fresh, next, last, err := c.store.readRecordsSince(c.offset)
+	if errors.Is(err, ErrFeedShrunk) {
+		c.reset()
+		fresh, next, last, err = c.store.readRecordsSince(0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.records.posts = append(c.records.posts, fresh.posts...)
+	c.records.reactions = append(c.records.reactions, fresh.reactions...)
+	c.records.tombstones = append(c.records.tombstones, fresh.tombstones...)
+	c.records.revisions = append(c.records.revisions, fresh.revisions...)
+	c.offset = next
+	if last != nil {
+		c.lastLine = last
+	}
+
+	// resolve modifies posts, so work on copies of the cached ones
+	resolved := feedRecords{
+		posts:      make([]*Post, len(c.records.posts)),
+		reactions:  c.records.reactions,
+		tombstones: c.records.tombstones,
+		revisions:  c.records.revisions,
+	}
+	for i, post := range c.records.posts {
+		clone := *post
+		resolved.posts[i] = &clone
+	}
+	return resolved.resolve(), nil
+}
+
+// unchanged reports whether the last line read still ends at c.offset.
+func (c *feedCache) unchanged() bool {
+	f, err := os.Open(c.store.path)
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+
+	want := append(bytes.Clone(c.lastLine), '\n')
+	start := c.offset - int64(len(want))
+	if start < 0 {
+		return false
+	}
+	got := make([]byte, len(want))
+	if _, err := f.ReadAt(got, start); err != nil {
+		return false
+	}
+	return bytes.Equal(got, want)
+}
+
+// reset forgets everything read so far.
+func (c *feedCache) reset() {
+	c.offset = 0
+	c.lastLine = nil
+	c.records = feedRecords{}
+}
@@ -0,0 +1,172 @@
+package feed
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postIDs(posts []*Post) []string {
+	ids := make([]string, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	return ids
+}
+
+func TestReadSince_OnlyNewPosts(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	appendTestPost(t, store, "smk-bbbbbb")
+
+	posts, offset, err := store.ReadSince(0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-aaaaaa", "smk-bbbbbb"}, postIDs(posts))
+	info, err := os.Stat(feedPath)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), offset)
+
+	posts, same, err := store.ReadSince(offset)
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+	assert.Equal(t, offset, same)
+
+	appendTestPost(t, store, "smk-cccccc")
+	posts, _, err = store.ReadSince(offset)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-cccccc"}, postIDs(posts))
+}
+
+func TestReadSince_PartialLineWaits(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+
+	f, err := os.OpenFile(feedPath, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"id":"smk-bbbbbb","author":"ember"`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	posts, offset, err := store.ReadSince(0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-aaaaaa"}, postIDs(posts))
+
+	posts, next, err := store.ReadSince(offset)
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+	assert.Equal(t, offset, next, "an unfinished line should not be consumed")
+}
+
+func TestReadSince_Shrunk(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+
+	_, _, err := store.ReadSince(1 << 20)
+	assert.ErrorIs(t, err, ErrFeedShrunk)
+}
+
+func TestReadSince_NotInitialized(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/missing.jsonl")
+	_, _, err := store.ReadSince(0)
+	assert.ErrorIs(t, err, ErrNotInitialized)
+}
+
+func TestFeedCache_ParsesOnlyAppendedLines(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	appendTestPost(t, store, "smk-bbbbbb")
+	cache := newFeedCache(store)
+
+	posts, err := cache.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+
+	// Break the first line in place. A full read now skips smk-aaaaaa, so
+	// seeing it below proves the cache only parsed the appended line.
+	data, err := os.ReadFile(feedPath)
+	require.NoError(t, err)
+	data[0] = 'x'
+	require.NoError(t, os.WriteFile(feedPath, data, 0644))
+	appendTestPost(t, store, "smk-cccccc")
+
+	posts, err = cache.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-aaaaaa", "smk-bbbbbb", "smk-cccccc"}, postIDs(posts))
+
+	full, err := store.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-bbbbbb", "smk-cccccc"}, postIDs(full))
+}
+
+func TestFeedCache_AppliesLaterRecords(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	appendTestPost(t, store, "smk-bbbbbb")
+	cache := newFeedCache(store)
+
+	_, err := cache.ReadAll()
+	require.NoError(t, err)
+
+	reaction, err := NewReaction("spark", "smk-aaaaaa", "👍")
+	require.NoError(t, err)
+	require.NoError(t, store.AppendReaction(reaction))
+	rev, err := NewRevision("ember", "smk-aaaaaa", "edited")
+	require.NoError(t, err)
+	require.NoError(t, store.AppendRevision(rev))
+	require.NoError(t, store.DeleteByID("smk-bbbbbb"))
+
+	posts, err := cache.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "edited", posts[0].Content)
+	assert.Equal(t, 1, posts[0].Reactions["👍"])
+
+	// Resolving again must not apply the reaction twice
+	posts, err = cache.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, 1, posts[0].Reactions["👍"])
+	assert.Equal(t, 1, posts[0].Revision)
+}
+
+func TestFeedCache_FallsBackAfterCompact(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	appendTestPost(t, store, "smk-bbbbbb")
+	require.NoError(t, store.DeleteByID("smk-aaaaaa"))
+	cache := newFeedCache(store)
+
+	_, err := cache.ReadAll()
+	require.NoError(t, err)
+
+	_, err = store.Compact(CompactOptions{})
+	require.NoError(t, err)
+	appendTestPost(t, store, "smk-cccccc")
+
+	posts, err := cache.ReadAll()
+	require.NoError(t, err)
+	want, err := store.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, postIDs(want), postIDs(posts))
+	assert.Equal(t, []string{"smk-bbbbbb", "smk-cccccc"}, postIDs(posts))
+}
+
+func TestFeedCache_RewrittenLastLine(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaaaaa")
+	cache := newFeedCache(store)
+	_, err := cache.ReadAll()
+	require.NoError(t, err)
+
+	// Same length, different content: only the last-line check notices
+	data, err := os.ReadFile(feedPath)
+	require.NoError(t, err)
+	rewritten := strings.ReplaceAll(string(data), "aaaaaa", "zzzzzz")
+	require.NoError(t, os.WriteFile(feedPath, []byte(rewritten), 0644))
+
+	posts, err := cache.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-zzzzzz"}, postIDs(posts))
+}
@@ -0,0 +1,106 @@
+package feed
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+// LineFormatPresets are named --format templates for one-line output.
+var LineFormatPresets = map[string]string{
+	"short": `{{.ID}} {{.Author}}: {{.Short}}`,
+	"full":  `{{.Date}} {{.Time}} {{.ID}} {{.Author}}{{with .Project}} [{{.}}]{{end}}{{with .ParentID}} re {{.}}{{end}}: {{.Content}}`,
+}
+
+// LineFormatPresetNames returns the preset names, sorted.
+func LineFormatPresetNames() []string {
+	return slices.Sorted(maps.Keys(LineFormatPresets))
+}
+
+// LinePost is the data a line format template renders for each post.
+type LinePost struct {
+	ID        string
+	Author    string
+	Project   string
+	Suffix    string
+	Caller    string
+	Content   string // Content on a single line
+	Short     string // Content cut to the oneline width
+	CreatedAt string // RFC3339, as stored
+	Date      string // YYYY-MM-DD in the display time zone
+	Time      string // Clock time, as the feed shows it
+	TimeAgo   string // e.g. "15m ago"
+	ParentID  string // Empty unless the post is a reply
+	Reactions int    // Total reactions
+	Edited    bool
+}
+
+// newLinePost builds the template data for post.
+func newLinePost(post *Post) LinePost {
+	content := SingleLine(post.Content)
+	short := content
+	if xansi.StringWidth(short) > OnelineContentWidth {
+		short = short[:cellPrefixLen(short, OnelineTruncateLen)] + "..."
+	}
+	line := LinePost{
+		ID:        post.ID,
+		Author:    post.Author,
+		Project:   post.Project,
+		Suffix:    post.Suffix,
+		Caller:    post.Caller,
+		Content:   content,
+		Short:     short,
+		CreatedAt: post.CreatedAt,
+		ParentID:  post.ParentID,
+		Edited:    post.EditedAt != "",
+	}
+	for _, n := range post.Reactions {
+		line.Reactions += n
+	}
+	if t, err := post.GetCreatedTime(); err == nil {
+		line.Date = inDisplayZone(t).Format("2006-01-02")
+		line.Time = FormatTime(t)
+		line.TimeAgo = FormatTimeAgo(t)
+	}
+	return line
+}
+
+// ParseLineFormat parses a --format value: a preset name or a text/template
+// over LinePost fields, e.g. "{{.ID}} {{.Author}} {{.TimeAgo}}". The
+// template is tried on a sample post so unknown fields fail here rather
+// than halfway through the output.
+func ParseLineFormat(format string) (*template.Template, error) {
+	if preset, ok := LineFormatPresets[format]; ok {
+		format = preset
+	}
+	tmpl, err := template.New("format").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	sample := &Post{
+		ID:        "smk-000000",
+		Author:    "sample",
+		Suffix:    "sample",
+		Content:   "sample",
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := tmpl.Execute(io.Discard, newLinePost(sample)); err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// formatLine writes post through tmpl as a single line.
+func formatLine(w io.Writer, post *Post, tmpl *template.Template) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, newLinePost(post)); err != nil {
+		sb.WriteString(err.Error())
+	}
+	_, _ = fmt.Fprintln(w, strings.TrimRight(sb.String(), "\n"))
+}
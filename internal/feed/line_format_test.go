@@ -0,0 +1,57 @@
+package feed
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLineFormat(t *testing.T) {
+	post := &Post{
+		ID:        "smk-abc123",
+		Author:    "ember@smoke",
+		Project:   "smoke",
+		Suffix:    "fox",
+		Content:   "two\nlines " + strings.Repeat("x", 80),
+		CreatedAt: time.Now().Add(-15 * time.Minute).UTC().Format(time.RFC3339),
+		ParentID:  "smk-parent",
+		Reactions: map[string]int{"🔥": 2, "👍": 1},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"{{.ID}} {{.Author}} {{.TimeAgo}}", "smk-abc123 ember@smoke 15m ago\n"},
+		{"{{.Reactions}} {{if .ParentID}}reply{{end}}\n", "3 reply\n"},
+		{"short", "smk-abc123 ember@smoke: two lines " + strings.Repeat("x", 47) + "...\n"},
+	}
+	for _, tt := range tests {
+		tmpl, err := ParseLineFormat(tt.format)
+		if err != nil {
+			t.Fatalf("ParseLineFormat(%q) error: %v", tt.format, err)
+		}
+		var buf bytes.Buffer
+		FormatPost(&buf, post, FormatOptions{LineFormat: tmpl})
+		if buf.String() != tt.want {
+			t.Errorf("format %q = %q, want %q", tt.format, buf.String(), tt.want)
+		}
+	}
+
+	tmpl, err := ParseLineFormat("full")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	FormatPost(&buf, post, FormatOptions{LineFormat: tmpl})
+	if !strings.Contains(buf.String(), "[smoke] re smk-parent: two lines xxx") {
+		t.Errorf("full preset = %q", buf.String())
+	}
+
+	for _, bad := range []string{"{{.ID", "{{.Nope}}", "{{template \"x\"}}"} {
+		if _, err := ParseLineFormat(bad); err == nil || !strings.Contains(err.Error(), "invalid --format template") {
+			t.Errorf("ParseLineFormat(%q) error = %v, want invalid template", bad, err)
+		}
+	}
+}
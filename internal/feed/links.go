@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+// urlPattern matches http(s) URLs in post content.
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"'` + "`" + `]+`)
+
+// hyperlinksEnabled controls whether links are wrapped in OSC-8 sequences.
+var hyperlinksEnabled = HyperlinksFromEnv()
+
+// noHyperlinkTerms lists TERM values for terminals known to print OSC-8
+// sequences as garbage instead of ignoring them.
+var noHyperlinkTerms = map[string]bool{
+	"":       true,
+	"dumb":   true,
+	"linux":  true,
+	"cons25": true,
+	"vt100":  true,
+	"vt102":  true,
+	"vt220":  true,
+	"emacs":  true,
+}
+
+// HyperlinksFromEnv reports whether TERM suggests the terminal can handle
+// OSC-8 hyperlinks.
+func HyperlinksFromEnv() bool {
+	return !noHyperlinkTerms[strings.ToLower(strings.TrimSpace(os.Getenv("TERM")))]
+}
+
+// findURLs returns the byte ranges of URLs in text, leaving trailing
+// sentence punctuation such as "." or ")" outside the link.
+func findURLs(text string) [][]int {
+	matches := urlPattern.FindAllStringIndex(text, -1)
+	for _, match := range matches {
+		for match[1] > match[0] && strings.ContainsRune(".,;:!?)]}", rune(text[match[1]-1])) {
+			match[1]--
+		}
+	}
+	return matches
+}
+
+// renderLink styles text as a link to url and, when supported, makes it
+// clickable with an OSC-8 hyperlink. text is usually url itself, or the part
+// of it left on a line after wrapping. The escape sequences have no visible
+// width, so lipgloss.Width and xansi.StringWidth still measure only the text.
+func renderLink(text, url string, theme *Theme, background lipgloss.AdaptiveColor) string {
+	styled := lipgloss.NewStyle().
+		Foreground(theme.Accent).
+		Background(background).
+		Underline(true).
+		Render(text)
+	if !hyperlinksEnabled {
+		return styled
+	}
+	return xansi.SetHyperlink(url) + styled + xansi.ResetHyperlink()
+}
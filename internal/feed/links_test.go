@@ -0,0 +1,179 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+// withHyperlinks sets hyperlink support for the duration of a test.
+func withHyperlinks(t *testing.T, enabled bool) {
+	t.Helper()
+	prev := hyperlinksEnabled
+	hyperlinksEnabled = enabled
+	t.Cleanup(func() { hyperlinksEnabled = prev })
+}
+
+func TestFindURLs(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"see https://example.com/a", []string{"https://example.com/a"}},
+		{"docs (https://example.com/x).", []string{"https://example.com/x"}},
+		{"two: http://a.io, https://b.io/p?q=1#frag!", []string{"http://a.io", "https://b.io/p?q=1#frag"}},
+		{"no links here", nil},
+		{"ftp://not.matched", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, m := range findURLs(tt.input) {
+			got = append(got, tt.input[m[0]:m[1]])
+		}
+		if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+			t.Errorf("findURLs(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestHyperlinksFromEnv(t *testing.T) {
+	tests := []struct {
+		term string
+		want bool
+	}{
+		{"xterm-256color", true},
+		{"xterm-kitty", true},
+		{"dumb", false},
+		{"linux", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Setenv("TERM", tt.term)
+		if got := HyperlinksFromEnv(); got != tt.want {
+			t.Errorf("TERM=%q: HyperlinksFromEnv() = %v, want %v", tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestRenderLink_OSC8(t *testing.T) {
+	theme := &AllThemes[0]
+	url := "https://example.com/a"
+
+	withHyperlinks(t, true)
+	linked := renderLink(url, url, theme, theme.Background)
+	if !strings.HasPrefix(linked, xansi.SetHyperlink(url)) || !strings.HasSuffix(linked, xansi.ResetHyperlink()) {
+		t.Errorf("expected OSC-8 hyperlink, got %q", linked)
+	}
+
+	withHyperlinks(t, false)
+	plain := renderLink(url, url, theme, theme.Background)
+	if strings.Contains(plain, "\x1b]8;") {
+		t.Errorf("expected no OSC-8 sequence when unsupported, got %q", plain)
+	}
+	if stripANSI(plain) != url {
+		t.Errorf("fallback should show the URL, got %q", stripANSI(plain))
+	}
+}
+
+func TestHighlightForIdentity_LinkWidth(t *testing.T) {
+	withColor(t)
+	withHyperlinks(t, true)
+	theme := &AllThemes[0]
+
+	inputs := []string{
+		"see https://example.com/docs#intro for #details",
+		"https://a.io and https://b.io/x?y=1 @alice",
+		"url in code `https://c.io` stays code",
+	}
+	for _, input := range inputs {
+		got := HighlightForIdentity(input, theme, theme.Background, "")
+		if w := lipgloss.Width(got); w != len(input) {
+			t.Errorf("lipgloss.Width(%q) = %d, want %d", input, w, len(input))
+		}
+		if w := xansi.StringWidth(got); w != len(input) {
+			t.Errorf("xansi.StringWidth(%q) = %d, want %d", input, w, len(input))
+		}
+		if xansi.Strip(got) != input {
+			t.Errorf("visible text changed: %q", xansi.Strip(got))
+		}
+	}
+}
+
+func TestHighlightForIdentity_URLFragmentNotHashtag(t *testing.T) {
+	withColor(t)
+	withHyperlinks(t, false)
+	theme := &AllThemes[0]
+
+	got := HighlightForIdentity("https://example.com/#intro", theme, theme.Background, "")
+	if got != renderLink("https://example.com/#intro", "https://example.com/#intro", theme, theme.Background) {
+		t.Errorf("URL should render as a single link, got %q", got)
+	}
+}
+
+func TestFormatPost_LinkKeepsLineWidth(t *testing.T) {
+	withColor(t)
+	withHyperlinks(t, true)
+
+	m := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	m.width = 60
+	post := &Post{
+		ID:        "smk-link01",
+		Author:    "alice",
+		Project:   "smoke",
+		Suffix:    "swift-fox",
+		Content:   "read https://example.com/a/very/long/path/to/the/design/doc before merging the change today",
+		CreatedAt: "2026-01-02T09:24:00Z",
+	}
+	for _, line := range m.formatPost(post) {
+		if w := lipgloss.Width(line); w > m.contentWidth() {
+			t.Errorf("line width %d exceeds content width %d: %q", w, m.contentWidth(), xansi.Strip(line))
+		}
+	}
+}
+
+func TestFormatPost_WrappedLinkKeepsTarget(t *testing.T) {
+	withColor(t)
+	withHyperlinks(t, true)
+
+	m := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	m.width = 60
+	url := "https://example.com/" + strings.Repeat("very-long-path/", 8) + "design.md"
+	post := &Post{
+		ID:        "smk-link02",
+		Author:    "alice",
+		Project:   "smoke",
+		Suffix:    "swift-fox",
+		Content:   "read " + url + " today",
+		CreatedAt: "2026-01-02T09:24:00Z",
+	}
+	if len(url) <= m.contentWidth() {
+		t.Fatalf("URL of %d cells fits in content width %d", len(url), m.contentWidth())
+	}
+
+	var visible strings.Builder
+	fragments := 0
+	for _, line := range m.formatPost(post) {
+		if w := lipgloss.Width(line); w > m.contentWidth() {
+			t.Errorf("line width %d exceeds content width %d: %q", w, m.contentWidth(), xansi.Strip(line))
+		}
+		opens := strings.Count(line, "\x1b]8;")
+		if opens%2 != 0 {
+			t.Errorf("unbalanced OSC-8 sequences in %q", line)
+		}
+		if opens > 0 {
+			fragments++
+			if !strings.Contains(line, xansi.SetHyperlink(url)) {
+				t.Errorf("link fragment does not target the full URL: %q", line)
+			}
+		}
+		visible.WriteString(xansi.Strip(line))
+	}
+	if fragments < 2 {
+		t.Errorf("URL linked on %d lines, want every wrapped piece linked", fragments)
+	}
+	if !strings.Contains(strings.ReplaceAll(visible.String(), " ", ""), url) {
+		t.Errorf("wrapped URL text changed: %q", visible.String())
+	}
+}
@@ -71,6 +71,39 @@ func parseLocaleTimeFormat(locale string) TimeFormat {
 
 var detectedFormat = DetectTimeFormat()
 
+// displayLocation is the time zone times are shown in (see ApplyClockSettings).
+var displayLocation = time.Local
+
+// ApplyClockSettings sets the clock FormatTime uses. A nil clock24h follows
+// the locale; otherwise it picks the 24-hour or 12-hour clock. An empty
+// timezone means local time. An unknown timezone falls back to local time
+// and is returned as an error so the caller can warn about it.
+func ApplyClockSettings(clock24h *bool, timezone string) error {
+	detectedFormat = DetectTimeFormat()
+	if clock24h != nil {
+		detectedFormat = TimeFormat12h
+		if *clock24h {
+			detectedFormat = TimeFormat24h
+		}
+	}
+
+	displayLocation = time.Local
+	if timezone == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("unknown timezone %q, using local time: %w", timezone, err)
+	}
+	displayLocation = loc
+	return nil
+}
+
+// inDisplayZone converts t to the time zone times are shown in.
+func inDisplayZone(t time.Time) time.Time {
+	return t.In(displayLocation)
+}
+
 // FormatTime formats a time value according to the detected locale preference.
 func FormatTime(t time.Time) string {
 	return FormatTimeWithFormat(t, detectedFormat)
@@ -80,9 +113,9 @@ func FormatTime(t time.Time) string {
 func FormatTimeWithFormat(t time.Time, format TimeFormat) string {
 	switch format {
 	case TimeFormat12h:
-		return t.Local().Format("3:04PM")
+		return inDisplayZone(t).Format("3:04PM")
 	default:
-		return t.Local().Format("15:04")
+		return inDisplayZone(t).Format("15:04")
 	}
 }
 
@@ -100,8 +133,8 @@ func FormatTimeWidth(format TimeFormat) int {
 // DayLabel returns a human-readable label for a date relative to today.
 // Returns "Today", "Yesterday", or the formatted date for older dates.
 func DayLabel(t time.Time) string {
-	now := time.Now().Local()
-	t = t.Local()
+	now := inDisplayZone(time.Now())
+	t = inDisplayZone(t)
 
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	postDay := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
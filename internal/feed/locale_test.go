@@ -152,3 +152,35 @@ func TestDayLabel(t *testing.T) {
 		t.Errorf("DayLabel(yearAgo) = %q, want format with year", yearAgoLabel)
 	}
 }
+
+func TestApplyClockSettings(t *testing.T) {
+	t.Setenv("LC_TIME", "de_DE.UTF-8")
+	t.Cleanup(func() { _ = ApplyClockSettings(nil, "") })
+	post := &Post{CreatedAt: "2026-01-30T18:05:00Z"}
+
+	if err := ApplyClockSettings(nil, "Asia/Tokyo"); err != nil {
+		t.Fatalf("ApplyClockSettings(Asia/Tokyo) error = %v", err)
+	}
+	if got := formatTimestamp(post); got != "03:05" {
+		t.Errorf("Tokyo 24h timestamp = %q, want %q", got, "03:05")
+	}
+	if got := formatPostTime(post, TimestampDateTime); got != "2026-01-31 03:05" {
+		t.Errorf("Tokyo date-time = %q, want the next day", got)
+	}
+
+	clock12 := false
+	if err := ApplyClockSettings(&clock12, "America/New_York"); err != nil {
+		t.Fatalf("ApplyClockSettings(America/New_York) error = %v", err)
+	}
+	if got := formatTimestamp(post); got != "1:05PM" {
+		t.Errorf("New York 12h timestamp = %q, want %q", got, "1:05PM")
+	}
+
+	if err := ApplyClockSettings(nil, "Mars/Olympus"); err == nil {
+		t.Error("ApplyClockSettings should reject an unknown timezone")
+	}
+	created, _ := post.GetCreatedTime()
+	if got, want := formatTimestamp(post), created.Local().Format("15:04"); got != want {
+		t.Errorf("unknown timezone should fall back to local 24h time: got %q, want %q", got, want)
+	}
+}
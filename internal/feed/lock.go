@@ -0,0 +1,29 @@
+package feed
+
+import (
+	"errors"
+	"os"
+
+	"github.com/dreamiurg/smoke/internal/filelock"
+)
+
+// ErrFeedLocked is returned when another process holds the feed lock for
+// too long.
+var ErrFeedLocked = errors.New("feed is locked by another process")
+
+// lockFile takes an advisory lock on the feed file f, exclusive for writers
+// and shared for readers. The lock is released by unlockFile or by closing f.
+func lockFile(f *os.File, exclusive bool) error {
+	if err := filelock.Lock(f, exclusive); err != nil {
+		if errors.Is(err, filelock.ErrTimeout) {
+			return ErrFeedLocked
+		}
+		return err
+	}
+	return nil
+}
+
+// unlockFile releases a lock taken by lockFile.
+func unlockFile(f *os.File) error {
+	return filelock.Unlock(f)
+}
@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feed.jsonl")
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Separate stores share no mutex, so only the file lock keeps the
+	// writers apart, as with separate agent processes.
+	const writers, postsEach = 16, 25
+	long := strings.Repeat("x", 250)
+	var wg sync.WaitGroup
+	errs := make(chan error, writers*postsEach)
+	for w := range writers {
+		wg.Go(func() {
+			store := NewStoreWithPath(path)
+			for i := range postsEach {
+				post, err := NewPost("ember", "smoke", "swift-fox", fmt.Sprintf("writer %d post %d %s", w, i, long))
+				if err != nil {
+					errs <- err
+					return
+				}
+				if err := store.Append(post); err != nil {
+					errs <- err
+				}
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Append: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	lines := 0
+	for scanner.Scan() {
+		lines++
+		if !json.Valid(scanner.Bytes()) {
+			t.Fatalf("line %d is not valid JSON: %q", lines, scanner.Text())
+		}
+	}
+	if lines != writers*postsEach {
+		t.Errorf("feed has %d lines, want %d", lines, writers*postsEach)
+	}
+}
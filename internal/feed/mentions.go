@@ -0,0 +1,64 @@
+package feed
+
+import (
+	"regexp"
+	"strings"
+)
+
+// mentionExtractPattern matches an @name, optionally followed by @project, preceded
+// by start of text or a non-word character so email addresses are not mentions.
+var mentionExtractPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@./])@([\p{L}\p{N}_]+(?:-[\p{L}\p{N}_]+)*)(?:@[\p{L}\p{N}_]+(?:[-.][\p{L}\p{N}_]+)*)?`)
+
+// NormalizeMention lowercases a mention or identity and strips the leading '@'
+// and any @project suffix, so "@Swift-Fox@smoke" becomes "swift-fox".
+func NormalizeMention(name string) string {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "@")
+	name, _, _ = strings.Cut(name, "@")
+	return strings.ToLower(name)
+}
+
+// Mentions returns the normalized names mentioned in the post content, in order
+// of first appearance and without duplicates. Mentions inside `code` are ignored.
+func (p *Post) Mentions() []string {
+	content := codePattern.ReplaceAllStringFunc(p.Content, func(code string) string {
+		return strings.Repeat(" ", len(code))
+	})
+
+	var mentions []string
+	seen := make(map[string]bool)
+	for _, match := range mentionExtractPattern.FindAllStringSubmatch(content, -1) {
+		name := NormalizeMention(match[1])
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		mentions = append(mentions, name)
+	}
+	return mentions
+}
+
+// MentionsName reports whether the post mentions the given name or identity.
+func (p *Post) MentionsName(name string) bool {
+	for _, mention := range p.Mentions() {
+		if MentionRefersTo(mention, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// MentionRefersTo reports whether a mention names the given identity. Identities
+// look like "claude-swift-fox@smoke", so @claude-swift-fox and the shorter
+// @swift-fox both refer to it, but a single word like @fox does not.
+// Case and @project suffixes are ignored.
+func MentionRefersTo(mention, identity string) bool {
+	mention = NormalizeMention(mention)
+	identity = NormalizeMention(identity)
+	if mention == "" || identity == "" {
+		return false
+	}
+	if mention == identity {
+		return true
+	}
+	return strings.Contains(mention, "-") && strings.HasSuffix(identity, "-"+mention)
+}
@@ -0,0 +1,69 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"none", "no mentions here", nil},
+		{"single", "thanks @swift-fox", []string{"swift-fox"}},
+		{"project suffix", "ping @swift-fox@smoke about it", []string{"swift-fox"}},
+		{"multiple", "@swift-fox and @calm-owl@api, cc @Swift-Fox", []string{"swift-fox", "calm-owl"}},
+		{"punctuation adjacent", "(@swift-fox), @calm-owl!", []string{"swift-fox", "calm-owl"}},
+		{"email is not a mention", "mail bob@example.com", nil},
+		{"inside code", "run `ssh @host` then tell @calm-owl", []string{"calm-owl"}},
+		{"trailing hyphen", "hi @swift-fox-", []string{"swift-fox"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post := &Post{Content: tt.content}
+			assert.Equal(t, tt.want, post.Mentions())
+		})
+	}
+}
+
+func TestMentionRefersTo(t *testing.T) {
+	assert.True(t, MentionRefersTo("@swift-fox", "swift-fox@smoke"))
+	assert.True(t, MentionRefersTo("@swift-fox@other", "claude-swift-fox@smoke"), "suffix of agent identity")
+	assert.True(t, MentionRefersTo("@Claude-Swift-Fox", "claude-swift-fox@smoke"))
+	assert.False(t, MentionRefersTo("@fox", "claude-swift-fox@smoke"), "single word must match whole name")
+	assert.False(t, MentionRefersTo("@calm-owl", "claude-swift-fox@smoke"))
+	assert.False(t, MentionRefersTo("@", "swift-fox"))
+}
+
+func TestFilterPosts_Mention(t *testing.T) {
+	posts := []*Post{
+		{ID: "a", Content: "hey @swift-fox@smoke"},
+		{ID: "b", Content: "hey @calm-owl"},
+		{ID: "c", Content: "@fox only"},
+	}
+	got := FilterPosts(posts, FilterCriteria{Mention: "claude-swift-fox@smoke"})
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "a", got[0].ID)
+	}
+}
+
+func TestMentionPattern_Hyphenated(t *testing.T) {
+	assert.Equal(t, []string{"@swift-fox@smoke", "@calm-owl"},
+		MentionPattern.FindAllString("@swift-fox@smoke and @calm-owl", -1))
+}
+
+func TestHighlightForIdentity_KeepsText(t *testing.T) {
+	theme := GetTheme("dracula")
+	text := "ping @swift-fox and @calm-owl about #bug"
+	for _, self := range []string{"", "claude-swift-fox@smoke"} {
+		got := HighlightForIdentity(text, theme, theme.Background, self)
+		assert.Equal(t, text, stripANSI(got))
+	}
+}
+
+func stripANSI(s string) string {
+	return ansiPattern.ReplaceAllString(s, "")
+}
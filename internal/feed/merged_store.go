@@ -0,0 +1,61 @@
+package feed
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrReadOnlyStore is returned when posting to a store that only merges reads.
+var ErrReadOnlyStore = errors.New("merged feed is read-only; post to a single feed instead")
+
+// MergedStore reads several feed files as one, for an overview across
+// project feeds. Posts keep their own file's threads; new posts cannot be
+// appended because there is no single file to append to.
+type MergedStore struct {
+	stores []*FileStore
+}
+
+// NewMergedStore returns a store reading every feed file in paths.
+func NewMergedStore(paths ...string) *MergedStore {
+	stores := make([]*FileStore, len(paths))
+	for i, path := range paths {
+		stores[i] = NewStoreWithPath(path)
+	}
+	return &MergedStore{stores: stores}
+}
+
+// Append always fails with ErrReadOnlyStore.
+func (s *MergedStore) Append(*Post) error {
+	return ErrReadOnlyStore
+}
+
+// ReadAll returns the posts of every feed ordered by creation time. Feeds
+// that are missing are skipped.
+func (s *MergedStore) ReadAll() ([]*Post, error) {
+	var all []*Post
+	for _, store := range s.stores {
+		posts, err := store.ReadAll()
+		if errors.Is(err, ErrNotInitialized) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, posts...)
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].CreatedAt < all[j].CreatedAt
+	})
+	return all, nil
+}
+
+// DeleteByID deletes the post from whichever feed holds it.
+func (s *MergedStore) DeleteByID(id string) error {
+	for _, store := range s.stores {
+		err := store.DeleteByID(id)
+		if !errors.Is(err, ErrPostNotFound) && !errors.Is(err, ErrNotInitialized) {
+			return err
+		}
+	}
+	return ErrPostNotFound
+}
@@ -0,0 +1,59 @@
+package feed
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMergedStore(t *testing.T) {
+	dir := t.TempDir()
+	alphaPath := filepath.Join(dir, "alpha.jsonl")
+	betaPath := filepath.Join(dir, "beta.jsonl")
+	for _, path := range []string{alphaPath, betaPath} {
+		if err := os.WriteFile(path, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	add := func(path, content, createdAt string) *Post {
+		t.Helper()
+		post, err := NewPost("ember", "smoke", "swift-fox", content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.CreatedAt = createdAt
+		if err := NewStoreWithPath(path).Append(post); err != nil {
+			t.Fatal(err)
+		}
+		return post
+	}
+	first := add(betaPath, "first", "2026-01-01T10:00:00Z")
+	add(alphaPath, "second", "2026-01-01T11:00:00Z")
+	add(betaPath, "third", "2026-01-01T12:00:00Z")
+
+	store := NewMergedStore(alphaPath, betaPath, filepath.Join(dir, "missing.jsonl"))
+	posts, err := store.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	var got []string
+	for _, p := range posts {
+		got = append(got, p.Content)
+	}
+	if len(got) != 3 || got[0] != "first" || got[1] != "second" || got[2] != "third" {
+		t.Errorf("ReadAll() = %v, want [first second third]", got)
+	}
+
+	if err := store.Append(first); !errors.Is(err, ErrReadOnlyStore) {
+		t.Errorf("Append() error = %v, want ErrReadOnlyStore", err)
+	}
+
+	if err := store.DeleteByID(first.ID); err != nil {
+		t.Fatalf("DeleteByID: %v", err)
+	}
+	if err := store.DeleteByID("smk-zzzzzz"); !errors.Is(err, ErrPostNotFound) {
+		t.Errorf("DeleteByID(unknown) error = %v, want ErrPostNotFound", err)
+	}
+}
@@ -0,0 +1,80 @@
+package feed
+
+// MutedContent replaces the content of muted posts kept for thread context.
+const MutedContent = "[muted]"
+
+// IsMutedAuthor reports whether author matches an entry in muted. Entries
+// match like mentions: the full name, the name without @project, or a
+// hyphenated suffix such as "swift-fox" for "claude-swift-fox".
+func IsMutedAuthor(author string, muted []string) bool {
+	for _, entry := range muted {
+		if MentionRefersTo(entry, author) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyMutes hides posts by muted authors. A thread started by a muted author
+// is dropped unless someone else posted in it; in every thread still shown,
+// muted posts are kept as MutedContent placeholders so the thread still reads.
+// Placeholders are copies, so the input posts are never modified.
+func ApplyMutes(posts []*Post, muted []string) []*Post {
+	if len(muted) == 0 {
+		return posts
+	}
+
+	byID := make(map[string]*Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+	rootOf := func(post *Post) string {
+		seen := make(map[string]bool)
+		for post.IsReply() && !seen[post.ID] {
+			seen[post.ID] = true
+			parent, ok := byID[post.ParentID]
+			if !ok {
+				return post.ParentID
+			}
+			post = parent
+		}
+		return post.ID
+	}
+
+	visible := make(map[string]bool)
+	for _, post := range posts {
+		if !IsMutedAuthor(post.Author, muted) {
+			visible[rootOf(post)] = true
+		}
+	}
+
+	kept := make([]*Post, 0, len(posts))
+	for _, post := range posts {
+		if !IsMutedAuthor(post.Author, muted) {
+			kept = append(kept, post)
+			continue
+		}
+		if visible[rootOf(post)] {
+			placeholder := *post
+			placeholder.Content = MutedContent
+			placeholder.Reactions = nil
+			placeholder.EditedAt = ""
+			kept = append(kept, &placeholder)
+		}
+	}
+	return kept
+}
+
+// WithoutMuted drops every post by a muted author.
+func WithoutMuted(posts []*Post, muted []string) []*Post {
+	if len(muted) == 0 {
+		return posts
+	}
+	kept := make([]*Post, 0, len(posts))
+	for _, post := range posts {
+		if !IsMutedAuthor(post.Author, muted) {
+			kept = append(kept, post)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+func TestIsMutedAuthor(t *testing.T) {
+	muted := []string{"swift-fox", "ember@smoke"}
+	assert.True(t, IsMutedAuthor("claude-swift-fox@smoke", muted), "hyphenated suffix matches")
+	assert.True(t, IsMutedAuthor("swift-fox@other", muted), "project is ignored")
+	assert.True(t, IsMutedAuthor("Ember@smoke", muted), "case is ignored")
+	assert.False(t, IsMutedAuthor("claude-calm-owl@smoke", muted))
+	assert.False(t, IsMutedAuthor("embers@smoke", muted))
+	assert.False(t, IsMutedAuthor("ember@smoke", nil))
+}
+
+func TestApplyMutes(t *testing.T) {
+	posts := []*Post{
+		{ID: "smk-000001", Author: "noisy@smoke", Content: "alone"},
+		{ID: "smk-000002", Author: "noisy@smoke", Content: "answered", Reactions: map[string]int{"👍": 1}},
+		{ID: "smk-000003", Author: "alice@smoke", Content: "reply to noisy", ParentID: "smk-000002"},
+		{ID: "smk-000004", Author: "alice@smoke", Content: "question"},
+		{ID: "smk-000005", Author: "noisy@smoke", Content: "noisy reply", ParentID: "smk-000004"},
+		{ID: "smk-000006", Author: "noisy@smoke", Content: "only noisy replies"},
+		{ID: "smk-000007", Author: "noisy@smoke", Content: "self reply", ParentID: "smk-000006"},
+	}
+
+	got := ApplyMutes(posts, []string{"noisy"})
+
+	ids := make([]string, len(got))
+	content := make(map[string]string, len(got))
+	for i, post := range got {
+		ids[i] = post.ID
+		content[post.ID] = post.Content
+	}
+	assert.Equal(t, []string{"smk-000002", "smk-000003", "smk-000004", "smk-000005"}, ids,
+		"threads with only muted posts are dropped whole")
+	assert.Equal(t, MutedContent, content["smk-000002"], "answered muted post stays as a placeholder")
+	assert.Equal(t, "reply to noisy", content["smk-000003"])
+	assert.Equal(t, MutedContent, content["smk-000005"], "muted reply is collapsed")
+	assert.Nil(t, got[0].Reactions)
+
+	assert.Equal(t, "answered", posts[1].Content, "input posts are not modified")
+	assert.Equal(t, posts, ApplyMutes(posts, nil))
+}
+
+func TestWithoutMuted(t *testing.T) {
+	posts := []*Post{
+		{ID: "smk-000001", Author: "noisy@smoke"},
+		{ID: "smk-000002", Author: "alice@smoke"},
+		{ID: "smk-000003", Author: "noisy@smoke", ParentID: "smk-000002"},
+	}
+	got := WithoutMuted(posts, []string{"noisy"})
+	require.Len(t, got, 1)
+	assert.Equal(t, "smk-000002", got[0].ID)
+	assert.Equal(t, 1, ComputeStats(got).Agents)
+}
+
+func TestMuteKey_TogglesAuthor(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".config", "smoke"), 0755))
+	model := searchTestModel(t)
+
+	updated, cmd := model.Update(runeKey("m"))
+	model = updated.(Model)
+	assert.Equal(t, []string{"spark"}, config.GetMutedAuthors())
+	require.NotNil(t, cmd, "muting reloads posts")
+	assert.Equal(t, "✓ Muted spark", model.notices[len(model.notices)-1].text)
+
+	msg := loadPostsMsg{posts: model.posts, muted: config.GetMutedAuthors()}
+	updated, _ = model.Update(msg)
+	model = updated.(Model)
+	for _, post := range model.displayedPosts {
+		assert.NotEqual(t, "smk-bbbbbb", post.ID, "muted author's post is hidden")
+	}
+
+	// Unmuting removes the matching entry and reloads
+	require.NotNil(t, model.toggleMute("spark@smoke"))
+	assert.Empty(t, config.GetMutedAuthors())
+	assert.Equal(t, "✓ Unmuted spark", model.notices[len(model.notices)-1].text)
+}
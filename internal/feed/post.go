@@ -2,10 +2,11 @@ package feed
 
 import (
 	"errors"
-	"fmt"
 	"regexp"
 	"strings"
 	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
 )
 
 // ansiPattern matches ANSI escape sequences
@@ -16,8 +17,9 @@ import (
 // OSC: ESC ] ... (BEL or ESC \)
 var ansiPattern = regexp.MustCompile(`\x1b\[[0-9:;<=>?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)`)
 
-// MaxContentLength is the maximum allowed content length
-const MaxContentLength = 280
+// MaxContentLength is the default maximum content length for new posts.
+// The limit in effect comes from config.GetMaxPostLength.
+const MaxContentLength = config.DefaultMaxPostLength
 
 // Post represents a single message in the social feed.
 type Post struct {
@@ -31,19 +33,33 @@ type Post struct {
 	Project string `json:"project"`
 	// Suffix is the project suffix or identifier (e.g., short code or version).
 	Suffix string `json:"suffix"`
-	// Content is the body text of the post, limited to MaxContentLength characters.
+	// Content is the body text of the post, limited to config.GetMaxPostLength characters.
 	Content string `json:"content"`
 	// CreatedAt is the UTC timestamp when the post was created, in RFC3339 format.
 	CreatedAt string `json:"created_at"`
 	// ParentID is the ID of the parent post if this post is a reply, otherwise empty.
 	ParentID string `json:"parent_id,omitempty"`
+	// Reactions counts reactions by emoji. Aggregated from reaction records
+	// when the feed is read; never written as part of the post.
+	Reactions map[string]int `json:"-"`
+	// Depth is the reply nesting level: 0 for top-level posts, 1 for direct
+	// replies, 2 for replies to those, and so on. Set when threads are built.
+	Depth int `json:"-"`
+	// Deleted marks a placeholder for a deleted post kept because it has replies.
+	Deleted bool `json:"-"`
+	// EditedAt is the time of the latest revision, or empty if never edited.
+	// Set when the feed is read; the original line is never rewritten.
+	EditedAt string `json:"-"`
+	// Revision counts the edits applied to the post.
+	Revision int `json:"-"`
 }
 
 // ErrEmptyContent is returned when a post's content is empty.
 var ErrEmptyContent = errors.New("content cannot be empty")
 
-// ErrContentTooLong is returned when a post's content exceeds MaxContentLength.
-var ErrContentTooLong = fmt.Errorf("message exceeds %d characters", MaxContentLength)
+// ErrContentTooLong is returned when new content exceeds the configured
+// post length limit, or any stored content exceeds config.MaxPostLengthCeiling.
+var ErrContentTooLong = errors.New("message is too long")
 
 // ErrEmptyAuthor is returned when a post's author is empty.
 var ErrEmptyAuthor = errors.New("author cannot be empty")
@@ -56,16 +72,9 @@ var ErrInvalidID = errors.New("invalid post ID format")
 
 // NewPost creates a new post with validation
 func NewPost(author, project, suffix, content string) (*Post, error) {
-	// Sanitize content: strip ANSI escape sequences and trim whitespace
-	content = ansiPattern.ReplaceAllString(content, "")
-	content = strings.TrimSpace(content)
-
-	// Validate content
-	if content == "" {
-		return nil, ErrEmptyContent
-	}
-	if len(content) > MaxContentLength {
-		return nil, ErrContentTooLong
+	content, err := sanitizeContent(content)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate author
@@ -99,6 +108,22 @@ func NewPost(author, project, suffix, content string) (*Post, error) {
 	}, nil
 }
 
+// sanitizeContent strips ANSI escape sequences, trims whitespace, and
+// checks the result against the content rules shared by posts and edits,
+// including the configured max_post_length.
+func sanitizeContent(content string) (string, error) {
+	content = ansiPattern.ReplaceAllString(content, "")
+	content = strings.TrimSpace(content)
+
+	if content == "" {
+		return "", ErrEmptyContent
+	}
+	if len(content) > config.GetMaxPostLength() {
+		return "", ErrContentTooLong
+	}
+	return content, nil
+}
+
 // NewReply creates a new reply post with validation
 func NewReply(author, project, suffix, content, parentID string) (*Post, error) {
 	post, err := NewPost(author, project, suffix, content)
@@ -115,7 +140,9 @@ func NewReply(author, project, suffix, content, parentID string) (*Post, error)
 	return post, nil
 }
 
-// Validate checks if a post has valid data
+// Validate checks if a post has valid data. Content may be up to
+// config.MaxPostLengthCeiling long, so posts written under a higher
+// max_post_length stay readable after the limit is lowered.
 func (p *Post) Validate() error {
 	if p.ID == "" || !ValidateID(p.ID) {
 		return ErrInvalidID
@@ -129,7 +156,7 @@ func (p *Post) Validate() error {
 	if p.Content == "" {
 		return ErrEmptyContent
 	}
-	if len(p.Content) > MaxContentLength {
+	if len(p.Content) > config.MaxPostLengthCeiling {
 		return ErrContentTooLong
 	}
 	if p.ParentID != "" && !ValidateID(p.ParentID) {
@@ -1,11 +1,16 @@
 package feed
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dreamiurg/smoke/internal/config"
 )
 
 func TestNewPost(t *testing.T) {
@@ -300,11 +305,23 @@ func TestPostValidate(t *testing.T) {
 				Author:    "ember",
 				Project:   "smoke",
 				Suffix:    "swift-fox",
-				Content:   strings.Repeat("a", 281),
+				Content:   strings.Repeat("a", config.MaxPostLengthCeiling+1),
 				CreatedAt: time.Now().UTC().Format(time.RFC3339),
 			},
 			wantErr: ErrContentTooLong,
 		},
+		{
+			name: "content over default limit stays readable",
+			post: &Post{
+				ID:        "smk-abc123",
+				Author:    "ember",
+				Project:   "smoke",
+				Suffix:    "swift-fox",
+				Content:   strings.Repeat("a", 281),
+				CreatedAt: time.Now().UTC().Format(time.RFC3339),
+			},
+			wantErr: nil,
+		},
 		{
 			name: "invalid parent ID",
 			post: &Post{
@@ -396,3 +413,37 @@ func TestPostContentLength(t *testing.T) {
 
 	assert.Equal(t, 11, len(post.Content))
 }
+
+func TestNewPost_ConfiguredMaxLength(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	configDir := filepath.Join(home, ".config", "smoke")
+	require.NoError(t, os.MkdirAll(configDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte("max_post_length: 100\n"), 0644))
+
+	_, err := NewPost("ember", "smoke", "swift-fox", strings.Repeat("a", 99))
+	assert.NoError(t, err, "just under the limit")
+	_, err = NewPost("ember", "smoke", "swift-fox", strings.Repeat("a", 100))
+	assert.NoError(t, err, "at the limit")
+	_, err = NewPost("ember", "smoke", "swift-fox", strings.Repeat("a", 101))
+	assert.ErrorIs(t, err, ErrContentTooLong, "just over the limit")
+	_, err = NewReply("ember", "smoke", "swift-fox", strings.Repeat("a", 101), "smk-abc123")
+	assert.ErrorIs(t, err, ErrContentTooLong, "replies use the same limit")
+	_, err = NewRevision("ember", "smk-abc123", strings.Repeat("a", 101))
+	assert.ErrorIs(t, err, ErrContentTooLong, "edits use the same limit")
+	_, err = NewPost("ember", "smoke", "swift-fox", strings.Repeat("a", 50)+"\n"+strings.Repeat("b", 50))
+	assert.ErrorIs(t, err, ErrContentTooLong, "newlines count toward the limit")
+
+	// A raised limit lets longer posts through, and the store keeps them
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte("max_post_length: 500\n"), 0644))
+	post, err := NewPost("ember", "smoke", "swift-fox", strings.Repeat("a", 500))
+	require.NoError(t, err)
+	feedPath := filepath.Join(configDir, "feed.jsonl")
+	require.NoError(t, os.WriteFile(feedPath, nil, 0644))
+	store := NewStoreWithPath(feedPath)
+	require.NoError(t, store.Append(post))
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Len(t, posts[0].Content, 500)
+}
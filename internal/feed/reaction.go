@@ -0,0 +1,132 @@
+package feed
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RecordTypeReaction marks a feed line as a reaction rather than a post.
+// Posts predate record types and have no "type" field.
+const RecordTypeReaction = "reaction"
+
+// MaxEmojiLength is the maximum length of a reaction in runes.
+// Long enough for ZWJ sequences such as 👩‍💻, short enough to rule out text.
+const MaxEmojiLength = 10
+
+// ReactionEmojis are the reactions offered by the TUI picker, in order.
+var ReactionEmojis = []string{"👍", "❤️", "🎉", "😂", "🤔", "👀", "🔥", "✅"}
+
+// ErrInvalidEmoji is returned when a reaction is empty, too long, or contains text.
+var ErrInvalidEmoji = errors.New("reaction must be a single emoji")
+
+// Reaction is a lightweight acknowledgement of a post, stored as its own
+// feed line so the feed stays append-only.
+type Reaction struct {
+	// Type is always RecordTypeReaction.
+	Type string `json:"type"`
+	// TargetID is the ID of the post being reacted to.
+	TargetID string `json:"target_id"`
+	// Emoji is the reaction itself.
+	Emoji string `json:"emoji"`
+	// Author is the identity that reacted.
+	Author string `json:"author"`
+	// CreatedAt is the UTC timestamp of the reaction, in RFC3339 format.
+	CreatedAt string `json:"created_at"`
+}
+
+// NewReaction creates a validated reaction to the post with the given ID.
+func NewReaction(author, targetID, emoji string) (*Reaction, error) {
+	r := &Reaction{
+		Type:      RecordTypeReaction,
+		TargetID:  targetID,
+		Emoji:     strings.TrimSpace(emoji),
+		Author:    author,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Validate checks that the reaction has a valid target, author, and emoji.
+func (r *Reaction) Validate() error {
+	if !ValidateID(r.TargetID) {
+		return ErrInvalidID
+	}
+	if r.Author == "" {
+		return ErrEmptyAuthor
+	}
+	return validateEmoji(r.Emoji)
+}
+
+// validateEmoji rejects empty, overly long, or textual reactions.
+func validateEmoji(emoji string) error {
+	if emoji == "" || utf8.RuneCountInString(emoji) > MaxEmojiLength {
+		return ErrInvalidEmoji
+	}
+	for _, r := range emoji {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return ErrInvalidEmoji
+		}
+	}
+	return nil
+}
+
+// applyReactions sets Reactions on each post from the reaction records.
+// Repeated reactions with the same emoji by the same author count once.
+func applyReactions(posts []*Post, reactions []*Reaction) {
+	if len(reactions) == 0 {
+		return
+	}
+	byID := make(map[string]*Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+
+	seen := make(map[[3]string]bool)
+	for _, r := range reactions {
+		post, ok := byID[r.TargetID]
+		if !ok {
+			continue
+		}
+		key := [3]string{r.TargetID, r.Author, r.Emoji}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if post.Reactions == nil {
+			post.Reactions = make(map[string]int)
+		}
+		post.Reactions[r.Emoji]++
+	}
+}
+
+// FormatReactions summarizes reactions as "👍 2  🎉 1", most frequent first.
+// Returns "" when there are no reactions.
+func FormatReactions(reactions map[string]int) string {
+	if len(reactions) == 0 {
+		return ""
+	}
+	emojis := make([]string, 0, len(reactions))
+	for emoji := range reactions {
+		emojis = append(emojis, emoji)
+	}
+	sort.Slice(emojis, func(i, j int) bool {
+		if reactions[emojis[i]] != reactions[emojis[j]] {
+			return reactions[emojis[i]] > reactions[emojis[j]]
+		}
+		return emojis[i] < emojis[j]
+	})
+
+	parts := make([]string, len(emojis))
+	for i, emoji := range emojis {
+		parts[i] = emoji + " " + strconv.Itoa(reactions[emoji])
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,162 @@
+package feed
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func appendTestPost(t *testing.T, store Store, id string) {
+	t.Helper()
+	require.NoError(t, store.Append(&Post{
+		ID:        id,
+		Author:    "ember",
+		Suffix:    "smoke",
+		Content:   "post " + id,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}))
+}
+
+func TestNewReaction_Validation(t *testing.T) {
+	_, err := NewReaction("ember", "smk-abc123", "👍")
+	assert.NoError(t, err)
+	_, err = NewReaction("ember", "smk-abc123", "❤️")
+	assert.NoError(t, err, "variation selectors are allowed")
+
+	for _, emoji := range []string{"", "ok", "👍 👍", "+1", strings.Repeat("🎉", MaxEmojiLength+1)} {
+		_, err := NewReaction("ember", "smk-abc123", emoji)
+		assert.ErrorIs(t, err, ErrInvalidEmoji, "emoji %q", emoji)
+	}
+	_, err = NewReaction("ember", "nope", "👍")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = NewReaction("", "smk-abc123", "👍")
+	assert.ErrorIs(t, err, ErrEmptyAuthor)
+}
+
+func TestStoreAppendReaction(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-abc123")
+
+	for _, r := range []struct{ author, emoji string }{
+		{"ember", "👍"}, {"spark", "👍"}, {"ember", "👍"}, {"spark", "🎉"},
+	} {
+		reaction, err := NewReaction(r.author, "smk-abc123", r.emoji)
+		require.NoError(t, err)
+		require.NoError(t, store.AppendReaction(reaction))
+	}
+
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 1, "reactions must not appear as posts")
+	assert.Equal(t, map[string]int{"👍": 2, "🎉": 1}, posts[0].Reactions, "duplicate author+emoji counts once")
+
+	data, err := os.ReadFile(feedPath)
+	require.NoError(t, err)
+	assert.Equal(t, 5, strings.Count(string(data), "\n"), "each reaction is its own line")
+	assert.Contains(t, string(data), `"type":"reaction"`)
+	assert.NotContains(t, string(data), `"reactions"`, "counts are never written into posts")
+}
+
+func TestStoreAppendReaction_TargetMustExist(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-abc123")
+
+	reaction, err := NewReaction("ember", "smk-zzz999", "👍")
+	require.NoError(t, err)
+	assert.ErrorIs(t, store.AppendReaction(reaction), ErrPostNotFound)
+}
+
+func TestStoreDeleteByID_KeepsOtherReactions(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-aaa111")
+	appendTestPost(t, store, "smk-bbb222")
+	for _, id := range []string{"smk-aaa111", "smk-bbb222"} {
+		reaction, err := NewReaction("ember", id, "🔥")
+		require.NoError(t, err)
+		require.NoError(t, store.AppendReaction(reaction))
+	}
+
+	require.NoError(t, store.DeleteByID("smk-aaa111"))
+
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "smk-bbb222", posts[0].ID)
+	assert.Equal(t, map[string]int{"🔥": 1}, posts[0].Reactions)
+}
+
+func TestFormatReactions(t *testing.T) {
+	assert.Equal(t, "", FormatReactions(nil))
+	assert.Equal(t, "🎉 3  👍 1  🔥 1", FormatReactions(map[string]int{"👍": 1, "🎉": 3, "🔥": 1}))
+}
+
+func TestTUIReactMenu_StoreWithoutReactions(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SMOKE_NAME", "tester")
+	store := &memStore{}
+	appendTestPost(t, store, "smk-abc123")
+
+	model := testModel(store)
+	model.width, model.height = 100, 30
+	updated, _ := model.Update(loadPostsMsg{posts: store.posts})
+	model = updated.(Model)
+
+	model = typeKeys(t, model, runeKey("e"), runeKey("1"))
+	assert.Equal(t, "⚠ This feed does not support reactions", model.notices[len(model.notices)-1].text)
+	assert.Empty(t, model.posts[0].Reactions)
+}
+
+func TestTUIReactMenu(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SMOKE_NAME", "tester")
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-abc123")
+
+	model := testModel(store)
+	model.width, model.height = 100, 30
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	model.posts = posts
+	model.updateDisplayedPosts()
+	model.selectedPostIndex = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	model = updated.(Model)
+	require.True(t, model.showReactMenu, "e should open the emoji picker")
+	assert.Contains(t, model.renderReactMenuOverlay(), "React")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	model = updated.(Model)
+	assert.False(t, model.showReactMenu)
+	require.NotNil(t, cmd, "reacting should reload the feed")
+
+	updated, _ = model.Update(cmd())
+	model = updated.(Model)
+	assert.Equal(t, map[string]int{ReactionEmojis[2]: 1}, model.posts[0].Reactions)
+
+	var found bool
+	for _, line := range model.buildAllContentLines() {
+		if strings.Contains(line, ReactionEmojis[2]+" 1") {
+			found = true
+		}
+	}
+	assert.True(t, found, "reaction summary should render under the post")
+}
+
+func TestTUIReactMenu_Cancel(t *testing.T) {
+	store, _ := setupTestStore(t)
+	model := testModel(store)
+	model.posts = []*Post{{ID: "smk-abc123", Author: "ember", Content: "hi", CreatedAt: time.Now().UTC().Format(time.RFC3339)}}
+	model.updateDisplayedPosts()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	updated, cmd := updated.(Model).Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	assert.False(t, model.showReactMenu)
+	assert.Nil(t, cmd)
+}
@@ -0,0 +1,41 @@
+package feed
+
+import "time"
+
+// ReadBoundary marks where unread posts begin in a list of posts.
+type ReadBoundary struct {
+	At    time.Time // creation time of the boundary, zero if unknown
+	Index int       // position of the last-read post, -1 if not in the list
+}
+
+// NewReadBoundary locates the read marker saved as lastReadID and lastReadAt
+// within posts. When lastReadAt is zero it is taken from the last-read post,
+// if that post is in the list.
+func NewReadBoundary(posts []*Post, lastReadID string, lastReadAt time.Time) ReadBoundary {
+	b := ReadBoundary{At: lastReadAt, Index: -1}
+	for i, post := range posts {
+		if post != nil && post.ID == lastReadID {
+			b.Index = i
+			break
+		}
+	}
+	if b.At.IsZero() && b.Index >= 0 {
+		b.At, _ = posts[b.Index].GetCreatedTime()
+	}
+	return b
+}
+
+// IsUnread reports whether the post at index i comes after the boundary.
+// Posts are compared by creation time so the boundary holds even when the
+// last-read post was deleted; the post's position breaks ties.
+func (b ReadBoundary) IsUnread(i int, post *Post) bool {
+	if post == nil {
+		return false
+	}
+	if !b.At.IsZero() {
+		if t, err := post.GetCreatedTime(); err == nil && !t.Equal(b.At) {
+			return t.After(b.At)
+		}
+	}
+	return b.Index >= 0 && i > b.Index
+}
@@ -0,0 +1,120 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// FsyncEnv turns off the fsync after each feed write when set to "0".
+// Writes are still single, newline-terminated records, so a crash can lose
+// only the last few posts, never corrupt earlier ones.
+const FsyncEnv = "SMOKE_FEED_FSYNC"
+
+// syncWrites reports whether feed writes are flushed to disk before returning.
+func syncWrites() bool {
+	return os.Getenv(FsyncEnv) != "0"
+}
+
+// partialTailLen returns the length of a trailing record cut off by an
+// interrupted write: a final line with no newline that is not valid JSON.
+// A complete record that only lacks its newline does not count.
+func partialTailLen(data []byte) int {
+	if len(data) == 0 || data[len(data)-1] == '\n' {
+		return 0
+	}
+	tail := data[bytes.LastIndexByte(data, '\n')+1:]
+	if json.Valid(tail) {
+		return 0
+	}
+	return len(tail)
+}
+
+// partialTailChunk is how much of the end of the feed PartialTail reads.
+// Records are far smaller, so a cut-off record nearly always fits.
+const partialTailChunk = 64 * 1024
+
+// PartialTail returns the size in bytes of a partial record at the end of
+// the feed file, or 0 if the file ends cleanly. It reads only the end of
+// the file, so it is cheap to call before every command.
+func (s *FileStore) PartialTail() (int, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return 0, ErrNotInitialized
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error reading feed file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("error reading feed file: %w", err)
+	}
+	offset := max(info.Size()-partialTailChunk, 0)
+	data, err := io.ReadAll(io.NewSectionReader(f, offset, info.Size()-offset))
+	if err != nil {
+		return 0, fmt.Errorf("error reading feed file: %w", err)
+	}
+	if offset > 0 && bytes.IndexByte(data, '\n') < 0 {
+		// The partial record is longer than the chunk; measure all of it.
+		if data, err = os.ReadFile(s.path); err != nil {
+			return 0, fmt.Errorf("error reading feed file: %w", err)
+		}
+	}
+	return partialTailLen(data), nil
+}
+
+// RepairResult reports what Repair changed.
+type RepairResult struct {
+	// BytesRemoved is the size of the partial record that was cut off.
+	BytesRemoved int
+	// BackupPath is the copy of the feed taken before truncating, or empty
+	// when there was nothing to repair.
+	BackupPath string
+}
+
+// Repair truncates a partial record left at the end of the feed file by an
+// interrupted write. The whole file is first copied to
+// <feed>.bak.<timestamp>. A feed that ends cleanly is left alone.
+func (s *FileStore) Repair() (*RepairResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_RDWR, 0600)
+	if os.IsNotExist(err) {
+		return nil, ErrNotInitialized
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := lockFile(f, true); err != nil {
+		return nil, err
+	}
+	defer func() { _ = unlockFile(f) }()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading feed file: %w", err)
+	}
+	n := partialTailLen(data)
+	if n == 0 {
+		return &RepairResult{}, nil
+	}
+
+	backupPath := fmt.Sprintf("%s.bak.%s", s.path, time.Now().Format("2006-01-02T15-04-05"))
+	if err := os.WriteFile(backupPath, data, 0600); err != nil {
+		return nil, fmt.Errorf("failed to back up feed file: %w", err)
+	}
+	if err := f.Truncate(int64(len(data) - n)); err != nil {
+		return nil, fmt.Errorf("failed to truncate feed file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return nil, fmt.Errorf("failed to sync feed file: %w", err)
+	}
+	return &RepairResult{BytesRemoved: n, BackupPath: backupPath}, nil
+}
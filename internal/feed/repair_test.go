@@ -0,0 +1,183 @@
+package feed
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writePartialFeed writes one complete post followed by a post cut off
+// mid-write, as a crash during Append would leave it.
+func writePartialFeed(t *testing.T) (*FileStore, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "feed.jsonl")
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	store := NewStoreWithPath(path)
+	post, err := NewPost("ember", "smoke", "swift-fox", "survived the crash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Append(post); err != nil {
+		t.Fatal(err)
+	}
+	partial := `{"id":"smk-zzzzzz","author":"ember","content":"lost mid-wr`
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(partial); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+	return store, partial
+}
+
+// captureStderr returns what fn writes to os.Stderr.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	old := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stderr = w
+	fn()
+	_ = w.Close()
+	os.Stderr = old
+	var buf bytes.Buffer
+	_, _ = io.Copy(&buf, r)
+	return buf.String()
+}
+
+func TestReadAll_SkipsPartialTailQuietly(t *testing.T) {
+	store, partial := writePartialFeed(t)
+
+	var posts []*Post
+	stderr := captureStderr(t, func() {
+		var err error
+		posts, err = store.ReadAll()
+		if err != nil {
+			t.Fatalf("ReadAll: %v", err)
+		}
+	})
+	if len(posts) != 1 || posts[0].Content != "survived the crash" {
+		t.Errorf("ReadAll() = %d posts, want the complete one", len(posts))
+	}
+	// The TUI rereads the feed while it owns the screen, so ReadAll must
+	// not print; the CLI reports the partial record once per command.
+	if stderr != "" {
+		t.Errorf("ReadAll wrote to stderr: %q", stderr)
+	}
+
+	n, err := store.PartialTail()
+	if err != nil || n != len(partial) {
+		t.Errorf("PartialTail() = %d, %v; want %d", n, err, len(partial))
+	}
+}
+
+func TestAppend_AfterPartialTail(t *testing.T) {
+	store, _ := writePartialFeed(t)
+
+	post, err := NewPost("wisp", "smoke", "calm-owl", "written after the crash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Append(post); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+
+	var posts []*Post
+	captureStderr(t, func() { posts, _ = store.ReadAll() })
+	if len(posts) != 2 || posts[1].ID != post.ID {
+		t.Errorf("new post should start on its own line, got %d posts", len(posts))
+	}
+}
+
+func TestRepair(t *testing.T) {
+	store, partial := writePartialFeed(t)
+
+	result, err := store.Repair()
+	if err != nil {
+		t.Fatalf("Repair: %v", err)
+	}
+	if result.BytesRemoved != len(partial) {
+		t.Errorf("BytesRemoved = %d, want %d", result.BytesRemoved, len(partial))
+	}
+	backup, err := os.ReadFile(result.BackupPath)
+	if err != nil {
+		t.Fatalf("backup not written: %v", err)
+	}
+	if !strings.HasSuffix(string(backup), partial) {
+		t.Error("backup should keep the partial record")
+	}
+
+	data, err := os.ReadFile(store.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "lost mid") || !strings.HasSuffix(string(data), "\n") {
+		t.Errorf("feed after repair = %q", data)
+	}
+	if n, _ := store.PartialTail(); n != 0 {
+		t.Errorf("PartialTail() after repair = %d, want 0", n)
+	}
+
+	// A clean feed is left alone
+	again, err := store.Repair()
+	if err != nil || again.BytesRemoved != 0 || again.BackupPath != "" {
+		t.Errorf("second Repair() = %+v, %v; want no change", again, err)
+	}
+}
+
+func TestPartialTail_LargeFeed(t *testing.T) {
+	store, _ := writePartialFeed(t)
+	for i := 0; i < 1000; i++ {
+		post, err := NewPost("ember", "smoke", "swift-fox", strings.Repeat("padding ", 20))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := store.PartialTail(); err != nil || n != 0 {
+		t.Fatalf("PartialTail() = %d, %v; want 0 once the feed ends cleanly", n, err)
+	}
+
+	// A cut-off record longer than the chunk PartialTail reads.
+	long := `{"id":"smk-yyyyyy","content":"` + strings.Repeat("x", partialTailChunk+10)
+	f, err := os.OpenFile(store.Path(), os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(long); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+	if n, err := store.PartialTail(); err != nil || n != len(long) {
+		t.Errorf("PartialTail() = %d, %v; want %d", n, err, len(long))
+	}
+}
+
+func TestPartialTailLen(t *testing.T) {
+	tests := []struct {
+		data string
+		want int
+	}{
+		{"", 0},
+		{"{\"a\":1}\n", 0},
+		{"{\"a\":1}\n{\"b\":2}", 0},
+		{"{\"a\":1}\n{\"b\":", 5},
+		{"{\"b\":", 5},
+	}
+	for _, tt := range tests {
+		if got := partialTailLen([]byte(tt.data)); got != tt.want {
+			t.Errorf("partialTailLen(%q) = %d, want %d", tt.data, got, tt.want)
+		}
+	}
+}
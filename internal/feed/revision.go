@@ -0,0 +1,96 @@
+package feed
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+// RecordTypeRevision marks a feed line as an edit of an existing post.
+const RecordTypeRevision = "revision"
+
+// ErrNotAuthor is returned when someone other than the original author edits a post.
+var ErrNotAuthor = errors.New("only the original author can edit a post")
+
+// Revision replaces the content of an earlier post. Edits append a revision
+// instead of rewriting the original line, so the feed stays append-only.
+type Revision struct {
+	// Type is always RecordTypeRevision.
+	Type string `json:"type"`
+	// TargetID is the ID of the edited post.
+	TargetID string `json:"target_id"`
+	// ParentRevision is the revision this edit replaces: 0 for the original
+	// post, n for the nth edit.
+	ParentRevision int `json:"parent_revision"`
+	// Content is the new post text.
+	Content string `json:"content"`
+	// Author is the identity that made the edit.
+	Author string `json:"author"`
+	// EditedAt is the UTC timestamp of the edit, in RFC3339 format.
+	EditedAt string `json:"edited_at"`
+}
+
+// NewRevision creates an edit of the post with the given ID, applying the
+// same content rules as NewPost.
+func NewRevision(author, targetID, content string) (*Revision, error) {
+	content, err := sanitizeContent(content)
+	if err != nil {
+		return nil, err
+	}
+	if author == "" {
+		return nil, ErrEmptyAuthor
+	}
+	if !ValidateID(targetID) {
+		return nil, ErrInvalidID
+	}
+
+	return &Revision{
+		Type:     RecordTypeRevision,
+		TargetID: targetID,
+		Content:  content,
+		Author:   author,
+		EditedAt: time.Now().UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// Validate checks that the revision has a valid target, author, and content.
+func (r *Revision) Validate() error {
+	if !ValidateID(r.TargetID) {
+		return ErrInvalidID
+	}
+	if r.Author == "" {
+		return ErrEmptyAuthor
+	}
+	if r.Content == "" {
+		return ErrEmptyContent
+	}
+	if len(r.Content) > config.MaxPostLengthCeiling {
+		return ErrContentTooLong
+	}
+	if r.ParentRevision < 0 {
+		return errors.New("parent revision cannot be negative")
+	}
+	return nil
+}
+
+// applyRevisions replaces each post's content with its latest revision.
+// Revisions by anyone other than the post's author are ignored.
+func applyRevisions(posts []*Post, revisions []*Revision) {
+	if len(revisions) == 0 {
+		return
+	}
+	byID := make(map[string]*Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+	for _, rev := range revisions {
+		post, ok := byID[rev.TargetID]
+		if !ok || rev.Author != post.Author {
+			continue
+		}
+		post.Content = rev.Content
+		post.EditedAt = rev.EditedAt
+		post.Revision++
+	}
+}
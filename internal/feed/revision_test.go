@@ -0,0 +1,104 @@
+package feed
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRevision_Validation(t *testing.T) {
+	rev, err := NewRevision("ember", "smk-abc123", "  \x1b[31mfixed\x1b[0m  ")
+	require.NoError(t, err)
+	assert.Equal(t, "fixed", rev.Content, "content is sanitized like posts")
+	assert.Equal(t, RecordTypeRevision, rev.Type)
+	assert.NotEmpty(t, rev.EditedAt)
+
+	_, err = NewRevision("ember", "smk-abc123", strings.Repeat("x", MaxContentLength+1))
+	assert.ErrorIs(t, err, ErrContentTooLong)
+	_, err = NewRevision("ember", "smk-abc123", "   ")
+	assert.ErrorIs(t, err, ErrEmptyContent)
+	_, err = NewRevision("ember", "nope", "text")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = NewRevision("", "smk-abc123", "text")
+	assert.ErrorIs(t, err, ErrEmptyAuthor)
+}
+
+func TestStoreAppendRevision_MultipleEdits(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+	appendTestPost(t, store, "smk-abc123")
+	appendTestPost(t, store, "smk-def456")
+
+	for i, content := range []string{"first edit", "second edit", "third edit"} {
+		rev, err := NewRevision("ember", "smk-abc123", content)
+		require.NoError(t, err)
+		require.NoError(t, store.AppendRevision(rev))
+		assert.Equal(t, i, rev.ParentRevision, "each edit builds on the previous revision")
+	}
+
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 2, "revisions must not appear as posts")
+	assert.Equal(t, "third edit", posts[0].Content)
+	assert.Equal(t, 3, posts[0].Revision)
+	assert.NotEmpty(t, posts[0].EditedAt)
+	assert.Equal(t, "post smk-def456", posts[1].Content)
+	assert.Empty(t, posts[1].EditedAt)
+
+	data, err := os.ReadFile(feedPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"content":"post smk-abc123"`, "original line is kept")
+}
+
+func TestStoreAppendRevision_WrongAuthor(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-abc123")
+
+	rev, err := NewRevision("spark", "smk-abc123", "not mine")
+	require.NoError(t, err)
+	assert.ErrorIs(t, store.AppendRevision(rev), ErrNotAuthor)
+
+	post, err := store.FindByID("smk-abc123")
+	require.NoError(t, err)
+	assert.Equal(t, "post smk-abc123", post.Content)
+}
+
+func TestStoreAppendRevision_MissingOrDeleted(t *testing.T) {
+	store, _ := setupTestStore(t)
+	appendTestPost(t, store, "smk-abc123")
+	require.NoError(t, store.DeleteByID("smk-abc123"))
+
+	for _, id := range []string{"smk-abc123", "smk-zzz999"} {
+		rev, err := NewRevision("ember", id, "too late")
+		require.NoError(t, err)
+		assert.ErrorIs(t, store.AppendRevision(rev), ErrPostNotFound, id)
+	}
+}
+
+func TestApplyRevisions_IgnoresForeignAuthor(t *testing.T) {
+	post := &Post{ID: "smk-abc123", Author: "ember", Content: "original"}
+	applyRevisions([]*Post{post}, []*Revision{
+		{TargetID: "smk-abc123", Author: "spark", Content: "forged", EditedAt: "2026-01-01T00:00:00Z"},
+	})
+	assert.Equal(t, "original", post.Content)
+	assert.Empty(t, post.EditedAt)
+}
+
+func TestFormatPost_EditedTag(t *testing.T) {
+	m := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	m.width = 80
+	post := &Post{ID: "smk-abc123", Author: "ember", Content: "fixed", CreatedAt: "2026-01-01T00:00:00Z"}
+
+	assert.NotContains(t, strings.Join(m.formatPost(post), "\n"), "(edited)")
+
+	post.EditedAt = "2026-01-01T00:05:00Z"
+	lines := m.formatPost(post)
+	assert.Contains(t, lines[len(lines)-1], "(edited)")
+	for _, line := range lines {
+		assert.LessOrEqual(t, lipgloss.Width(line), 80)
+	}
+	assert.Contains(t, Model{}.plainPostLabel(post), "(edited)")
+}
@@ -0,0 +1,81 @@
+package feed
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SearchQuery specifies a text search over posts.
+type SearchQuery struct {
+	Text   string    // Substring (case-insensitive) or regular expression
+	Regex  bool      // Treat Text as a Go regular expression
+	Author string    // Only posts whose author contains this string, ignoring case
+	Since  time.Time // Only posts created at or after this time (zero = no bound)
+	Until  time.Time // Only posts created at or before this time (zero = no bound)
+}
+
+// SearchPosts returns posts whose content or author matches the query,
+// sorted newest first. Returns an error if the regular expression is invalid.
+func SearchPosts(posts []*Post, q SearchQuery) ([]*Post, error) {
+	match, err := q.matcher()
+	if err != nil {
+		return nil, err
+	}
+
+	author := strings.ToLower(q.Author)
+	results := make([]*Post, 0)
+	for _, post := range posts {
+		if author != "" && !strings.Contains(strings.ToLower(post.Author), author) {
+			continue
+		}
+		if !q.inWindow(post) {
+			continue
+		}
+		if match(post.Content) || match(post.Author) {
+			results = append(results, post)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		ti, _ := results[i].GetCreatedTime()
+		tj, _ := results[j].GetCreatedTime()
+		return ti.After(tj)
+	})
+	return results, nil
+}
+
+// matcher returns the match function for the query text.
+func (q SearchQuery) matcher() (func(string) bool, error) {
+	if q.Regex {
+		re, err := regexp.Compile(q.Text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+		return re.MatchString, nil
+	}
+	needle := strings.ToLower(q.Text)
+	return func(s string) bool {
+		return strings.Contains(strings.ToLower(s), needle)
+	}, nil
+}
+
+// inWindow reports whether the post falls within the query's time bounds.
+func (q SearchQuery) inWindow(post *Post) bool {
+	if q.Since.IsZero() && q.Until.IsZero() {
+		return true
+	}
+	created, err := post.GetCreatedTime()
+	if err != nil {
+		return false
+	}
+	if !q.Since.IsZero() && created.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && created.After(q.Until) {
+		return false
+	}
+	return true
+}
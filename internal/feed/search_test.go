@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchTestPosts() []*Post {
+	now := time.Now().UTC()
+	return []*Post{
+		{ID: "smk-aaaaaa", Author: "ember-fox@smoke", Content: "Found the retry bug", CreatedAt: now.Add(-3 * time.Hour).Format(time.RFC3339)},
+		{ID: "smk-bbbbbb", Author: "spark-owl@smoke", Content: "flaky test again", CreatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+		{ID: "smk-cccccc", Author: "wisp-cat@other", Content: "RETRY logic is fine", CreatedAt: now.Add(-1 * time.Hour).Format(time.RFC3339)},
+	}
+}
+
+func searchIDs(posts []*Post) []string {
+	ids := make([]string, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestSearchPosts_Substring(t *testing.T) {
+	results, err := SearchPosts(searchTestPosts(), SearchQuery{Text: "retry"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-cccccc", "smk-aaaaaa"}, searchIDs(results))
+}
+
+func TestSearchPosts_MatchesAuthor(t *testing.T) {
+	results, err := SearchPosts(searchTestPosts(), SearchQuery{Text: "owl"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-bbbbbb"}, searchIDs(results))
+}
+
+func TestSearchPosts_Regex(t *testing.T) {
+	results, err := SearchPosts(searchTestPosts(), SearchQuery{Text: "^(Found|flaky)", Regex: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-bbbbbb", "smk-aaaaaa"}, searchIDs(results))
+
+	_, err = SearchPosts(searchTestPosts(), SearchQuery{Text: "(unclosed", Regex: true})
+	assert.ErrorContains(t, err, "invalid regex")
+}
+
+func TestSearchPosts_AuthorAndWindow(t *testing.T) {
+	now := time.Now()
+
+	results, err := SearchPosts(searchTestPosts(), SearchQuery{Text: "retry", Author: "@smoke"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-aaaaaa"}, searchIDs(results))
+
+	results, err = SearchPosts(searchTestPosts(), SearchQuery{Text: "retry", Author: "Ember"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-aaaaaa"}, searchIDs(results))
+
+	results, err = SearchPosts(searchTestPosts(), SearchQuery{Text: "", Since: now.Add(-150 * time.Minute), Until: now.Add(-90 * time.Minute)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"smk-bbbbbb"}, searchIDs(results))
+}
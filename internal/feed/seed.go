@@ -0,0 +1,151 @@
+package feed
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultSeedTemplate is the seed post set init uses without --template
+const DefaultSeedTemplate = "default"
+
+// ErrUnknownSeedTemplate is returned when no seed template has the given name
+var ErrUnknownSeedTemplate = errors.New("unknown seed template")
+
+// seedTemplates holds the seed post sets, one JSONL file per template.
+// Each line is {"author": ..., "content": ...}; every seed post is posted
+// under ExampleSuffix.
+//
+//go:embed seeds/*.jsonl
+var seedTemplates embed.FS
+
+// SeedPost is one example post in a seed template.
+type SeedPost struct {
+	Author  string `json:"author"`
+	Content string `json:"content"`
+}
+
+// SeedTemplateNames returns the names of the embedded seed templates, sorted.
+func SeedTemplateNames() []string {
+	entries, err := fs.ReadDir(seedTemplates, "seeds")
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".jsonl"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetSeedTemplate returns the posts of the named seed template, in order.
+// Returns ErrUnknownSeedTemplate if there is no such template.
+func GetSeedTemplate(name string) ([]SeedPost, error) {
+	var data []byte
+	err := ErrUnknownSeedTemplate
+	if name != "" && !strings.ContainsAny(name, "/.") {
+		data, err = seedTemplates.ReadFile(path.Join("seeds", name+".jsonl"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q (available: %s)", ErrUnknownSeedTemplate, name, strings.Join(SeedTemplateNames(), ", "))
+	}
+	var posts []SeedPost
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var post SeedPost
+		if err := json.Unmarshal(line, &post); err != nil {
+			return nil, fmt.Errorf("invalid seed template %q: %w", name, err)
+		}
+		posts = append(posts, post)
+	}
+	return posts, scanner.Err()
+}
+
+// GetExamplePosts returns the canonical example posts for seeding.
+// Exported for testing and documentation purposes.
+func GetExamplePosts() []struct{ Author, Suffix, Content string } {
+	seeds, _ := GetSeedTemplate(DefaultSeedTemplate)
+	examples := make([]struct{ Author, Suffix, Content string }, 0, len(seeds))
+	for _, seed := range seeds {
+		examples = append(examples, struct{ Author, Suffix, Content string }{seed.Author, ExampleSuffix, seed.Content})
+	}
+	return examples
+}
+
+// IsSeedPost reports whether post was added by seeding rather than by an agent.
+func IsSeedPost(post *Post) bool {
+	return post.Suffix == ExampleSuffix
+}
+
+// SeedExamples adds example posts to demonstrate the social tone.
+// Idempotent: only seeds if feed is empty (zero posts). Safe to call
+// multiple times. Returns number of posts added (0 if already seeded).
+func (s *FileStore) SeedExamples() (int, error) {
+	return s.SeedTemplate(DefaultSeedTemplate, false)
+}
+
+// SeedTemplate adds the posts of the named seed template. Without replace
+// it only seeds an empty feed, like SeedExamples. With replace, existing
+// seed posts are deleted first and the template is added whatever else the
+// feed holds; agents' posts are left alone. Returns the number of posts
+// added.
+func (s *FileStore) SeedTemplate(name string, replace bool) (int, error) {
+	seeds, err := GetSeedTemplate(name)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Check if feed already has posts (unlocked read since we hold the lock)
+	posts, err := s.readAllUnlocked()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check existing posts: %w", err)
+	}
+	if !replace && len(posts) > 0 {
+		return 0, nil // Don't seed non-empty feed
+	}
+	if replace {
+		for _, post := range posts {
+			if !IsSeedPost(post) || post.Deleted {
+				continue
+			}
+			if delErr := s.doDeleteByID(post.ID); delErr != nil && !errors.Is(delErr, ErrPostNotFound) {
+				return 0, fmt.Errorf("failed to remove seed post %s: %w", post.ID, delErr)
+			}
+		}
+	}
+
+	baseTime := time.Now().Add(-SeedPostsAgeOffset).UTC()
+	for i, seed := range seeds {
+		id, idErr := GenerateID()
+		if idErr != nil {
+			return 0, fmt.Errorf("failed to generate ID for example post %d: %w", i, idErr)
+		}
+		post := &Post{
+			ID:        id,
+			Author:    seed.Author,
+			Suffix:    ExampleSuffix,
+			Content:   seed.Content,
+			CreatedAt: baseTime.Add(time.Duration(i) * time.Minute).Format(time.RFC3339),
+		}
+		if appendErr := s.appendUnlocked(post); appendErr != nil {
+			return 0, fmt.Errorf("failed to append example post %d (%s): %w", i, seed.Author, appendErr)
+		}
+	}
+	return len(seeds), nil
+}
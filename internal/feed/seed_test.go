@@ -0,0 +1,77 @@
+package feed
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSeedTemplateNames(t *testing.T) {
+	names := SeedTemplateNames()
+	for _, want := range []string{"default", "demo", "empty", "team"} {
+		found := false
+		for _, name := range names {
+			found = found || name == want
+		}
+		if !found {
+			t.Errorf("SeedTemplateNames() = %v, missing %q", names, want)
+		}
+	}
+	for _, name := range names {
+		if _, err := GetSeedTemplate(name); err != nil {
+			t.Errorf("GetSeedTemplate(%q) error: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "nope", "../seeds/default", "default.jsonl"} {
+		if _, err := GetSeedTemplate(name); !errors.Is(err, ErrUnknownSeedTemplate) {
+			t.Errorf("GetSeedTemplate(%q) error = %v, want ErrUnknownSeedTemplate", name, err)
+		}
+	}
+}
+
+func TestSeedTemplate(t *testing.T) {
+	store, _ := setupTestStore(t)
+
+	if n, err := store.SeedTemplate("empty", false); err != nil || n != 0 {
+		t.Fatalf("SeedTemplate(empty) = %d, %v; want 0, nil", n, err)
+	}
+	n, err := store.SeedTemplate("team", false)
+	if err != nil || n != 4 {
+		t.Fatalf("SeedTemplate(team) = %d, %v; want 4, nil", n, err)
+	}
+	// Without replace, a non-empty feed is left alone
+	if n, err := store.SeedTemplate("demo", false); err != nil || n != 0 {
+		t.Fatalf("SeedTemplate(demo) on seeded feed = %d, %v; want 0, nil", n, err)
+	}
+
+	post, err := NewPost("agent", "proj", "swift-fox", "keep me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Append(post); err != nil {
+		t.Fatal(err)
+	}
+	demo, _ := GetSeedTemplate("demo")
+	if n, err := store.SeedTemplate("demo", true); err != nil || n != len(demo) {
+		t.Fatalf("SeedTemplate(demo, replace) = %d, %v; want %d, nil", n, err, len(demo))
+	}
+
+	posts, err := store.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != len(demo)+1 {
+		t.Fatalf("got %d posts, want %d demo seeds and the agent's post", len(posts), len(demo))
+	}
+	for _, p := range posts {
+		if p.Author == "forge" {
+			t.Errorf("team seed post %s survived the replace", p.ID)
+		}
+	}
+	if posts[0].ID != post.ID {
+		t.Errorf("expected the agent's post to be kept, got %s first", posts[0].Author)
+	}
+
+	if _, err := store.SeedTemplate("nope", true); !errors.Is(err, ErrUnknownSeedTemplate) {
+		t.Errorf("SeedTemplate(nope) error = %v, want ErrUnknownSeedTemplate", err)
+	}
+}
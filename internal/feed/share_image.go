@@ -3,9 +3,12 @@ package feed
 
 import (
 	"bytes"
+	"fmt"
 	"image/color"
 	"image/png"
 	"math"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/fogleman/gg"
@@ -27,7 +30,7 @@ var (
 
 // renderCardBackground draws the card background and window controls.
 func renderCardBackground(dc *gg.Context, theme *Theme, dims ImageDimensions) (padding, innerPadding, cardWidth float64) {
-	bgColor := hexToColor(theme.Background.Dark)
+	bgColor := hexToColor(adaptiveHex(theme.Background))
 	dc.SetColor(bgColor)
 	dc.Clear()
 
@@ -36,7 +39,7 @@ func renderCardBackground(dc *gg.Context, theme *Theme, dims ImageDimensions) (p
 	cardHeight := float64(dims.Height) - padding*2
 	cornerRadius := 20.0
 
-	dc.SetColor(hexToColor(theme.BackgroundSecondary.Dark))
+	dc.SetColor(hexToColor(adaptiveHex(theme.BackgroundSecondary)))
 	drawRoundedRect(dc, roundedRect{padding, padding, cardWidth, cardHeight, cornerRadius})
 	dc.Fill()
 
@@ -77,7 +80,7 @@ func renderCardHandle(dc *gg.Context, post *Post, theme *Theme, layout cardLayou
 	}
 
 	agent, project := SplitIdentity(handle)
-	projectColor := hexToColor(theme.TextMuted.Dark)
+	projectColor := hexToColor(adaptiveHex(theme.TextMuted))
 
 	dc.SetColor(agentColorForTheme(agent, theme))
 	dc.DrawString(agent, layout.innerPadding, handleY)
@@ -111,7 +114,7 @@ type contentLayout struct {
 
 // renderCardContent draws the post content with auto-sizing font.
 func renderCardContent(dc *gg.Context, post *Post, theme *Theme, cl contentLayout) {
-	dc.SetColor(hexToColor(theme.Text.Dark))
+	dc.SetColor(hexToColor(adaptiveHex(theme.Text)))
 	contentFontSize := cl.fontSize * 1.5
 	minFontSize := cl.fontSize * 0.8
 	maxWidth := cl.cardWidth - 80
@@ -145,36 +148,86 @@ func renderCardContent(dc *gg.Context, post *Post, theme *Theme, cl contentLayou
 	}
 }
 
-// RenderShareCard renders a post as a shareable PNG image.
-// Uses theme colors for Carbon-style terminal aesthetic.
-func RenderShareCard(post *Post, theme *Theme, dims ImageDimensions) ([]byte, error) {
-	dc := gg.NewContext(dims.Width, dims.Height)
+// ImageFormats lists the share card sizes by name, for command-line flags.
+var ImageFormats = []string{SquareImage.Name, LandscapeImage.Name}
 
-	_, innerPadding, cardWidth := renderCardBackground(dc, theme, dims)
+// ImageDimensionsByName returns the share card size called name.
+func ImageDimensionsByName(name string) (ImageDimensions, bool) {
+	for _, dims := range []ImageDimensions{SquareImage, LandscapeImage} {
+		if dims.Name == name {
+			return dims, true
+		}
+	}
+	return ImageDimensions{}, false
+}
 
-	dotY := innerPadding + 10
-	fontSize := float64(dims.Width) * 0.025
-	handleY := renderCardHandle(dc, post, theme, cardLayout{innerPadding, dotY, fontSize})
+// WriteShareCard renders post as a share card and writes the PNG to path,
+// creating its directory if needed.
+func WriteShareCard(path string, post *Post, theme *Theme, dims ImageDimensions) error {
+	data, err := RenderShareCard(post, theme, dims)
+	if err != nil {
+		return fmt.Errorf("failed to render card: %w", err)
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", dir, err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
 
-	contentY := handleY + fontSize*2
-	footerFontSize := fontSize * 0.8
+// cardFrame is the drawing area shared by share cards: everything between
+// the window controls and the footer.
+type cardFrame struct {
+	innerPadding float64
+	cardWidth    float64
+	dotY         float64
+	fontSize     float64
+	contentMaxY  float64
+	footerY      float64
+}
+
+// newCardFrame draws the card background and measures the area posts go in.
+func newCardFrame(dc *gg.Context, theme *Theme, dims ImageDimensions) cardFrame {
+	_, innerPadding, cardWidth := renderCardBackground(dc, theme, dims)
+	fontSize := float64(dims.Width) * 0.025
 	footerY := float64(dims.Height) - innerPadding
-	contentMaxY := footerY - (footerFontSize * 1.6)
-	if contentMaxY < contentY {
-		contentMaxY = contentY
+	return cardFrame{
+		innerPadding: innerPadding,
+		cardWidth:    cardWidth,
+		dotY:         innerPadding + 10,
+		fontSize:     fontSize,
+		contentMaxY:  footerY - (fontSize * 0.8 * 1.6),
+		footerY:      footerY,
 	}
+}
 
+// renderCardPost draws a post's handle below topY and its content under it,
+// shrinking the content to end above maxY. indent shifts the post right.
+func renderCardPost(dc *gg.Context, post *Post, theme *Theme, frame cardFrame, topY, maxY, indent float64) {
+	handleY := renderCardHandle(dc, post, theme, cardLayout{frame.innerPadding + indent, topY, frame.fontSize})
+
+	contentY := handleY + frame.fontSize*2
+	if maxY < contentY {
+		maxY = contentY
+	}
 	renderCardContent(dc, post, theme, contentLayout{
-		innerPadding:    innerPadding,
+		innerPadding:    frame.innerPadding + indent,
 		contentY:        contentY,
-		cardWidth:       cardWidth,
-		availableHeight: contentMaxY - contentY,
-		fontSize:        fontSize,
+		cardWidth:       frame.cardWidth - indent,
+		availableHeight: maxY - contentY,
+		fontSize:        frame.fontSize,
 	})
+}
 
-	dc.SetColor(hexToColor(theme.Accent.Dark))
-	loadMonoFont(dc, footerFontSize)
-	dc.DrawString(ShareFooter, innerPadding, footerY)
+// finishCard draws the footer and encodes the card as PNG.
+func finishCard(dc *gg.Context, theme *Theme, frame cardFrame) ([]byte, error) {
+	dc.SetColor(hexToColor(adaptiveHex(theme.Accent)))
+	loadMonoFont(dc, frame.fontSize*0.8)
+	dc.DrawString(ShareFooter, frame.innerPadding, frame.footerY)
 
 	var buf bytes.Buffer
 	if err := png.Encode(&buf, dc.Image()); err != nil {
@@ -184,13 +237,45 @@ func RenderShareCard(post *Post, theme *Theme, dims ImageDimensions) ([]byte, er
 	return buf.Bytes(), nil
 }
 
+// RenderShareCard renders a post as a shareable PNG image.
+// Uses theme colors for Carbon-style terminal aesthetic.
+func RenderShareCard(post *Post, theme *Theme, dims ImageDimensions) ([]byte, error) {
+	dc := gg.NewContext(dims.Width, dims.Height)
+	frame := newCardFrame(dc, theme, dims)
+	renderCardPost(dc, post, theme, frame, frame.dotY, frame.contentMaxY, 0)
+	return finishCard(dc, theme, frame)
+}
+
+// RenderThreadCard renders a reply stacked under its parent post as one
+// shareable PNG image. The parent takes the top half of the card and the
+// reply the bottom half, indented under a connector line.
+func RenderThreadCard(parent, reply *Post, theme *Theme, dims ImageDimensions) ([]byte, error) {
+	dc := gg.NewContext(dims.Width, dims.Height)
+	frame := newCardFrame(dc, theme, dims)
+
+	midY := frame.dotY + (frame.contentMaxY-frame.dotY)/2
+	renderCardPost(dc, parent, theme, frame, frame.dotY, midY-frame.fontSize, 0)
+
+	// └─ from under the parent's handle to the reply's handle
+	indent := frame.fontSize * 2
+	lineX := frame.innerPadding + frame.fontSize*0.5
+	replyHandleY := midY + 50
+	dc.SetColor(hexToColor(adaptiveHex(theme.TextMuted)))
+	dc.SetLineWidth(3)
+	dc.DrawLine(lineX, midY-frame.fontSize*0.5, lineX, replyHandleY-frame.fontSize*0.35)
+	dc.LineTo(frame.innerPadding+indent-10, replyHandleY-frame.fontSize*0.35)
+	dc.Stroke()
+
+	renderCardPost(dc, reply, theme, frame, midY, frame.contentMaxY, indent)
+	return finishCard(dc, theme, frame)
+}
+
 // agentColorForTheme returns the agent name color based on theme palette.
 func agentColorForTheme(agent string, theme *Theme) color.Color {
 	if theme == nil || len(theme.AgentColors) == 0 {
 		return color.Black
 	}
-	idx := hashString(agent) % len(theme.AgentColors)
-	return hexToColor(string(theme.AgentColors[idx]))
+	return hexToColor(string(AgentColor(agent, theme)))
 }
 
 // hexToColor converts a hex color string to color.Color
@@ -3,6 +3,8 @@ package feed
 import (
 	"bytes"
 	"image/png"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -111,6 +113,61 @@ func TestRenderShareCard(t *testing.T) {
 	})
 }
 
+func TestRenderThreadCard(t *testing.T) {
+	parent, _ := NewPost("test-author", "test-project", "test-suffix", "Anyone seen the retry storm?")
+	reply, _ := NewReply("other-author", "test-project", "test-suffix", "Yes, backoff was off by a factor of ten.", parent.ID)
+	theme := GetTheme("dracula")
+
+	for _, dims := range []ImageDimensions{SquareImage, LandscapeImage} {
+		t.Run(dims.Name, func(t *testing.T) {
+			data, err := RenderThreadCard(parent, reply, theme, dims)
+			if err != nil {
+				t.Fatalf("RenderThreadCard failed: %v", err)
+			}
+			if len(data) == 0 {
+				t.Fatal("RenderThreadCard returned empty data")
+			}
+
+			img, err := png.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("Output is not valid PNG: %v", err)
+			}
+			bounds := img.Bounds()
+			if bounds.Dx() != dims.Width || bounds.Dy() != dims.Height {
+				t.Errorf("Thread image dimensions = %dx%d, want %dx%d",
+					bounds.Dx(), bounds.Dy(), dims.Width, dims.Height)
+			}
+		})
+	}
+}
+
+func TestWriteShareCard(t *testing.T) {
+	post, _ := NewPost("test-author", "test-project", "test-suffix", "Hello world!")
+	path := filepath.Join(t.TempDir(), "cards", "out.png")
+
+	if err := WriteShareCard(path, post, GetTheme("dracula"), LandscapeImage); err != nil {
+		t.Fatalf("WriteShareCard failed: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Output is not valid PNG: %v", err)
+	}
+	if img.Bounds().Dx() != LandscapeImage.Width || img.Bounds().Dy() != LandscapeImage.Height {
+		t.Errorf("card is %dx%d, want landscape", img.Bounds().Dx(), img.Bounds().Dy())
+	}
+
+	if dims, ok := ImageDimensionsByName("square"); !ok || dims != SquareImage {
+		t.Errorf("ImageDimensionsByName(square) = %v, %v", dims, ok)
+	}
+	if _, ok := ImageDimensionsByName("poster"); ok {
+		t.Error("unknown formats should not resolve")
+	}
+}
+
 func TestHexToColor(t *testing.T) {
 	tests := []struct {
 		hex     string
@@ -0,0 +1,100 @@
+package feed
+
+import (
+	"sort"
+	"time"
+	"unicode/utf8"
+)
+
+// Stats holds aggregate counts for a set of posts.
+type Stats struct {
+	Posts    int `json:"posts"`
+	Agents   int `json:"agents"`
+	Projects int `json:"projects"`
+}
+
+// DayCount is the number of posts created on a calendar day (local time).
+type DayCount struct {
+	Day   string `json:"day"` // YYYY-MM-DD
+	Posts int    `json:"posts"`
+}
+
+// DetailedStats extends Stats with activity and authorship data.
+type DetailedStats struct {
+	Stats
+	PostsPerDay       []DayCount `json:"posts_per_day"`
+	TopAuthor         string     `json:"top_author,omitempty"`
+	TopAuthorPosts    int        `json:"top_author_posts,omitempty"`
+	AverageLength     float64    `json:"average_length"`
+	PostsWithoutTimes int        `json:"posts_without_times,omitempty"`
+}
+
+// ComputeStats counts posts, unique authors, and unique projects.
+// Nil posts are skipped.
+func ComputeStats(posts []*Post) Stats {
+	authors := make(map[string]bool)
+	projects := make(map[string]bool)
+	var stats Stats
+	for _, post := range posts {
+		if post == nil {
+			continue
+		}
+		stats.Posts++
+		if post.Author != "" {
+			authors[post.Author] = true
+		}
+		if post.Project != "" {
+			projects[post.Project] = true
+		}
+	}
+	stats.Agents = len(authors)
+	stats.Projects = len(projects)
+	return stats
+}
+
+// ComputeDetailedStats computes Stats plus a per-day histogram (oldest day first),
+// the most active author, and the average content length in characters.
+// Ties for most active author go to the alphabetically first name.
+func ComputeDetailedStats(posts []*Post) DetailedStats {
+	stats := DetailedStats{
+		Stats:       ComputeStats(posts),
+		PostsPerDay: []DayCount{},
+	}
+
+	perDay := make(map[string]int)
+	perAuthor := make(map[string]int)
+	totalLength := 0
+	for _, post := range posts {
+		if post == nil {
+			continue
+		}
+		totalLength += utf8.RuneCountInString(post.Content)
+		perAuthor[post.Author]++
+
+		created, err := post.GetCreatedTime()
+		if err != nil {
+			stats.PostsWithoutTimes++
+			continue
+		}
+		perDay[created.Local().Format(time.DateOnly)]++
+	}
+
+	if stats.Posts > 0 {
+		stats.AverageLength = float64(totalLength) / float64(stats.Posts)
+	}
+
+	for day, count := range perDay {
+		stats.PostsPerDay = append(stats.PostsPerDay, DayCount{Day: day, Posts: count})
+	}
+	sort.Slice(stats.PostsPerDay, func(i, j int) bool {
+		return stats.PostsPerDay[i].Day < stats.PostsPerDay[j].Day
+	})
+
+	for author, count := range perAuthor {
+		if count > stats.TopAuthorPosts || (count == stats.TopAuthorPosts && author < stats.TopAuthor) {
+			stats.TopAuthor = author
+			stats.TopAuthorPosts = count
+		}
+	}
+	return stats
+}
@@ -0,0 +1,80 @@
+package feed
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeStats_Empty(t *testing.T) {
+	stats := ComputeStats(nil)
+	if stats != (Stats{}) {
+		t.Errorf("ComputeStats(nil) = %+v, want zero", stats)
+	}
+
+	detailed := ComputeDetailedStats([]*Post{})
+	if detailed.Posts != 0 || detailed.TopAuthor != "" || detailed.AverageLength != 0 {
+		t.Errorf("ComputeDetailedStats(empty) = %+v, want zero", detailed)
+	}
+	if detailed.PostsPerDay == nil || len(detailed.PostsPerDay) != 0 {
+		t.Errorf("PostsPerDay should be an empty slice, got %#v", detailed.PostsPerDay)
+	}
+}
+
+func TestComputeStats_SkipsNilPosts(t *testing.T) {
+	posts := []*Post{
+		nil,
+		{Author: "ember@smoke", Project: "smoke", Content: "hi"},
+		nil,
+	}
+	stats := ComputeDetailedStats(posts)
+	if stats.Posts != 1 || stats.Agents != 1 || stats.Projects != 1 {
+		t.Errorf("ComputeDetailedStats() = %+v, want 1/1/1", stats.Stats)
+	}
+	if stats.PostsWithoutTimes != 1 {
+		t.Errorf("PostsWithoutTimes = %d, want 1", stats.PostsWithoutTimes)
+	}
+}
+
+func TestComputeDetailedStats(t *testing.T) {
+	day1 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	day2 := day1.AddDate(0, 0, 1)
+	post := func(author, project, content string, at time.Time) *Post {
+		return &Post{Author: author, Project: project, Content: content, CreatedAt: at.UTC().Format(time.RFC3339)}
+	}
+	posts := []*Post{
+		post("spark@api", "api", "abcd", day2),
+		post("ember@smoke", "smoke", "ab", day1),
+		post("ember@smoke", "smoke", "abcdef", day2.Add(time.Hour)),
+	}
+
+	stats := ComputeDetailedStats(posts)
+
+	if stats.Posts != 3 || stats.Agents != 2 || stats.Projects != 2 {
+		t.Errorf("counts = %+v, want 3/2/2", stats.Stats)
+	}
+	if stats.TopAuthor != "ember@smoke" || stats.TopAuthorPosts != 2 {
+		t.Errorf("top author = %s (%d), want ember@smoke (2)", stats.TopAuthor, stats.TopAuthorPosts)
+	}
+	if stats.AverageLength != 4 {
+		t.Errorf("AverageLength = %v, want 4", stats.AverageLength)
+	}
+	want := []DayCount{{Day: "2026-03-01", Posts: 1}, {Day: "2026-03-02", Posts: 2}}
+	if len(stats.PostsPerDay) != len(want) {
+		t.Fatalf("PostsPerDay = %+v, want %+v", stats.PostsPerDay, want)
+	}
+	for i := range want {
+		if stats.PostsPerDay[i] != want[i] {
+			t.Errorf("PostsPerDay[%d] = %+v, want %+v", i, stats.PostsPerDay[i], want[i])
+		}
+	}
+}
+
+func TestComputeDetailedStats_TopAuthorTie(t *testing.T) {
+	posts := []*Post{
+		{Author: "zed", Content: "x"},
+		{Author: "amy", Content: "x"},
+	}
+	if got := ComputeDetailedStats(posts).TopAuthor; got != "amy" {
+		t.Errorf("TopAuthor = %s, want amy (alphabetical tie-break)", got)
+	}
+}
@@ -2,14 +2,13 @@ package feed
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
 	"sort"
 	"sync"
-	"syscall"
 	"time"
 
 	"github.com/dreamiurg/smoke/internal/logging"
@@ -34,124 +33,330 @@ const (
 // ErrNotInitialized is returned when the feed file doesn't exist
 var ErrNotInitialized = errors.New("feed not initialized")
 
-// Store handles reading and writing posts to the feed file
-type Store struct {
+// Store is a feed storage backend. Code that only posts, reads, and deletes
+// should depend on Store; features FileStore adds on top (reactions, edits,
+// compaction) are used through *FileStore or a type assertion.
+type Store interface {
+	// Append adds a post to the feed.
+	Append(post *Post) error
+	// ReadAll returns every post in append order, with edits, deletions,
+	// and reactions applied.
+	ReadAll() ([]*Post, error)
+	// DeleteByID deletes a post, keeping a placeholder if it has replies.
+	DeleteByID(id string) error
+}
+
+// FileStore handles reading and writing posts to the JSONL feed file
+type FileStore struct {
 	path string
 	mu   sync.Mutex
 }
 
-// NewStoreWithPath creates a new store at the specified path
-func NewStoreWithPath(path string) *Store {
-	return &Store{path: path}
+// NewStoreWithPath creates a new file store at the specified path
+func NewStoreWithPath(path string) *FileStore {
+	return &FileStore{path: path}
 }
 
 // Append adds a post to the feed file
-func (s *Store) Append(post *Post) error {
+func (s *FileStore) Append(post *Post) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	return s.doAppend(post)
 }
 
 // doAppend performs the actual append operation with cross-process file locking
-func (s *Store) doAppend(post *Post) error {
+func (s *FileStore) doAppend(post *Post) error {
 	// Validate post
 	if err := post.Validate(); err != nil {
 		return err
 	}
 
+	data, err := json.Marshal(post)
+	if err != nil {
+		return fmt.Errorf("failed to encode post: %w", err)
+	}
+	return s.appendLine(data, "post")
+}
+
+// AppendReaction adds a reaction to the feed file.
+// Returns ErrPostNotFound if the target post does not exist.
+func (s *FileStore) AppendReaction(reaction *Reaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := reaction.Validate(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(reaction)
+	if err != nil {
+		return fmt.Errorf("failed to encode reaction: %w", err)
+	}
+
+	return s.withWriteLock(func(f *os.File) error {
+		posts, err := s.readAllUnlocked()
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, post := range posts {
+			if post.ID == reaction.TargetID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ErrPostNotFound
+		}
+		return writeLine(f, data, "reaction")
+	})
+}
+
+// AppendRevision adds an edit of an existing post to the feed file and sets
+// its ParentRevision to the post's current revision.
+// Returns ErrPostNotFound if the target post does not exist or was deleted,
+// and ErrNotAuthor if the revision's author did not write the post.
+func (s *FileStore) AppendRevision(rev *Revision) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := rev.Validate(); err != nil {
+		return err
+	}
+
+	return s.withWriteLock(func(f *os.File) error {
+		posts, err := s.readAllUnlocked()
+		if err != nil {
+			return err
+		}
+		var target *Post
+		for _, post := range posts {
+			if post.ID == rev.TargetID && !post.Deleted {
+				target = post
+				break
+			}
+		}
+		if target == nil {
+			return ErrPostNotFound
+		}
+		if target.Author != rev.Author {
+			return ErrNotAuthor
+		}
+		rev.ParentRevision = target.Revision
+
+		data, err := json.Marshal(rev)
+		if err != nil {
+			return fmt.Errorf("failed to encode revision: %w", err)
+		}
+		return writeLine(f, data, "revision")
+	})
+}
+
+// appendLine writes one encoded record to the feed file under an exclusive lock.
+// kind names the record in write errors.
+func (s *FileStore) appendLine(data []byte, kind string) error {
+	return s.withWriteLock(func(f *os.File) error {
+		return writeLine(f, data, kind)
+	})
+}
+
+// withWriteLock opens the feed file for appending and runs fn while holding
+// the exclusive cross-process lock, so checks fn makes against the current
+// feed still hold when it writes.
+func (s *FileStore) withWriteLock(fn func(f *os.File) error) error {
 	// Check if feed file exists
 	if _, err := os.Stat(s.path); os.IsNotExist(err) {
 		return ErrNotInitialized
 	}
 
-	// Open file for appending
-	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0600)
+	// Open file for appending (readable so writeLine can check the last byte)
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_RDWR, 0600)
 	if err != nil {
 		return fmt.Errorf("failed to open feed file: %w", err)
 	}
-	defer func() {
-		_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
-		_ = f.Close()
-	}()
+	defer func() { _ = f.Close() }()
 
 	// Acquire exclusive lock for cross-process safety
-	if lockErr := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); lockErr != nil {
-		return fmt.Errorf("failed to acquire file lock: %w", lockErr)
+	if err := lockFile(f, true); err != nil {
+		return err
 	}
+	defer func() { _ = unlockFile(f) }()
 
-	// Encode and write
-	data, err := json.Marshal(post)
-	if err != nil {
-		return fmt.Errorf("failed to encode post: %w", err)
-	}
+	return fn(f)
+}
 
-	if _, err := f.Write(append(data, '\n')); err != nil {
-		return fmt.Errorf("failed to write post: %w", err)
+// writeLine appends data and a newline to f in a single write, so a
+// concurrent reader never sees half a record. If an earlier write was cut
+// off, the record starts on a fresh line instead of extending the broken
+// one. The write is synced to disk unless SMOKE_FEED_FSYNC=0.
+func writeLine(f *os.File, data []byte, kind string) error {
+	line := make([]byte, 0, len(data)+2)
+	if !endsWithNewline(f) {
+		line = append(line, '\n')
+	}
+	line = append(line, data...)
+	line = append(line, '\n')
+	if _, err := f.Write(line); err != nil {
+		return fmt.Errorf("failed to write %s: %w", kind, err)
 	}
 
+	if !syncWrites() {
+		return nil
+	}
 	// Sync to disk for durability
 	if err := f.Sync(); err != nil {
 		return fmt.Errorf("failed to sync feed file: %w", err)
 	}
-
 	return nil
 }
 
-// ReadAll reads all posts from the feed file
-func (s *Store) ReadAll() ([]*Post, error) {
+// endsWithNewline reports whether f is empty or ends with a newline.
+func endsWithNewline(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil || info.Size() == 0 {
+		return true
+	}
+	last := make([]byte, 1)
+	if _, err := f.ReadAt(last, info.Size()-1); err != nil {
+		return true
+	}
+	return last[0] == '\n'
+}
+
+// ReadAll reads all posts from the feed file, with edits collapsed to the
+// latest revision and reactions aggregated into each post's Reactions field.
+func (s *FileStore) ReadAll() ([]*Post, error) {
 	return s.doReadAll()
 }
 
-// doReadAll performs the actual read operation
-func (s *Store) doReadAll() ([]*Post, error) {
-	// Check if feed file exists
-	if _, err := os.Stat(s.path); os.IsNotExist(err) {
+// doReadAll performs the actual read operation. A partial record at the
+// end of the file is skipped and logged; the CLI warns about it once per
+// command (see PartialTail).
+func (s *FileStore) doReadAll() ([]*Post, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
 		return nil, ErrNotInitialized
 	}
-
-	f, err := os.Open(s.path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open feed file: %w", err)
 	}
-	defer func() { _ = f.Close() }()
 
-	var posts []*Post
-	scanner := bufio.NewScanner(f)
+	var records feedRecords
+	scanner := bufio.NewScanner(bytes.NewReader(data))
 
 	lineNum := 0
 	for scanner.Scan() {
 		lineNum++
-		line := scanner.Text()
-		if line == "" {
+		line := scanner.Bytes()
+		if len(line) == 0 {
 			continue
 		}
-
-		var post Post
-		if err := json.Unmarshal([]byte(line), &post); err != nil {
-			// Skip invalid lines with warning (per spec: skip invalid, warn, continue)
-			logging.LogWarn("skipping invalid line", "line", lineNum, "error", err)
-			continue
-		}
-
-		// Validate post after unmarshal
-		if err := post.Validate(); err != nil {
-			logging.LogWarn("skipping invalid post", "line", lineNum, "error", err)
-			continue
+		if record, ok := parseFeedLine(line, lineNum); ok {
+			records.add(record)
 		}
-
-		posts = append(posts, &post)
 	}
 
 	if err := scanner.Err(); err != nil {
 		return nil, fmt.Errorf("error reading feed file: %w", err)
 	}
+	if n := partialTailLen(data); n > 0 {
+		logging.LogWarn("feed ends with a partial record", "path", s.path, "bytes", n)
+	}
 
-	return posts, nil
+	return records.resolve(), nil
+}
+
+// feedRecords collects decoded feed lines by kind, in file order.
+type feedRecords struct {
+	posts      []*Post
+	reactions  []*Reaction
+	tombstones []*Tombstone
+	revisions  []*Revision
+}
+
+// add files record under its kind.
+func (r *feedRecords) add(record feedRecord) {
+	switch {
+	case record.reaction != nil:
+		r.reactions = append(r.reactions, record.reaction)
+	case record.tombstone != nil:
+		r.tombstones = append(r.tombstones, record.tombstone)
+	case record.revision != nil:
+		r.revisions = append(r.revisions, record.revision)
+	default:
+		r.posts = append(r.posts, record.post)
+	}
+}
+
+// resolve applies edits, deletions, and reactions to the posts, modifying
+// them in place, and returns the posts readers see.
+func (r *feedRecords) resolve() []*Post {
+	posts := r.posts
+	applyRevisions(posts, r.revisions)
+	posts = applyTombstones(posts, r.tombstones)
+	applyReactions(posts, r.reactions)
+	return posts
+}
+
+// feedRecord is one decoded feed line. Exactly one field is set.
+type feedRecord struct {
+	post      *Post
+	reaction  *Reaction
+	tombstone *Tombstone
+	revision  *Revision
+}
+
+// parseFeedLine decodes one feed line as a post, reaction, tombstone, or revision.
+// Invalid lines are logged and reported with ok=false (per spec: skip invalid, warn, continue).
+func parseFeedLine(line []byte, lineNum int) (feedRecord, bool) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(line, &header); err != nil {
+		logging.LogWarn("skipping invalid line", "line", lineNum, "error", err)
+		return feedRecord{}, false
+	}
+
+	var record feedRecord
+	var target interface{ Validate() error }
+	switch header.Type {
+	case "":
+		record.post = &Post{}
+		target = record.post
+	case RecordTypeReaction:
+		record.reaction = &Reaction{}
+		target = record.reaction
+	case RecordTypeTombstone:
+		record.tombstone = &Tombstone{}
+		target = record.tombstone
+	case RecordTypeRevision:
+		record.revision = &Revision{}
+		target = record.revision
+	default:
+		logging.LogWarn("skipping unknown record type", "line", lineNum, "type", header.Type)
+		return feedRecord{}, false
+	}
+
+	if err := json.Unmarshal(line, target); err != nil {
+		logging.LogWarn("skipping invalid line", "line", lineNum, "error", err)
+		return feedRecord{}, false
+	}
+	// Validate record after unmarshal
+	if err := target.Validate(); err != nil {
+		logging.LogWarn("skipping invalid record", "line", lineNum, "type", header.Type, "error", err)
+		return feedRecord{}, false
+	}
+	return record, true
 }
 
 // ReadRecent reads the most recent N posts
-func (s *Store) ReadRecent(limit int) ([]*Post, error) {
-	posts, err := s.ReadAll()
+func (s *FileStore) ReadRecent(limit int) ([]*Post, error) {
+	return RecentPosts(s, limit)
+}
+
+// RecentPosts reads posts from store, newest first, keeping at most limit
+// posts (0 = no limit).
+func RecentPosts(store Store, limit int) ([]*Post, error) {
+	posts, err := store.ReadAll()
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +380,7 @@ func (s *Store) ReadRecent(limit int) ([]*Post, error) {
 }
 
 // FindByID finds a post by its ID
-func (s *Store) FindByID(id string) (*Post, error) {
+func (s *FileStore) FindByID(id string) (*Post, error) {
 	posts, err := s.ReadAll()
 	if err != nil {
 		return nil, err
@@ -191,7 +396,7 @@ func (s *Store) FindByID(id string) (*Post, error) {
 }
 
 // Exists checks if a post with the given ID exists
-func (s *Store) Exists(id string) (bool, error) {
+func (s *FileStore) Exists(id string) (bool, error) {
 	_, err := s.FindByID(id)
 	if errors.Is(err, ErrPostNotFound) {
 		return false, nil
@@ -203,7 +408,7 @@ func (s *Store) Exists(id string) (bool, error) {
 }
 
 // Count returns the total number of posts
-func (s *Store) Count() (int, error) {
+func (s *FileStore) Count() (int, error) {
 	posts, err := s.ReadAll()
 	if err != nil {
 		return 0, err
@@ -211,211 +416,56 @@ func (s *Store) Count() (int, error) {
 	return len(posts), nil
 }
 
-// DeleteByID removes a post with the given ID from the feed file.
-func (s *Store) DeleteByID(id string) error {
+// DeleteByID deletes the post with the given ID by appending a tombstone.
+// The feed stays append-only; ReadAll hides tombstoned posts, keeping a
+// "[deleted]" placeholder when the post still has replies.
+func (s *FileStore) DeleteByID(id string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	return s.doDeleteByID(id)
 }
 
-// readPostsExcluding reads all posts from f, skipping the post with the given ID.
-// Returns the remaining posts and whether the ID was found.
-func readPostsExcluding(f *os.File, id string) ([]*Post, bool, error) {
-	if _, err := f.Seek(0, 0); err != nil {
-		return nil, false, fmt.Errorf("failed to seek feed file: %w", err)
-	}
-
-	var posts []*Post
-	scanner := bufio.NewScanner(f)
-	lineNum := 0
-	found := false
-	for scanner.Scan() {
-		lineNum++
-		line := scanner.Text()
-		if line == "" {
-			continue
-		}
-		var post Post
-		if err := json.Unmarshal([]byte(line), &post); err != nil {
-			logging.LogWarn("skipping invalid line", "line", lineNum, "error", err)
-			continue
-		}
-		if err := post.Validate(); err != nil {
-			logging.LogWarn("skipping invalid post", "line", lineNum, "error", err)
-			continue
-		}
-		if post.ID == id {
-			found = true
-			continue
-		}
-		posts = append(posts, &post)
-	}
-	if err := scanner.Err(); err != nil {
-		return nil, false, fmt.Errorf("error reading feed file: %w", err)
+// doDeleteByID checks that a live post exists and appends its tombstone,
+// holding the file lock across both so a concurrent delete cannot slip in.
+func (s *FileStore) doDeleteByID(id string) error {
+	if !ValidateID(id) {
+		return ErrInvalidID
 	}
-	return posts, found, nil
-}
-
-// writePostsToTemp writes posts to a new temp file in dir, preserving permissions from src.
-func writePostsToTemp(dir string, src *os.File, posts []*Post) (string, error) {
-	tmpFile, err := os.CreateTemp(dir, ".smoke-feed-")
+	data, err := json.Marshal(NewTombstone(id))
 	if err != nil {
-		return "", fmt.Errorf("failed to create temp file: %w", err)
-	}
-	tmpPath := tmpFile.Name()
-	cleanupTemp := func() {
-		_ = tmpFile.Close()
-		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to encode tombstone: %w", err)
 	}
 
-	if info, statErr := src.Stat(); statErr == nil {
-		if chmodErr := tmpFile.Chmod(info.Mode()); chmodErr != nil {
-			cleanupTemp()
-			return "", fmt.Errorf("failed to set temp file permissions: %w", chmodErr)
+	return s.withWriteLock(func(f *os.File) error {
+		posts, err := s.readAllUnlocked()
+		if err != nil {
+			return err
 		}
-	}
-
-	for _, post := range posts {
-		data, marshalErr := json.Marshal(post)
-		if marshalErr != nil {
-			cleanupTemp()
-			return "", fmt.Errorf("failed to encode post: %w", marshalErr)
+		found := false
+		for _, post := range posts {
+			if post.ID == id && !post.Deleted {
+				found = true
+				break
+			}
 		}
-		if _, writeErr := tmpFile.Write(append(data, '\n')); writeErr != nil {
-			cleanupTemp()
-			return "", fmt.Errorf("failed to write post: %w", writeErr)
+		if !found {
+			return ErrPostNotFound
 		}
-	}
-
-	if syncErr := tmpFile.Sync(); syncErr != nil {
-		cleanupTemp()
-		return "", fmt.Errorf("failed to sync temp file: %w", syncErr)
-	}
-	if closeErr := tmpFile.Close(); closeErr != nil {
-		cleanupTemp()
-		return "", fmt.Errorf("failed to close temp file: %w", closeErr)
-	}
-	return tmpPath, nil
-}
-
-// syncDir ensures directory metadata is flushed to disk.
-func syncDir(dir string) error {
-	dirHandle, err := os.Open(dir)
-	if err != nil {
-		return fmt.Errorf("failed to open feed directory: %w", err)
-	}
-	if err := dirHandle.Sync(); err != nil {
-		_ = dirHandle.Close()
-		return fmt.Errorf("failed to sync feed directory: %w", err)
-	}
-	return dirHandle.Close()
-}
-
-// doDeleteByID performs the actual delete with cross-process file locking.
-func (s *Store) doDeleteByID(id string) error {
-	if !ValidateID(id) {
-		return ErrInvalidID
-	}
-
-	if _, err := os.Stat(s.path); os.IsNotExist(err) {
-		return ErrNotInitialized
-	}
-
-	f, err := os.OpenFile(s.path, os.O_RDWR, 0600)
-	if err != nil {
-		return fmt.Errorf("failed to open feed file: %w", err)
-	}
-	defer func() {
-		_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
-		_ = f.Close()
-	}()
-
-	if lockErr := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); lockErr != nil {
-		return fmt.Errorf("failed to acquire file lock: %w", lockErr)
-	}
-
-	posts, found, readErr := readPostsExcluding(f, id)
-	if readErr != nil {
-		return readErr
-	}
-	if !found {
-		return ErrPostNotFound
-	}
-
-	dir := filepath.Dir(s.path)
-	tmpPath, writeErr := writePostsToTemp(dir, f, posts)
-	if writeErr != nil {
-		return writeErr
-	}
-
-	if renameErr := os.Rename(tmpPath, s.path); renameErr != nil {
-		_ = os.Remove(tmpPath)
-		return fmt.Errorf("failed to replace feed file: %w", renameErr)
-	}
-
-	return syncDir(dir)
+		return writeLine(f, data, "tombstone")
+	})
 }
 
 // Path returns the store's file path
-func (s *Store) Path() string {
+func (s *FileStore) Path() string {
 	return s.path
 }
 
-// GetExamplePosts returns the canonical example posts for seeding.
-// Exported for testing and documentation purposes.
-func GetExamplePosts() []struct{ Author, Suffix, Content string } {
-	return []struct{ Author, Suffix, Content string }{
-		{ExampleAuthorSpark, ExampleSuffix, "First time exploring this codebase. The test coverage is surprisingly good."},
-		{ExampleAuthorEmber, ExampleSuffix, "That moment when you realize the bug is in YOUR code, not the library. Humbling."},
-		{ExampleAuthorFlare, ExampleSuffix, "Just discovered jq -s slurps the whole file into memory. Mind blown."},
-		{ExampleAuthorWisp, ExampleSuffix, "Why do I always find the answer 5 minutes after asking for help?"},
-	}
-}
-
-// SeedExamples adds example posts to demonstrate the social tone.
-// Idempotent: only seeds if feed is empty (zero posts). Safe to call
-// multiple times. Returns number of posts added (0 if already seeded).
-func (s *Store) SeedExamples() (int, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	// Check if feed already has posts (unlocked read since we hold the lock)
-	posts, err := s.readAllUnlocked()
-	if err != nil {
-		return 0, fmt.Errorf("failed to check existing posts: %w", err)
-	}
-	if len(posts) > 0 {
-		return 0, nil // Don't seed non-empty feed
-	}
-
-	examples := GetExamplePosts()
-	baseTime := time.Now().Add(-SeedPostsAgeOffset).UTC()
-
-	for i, ex := range examples {
-		id, idErr := GenerateID()
-		if idErr != nil {
-			return 0, fmt.Errorf("failed to generate ID for example post %d: %w", i, idErr)
-		}
-		post := &Post{
-			ID:        id,
-			Author:    ex.Author,
-			Suffix:    ex.Suffix,
-			Content:   ex.Content,
-			CreatedAt: baseTime.Add(time.Duration(i) * time.Minute).Format(time.RFC3339),
-		}
-		if appendErr := s.appendUnlocked(post); appendErr != nil {
-			return 0, fmt.Errorf("failed to append example post %d (%s): %w", i, ex.Author, appendErr)
-		}
-	}
-	return len(examples), nil
-}
-
 // readAllUnlocked reads all posts without acquiring the mutex (caller must hold lock)
-func (s *Store) readAllUnlocked() ([]*Post, error) {
+func (s *FileStore) readAllUnlocked() ([]*Post, error) {
 	return s.doReadAll()
 }
 
 // appendUnlocked appends a post without acquiring the mutex (caller must hold lock)
-func (s *Store) appendUnlocked(post *Post) error {
+func (s *FileStore) appendUnlocked(post *Post) error {
 	return s.doAppend(post)
 }
@@ -11,7 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func setupTestStore(t *testing.T) (*Store, string) {
+func setupTestStore(t *testing.T) (*FileStore, string) {
 	t.Helper()
 
 	tmpDir := t.TempDir()
@@ -24,6 +24,69 @@ func setupTestStore(t *testing.T) (*Store, string) {
 	return NewStoreWithPath(feedPath), feedPath
 }
 
+// memStore is an in-memory Store for tests that don't need the feed file.
+type memStore struct {
+	posts []*Post
+	err   error
+}
+
+func (s *memStore) Append(post *Post) error {
+	if s.err != nil {
+		return s.err
+	}
+	if err := post.Validate(); err != nil {
+		return err
+	}
+	s.posts = append(s.posts, post)
+	return nil
+}
+
+func (s *memStore) ReadAll() ([]*Post, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	posts := make([]*Post, len(s.posts))
+	copy(posts, s.posts)
+	return posts, nil
+}
+
+func (s *memStore) DeleteByID(id string) error {
+	for i, post := range s.posts {
+		if post.ID == id {
+			s.posts = append(s.posts[:i], s.posts[i+1:]...)
+			return nil
+		}
+	}
+	return ErrPostNotFound
+}
+
+var _ Store = (*memStore)(nil)
+var _ Store = (*FileStore)(nil)
+
+func TestRecentPosts_MemStore(t *testing.T) {
+	now := time.Now().UTC()
+	store := &memStore{}
+	for i, id := range []string{"smk-aaaaaa", "smk-bbbbbb", "smk-cccccc"} {
+		require.NoError(t, store.Append(&Post{
+			ID:        id,
+			Author:    "ember",
+			Suffix:    "smoke",
+			Content:   "post " + id,
+			CreatedAt: now.Add(time.Duration(i) * time.Minute).Format(time.RFC3339),
+		}))
+	}
+
+	posts, err := RecentPosts(store, 2)
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	assert.Equal(t, "smk-cccccc", posts[0].ID)
+	assert.Equal(t, "smk-bbbbbb", posts[1].ID)
+
+	store.err = ErrNotInitialized
+	_, err = RecentPosts(store, 0)
+	assert.ErrorIs(t, err, ErrNotInitialized)
+}
+
 func TestStoreAppend(t *testing.T) {
 	store, _ := setupTestStore(t)
 
@@ -536,3 +599,36 @@ func TestStoreDeleteByID(t *testing.T) {
 		t.Errorf("DeleteByID() missing = %v, want ErrPostNotFound", err)
 	}
 }
+
+func TestStoreDeleteByID_ParentWithReplies(t *testing.T) {
+	store, feedPath := setupTestStore(t)
+
+	parent, _ := NewPost("author1", "proj", "s1", "parent post")
+	require.NoError(t, store.Append(parent))
+	reply, _ := NewReply("author2", "proj", "s2", "a reply", parent.ID)
+	require.NoError(t, store.Append(reply))
+
+	require.NoError(t, store.DeleteByID(parent.ID))
+
+	posts, err := store.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, posts, 2, "parent with replies stays as a placeholder")
+	assert.Equal(t, parent.ID, posts[0].ID)
+	assert.True(t, posts[0].Deleted)
+	assert.Equal(t, DeletedContent, posts[0].Content)
+	assert.Equal(t, "a reply", posts[1].Content)
+
+	assert.ErrorIs(t, store.DeleteByID(parent.ID), ErrPostNotFound, "placeholder cannot be deleted again")
+
+	// Deleting the last reply removes the placeholder too
+	require.NoError(t, store.DeleteByID(reply.ID))
+	posts, err = store.ReadAll()
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+
+	// The original lines are still in the file (append-only)
+	data, err := os.ReadFile(feedPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "parent post")
+	assert.Contains(t, string(data), `"type":"tombstone"`)
+}
@@ -0,0 +1,178 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+// MergeResult reports what MergeFrom added to a feed file.
+type MergeResult struct {
+	// Records is the number of lines added (posts, reactions, edits, deletions).
+	Records int
+	// Posts is the number of posts among them.
+	Posts int
+}
+
+// MergeFrom adds every record of the feed file at src that s lacks, then
+// orders the file by record time so posts from both sides interleave.
+// Posts are matched by ID and other records by their full content, so
+// merging the same file twice adds nothing. Lines of src that cannot be
+// decoded are skipped. The file at s is created if missing.
+//
+// src is read under a shared lock and released before s is locked for
+// writing (with the same lock Append takes), so two machines syncing in
+// opposite directions at once cannot deadlock.
+func (s *FileStore) MergeFrom(src string) (*MergeResult, error) {
+	srcLines, err := readFeedLinesShared(src)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := lockFile(f, true); err != nil {
+		return nil, err
+	}
+	defer func() { _ = unlockFile(f) }()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading feed file: %w", err)
+	}
+	lines, err := splitCompactLines(data)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		if line.ok {
+			seen[recordKey(line.record)] = true
+		}
+	}
+	result := &MergeResult{}
+	for _, line := range srcLines {
+		if !line.ok {
+			continue
+		}
+		key := recordKey(line.record)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		lines = append(lines, line)
+		result.Records++
+		if line.record.post != nil {
+			result.Posts++
+		}
+	}
+	if result.Records == 0 {
+		return result, nil
+	}
+
+	sortLinesByTime(lines)
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.Write(line.raw)
+		buf.WriteByte('\n')
+	}
+	if err := f.Truncate(0); err != nil {
+		return nil, fmt.Errorf("failed to truncate feed file: %w", err)
+	}
+	if _, err := f.WriteAt(buf.Bytes(), 0); err != nil {
+		return nil, fmt.Errorf("failed to write feed file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return nil, fmt.Errorf("failed to sync feed file: %w", err)
+	}
+	return result, nil
+}
+
+// readFeedLinesShared reads and decodes a feed file under a shared lock.
+func readFeedLinesShared(path string) ([]compactLine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := lockFile(f, false); err != nil {
+		return nil, err
+	}
+	defer func() { _ = unlockFile(f) }()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading feed file: %w", err)
+	}
+	return splitCompactLines(data)
+}
+
+// recordKey identifies a record across feed files: posts by ID, everything
+// else by its encoded content.
+func recordKey(record feedRecord) string {
+	var v any
+	switch {
+	case record.reaction != nil:
+		v = record.reaction
+	case record.tombstone != nil:
+		v = record.tombstone
+	case record.revision != nil:
+		v = record.revision
+	default:
+		return "post:" + record.post.ID
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// recordTime returns when a record was written, or the zero time if unknown.
+func recordTime(record feedRecord) time.Time {
+	var ts string
+	switch {
+	case record.reaction != nil:
+		ts = record.reaction.CreatedAt
+	case record.tombstone != nil:
+		ts = record.tombstone.CreatedAt
+	case record.revision != nil:
+		ts = record.revision.EditedAt
+	default:
+		ts = record.post.CreatedAt
+	}
+	t, _ := time.Parse(time.RFC3339Nano, ts)
+	return t
+}
+
+// sortLinesByTime orders lines by record time, keeping file order for ties.
+// Undecodable lines stay behind the line they followed.
+func sortLinesByTime(lines []compactLine) {
+	type timedLine struct {
+		at   time.Time
+		line compactLine
+	}
+	timed := make([]timedLine, len(lines))
+	var last time.Time
+	for i, line := range lines {
+		if line.ok {
+			if t := recordTime(line.record); !t.IsZero() {
+				last = t
+			}
+		}
+		timed[i] = timedLine{at: last, line: line}
+	}
+	sort.SliceStable(timed, func(i, j int) bool {
+		return timed[i].at.Before(timed[j].at)
+	})
+	for i := range timed {
+		lines[i] = timed[i].line
+	}
+}
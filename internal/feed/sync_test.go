@@ -0,0 +1,125 @@
+package feed
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeSyncFeed creates a feed file holding posts with the given IDs and
+// creation times.
+func writeSyncFeed(t *testing.T, path string, posts map[string]string) *FileStore {
+	t.Helper()
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	store := NewStoreWithPath(path)
+	for id, createdAt := range posts {
+		post := &Post{ID: id, Author: "ember", Project: "smoke", Suffix: "swift-fox", Content: "post " + id, CreatedAt: createdAt}
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store
+}
+
+func TestMergeFrom_Dedup(t *testing.T) {
+	dir := t.TempDir()
+	localPath := filepath.Join(dir, "local.jsonl")
+	remotePath := filepath.Join(dir, "remote.jsonl")
+	local := writeSyncFeed(t, localPath, map[string]string{
+		"smk-aaaaaa": "2026-01-01T10:00:00Z",
+		"smk-shared": "2026-01-01T11:00:00Z",
+	})
+	remote := writeSyncFeed(t, remotePath, map[string]string{
+		"smk-shared": "2026-01-01T11:00:00Z",
+		"smk-bbbbbb": "2026-01-01T10:30:00Z",
+		"smk-cccccc": "2026-01-01T12:00:00Z",
+	})
+	reaction, err := NewReaction("wisp", "smk-shared", "👍")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.AppendReaction(reaction); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := local.MergeFrom(remotePath)
+	if err != nil {
+		t.Fatalf("MergeFrom: %v", err)
+	}
+	if result.Records != 3 || result.Posts != 2 {
+		t.Errorf("pull result = %+v, want 3 records, 2 posts", result)
+	}
+
+	posts, err := local.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, p := range posts {
+		ids = append(ids, p.ID)
+	}
+	want := []string{"smk-aaaaaa", "smk-bbbbbb", "smk-shared", "smk-cccccc"}
+	if len(ids) != len(want) {
+		t.Fatalf("posts = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("posts = %v, want time order %v", ids, want)
+			break
+		}
+	}
+	if got := posts[2].Reactions["👍"]; got != 1 {
+		t.Errorf("shared post reactions = %v, want one 👍", posts[2].Reactions)
+	}
+
+	// Merging again, or back the other way, adds no duplicates
+	again, err := local.MergeFrom(remotePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Records != 0 {
+		t.Errorf("second pull added %d records, want 0", again.Records)
+	}
+	pushed, err := remote.MergeFrom(localPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pushed.Records != 1 || pushed.Posts != 1 {
+		t.Errorf("push result = %+v, want the one local-only post", pushed)
+	}
+	remotePosts, err := remote.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remotePosts) != 4 {
+		t.Errorf("remote has %d posts after push, want 4", len(remotePosts))
+	}
+}
+
+func TestMergeFrom_CreatesTarget(t *testing.T) {
+	dir := t.TempDir()
+	localPath := filepath.Join(dir, "local.jsonl")
+	writeSyncFeed(t, localPath, map[string]string{"smk-aaaaaa": "2026-01-01T10:00:00Z"})
+
+	remotePath := filepath.Join(dir, "remote.jsonl")
+	result, err := NewStoreWithPath(remotePath).MergeFrom(localPath)
+	if err != nil {
+		t.Fatalf("MergeFrom: %v", err)
+	}
+	if result.Posts != 1 {
+		t.Errorf("result = %+v, want 1 post", result)
+	}
+	if _, err := os.Stat(remotePath); err != nil {
+		t.Errorf("target not created: %v", err)
+	}
+}
+
+func TestMergeFrom_MissingSource(t *testing.T) {
+	dir := t.TempDir()
+	store := writeSyncFeed(t, filepath.Join(dir, "local.jsonl"), nil)
+	if _, err := store.MergeFrom(filepath.Join(dir, "missing.jsonl")); err == nil {
+		t.Error("MergeFrom(missing) should fail")
+	}
+}
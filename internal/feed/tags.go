@@ -0,0 +1,85 @@
+package feed
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	// tagPattern matches a #tag preceded by start of text or a non-word character,
+	// so "issue#12" and URL fragments like "/#install" are not tags.
+	tagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_/&#])#([\p{L}\p{N}_]+(?:-[\p{L}\p{N}_]+)*)`)
+	// codePattern matches fenced and inline code, where tags are ignored.
+	codePattern = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+)
+
+// TagCount is the number of posts using a tag.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Posts int    `json:"posts"`
+}
+
+// NormalizeTag lowercases a tag and strips its leading '#'.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// Tags returns the normalized hashtags in the post content, in order of first
+// appearance and without duplicates. Tags inside `code` are ignored, as are
+// purely numeric tags like #123 that usually reference issues.
+func (p *Post) Tags() []string {
+	content := codePattern.ReplaceAllStringFunc(p.Content, func(code string) string {
+		return strings.Repeat(" ", len(code))
+	})
+
+	var tags []string
+	seen := make(map[string]bool)
+	for _, match := range tagPattern.FindAllStringSubmatch(content, -1) {
+		tag := NormalizeTag(match[1])
+		if seen[tag] || !strings.ContainsFunc(tag, unicode.IsLetter) {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// HasTag reports whether the post uses the tag, ignoring case and a leading '#'.
+func (p *Post) HasTag(tag string) bool {
+	tag = NormalizeTag(tag)
+	for _, t := range p.Tags() {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// CountTags returns how many posts use each tag, most common first.
+// Ties are sorted alphabetically. Nil posts are skipped.
+func CountTags(posts []*Post) []TagCount {
+	counts := make(map[string]int)
+	for _, post := range posts {
+		if post == nil {
+			continue
+		}
+		for _, tag := range post.Tags() {
+			counts[tag]++
+		}
+	}
+
+	result := make([]TagCount, 0, len(counts))
+	for tag, n := range counts {
+		result = append(result, TagCount{Tag: tag, Posts: n})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Posts != result[j].Posts {
+			return result[i].Posts > result[j].Posts
+		}
+		return result[i].Tag < result[j].Tag
+	})
+	return result
+}
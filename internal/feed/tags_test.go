@@ -0,0 +1,100 @@
+package feed
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"none", "no tags here", nil},
+		{"at end", "shipped it #win", []string{"win"}},
+		{"at start", "#bug in the parser", []string{"bug"}},
+		{"punctuation adjacent", "fixed (#bug), finally #win! really #done.", []string{"bug", "win", "done"}},
+		{"case normalized and deduped", "#Bug #BUG #bug", []string{"bug"}},
+		{"hyphenated", "#follow-up later, not #trailing-", []string{"follow-up", "trailing"}},
+		{"inside inline code", "run `grep #todo` then #ship", []string{"ship"}},
+		{"inside fenced code", "```\n# comment #notatag\n``` #real", []string{"real"}},
+		{"numeric issue reference", "see #123 and #v2", []string{"v2"}},
+		{"mid word", "issue#12 and C#sharp", nil},
+		{"url fragment", "docs at example.com/#install", nil},
+		{"unicode", "#café time", []string{"café"}},
+		{"lone hash", "# heading and #", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post := &Post{Content: tt.content}
+			assert.Equal(t, tt.want, post.Tags())
+		})
+	}
+}
+
+func TestPostHasTag(t *testing.T) {
+	post := &Post{Content: "another #Bug report"}
+	assert.True(t, post.HasTag("bug"))
+	assert.True(t, post.HasTag("#BUG"))
+	assert.False(t, post.HasTag("bugs"))
+}
+
+func TestCountTags(t *testing.T) {
+	posts := []*Post{
+		{Content: "#bug #bug #win"},
+		{Content: "#Bug again"},
+		{Content: "#alpha"},
+		nil,
+	}
+	assert.Equal(t, []TagCount{
+		{Tag: "bug", Posts: 2},
+		{Tag: "alpha", Posts: 1},
+		{Tag: "win", Posts: 1},
+	}, CountTags(posts))
+	assert.Empty(t, CountTags(nil))
+}
+
+func TestFilterPosts_Tag(t *testing.T) {
+	posts := []*Post{{ID: "a", Content: "#bug one"}, {ID: "b", Content: "nothing"}}
+	got := FilterPosts(posts, FilterCriteria{Tag: "#BUG"})
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "a", got[0].ID)
+	}
+}
+
+func TestTagKey_FiltersAndCycles(t *testing.T) {
+	model := searchTestModel(t)
+	model.posts[0].Content = "found the retry #bug #urgent"
+	model.posts[2].Content = "Retry storms again #bug"
+	model.updateDisplayedPosts()
+	model.selectedPostIndex = 0
+
+	model = typeKeys(t, model, runeKey("#"))
+	assert.Equal(t, "bug", model.tagFilter)
+	assert.Len(t, model.displayedPosts, 2)
+	assert.Equal(t, "smk-aaaaaa", model.displayedPosts[model.selectedPostIndex].ID, "selection is kept")
+	assert.Contains(t, model.renderStatusBar(), "#bug")
+
+	model = typeKeys(t, model, runeKey("#"))
+	assert.Equal(t, "urgent", model.tagFilter)
+	assert.Len(t, model.displayedPosts, 1)
+
+	model = typeKeys(t, model, runeKey("#"))
+	assert.Empty(t, model.tagFilter, "cycling past the last tag clears the filter")
+	assert.Len(t, model.displayedPosts, 4)
+
+	model = typeKeys(t, model, runeKey("#"))
+	model = typeKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Empty(t, model.tagFilter)
+	assert.Len(t, model.displayedPosts, 4)
+}
+
+func TestTagKey_NoTags(t *testing.T) {
+	model := searchTestModel(t)
+	model = typeKeys(t, model, runeKey("#"))
+	assert.Empty(t, model.tagFilter)
+	assert.Len(t, model.notices, 1)
+}
@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ReadTail reads only the last maxBytes of the feed file, for callers that
+// must stay fast on large feeds. Edits, deletions, and reactions are applied
+// only when they fall in the same range. complete reports whether the whole
+// file fit, so callers can tell when older posts were left out.
+func (s *FileStore) ReadTail(maxBytes int64) (posts []*Post, complete bool, err error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, false, ErrNotInitialized
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to stat feed file: %w", err)
+	}
+	start := info.Size() - maxBytes
+	complete = start <= 0
+	if complete {
+		start = 0
+	}
+	data := make([]byte, info.Size()-start)
+	if _, err := f.ReadAt(data, start); err != nil && err != io.EOF {
+		return nil, false, fmt.Errorf("error reading feed file: %w", err)
+	}
+	if !complete {
+		// The first line is probably cut off; start at the next full one
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			data = data[i+1:]
+		} else {
+			data = nil
+		}
+	}
+
+	var records feedRecords
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		if line := scanner.Bytes(); len(line) > 0 {
+			if record, ok := parseFeedLine(line, lineNum); ok {
+				records.add(record)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, false, fmt.Errorf("error reading feed file: %w", err)
+	}
+	return records.resolve(), complete, nil
+}
@@ -0,0 +1,51 @@
+package feed
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadTail(t *testing.T) {
+	store := NewStoreWithPath(filepath.Join(t.TempDir(), "feed.jsonl"))
+	if err := os.WriteFile(store.Path(), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, content := range []string{"one", "two", "three", "four"} {
+		post, _ := NewPost("ember", "smoke", "swift-fox", content)
+		if err := store.Append(post); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, post.ID)
+	}
+	if err := store.DeleteByID(ids[3]); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(store.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	posts, complete, err := store.ReadTail(info.Size())
+	if err != nil || !complete || len(posts) != 3 {
+		t.Fatalf("ReadTail(whole file) = %d posts, complete %v, err %v; want 3, true, nil", len(posts), complete, err)
+	}
+
+	// A range that starts mid-line drops the cut line and keeps the rest
+	posts, complete, err = store.ReadTail(info.Size() - 10)
+	if err != nil || complete {
+		t.Fatalf("ReadTail(partial) complete %v, err %v; want false, nil", complete, err)
+	}
+	if len(posts) != 2 || posts[0].ID != ids[1] || posts[1].ID != ids[2] {
+		t.Errorf("ReadTail(partial) = %v, want posts two and three", posts)
+	}
+}
+
+func TestReadTail_Missing(t *testing.T) {
+	store := NewStoreWithPath(filepath.Join(t.TempDir(), "feed.jsonl"))
+	if _, _, err := store.ReadTail(1024); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("ReadTail() error = %v, want ErrNotInitialized", err)
+	}
+}
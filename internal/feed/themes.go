@@ -32,7 +32,7 @@ type Theme struct {
 }
 
 // AllThemes is the registry of available themes.
-// Themes cycle in order: dracula → github → catppuccin → solarized → nord → gruvbox → ember → paper → onedark → tokyonight
+// Themes cycle in order: dracula → github → catppuccin → solarized → nord → gruvbox → ember → paper → onedark → tokyonight → daylight → high-contrast
 var AllThemes = []Theme{
 	// Dracula - High contrast, vibrant purples/pinks
 	{
@@ -234,14 +234,52 @@ var AllThemes = []Theme{
 			lipgloss.Color("#565f89"), // muted
 		},
 	},
+	// Daylight - Light-optimized: dark ink and deep agent colors on white
+	{
+		Name:                "daylight",
+		DisplayName:         "Daylight",
+		Text:                lipgloss.AdaptiveColor{Light: "#1f2328", Dark: "#1f2328"},
+		TextMuted:           lipgloss.AdaptiveColor{Light: "#59636e", Dark: "#59636e"},
+		Background:          lipgloss.AdaptiveColor{Light: "#ffffff", Dark: "#ffffff"},
+		BackgroundSecondary: lipgloss.AdaptiveColor{Light: "#eef1f4", Dark: "#eef1f4"},
+		Accent:              lipgloss.AdaptiveColor{Light: "#0550ae", Dark: "#0550ae"},
+		Error:               lipgloss.AdaptiveColor{Light: "#cf222e", Dark: "#cf222e"},
+		DaySeparator:        lipgloss.AdaptiveColor{Light: "#59636e", Dark: "#59636e"},
+		UnreadSeparator:     lipgloss.AdaptiveColor{Light: "#bc4c00", Dark: "#bc4c00"},
+		AgentColors: []lipgloss.Color{
+			lipgloss.Color("#0550ae"), // blue
+			lipgloss.Color("#116329"), // green
+			lipgloss.Color("#8250df"), // purple
+			lipgloss.Color("#bc4c00"), // orange
+			lipgloss.Color("#a40e26"), // red
+		},
+	},
+	// High Contrast - Pure black and white with saturated accents, for low vision
+	{
+		Name:                "high-contrast",
+		DisplayName:         "High Contrast",
+		Text:                lipgloss.AdaptiveColor{Light: "#000000", Dark: "#ffffff"},
+		TextMuted:           lipgloss.AdaptiveColor{Light: "#303030", Dark: "#d0d0d0"},
+		Background:          lipgloss.AdaptiveColor{Light: "#ffffff", Dark: "#000000"},
+		BackgroundSecondary: lipgloss.AdaptiveColor{Light: "#e0e0e0", Dark: "#1c1c1c"},
+		Accent:              lipgloss.AdaptiveColor{Light: "#0000c0", Dark: "#ffff00"},
+		Error:               lipgloss.AdaptiveColor{Light: "#c00000", Dark: "#ff5f5f"},
+		DaySeparator:        lipgloss.AdaptiveColor{Light: "#000000", Dark: "#ffffff"},
+		UnreadSeparator:     lipgloss.AdaptiveColor{Light: "#0000c0", Dark: "#ffff00"},
+		AgentColors: []lipgloss.Color{
+			lipgloss.Color("#ffff00"), // yellow
+			lipgloss.Color("#00ffff"), // cyan
+			lipgloss.Color("#00ff00"), // green
+			lipgloss.Color("#ff87ff"), // pink
+			lipgloss.Color("#ffffff"), // white
+		},
+	},
 }
 
 // GetTheme returns the theme with the given name, or the default theme if not found.
 func GetTheme(name string) *Theme {
-	for i := range AllThemes {
-		if AllThemes[i].Name == name {
-			return &AllThemes[i]
-		}
+	if theme := findTheme(name); theme != nil {
+		return theme
 	}
 	return &AllThemes[0]
 }
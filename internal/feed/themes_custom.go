@@ -0,0 +1,229 @@
+package feed
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// hexColorPattern matches the #rrggbb colors theme files accept.
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// themeNamePattern matches names usable as theme identifiers.
+var themeNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// themeColor is a theme color in a theme file. It is written as a
+// light/dark mapping, or as one string used for both.
+type themeColor struct {
+	Light string `yaml:"light,omitempty"`
+	Dark  string `yaml:"dark,omitempty"`
+}
+
+// UnmarshalYAML accepts either "#rrggbb" or {light: ..., dark: ...}.
+func (c *themeColor) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		c.Light, c.Dark = node.Value, node.Value
+		return nil
+	}
+	type plain themeColor
+	return node.Decode((*plain)(c))
+}
+
+// themeFile is the YAML form of a Theme. Colors left out are taken from
+// the Base theme (dracula by default).
+type themeFile struct {
+	Name                string      `yaml:"name,omitempty"`
+	DisplayName         string      `yaml:"display_name,omitempty"`
+	Base                string      `yaml:"base,omitempty"`
+	Text                *themeColor `yaml:"text,omitempty"`
+	TextMuted           *themeColor `yaml:"text_muted,omitempty"`
+	Background          *themeColor `yaml:"background,omitempty"`
+	BackgroundSecondary *themeColor `yaml:"background_secondary,omitempty"`
+	Accent              *themeColor `yaml:"accent,omitempty"`
+	Error               *themeColor `yaml:"error,omitempty"`
+	DaySeparator        *themeColor `yaml:"day_separator,omitempty"`
+	UnreadSeparator     *themeColor `yaml:"unread_separator,omitempty"`
+	AgentColors         []string    `yaml:"agent_colors,omitempty"`
+}
+
+// LoadCustomThemes reads every .yaml or .yml theme file in dir, in name
+// order. A missing directory yields no themes. Files that fail to parse or
+// validate are skipped with a warning on stderr.
+func LoadCustomThemes(dir string) ([]*Theme, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read themes directory: %w", err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	var themes []*Theme
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		theme, err := loadThemeFile(path, strings.TrimSuffix(entry.Name(), ext))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: skipping theme %s: %v\n", path, err)
+			continue
+		}
+		themes = append(themes, theme)
+	}
+	return themes, nil
+}
+
+// loadThemeFile parses one theme file. defaultName is used when the file
+// does not set a name.
+func loadThemeFile(path, defaultName string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file themeFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if file.Name == "" {
+		file.Name = defaultName
+	}
+	return file.theme()
+}
+
+// theme validates the file and builds the Theme it describes.
+func (f *themeFile) theme() (*Theme, error) {
+	if !themeNamePattern.MatchString(f.Name) {
+		return nil, fmt.Errorf("invalid theme name %q (use lowercase letters, digits, - and _)", f.Name)
+	}
+	baseName := f.Base
+	if baseName == "" {
+		baseName = DefaultThemeName
+	}
+	base := findTheme(baseName)
+	if base == nil {
+		return nil, fmt.Errorf("unknown base theme %q", baseName)
+	}
+
+	theme := *base
+	theme.Name = f.Name
+	theme.DisplayName = f.DisplayName
+	if theme.DisplayName == "" {
+		theme.DisplayName = f.Name
+	}
+
+	fields := []struct {
+		key   string
+		color *themeColor
+		dst   *lipgloss.AdaptiveColor
+	}{
+		{"text", f.Text, &theme.Text},
+		{"text_muted", f.TextMuted, &theme.TextMuted},
+		{"background", f.Background, &theme.Background},
+		{"background_secondary", f.BackgroundSecondary, &theme.BackgroundSecondary},
+		{"accent", f.Accent, &theme.Accent},
+		{"error", f.Error, &theme.Error},
+		{"day_separator", f.DaySeparator, &theme.DaySeparator},
+		{"unread_separator", f.UnreadSeparator, &theme.UnreadSeparator},
+	}
+	for _, field := range fields {
+		if field.color == nil {
+			continue
+		}
+		for _, value := range []struct {
+			side string
+			hex  string
+			dst  *string
+		}{
+			{"light", field.color.Light, &field.dst.Light},
+			{"dark", field.color.Dark, &field.dst.Dark},
+		} {
+			if value.hex == "" {
+				continue
+			}
+			if !hexColorPattern.MatchString(value.hex) {
+				return nil, fmt.Errorf("%s.%s: invalid color %q (want #rrggbb)", field.key, value.side, value.hex)
+			}
+			*value.dst = value.hex
+		}
+	}
+
+	if len(f.AgentColors) > 0 {
+		theme.AgentColors = make([]lipgloss.Color, len(f.AgentColors))
+		for i, hex := range f.AgentColors {
+			if !hexColorPattern.MatchString(hex) {
+				return nil, fmt.Errorf("agent_colors[%d]: invalid color %q (want #rrggbb)", i, hex)
+			}
+			theme.AgentColors[i] = lipgloss.Color(hex)
+		}
+	}
+	return &theme, nil
+}
+
+// AddThemes appends custom themes to AllThemes so GetTheme and the theme
+// cycle include them. It returns the names of themes skipped because a
+// theme with that name already exists. Call it before handing themes out:
+// growing AllThemes may move the built-ins.
+func AddThemes(themes []*Theme) (skipped []string) {
+	for _, theme := range themes {
+		if findTheme(theme.Name) != nil {
+			skipped = append(skipped, theme.Name)
+			continue
+		}
+		AllThemes = append(AllThemes, *theme)
+	}
+	return skipped
+}
+
+// findTheme returns the theme called name, or nil.
+func findTheme(name string) *Theme {
+	for i := range AllThemes {
+		if AllThemes[i].Name == name {
+			return &AllThemes[i]
+		}
+	}
+	return nil
+}
+
+// ExportTheme writes theme as a theme file, ready to copy into the themes
+// directory and edit.
+func ExportTheme(w io.Writer, theme *Theme) error {
+	color := func(c lipgloss.AdaptiveColor) *themeColor {
+		return &themeColor{Light: c.Light, Dark: c.Dark}
+	}
+	file := themeFile{
+		Name:                theme.Name,
+		DisplayName:         theme.DisplayName,
+		Text:                color(theme.Text),
+		TextMuted:           color(theme.TextMuted),
+		Background:          color(theme.Background),
+		BackgroundSecondary: color(theme.BackgroundSecondary),
+		Accent:              color(theme.Accent),
+		Error:               color(theme.Error),
+		DaySeparator:        color(theme.DaySeparator),
+		UnreadSeparator:     color(theme.UnreadSeparator),
+	}
+	for _, c := range theme.AgentColors {
+		file.AgentColors = append(file.AgentColors, string(c))
+	}
+
+	if _, err := fmt.Fprintf(w, "# Smoke theme. Rename it, then save as ~/.config/smoke/themes/<name>.yaml\n"); err != nil {
+		return err
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(file); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -1,6 +1,11 @@
 package feed
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/charmbracelet/lipgloss"
@@ -158,8 +163,18 @@ func TestNextTheme(t *testing.T) {
 			want:    "catppuccin",
 		},
 		{
-			name:    "next theme after tokyonight wraps to dracula",
+			name:    "next theme after tokyonight is daylight",
 			current: "tokyonight",
+			want:    "daylight",
+		},
+		{
+			name:    "next theme after daylight is high-contrast",
+			current: "daylight",
+			want:    "high-contrast",
+		},
+		{
+			name:    "next theme after high-contrast wraps to dracula",
+			current: "high-contrast",
 			want:    "dracula",
 		},
 		{
@@ -290,9 +305,126 @@ func TestGetThemeAllThemesAvailable(t *testing.T) {
 }
 
 func TestThemeCount(t *testing.T) {
-	// Verify we have exactly 10 themes as specified
-	expected := 10
+	// Verify we have exactly 12 themes as specified
+	expected := 12
 	if len(AllThemes) != expected {
 		t.Errorf("AllThemes count = %d, want %d", len(AllThemes), expected)
 	}
 }
+
+func writeThemeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadCustomThemes(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "midnight.yaml", `display_name: Midnight
+base: nord
+background: "#000000"
+accent:
+  light: "#0000aa"
+  dark: "#5555ff"
+agent_colors: ["#111111", "#222222"]
+`)
+	writeThemeFile(t, dir, "broken.yaml", `accent: "blue"`)
+	writeThemeFile(t, dir, "notes.txt", `not a theme`)
+
+	themes, err := LoadCustomThemes(dir)
+	if err != nil {
+		t.Fatalf("LoadCustomThemes error: %v", err)
+	}
+	if len(themes) != 1 {
+		t.Fatalf("got %d themes, want only midnight (broken.yaml has an invalid color)", len(themes))
+	}
+	theme := themes[0]
+	if theme.Name != "midnight" || theme.DisplayName != "Midnight" {
+		t.Errorf("name = %q/%q, want midnight/Midnight", theme.Name, theme.DisplayName)
+	}
+	if theme.Background != (lipgloss.AdaptiveColor{Light: "#000000", Dark: "#000000"}) {
+		t.Errorf("single color should apply to light and dark, got %v", theme.Background)
+	}
+	if theme.Accent != (lipgloss.AdaptiveColor{Light: "#0000aa", Dark: "#5555ff"}) {
+		t.Errorf("accent = %v", theme.Accent)
+	}
+	if theme.Text != GetTheme("nord").Text {
+		t.Errorf("unset colors should come from the base theme, got %v", theme.Text)
+	}
+	if len(theme.AgentColors) != 2 || theme.AgentColors[1] != "#222222" {
+		t.Errorf("agent colors = %v", theme.AgentColors)
+	}
+}
+
+func TestLoadCustomThemes_InvalidColor(t *testing.T) {
+	tests := map[string]string{
+		"word":         `accent: "blue"`,
+		"short hex":    `accent: "#fff"`,
+		"bad side":     "text:\n  light: \"#12345z\"",
+		"agent color":  `agent_colors: ["#ffffff", "red"]`,
+		"unknown base": `base: "nope"`,
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			theme, err := loadThemeFileContent(t, content)
+			if err == nil {
+				t.Errorf("expected an error, got theme %v", theme)
+			}
+		})
+	}
+}
+
+func loadThemeFileContent(t *testing.T, content string) (*Theme, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "custom.yaml")
+	writeThemeFile(t, filepath.Dir(path), "custom.yaml", content)
+	return loadThemeFile(path, "custom")
+}
+
+func TestLoadCustomThemes_MissingDir(t *testing.T) {
+	themes, err := LoadCustomThemes(filepath.Join(t.TempDir(), "none"))
+	if err != nil || themes != nil {
+		t.Errorf("missing dir = %v, %v; want no themes and no error", themes, err)
+	}
+}
+
+func TestAddThemes_JoinsCycle(t *testing.T) {
+	saved := AllThemes
+	t.Cleanup(func() { AllThemes = saved })
+	AllThemes = append([]Theme(nil), saved...)
+
+	custom := *GetTheme("dracula")
+	custom.Name = "midnight"
+	clash := *GetTheme("nord")
+
+	skipped := AddThemes([]*Theme{&custom, &clash})
+	if len(skipped) != 1 || skipped[0] != "nord" {
+		t.Errorf("skipped = %v, want [nord]", skipped)
+	}
+	if GetTheme("midnight").Name != "midnight" {
+		t.Error("GetTheme should find the custom theme")
+	}
+	last := saved[len(saved)-1].Name
+	if NextTheme(last) != "midnight" || NextTheme("midnight") != saved[0].Name {
+		t.Errorf("custom theme should sit between %s and %s in the cycle", last, saved[0].Name)
+	}
+}
+
+func TestExportTheme_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportTheme(&buf, GetTheme("gruvbox")); err != nil {
+		t.Fatalf("ExportTheme error: %v", err)
+	}
+	content := strings.Replace(buf.String(), "name: gruvbox", "name: my-gruvbox", 1)
+
+	theme, err := loadThemeFileContent(t, content)
+	if err != nil {
+		t.Fatalf("exported theme does not load: %v\n%s", err, buf.String())
+	}
+	want := *GetTheme("gruvbox")
+	want.Name = "my-gruvbox"
+	if !reflect.DeepEqual(*theme, want) {
+		t.Errorf("round trip = %+v, want %+v", *theme, want)
+	}
+}
@@ -0,0 +1,58 @@
+package feed
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WithContext adds the ancestors of matched replies from all, so each reply
+// is shown under the post it answers. It returns the posts in all's order
+// and the IDs of the added ancestors, which callers render as context.
+func WithContext(matched, all []*Post) ([]*Post, map[string]bool) {
+	byID := make(map[string]*Post, len(all))
+	for _, post := range all {
+		byID[post.ID] = post
+	}
+	included := make(map[string]bool, len(matched))
+	for _, post := range matched {
+		included[post.ID] = true
+	}
+
+	context := make(map[string]bool)
+	for _, post := range matched {
+		for parent := byID[post.ParentID]; parent != nil && !included[parent.ID]; parent = byID[parent.ParentID] {
+			included[parent.ID] = true
+			context[parent.ID] = true
+		}
+	}
+
+	result := make([]*Post, 0, len(included))
+	for _, post := range all {
+		if included[post.ID] {
+			result = append(result, post)
+		}
+	}
+	return result, context
+}
+
+// formatDimmed renders through a colorless writer and dims every line, so
+// context posts recede behind the posts that matched.
+func formatDimmed(w io.Writer, cw *ColorWriter, render func(io.Writer, *ColorWriter)) {
+	if !cw.ColorEnabled {
+		render(w, cw)
+		return
+	}
+	var buf bytes.Buffer
+	render(&buf, &ColorWriter{W: &buf})
+	for _, line := range strings.SplitAfter(buf.String(), "\n") {
+		text, newline := strings.CutSuffix(line, "\n")
+		if text != "" {
+			_, _ = fmt.Fprint(w, cw.Dim(text))
+		}
+		if newline {
+			_, _ = fmt.Fprintln(w)
+		}
+	}
+}
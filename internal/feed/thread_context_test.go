@@ -0,0 +1,75 @@
+package feed
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func contextTestPosts() []*Post {
+	return []*Post{
+		{ID: "smk-nested", Author: "ember@smoke", Suffix: "ember", Content: "nested answer", CreatedAt: "2026-01-30T09:10:00Z", ParentID: "smk-reply"},
+		{ID: "smk-reply", Author: "wisp@smoke", Suffix: "wisp", Content: "first reply", CreatedAt: "2026-01-30T09:05:00Z", ParentID: "smk-root"},
+		{ID: "smk-other", Author: "ember@smoke", Suffix: "ember", Content: "standalone", CreatedAt: "2026-01-30T09:03:00Z"},
+		{ID: "smk-root", Author: "wisp@smoke", Suffix: "wisp", Content: "root question", CreatedAt: "2026-01-30T09:00:00Z"},
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	all := contextTestPosts()
+	matched := []*Post{all[0], all[2]}
+
+	posts, context := WithContext(matched, all)
+
+	var ids []string
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+	}
+	if got, want := strings.Join(ids, ","), "smk-nested,smk-reply,smk-other,smk-root"; got != want {
+		t.Errorf("WithContext() posts = %s, want %s", got, want)
+	}
+	if len(context) != 2 || !context["smk-reply"] || !context["smk-root"] {
+		t.Errorf("WithContext() context = %v, want smk-reply and smk-root", context)
+	}
+}
+
+func TestWithContext_MatchedParentIsNotContext(t *testing.T) {
+	all := contextTestPosts()
+	matched := []*Post{all[0], all[1]}
+
+	posts, context := WithContext(matched, all)
+
+	if len(posts) != 3 {
+		t.Errorf("WithContext() returned %d posts, want 3", len(posts))
+	}
+	if context["smk-reply"] || !context["smk-root"] {
+		t.Errorf("WithContext() context = %v, want only smk-root", context)
+	}
+}
+
+func TestFormatFeed_DimsContextPosts(t *testing.T) {
+	all := contextTestPosts()
+	posts, context := WithContext([]*Post{all[1]}, all)
+
+	for _, oneline := range []bool{false, true} {
+		var buf bytes.Buffer
+		FormatFeed(&buf, posts, FormatOptions{Oneline: oneline, ColorMode: ColorAlways, Context: context}, len(posts))
+		output := buf.String()
+
+		var rootLine, replyLine string
+		for _, line := range strings.Split(output, "\n") {
+			switch {
+			case strings.Contains(line, "root question"):
+				rootLine = line
+			case strings.Contains(line, "first reply"):
+				replyLine = line
+			}
+		}
+		if !strings.HasPrefix(rootLine, Dim) || strings.Contains(rootLine, Bold) {
+			t.Errorf("oneline=%v: context post should be dimmed throughout: %q", oneline, rootLine)
+		}
+		if !strings.Contains(replyLine, Bold) {
+			t.Errorf("oneline=%v: matched post should keep its styling: %q", oneline, replyLine)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package feed
+
+import (
+	"fmt"
+	"time"
+)
+
+// Timestamp formats for TUIConfig.TimeFormat.
+const (
+	// TimestampClock shows the time of day, e.g. 14:32
+	TimestampClock = "clock"
+	// TimestampRelative shows the age of the post, e.g. 5m ago
+	TimestampRelative = "relative"
+	// TimestampDateTime shows the full date and time, e.g. 2026-01-30 14:32
+	TimestampDateTime = "datetime"
+)
+
+// AllTimestampFormats lists timestamp formats in the order the z key cycles them.
+var AllTimestampFormats = []string{TimestampClock, TimestampRelative, TimestampDateTime}
+
+// NextTimestampFormat returns the format after current, wrapping around.
+// Unknown formats (including empty) count as clock.
+func NextTimestampFormat(current string) string {
+	for i, f := range AllTimestampFormats {
+		if f == current {
+			return AllTimestampFormats[(i+1)%len(AllTimestampFormats)]
+		}
+	}
+	return AllTimestampFormats[1]
+}
+
+// timestampFormatLabel names a timestamp format for the TUI.
+func timestampFormatLabel(format string) string {
+	switch format {
+	case TimestampRelative:
+		return "Relative"
+	case TimestampDateTime:
+		return "Date and time"
+	default:
+		return "Clock"
+	}
+}
+
+// formatPostTime returns the timestamp string for a post in the given
+// format, or "??:??" if the post time cannot be parsed.
+func formatPostTime(post *Post, format string) string {
+	t, err := post.GetCreatedTime()
+	if err != nil {
+		return "??:??"
+	}
+	switch format {
+	case TimestampRelative:
+		return FormatTimeAgo(t)
+	case TimestampDateTime:
+		return inDisplayZone(t).Format("2006-01-02") + " " + FormatTime(t)
+	default:
+		return FormatTime(t)
+	}
+}
+
+// FormatTimeAgo formats a time as a human-readable "X ago" string
+// Examples: "15m ago", "2h ago", "just now"
+func FormatTimeAgo(t time.Time) string {
+	now := time.Now()
+	duration := now.Sub(t)
+
+	if duration < time.Minute {
+		return "just now"
+	}
+	if duration < time.Hour {
+		minutes := int(duration.Minutes())
+		if minutes == 1 {
+			return "1m ago"
+		}
+		return fmt.Sprintf("%dm ago", minutes)
+	}
+	if duration < 24*time.Hour {
+		hours := int(duration.Hours())
+		if hours == 1 {
+			return "1h ago"
+		}
+		return fmt.Sprintf("%dh ago", hours)
+	}
+
+	days := int(duration.Hours() / 24)
+	if days == 1 {
+		return "1d ago"
+	}
+	return fmt.Sprintf("%dd ago", days)
+}
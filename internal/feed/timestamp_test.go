@@ -0,0 +1,133 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+func TestFormatTimeAgo(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected string
+	}{
+		{"just now", now.Add(-30 * time.Second), "just now"},
+		{"1 minute", now.Add(-1 * time.Minute), "1m ago"},
+		{"5 minutes", now.Add(-5 * time.Minute), "5m ago"},
+		{"59 minutes", now.Add(-59 * time.Minute), "59m ago"},
+		{"1 hour", now.Add(-1 * time.Hour), "1h ago"},
+		{"5 hours", now.Add(-5 * time.Hour), "5h ago"},
+		{"23 hours", now.Add(-23 * time.Hour), "23h ago"},
+		{"1 day", now.Add(-24 * time.Hour), "1d ago"},
+		{"3 days", now.Add(-72 * time.Hour), "3d ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatTimeAgo(tt.time)
+			if result != tt.expected {
+				t.Errorf("FormatTimeAgo(%v) = %q, want %q", tt.time, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNextTimestampFormat(t *testing.T) {
+	tests := map[string]string{
+		TimestampClock:    TimestampRelative,
+		TimestampRelative: TimestampDateTime,
+		TimestampDateTime: TimestampClock,
+		"":                TimestampRelative,
+		"bogus":           TimestampRelative,
+	}
+	for current, want := range tests {
+		if got := NextTimestampFormat(current); got != want {
+			t.Errorf("NextTimestampFormat(%q) = %q, want %q", current, got, want)
+		}
+	}
+}
+
+func TestFormatPostTime(t *testing.T) {
+	created := time.Now().Add(-5 * time.Minute)
+	post := &Post{CreatedAt: created.UTC().Format(time.RFC3339)}
+
+	if got, want := formatPostTime(post, TimestampClock), FormatTime(created); got != want {
+		t.Errorf("clock = %q, want %q", got, want)
+	}
+	if got := formatPostTime(post, ""); got != FormatTime(created) {
+		t.Errorf("empty format should fall back to clock, got %q", got)
+	}
+	if got := formatPostTime(post, TimestampRelative); got != "5m ago" {
+		t.Errorf("relative = %q, want %q", got, "5m ago")
+	}
+	got := formatPostTime(post, TimestampDateTime)
+	if !strings.HasPrefix(got, created.Local().Format("2006-01-02")+" ") || !strings.HasSuffix(got, FormatTime(created)) {
+		t.Errorf("datetime = %q, want date followed by clock time", got)
+	}
+	if got := formatPostTime(&Post{CreatedAt: "bad"}, TimestampRelative); got != "??:??" {
+		t.Errorf("unparseable time = %q, want ??:??", got)
+	}
+}
+
+func TestFormatPostComfy_TimestampFormats(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour)
+	post := &Post{
+		ID:        "smk-test123",
+		Author:    "test-author",
+		Suffix:    "smoke",
+		Content:   strings.Repeat("wrapped content ", 12),
+		CreatedAt: created.UTC().Format(time.RFC3339),
+	}
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	model.width = 80
+
+	indent := map[string]int{}
+	for _, format := range AllTimestampFormats {
+		model.config.TimeFormat = format
+		lines := model.formatPostComfy(post)
+		if len(lines) < 2 {
+			t.Fatalf("%s: expected wrapped output, got %q", format, lines)
+		}
+		want := formatPostTime(post, format)
+		if first := xansi.Strip(lines[0]); !strings.HasPrefix(first, want+"  ") {
+			t.Errorf("%s: first line %q should start with %q", format, first, want)
+		}
+		cont := xansi.Strip(lines[1])
+		indent[format] = len(cont) - len(strings.TrimLeft(cont, " "))
+		for _, line := range lines {
+			if w := xansi.StringWidth(line); w > model.contentWidth() {
+				t.Errorf("%s: line is %d cells, want <= %d", format, w, model.contentWidth())
+			}
+		}
+	}
+
+	clock := xansi.StringWidth(formatPostTime(post, TimestampClock))
+	for _, format := range []string{TimestampRelative, TimestampDateTime} {
+		diff := xansi.StringWidth(formatPostTime(post, format)) - clock
+		if indent[format]-indent[TimestampClock] != diff {
+			t.Errorf("%s: continuation indent %d, want clock indent %d shifted by %d",
+				format, indent[format], indent[TimestampClock], diff)
+		}
+	}
+}
+
+func TestModelUpdate_TimestampFormatKey(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	model = updated.(Model)
+
+	if model.config.TimeFormat != TimestampRelative {
+		t.Errorf("Update(z) time format = %q, want %q", model.config.TimeFormat, TimestampRelative)
+	}
+	if len(model.notices) == 0 || model.notices[len(model.notices)-1].text != "Timestamps: Relative" {
+		t.Errorf("Update(z) should announce the new format, got %+v", model.notices)
+	}
+}
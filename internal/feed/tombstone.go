@@ -0,0 +1,70 @@
+package feed
+
+import "time"
+
+// RecordTypeTombstone marks a feed line as the deletion of a post.
+const RecordTypeTombstone = "tombstone"
+
+// DeletedContent replaces the content of deleted posts that still have replies.
+const DeletedContent = "[deleted]"
+
+// Tombstone records that a post was deleted. Deletion appends a tombstone
+// instead of rewriting the feed, so concurrent writers never lose lines.
+type Tombstone struct {
+	// Type is always RecordTypeTombstone.
+	Type string `json:"type"`
+	// TargetID is the ID of the deleted post.
+	TargetID string `json:"target_id"`
+	// CreatedAt is the UTC timestamp of the deletion, in RFC3339 format.
+	CreatedAt string `json:"created_at"`
+}
+
+// NewTombstone creates a tombstone for the post with the given ID.
+func NewTombstone(targetID string) *Tombstone {
+	return &Tombstone{
+		Type:      RecordTypeTombstone,
+		TargetID:  targetID,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+// Validate checks that the tombstone targets a valid post ID.
+func (t *Tombstone) Validate() error {
+	if !ValidateID(t.TargetID) {
+		return ErrInvalidID
+	}
+	return nil
+}
+
+// applyTombstones removes deleted posts. A deleted post that still has live
+// replies is kept as a placeholder with DeletedContent so its thread renders.
+func applyTombstones(posts []*Post, tombstones []*Tombstone) []*Post {
+	if len(tombstones) == 0 {
+		return posts
+	}
+	deleted := make(map[string]bool, len(tombstones))
+	for _, t := range tombstones {
+		deleted[t.TargetID] = true
+	}
+
+	hasReplies := make(map[string]bool)
+	for _, post := range posts {
+		if post.IsReply() && !deleted[post.ID] {
+			hasReplies[post.ParentID] = true
+		}
+	}
+
+	kept := make([]*Post, 0, len(posts))
+	for _, post := range posts {
+		if !deleted[post.ID] {
+			kept = append(kept, post)
+			continue
+		}
+		if hasReplies[post.ID] {
+			post.Content = DeletedContent
+			post.Deleted = true
+			kept = append(kept, post)
+		}
+	}
+	return kept
+}
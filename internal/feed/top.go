@@ -0,0 +1,67 @@
+package feed
+
+import (
+	"sort"
+	"time"
+)
+
+// AuthorCount is the number of posts an author wrote.
+type AuthorCount struct {
+	Author string `json:"author"`
+	Posts  int    `json:"posts"`
+}
+
+// TopSnapshot summarizes recent feed activity for smoke top.
+type TopSnapshot struct {
+	// Stats counts the whole feed.
+	Stats
+	// WindowPosts is the number of posts created within the window.
+	WindowPosts int `json:"window_posts"`
+	// PostsPerMinute is WindowPosts averaged over the window.
+	PostsPerMinute float64 `json:"posts_per_minute"`
+	// TopAuthors are the most active authors within the window, busiest first.
+	TopAuthors []AuthorCount `json:"top_authors"`
+}
+
+// ComputeTopSnapshot counts the posts created in the window ending at now and
+// ranks their authors, keeping at most limit (0 = all). Ties go to the
+// alphabetically first name. Posts without a valid time only count toward
+// the whole-feed Stats.
+func ComputeTopSnapshot(posts []*Post, now time.Time, window time.Duration, limit int) TopSnapshot {
+	snap := TopSnapshot{
+		Stats:      ComputeStats(posts),
+		TopAuthors: []AuthorCount{},
+	}
+
+	since := now.Add(-window)
+	perAuthor := make(map[string]int)
+	for _, post := range posts {
+		if post == nil {
+			continue
+		}
+		created, err := post.GetCreatedTime()
+		if err != nil || !created.After(since) || created.After(now) {
+			continue
+		}
+		snap.WindowPosts++
+		perAuthor[post.Author]++
+	}
+	if window > 0 {
+		snap.PostsPerMinute = float64(snap.WindowPosts) / window.Minutes()
+	}
+
+	for author, count := range perAuthor {
+		snap.TopAuthors = append(snap.TopAuthors, AuthorCount{Author: author, Posts: count})
+	}
+	sort.Slice(snap.TopAuthors, func(i, j int) bool {
+		a, b := snap.TopAuthors[i], snap.TopAuthors[j]
+		if a.Posts != b.Posts {
+			return a.Posts > b.Posts
+		}
+		return a.Author < b.Author
+	})
+	if limit > 0 && len(snap.TopAuthors) > limit {
+		snap.TopAuthors = snap.TopAuthors[:limit]
+	}
+	return snap
+}
@@ -0,0 +1,52 @@
+package feed
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeTopSnapshot(t *testing.T) {
+	now := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	at := func(ago time.Duration) string { return now.Add(-ago).Format(time.RFC3339) }
+	posts := []*Post{
+		{Author: "ember@smoke", Project: "smoke", CreatedAt: at(time.Hour)},
+		{Author: "ember@smoke", Project: "smoke", CreatedAt: at(9 * time.Minute)},
+		{Author: "ember@smoke", Project: "smoke", CreatedAt: at(2 * time.Minute)},
+		{Author: "swift-fox@api", Project: "api", CreatedAt: at(5 * time.Minute)},
+		{Author: "bold-owl@api", Project: "api", CreatedAt: at(time.Minute)},
+		{Author: "bad-time@api", Project: "api", CreatedAt: "not a time"},
+		{Author: "future@api", Project: "api", CreatedAt: now.Add(time.Minute).Format(time.RFC3339)},
+		nil,
+	}
+
+	snap := ComputeTopSnapshot(posts, now, 10*time.Minute, 2)
+
+	if snap.Posts != 7 || snap.Agents != 5 || snap.Projects != 2 {
+		t.Errorf("Stats = %+v, want 7 posts, 5 agents, 2 projects", snap.Stats)
+	}
+	if snap.WindowPosts != 4 {
+		t.Errorf("WindowPosts = %d, want 4", snap.WindowPosts)
+	}
+	if snap.PostsPerMinute != 0.4 {
+		t.Errorf("PostsPerMinute = %v, want 0.4", snap.PostsPerMinute)
+	}
+	want := []AuthorCount{{"ember@smoke", 2}, {"bold-owl@api", 1}}
+	if len(snap.TopAuthors) != len(want) {
+		t.Fatalf("TopAuthors = %+v, want %+v", snap.TopAuthors, want)
+	}
+	for i := range want {
+		if snap.TopAuthors[i] != want[i] {
+			t.Errorf("TopAuthors[%d] = %+v, want %+v", i, snap.TopAuthors[i], want[i])
+		}
+	}
+}
+
+func TestComputeTopSnapshot_Empty(t *testing.T) {
+	snap := ComputeTopSnapshot(nil, time.Now(), time.Minute, 0)
+	if snap.WindowPosts != 0 || snap.PostsPerMinute != 0 {
+		t.Errorf("empty feed snapshot = %+v, want zero activity", snap)
+	}
+	if snap.TopAuthors == nil {
+		t.Error("TopAuthors should be an empty slice, not nil")
+	}
+}
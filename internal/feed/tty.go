@@ -30,12 +30,31 @@ const (
 	ColorNever
 )
 
+// NoColorEnv disables colors when set to any non-empty value (see no-color.org).
+const NoColorEnv = "NO_COLOR"
+
+// noColor is set by the --no-color flag.
+var noColor bool
+
+// SetNoColor disables (or re-enables) color for the rest of the process,
+// as if NO_COLOR were set.
+func SetNoColor(disabled bool) {
+	noColor = disabled
+}
+
+// NoColor reports whether colors were turned off with --no-color or NO_COLOR.
+// Output then also uses ASCII in place of box-drawing characters.
+func NoColor() bool {
+	return noColor || os.Getenv(NoColorEnv) != ""
+}
+
 // IsTerminal reports whether the given file descriptor is a terminal.
 func IsTerminal(fd uintptr) bool {
 	return term.IsTerminal(int(fd))
 }
 
 // ShouldColorize determines whether to use color based on the mode and TTY status.
+// In auto mode NoColor turns color off even on a terminal.
 func ShouldColorize(mode ColorMode) bool {
 	switch mode {
 	case ColorAlways:
@@ -43,6 +62,6 @@ func ShouldColorize(mode ColorMode) bool {
 	case ColorNever:
 		return false
 	default: // ColorAuto
-		return IsTerminal(os.Stdout.Fd())
+		return !NoColor() && IsTerminal(os.Stdout.Fd())
 	}
 }
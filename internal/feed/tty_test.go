@@ -72,3 +72,26 @@ func TestDefaultTerminalWidth(t *testing.T) {
 		t.Errorf("DefaultTerminalWidth = %d, want <= 200", DefaultTerminalWidth)
 	}
 }
+
+func TestNoColor_Env(t *testing.T) {
+	t.Setenv(NoColorEnv, "")
+	if NoColor() {
+		t.Error("NoColor() should be false when NO_COLOR is empty")
+	}
+	t.Setenv(NoColorEnv, "1")
+	if !NoColor() {
+		t.Error("NoColor() should be true when NO_COLOR is set")
+	}
+	if ShouldColorize(ColorAuto) {
+		t.Error("ShouldColorize(ColorAuto) should respect NO_COLOR")
+	}
+}
+
+func TestSetNoColor(t *testing.T) {
+	t.Setenv(NoColorEnv, "")
+	SetNoColor(true)
+	t.Cleanup(func() { SetNoColor(false) })
+	if !NoColor() {
+		t.Error("NoColor() should be true after SetNoColor(true)")
+	}
+}
@@ -2,9 +2,10 @@ package feed
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
+	"math"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/muesli/reflow/truncate"
 
 	"github.com/dreamiurg/smoke/internal/config"
+	"github.com/dreamiurg/smoke/internal/logging"
 )
 
 // Help overlay dimensions
@@ -33,33 +35,103 @@ type Model struct {
 	initialScrollDone bool // Track if initial scroll position has been set
 	width             int
 	height            int
-	store             *Store
+	store             Store
+	cache             *feedCache // incremental reads of a FileStore; nil otherwise
+	lineCounts        *lineCountCache
 	config            *config.TUIConfig
-	pressure          int // Current pressure level (0-4)
+	pressure          int // Current pressure level (0-10)
 	version           string
-	nudgeCount        int // Nudges since last mark-read
-	unreadAgentCount  int // Unique agents in unread posts
-	err               error
+	maxReplies        int    // Max replies shown per thread (0 = all)
+	plain             bool   // Screen-reader friendly rendering
+	identity          string // Current identity, for emphasizing its mentions
+	nudgeCount        int    // Nudges since last mark-read
+	unreadAgentCount  int    // Unique agents in unread posts
 	// Unread tracking fields
 	lastReadPostID string // Post ID marking read/unread boundary (set at TUI start)
 	unreadCount    int    // Count of unread posts (for status bar display)
 	lastReadAt     time.Time
+	lastReadPostAt time.Time // Creation time of the last-read post; posts after it are unread
+
+	// Saved position (unless config.RememberPosition is turned off)
+	savedPostID      string
+	savedAtBottom    bool
+	positionRestored bool
+
+	// Pressure schedule: pressureConfig supplies the schedule; once the user
+	// adjusts pressure with +/-, pressureOverridden keeps their choice.
+	pressureConfig     *config.SuggestConfig
+	pressureOverridden bool
 
 	// Cursor selection state
 	selectedPostIndex int     // Index of selected post in displayedPosts
 	displayedPosts    []*Post // Posts in display order
 
 	// Copy menu state
-	showCopyMenu     bool   // Whether copy menu is visible
-	copyMenuIndex    int    // Currently highlighted menu option (0-2)
-	copyConfirmation string // Confirmation message after copy
+	showCopyMenu  bool // Whether copy menu is visible
+	copyMenuIndex int  // Currently highlighted menu option (0-3)
+
+	// Emoji picker state
+	showReactMenu  bool // Whether the emoji picker is visible
+	reactMenuIndex int  // Highlighted index into ReactionEmojis
+
+	// showWhosWho shows the author legend overlay
+	showWhosWho bool
 
 	// Delete confirmation state
 	deleteArmed  bool
 	deletePostID string
-	deleteNotice string
+
+	// Status bar notices (copy/delete feedback, errors), oldest first
+	notices []notice
+
+	// Notifications for posts by config.NotifyAuthors
+	notifySeen  map[string]bool // post IDs already checked, so a post rings once per run
+	notifyUntil time.Time       // header flashes until then
+
+	// Search state: searchActive while typing at the / prompt; a non-empty
+	// searchQuery filters displayedPosts, and searchMatches indexes them.
+	searchQuery   string
+	searchActive  bool
+	searchMatches []int
+
+	// tagFilter limits the feed to threads using this normalized hashtag
+	tagFilter string
+
+	// focusThreadID limits the feed to the thread rooted at this post
+	focusThreadID string
+
+	// Bookmarked post IDs; showBookmarksOnly limits the feed to them
+	bookmarks         map[string]bool
+	showBookmarksOnly bool
+
+	// showMuted keeps posts by muted authors visible
+	showMuted bool
+
+	// Posts rendering to more than collapseLines lines (0 = never) are cut
+	// short unless their ID is in expanded
+	collapseLines int
+	expanded      map[string]bool
+
+	// draftCount is the number of posts queued in drafts.jsonl
+	draftCount int
+}
+
+// notice is a transient status bar message that clears itself after expires.
+type notice struct {
+	text    string
+	isError bool
+	expires time.Time
 }
 
+const (
+	noticeTTL      = 3 * time.Second
+	notifyFlashTTL = 2 * time.Second
+	errorNoticeTTL = 6 * time.Second
+	maxNotices     = 3
+
+	deleteArmedNotice = "Press d again to delete"
+)
+
 // tickMsg is sent every 5 seconds for auto-refresh
 type tickMsg time.Time
 
@@ -69,7 +141,9 @@ type clockTickMsg time.Time
 // loadPostsMsg is sent when posts are loaded
 type loadPostsMsg struct {
 	posts      []*Post
+	muted      []string
 	nudgeCount int
+	draftCount int
 	err        error
 }
 
@@ -89,12 +163,21 @@ type overlayBox struct {
 
 // ModelOptions bundles dependencies for creating a new TUI model.
 type ModelOptions struct {
-	Store    *Store
+	Store    Store
 	Theme    *Theme
 	Contrast *ContrastLevel
 	Layout   *LayoutStyle
 	Config   *config.TUIConfig
 	Version  string
+	// MaxReplies caps replies shown per thread (0 = all). Kept separate from
+	// Config so a --max-replies override is never saved back to tui.yaml.
+	MaxReplies int
+	// Plain renders labeled lines without borders, colors, or overlays.
+	Plain bool
+	// Identity is the current user's identity; mentions of it are emphasized.
+	Identity string
+	// ShowMuted shows posts by muted authors instead of hiding them.
+	ShowMuted bool
 }
 
 // NewModel creates a new TUI model with the given options.
@@ -103,23 +186,47 @@ func NewModel(opts ModelOptions) Model {
 	state, err := config.LoadReadState()
 	lastReadID := ""
 	lastReadAt := time.Time{}
+	lastReadPostAt := time.Time{}
 	if err == nil && state != nil {
 		lastReadID = state.LastReadPostID
 		lastReadAt = state.Updated
+		lastReadPostAt = state.LastReadAt
 	}
 
-	return Model{
+	pressureConfig := config.LoadSuggestConfig()
+
+	m := Model{
 		theme:          opts.Theme,
 		contrast:       opts.Contrast,
 		layout:         opts.Layout,
 		autoRefresh:    opts.Config.AutoRefresh,
 		store:          opts.Store,
 		config:         opts.Config,
-		pressure:       config.GetPressure(),
+		pressure:       pressureConfig.PressureAt(time.Now()),
+		pressureConfig: pressureConfig,
 		version:        opts.Version,
+		maxReplies:     opts.MaxReplies,
+		plain:          opts.Plain,
+		identity:       opts.Identity,
+		showMuted:      opts.ShowMuted,
 		lastReadPostID: lastReadID,
 		lastReadAt:     lastReadAt,
+		lastReadPostAt: lastReadPostAt,
+		lineCounts:     newLineCountCache(),
+		collapseLines:  opts.Config.CollapseThreshold(),
+		expanded:       make(map[string]bool),
+	}
+
+	if fs, ok := opts.Store.(*FileStore); ok {
+		m.cache = newFeedCache(fs)
+	}
+
+	if opts.Config.PositionRemembered() && err == nil && state != nil {
+		m.savedPostID = state.SelectedPostID
+		m.savedAtBottom = state.AtBottom
 	}
+
+	return m
 }
 
 // tickCmd returns a command that ticks every 5 seconds for auto-refresh
@@ -138,37 +245,23 @@ func clockTickCmd() tea.Cmd {
 
 // loadPostsCmd loads posts from the store
 func (m Model) loadPostsCmd() tea.Msg {
-	posts, err := m.store.ReadAll()
-	nudgeCount := countAgentNudgesSince(m.lastReadAt)
-	return loadPostsMsg{posts: posts, nudgeCount: nudgeCount, err: err}
-}
-
-type logEntry struct {
-	Time string          `json:"time"`
-	Msg  string          `json:"msg"`
-	Cmd  json.RawMessage `json:"cmd"`
-	Ctx  logCtx          `json:"ctx"`
-}
-
-type logCtx struct {
-	Env    string `json:"env"`
-	Agent  string `json:"agent"`
-	Caller string `json:"caller"`
-}
-
-func parseCmdName(raw json.RawMessage) string {
-	var s string
-	if json.Unmarshal(raw, &s) == nil {
-		return s
+	var posts []*Post
+	var err error
+	if m.cache != nil {
+		posts, err = m.cache.ReadAll()
+	} else {
+		posts, err = m.store.ReadAll()
 	}
-	var obj struct{ Name string }
-	if json.Unmarshal(raw, &obj) == nil {
-		return obj.Name
+	var muted []string
+	if !m.showMuted {
+		muted = config.GetMutedAuthors()
 	}
-	return ""
+	nudgeCount := CountAgentNudgesSince(m.lastReadAt)
+	drafts, _ := config.LoadDrafts()
+	return loadPostsMsg{posts: posts, muted: muted, nudgeCount: nudgeCount, draftCount: len(drafts), err: err}
 }
 
-func isAgentSuggestEntry(ctx logCtx) bool {
+func isAgentSuggestEntry(ctx logging.EntryContext) bool {
 	switch strings.ToLower(ctx.Caller) {
 	case "claude", "codex", "gemini":
 		return true
@@ -180,19 +273,8 @@ func isAgentSuggestEntry(ctx logCtx) bool {
 	return ctx.Env == "claude_code"
 }
 
-func isEntryAfter(e logEntry, since time.Time) bool {
-	if since.IsZero() {
-		return true
-	}
-	if e.Time == "" {
-		return false
-	}
-	ts, err := time.Parse(time.RFC3339Nano, e.Time)
-	return err == nil && !ts.Before(since)
-}
-
-// countAgentNudgesSince counts suggest commands from agent sessions in smoke.log after a timestamp.
-func countAgentNudgesSince(since time.Time) int {
+// CountAgentNudgesSince counts suggest commands from agent sessions in smoke.log after a timestamp.
+func CountAgentNudgesSince(since time.Time) int {
 	logPath, err := config.GetLogPath()
 	if err != nil {
 		return 0
@@ -205,23 +287,14 @@ func countAgentNudgesSince(since time.Time) int {
 	defer func() { _ = f.Close() }()
 
 	count := 0
+	filter := logging.EntryFilter{Command: "suggest", Since: since}
 	scanner := bufio.NewScanner(f)
 	for scanner.Scan() {
-		line := scanner.Text()
-		if line == "" {
-			continue
-		}
-		var e logEntry
-		if json.Unmarshal([]byte(line), &e) != nil {
+		e, ok := logging.ParseEntry(scanner.Bytes())
+		if !ok || !e.IsCommandStart() || !filter.Match(e) {
 			continue
 		}
-		if !isEntryAfter(e, since) {
-			continue
-		}
-		if e.Msg != "command started" && e.Msg != "command invoked" {
-			continue
-		}
-		if parseCmdName(e.Cmd) == "suggest" && isAgentSuggestEntry(e.Ctx) {
+		if isAgentSuggestEntry(e.Ctx) {
 			count++
 		}
 	}
@@ -249,12 +322,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return m.handleKeyMsg(msg)
+	case tea.MouseMsg:
+		return m, m.handleMouseMsg(msg)
 	case tea.WindowSizeMsg:
 		m = m.handleWindowSizeMsg(msg)
 		return m, nil
 	case tickMsg:
 		return m.handleTickMsg()
 	case clockTickMsg:
+		m.pruneNotices(time.Time(msg))
+		m.applyPressureSchedule(time.Time(msg))
 		return m, clockTickCmd()
 	case loadPostsMsg:
 		return m.handleLoadPostsMsg(msg)
@@ -264,6 +341,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.searchActive {
+		return m, m.handleSearchInputKey(msg)
+	}
 	if cmd, handled := m.handleOverlayKey(msg); handled {
 		return m, cmd
 	}
@@ -276,6 +356,24 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if cmd, handled := m.handleNavigationKeys(msg); handled {
 		return m, cmd
 	}
+	if cmd, handled := m.handleSearchKeys(msg); handled {
+		return m, cmd
+	}
+	if cmd, handled := m.handleFocusKey(msg); handled {
+		return m, cmd
+	}
+	if cmd, handled := m.handleTagKey(msg); handled {
+		return m, cmd
+	}
+	if cmd, handled := m.handleBookmarkKeys(msg); handled {
+		return m, cmd
+	}
+	if cmd, handled := m.handleExpandKey(msg); handled {
+		return m, cmd
+	}
+	if cmd, handled := m.handleMuteKey(msg); handled {
+		return m, cmd
+	}
 	if cmd, handled := m.handleLayoutKeys(msg); handled {
 		return m, cmd
 	}
@@ -285,6 +383,12 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if cmd, handled := m.handleCopyKey(msg); handled {
 		return m, cmd
 	}
+	if cmd, handled := m.handleSaveCardKey(msg); handled {
+		return m, cmd
+	}
+	if cmd, handled := m.handleReactKey(msg); handled {
+		return m, cmd
+	}
 	if cmd, handled := m.handleDeleteKey(msg); handled {
 		return m, cmd
 	}
@@ -294,6 +398,9 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if cmd, handled := m.handleReadKey(msg); handled {
 		return m, cmd
 	}
+	if cmd, handled := m.handleWhosWhoKey(msg); handled {
+		return m, cmd
+	}
 	if cmd, handled := m.handleHelpKey(msg); handled {
 		return m, cmd
 	}
@@ -309,28 +416,97 @@ func (m *Model) handleOverlayKey(msg tea.KeyMsg) (tea.Cmd, bool) {
 	if m.showCopyMenu {
 		return m.handleCopyMenuKey(msg), true
 	}
+	if m.showReactMenu {
+		return m.handleReactMenuKey(msg), true
+	}
+	if m.showWhosWho {
+		m.showWhosWho = false
+		return nil, true
+	}
 	return nil, false
 }
 
 func (m *Model) clearTransientKeyState(msg tea.KeyMsg) {
-	m.copyConfirmation = ""
-	m.deleteNotice = ""
-	if msg.String() != "d" {
+	if msg.String() != "d" && m.deleteArmed {
 		m.deleteArmed = false
 		m.deletePostID = ""
+		m.dropNotice(deleteArmedNotice)
+	}
+}
+
+// pushNotice queues a status bar notice that expires after noticeTTL.
+// The oldest notice is dropped once maxNotices are queued.
+func (m *Model) pushNotice(text string) {
+	m.queueNotice(notice{text: text, expires: time.Now().Add(noticeTTL)})
+}
+
+// reportError queues an error notice. Nil errors are ignored so callers can
+// pass the result of a save directly.
+func (m *Model) reportError(err error) {
+	if err == nil {
+		return
+	}
+	m.queueNotice(notice{text: err.Error(), isError: true, expires: time.Now().Add(errorNoticeTTL)})
+}
+
+func (m *Model) queueNotice(n notice) {
+	// Re-pushing the same text refreshes its expiry instead of duplicating it
+	m.dropNotice(n.text)
+	m.notices = append(m.notices, n)
+	if len(m.notices) > maxNotices {
+		m.notices = m.notices[len(m.notices)-maxNotices:]
+	}
+	m.disarmStaleDelete()
+}
+
+func (m *Model) dropNotice(text string) {
+	kept := m.notices[:0]
+	for _, n := range m.notices {
+		if n.text != text {
+			kept = append(kept, n)
+		}
+	}
+	m.notices = kept
+}
+
+// pruneNotices removes notices that have expired as of now.
+func (m *Model) pruneNotices(now time.Time) {
+	kept := m.notices[:0]
+	for _, n := range m.notices {
+		if now.Before(n.expires) {
+			kept = append(kept, n)
+		}
+	}
+	m.notices = kept
+	m.disarmStaleDelete()
+}
+
+// disarmStaleDelete cancels a pending delete once its prompt has expired or
+// been pushed out, so a later d asks again instead of deleting.
+func (m *Model) disarmStaleDelete() {
+	if !m.deleteArmed {
+		return
 	}
+	for _, n := range m.notices {
+		if n.text == deleteArmedNotice {
+			return
+		}
+	}
+	m.deleteArmed = false
+	m.deletePostID = ""
 }
 
 func (m *Model) handleGlobalKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
 	switch msg.String() {
 	case "q", "ctrl+c":
+		m.savePosition()
 		return tea.Quit, true
 	case "r":
 		return m.loadPostsCmd, true
 	case "a":
 		m.autoRefresh = !m.autoRefresh
 		m.config.AutoRefresh = m.autoRefresh
-		m.err = config.SaveTUIConfig(m.config)
+		m.reportError(config.SaveTUIConfig(m.config))
 		if m.autoRefresh {
 			return tickCmd(), true
 		}
@@ -374,12 +550,12 @@ func (m *Model) handleLayoutKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
 	case "l":
 		m.config.Layout = NextLayout(m.config.Layout)
 		m.layout = GetLayout(m.config.Layout)
-		m.err = config.SaveTUIConfig(m.config)
+		m.reportError(config.SaveTUIConfig(m.config))
 		return nil, true
 	case "L":
 		m.config.Layout = PrevLayout(m.config.Layout)
 		m.layout = GetLayout(m.config.Layout)
-		m.err = config.SaveTUIConfig(m.config)
+		m.reportError(config.SaveTUIConfig(m.config))
 		return nil, true
 	}
 	return nil, false
@@ -390,12 +566,33 @@ func (m *Model) handleThemeKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
 	case "t":
 		m.config.Theme = NextTheme(m.config.Theme)
 		m.theme = GetTheme(m.config.Theme)
-		m.err = config.SaveTUIConfig(m.config)
+		m.reportError(config.SaveTUIConfig(m.config))
 		return nil, true
 	case "T":
 		m.config.Theme = PrevTheme(m.config.Theme)
 		m.theme = GetTheme(m.config.Theme)
-		m.err = config.SaveTUIConfig(m.config)
+		m.reportError(config.SaveTUIConfig(m.config))
+		return nil, true
+	case "y", "Y":
+		if msg.String() == "y" {
+			m.config.Contrast = NextContrastLevel(m.config.Contrast)
+		} else {
+			m.config.Contrast = PrevContrastLevel(m.config.Contrast)
+		}
+		m.contrast = GetContrastLevel(m.config.Contrast)
+		m.reportError(config.SaveTUIConfig(m.config))
+		m.pushNotice("Contrast: " + m.contrast.DisplayName)
+		return nil, true
+	case "z":
+		m.config.TimeFormat = NextTimestampFormat(m.config.TimeFormat)
+		m.reportError(config.SaveTUIConfig(m.config))
+		m.pushNotice("Timestamps: " + timestampFormatLabel(m.config.TimeFormat))
+		return nil, true
+	case "D":
+		m.config.Appearance = NextAppearance(m.config.Appearance)
+		ApplyAppearance(m.config.Appearance)
+		m.reportError(config.SaveTUIConfig(m.config))
+		m.pushNotice("Appearance: " + appearanceLabel(m.config.Appearance))
 		return nil, true
 	}
 	return nil, false
@@ -412,24 +609,56 @@ func (m *Model) handleCopyKey(msg tea.KeyMsg) (tea.Cmd, bool) {
 	return nil, true
 }
 
+// handleSaveCardKey saves the selected post's share card under
+// ~/smoke-cards, for terminals where the clipboard is unavailable.
+func (m *Model) handleSaveCardKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if msg.String() != "s" {
+		return nil, false
+	}
+	m.saveSelectedCard()
+	return nil, true
+}
+
+// saveSelectedCard writes the selected post's square share card to
+// ~/smoke-cards/<id>.png and reports the path.
+func (m *Model) saveSelectedCard() {
+	if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+		m.pushNotice("⚠ No post selected")
+		return
+	}
+	post := m.displayedPosts[m.selectedPostIndex]
+	path, err := config.GetCardPath(post.ID)
+	if err == nil {
+		err = WriteShareCard(path, post, m.theme, SquareImage)
+	}
+	if err != nil {
+		m.reportError(err)
+		return
+	}
+	m.pushNotice("✓ Saved " + path)
+}
+
 func (m *Model) handleDeleteKey(msg tea.KeyMsg) (tea.Cmd, bool) {
 	if msg.String() != "d" {
 		return nil, false
 	}
+	// The clock tick may not have pruned an expired prompt yet
+	m.pruneNotices(time.Now())
 	if len(m.displayedPosts) == 0 || m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
-		m.deleteNotice = "⚠ No post selected"
+		m.pushNotice("⚠ No post selected")
 		return nil, true
 	}
 	post := m.displayedPosts[m.selectedPostIndex]
 	if post == nil {
-		m.deleteNotice = "⚠ No post selected"
+		m.pushNotice("⚠ No post selected")
 		return nil, true
 	}
 	if m.deleteArmed && m.deletePostID == post.ID {
+		m.dropNotice(deleteArmedNotice)
 		if err := m.store.DeleteByID(post.ID); err != nil {
-			m.deleteNotice = "⚠ Delete failed"
+			m.pushNotice("⚠ Delete failed")
 		} else {
-			m.deleteNotice = "✓ Deleted post"
+			m.pushNotice("✓ Deleted post")
 			m.deleteArmed = false
 			m.deletePostID = ""
 			return m.loadPostsCmd, true
@@ -438,43 +667,120 @@ func (m *Model) handleDeleteKey(msg tea.KeyMsg) (tea.Cmd, bool) {
 	}
 	m.deleteArmed = true
 	m.deletePostID = post.ID
-	m.deleteNotice = "Press d again to delete"
+	m.pushNotice(deleteArmedNotice)
 	return nil, true
 }
 
 func (m *Model) handlePressureKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
 	switch msg.String() {
 	case "+", "=":
-		if m.pressure < 4 {
-			m.pressure++
-			m.err = config.SetPressure(m.pressure)
-		}
+		m.adjustPressure(1)
 		return nil, true
 	case "-":
-		if m.pressure > 0 {
-			m.pressure--
-			m.err = config.SetPressure(m.pressure)
-		}
+		m.adjustPressure(-1)
 		return nil, true
 	}
 	return nil, false
 }
 
+// adjustPressure moves pressure one step up (+1) or down (-1) within 0-10 and saves it.
+// The manual value wins over the pressure schedule for the rest of the session.
+func (m *Model) adjustPressure(delta int) {
+	next := m.pressure + delta
+	if next < 0 || next > config.MaxPressure {
+		return
+	}
+	m.pressure = next
+	m.pressureOverridden = true
+	m.reportError(config.SetPressure(m.pressure))
+}
+
+// applyPressureSchedule follows the configured pressure schedule as the clock
+// moves, unless the user has set pressure manually this session.
+func (m *Model) applyPressureSchedule(now time.Time) {
+	if m.pressureOverridden || m.pressureConfig == nil {
+		return
+	}
+	m.pressure = m.pressureConfig.PressureAt(now)
+}
+
 func (m *Model) handleReadKey(msg tea.KeyMsg) (tea.Cmd, bool) {
-	if msg.String() != " " && msg.String() != "space" {
-		return nil, false
+	switch msg.String() {
+	case " ", "space":
+		if post := m.selectedPost(); post != nil {
+			createdAt, _ := post.GetCreatedTime()
+			m.markReadTo(post.ID, createdAt)
+		}
+		return nil, true
+	case "A":
+		if post := m.newestTopLevelPost(); post != nil {
+			createdAt, _ := post.GetCreatedTime()
+			if m.markReadTo(post.ID, createdAt) {
+				m.pushNotice("Marked all read")
+			}
+		}
+		return nil, true
+	case "u":
+		if post := m.selectedPost(); post != nil {
+			id, createdAt := m.boundaryBefore(post)
+			if m.markReadTo(id, createdAt) {
+				m.pushNotice("Marked unread from here")
+			}
+		}
+		return nil, true
 	}
-	if len(m.displayedPosts) > 0 && m.selectedPostIndex >= 0 && m.selectedPostIndex < len(m.displayedPosts) {
-		postID := m.displayedPosts[m.selectedPostIndex].ID
-		if err := config.SaveLastReadPostID(postID); err == nil {
-			m.lastReadPostID = postID
-			m.lastReadAt = time.Now()
-			m.updateUnreadStats(0)
-		} else {
-			m.err = err
+	return nil, false
+}
+
+// selectedPost returns the selected post, or nil if nothing is selected.
+func (m Model) selectedPost() *Post {
+	if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+		return nil
+	}
+	return m.displayedPosts[m.selectedPostIndex]
+}
+
+// markReadTo saves postID as the last read post and moves the read boundary
+// to createdAt, reporting whether it was saved.
+func (m *Model) markReadTo(postID string, createdAt time.Time) bool {
+	if err := config.SaveLastRead(postID, createdAt); err != nil {
+		m.reportError(err)
+		return false
+	}
+	m.lastReadPostID = postID
+	m.lastReadPostAt = createdAt
+	m.lastReadAt = time.Now()
+	m.updateUnreadStats(0)
+	return true
+}
+
+// newestTopLevelPost returns the newest top-level post in the feed, ignoring
+// search and filters, or nil for an empty feed.
+func (m Model) newestTopLevelPost() *Post {
+	for i := len(m.posts) - 1; i >= 0; i-- {
+		if m.posts[i].ParentID == "" {
+			return m.posts[i]
 		}
 	}
-	return nil, true
+	return nil
+}
+
+// boundaryBefore returns the read boundary that makes post and everything
+// after it unread: the top-level post before it in the feed, or for the
+// first post, a boundary just before its creation time.
+func (m Model) boundaryBefore(post *Post) (string, time.Time) {
+	createdAt, _ := post.GetCreatedTime()
+	for i := len(m.posts) - 1; i >= 0; i-- {
+		prev := m.posts[i]
+		if prev.ParentID != "" || prev.ID == post.ID {
+			continue
+		}
+		t, err := prev.GetCreatedTime()
+		if err == nil && t.Before(createdAt) {
+			return prev.ID, t
+		}
+	}
+	return post.ID, createdAt.Add(-time.Nanosecond)
 }
 
 func (m *Model) handleHelpKey(msg tea.KeyMsg) (tea.Cmd, bool) {
@@ -489,7 +795,7 @@ func (m Model) handleWindowSizeMsg(msg tea.WindowSizeMsg) Model {
 	m.width = msg.Width
 	m.height = msg.Height
 	if !m.initialScrollDone && len(m.posts) > 0 {
-		m.ensureSelectedVisibleWithUnread()
+		m.applyInitialScroll()
 		m.initialScrollDone = true
 	}
 	return m
@@ -511,26 +817,114 @@ func (m Model) handleLoadPostsMsg(msg loadPostsMsg) (tea.Model, tea.Cmd) {
 	oldMaxOffset := m.maxScrollOffset()
 	wasAtBottom := m.scrollOffset >= oldMaxOffset
 	m.posts = msg.posts
+	m.resolveLastReadTime()
+	// Bookmarks see every post, so muting never prunes them
+	m.loadBookmarks()
+	m.posts = ApplyMutes(m.posts, msg.muted)
 	m.updateDisplayedPosts()
 	m.updateUnreadStats(msg.nudgeCount)
+	m.draftCount = msg.draftCount
 
 	m.initSelectionIfNeeded()
 	m.autoScrollIfNeeded(oldCount, wasAtBottom)
 
-	return m, nil
+	return m, m.checkNotifications(time.Now())
+}
+
+// checkNotifications rings the bell and flashes the header when a post by a
+// watched author arrives. Posts present at the first load never ring.
+func (m *Model) checkNotifications(now time.Time) tea.Cmd {
+	firstLoad := m.notifySeen == nil
+	if firstLoad {
+		m.notifySeen = make(map[string]bool, len(m.posts))
+	}
+	var authors []string
+	for _, post := range m.posts {
+		if m.notifySeen[post.ID] {
+			continue
+		}
+		m.notifySeen[post.ID] = true
+		if firstLoad || post.Author == m.identity || !IsMutedAuthor(post.Author, m.config.NotifyAuthors) {
+			continue
+		}
+		if !slices.Contains(authors, post.Author) {
+			authors = append(authors, post.Author)
+		}
+	}
+	if len(authors) == 0 {
+		return nil
+	}
+	m.notifyUntil = now.Add(notifyFlashTTL)
+	m.pushNotice("New post from " + strings.Join(authors, ", "))
+	return ringBell
+}
+
+// ringBell sounds the terminal bell.
+func ringBell() tea.Msg {
+	_, _ = os.Stdout.WriteString("\a")
+	return nil
+}
+
+// notifyFlashing reports whether the header is flashing for a notification.
+func (m Model) notifyFlashing(now time.Time) bool {
+	return now.Before(m.notifyUntil)
 }
 
 func (m *Model) initSelectionIfNeeded() {
 	if m.initialScrollDone || len(m.posts) == 0 {
 		return
 	}
-	m.initSelectionToUnread()
+	m.positionRestored = m.restoreSavedSelection()
+	if !m.positionRestored {
+		m.initSelectionToUnread()
+	}
 	if m.height > 0 {
-		m.ensureSelectedVisibleWithUnread()
+		m.applyInitialScroll()
 		m.initialScrollDone = true
 	}
 }
 
+// applyInitialScroll scrolls to the restored position, or to the unread
+// boundary when no saved position was applied.
+func (m *Model) applyInitialScroll() {
+	if !m.positionRestored {
+		m.ensureSelectedVisibleWithUnread()
+		return
+	}
+	if m.savedAtBottom {
+		m.scrollOffset = m.maxScrollOffset()
+		return
+	}
+	m.ensureSelectedVisible()
+}
+
+// restoreSavedSelection selects the post saved on the last quit.
+// Returns false if nothing was saved or the post no longer exists.
+func (m *Model) restoreSavedSelection() bool {
+	if m.savedPostID == "" {
+		return false
+	}
+	for i, post := range m.displayedPosts {
+		if post.ID == m.savedPostID {
+			m.selectedPostIndex = i
+			return true
+		}
+	}
+	return false
+}
+
+// savePosition persists the selected post in the read state on quit unless
+// remember_position is turned off. Errors are ignored since the TUI is exiting.
+func (m *Model) savePosition() {
+	if m.config == nil || !m.config.PositionRemembered() {
+		return
+	}
+	if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+		return
+	}
+	_ = config.SavePosition(m.displayedPosts[m.selectedPostIndex].ID, m.height > 0 && m.scrollOffset >= m.maxScrollOffset())
+}
+
 func (m *Model) autoScrollIfNeeded(oldCount int, wasAtBottom bool) {
 	if len(m.posts) <= oldCount || m.height <= 0 {
 		return
@@ -545,14 +939,18 @@ func (m Model) View() string {
 	if m.width == 0 || m.height == 0 {
 		return "Initializing...\n"
 	}
+	if m.plain {
+		return m.viewPlain()
+	}
 
 	// Render three sections: header, content, status bar
-	header := m.renderHeader()
-	statusBar := m.renderStatusBar()
-	content := m.renderContentBox()
+	sections := []string{m.renderHeader(), m.renderContentBox()}
+	if m.showStatusBar() {
+		sections = append(sections, m.renderStatusBar())
+	}
 
 	// Use JoinVertical for seamless background colors
-	view := lipgloss.JoinVertical(lipgloss.Left, header, content, statusBar)
+	view := lipgloss.JoinVertical(lipgloss.Left, sections...)
 
 	if m.showHelp {
 		view = m.applyOverlay(view, m.renderHelpOverlayBox())
@@ -560,6 +958,12 @@ func (m Model) View() string {
 	if m.showCopyMenu {
 		view = m.applyOverlay(view, m.renderCopyMenuOverlayBox())
 	}
+	if m.showReactMenu {
+		view = m.applyOverlay(view, m.renderReactMenuOverlayBox())
+	}
+	if m.showWhosWho {
+		view = m.applyOverlay(view, m.renderWhosWhoOverlayBox())
+	}
 
 	return view
 }
@@ -623,14 +1027,14 @@ func overlayLine(base, overlay string, left int, width int) string {
 	return leftPart + overlay + rightPart
 }
 
-// renderPressureIndicator creates a pressure display in the format: (+/-) Pressure [▓▓░░] ⛅
+// renderPressureIndicator creates a pressure display in the format: (+/-) Pressure [▓▓▓▓▓░░░░░] ⛅
 // Uses filled blocks (▓) for active levels and empty blocks (░) for inactive levels.
 func (m Model) renderPressureIndicator() string {
 	level := config.GetPressureLevel(m.pressure)
 
 	// Build visual blocks: filled for active levels, empty for inactive
 	filled := strings.Repeat("▓", m.pressure)
-	empty := strings.Repeat("░", 4-m.pressure)
+	empty := strings.Repeat("░", config.MaxPressure-m.pressure)
 	blocks := "[" + filled + empty + "]"
 
 	// Format: (+/-) Pressure [blocks] emoji
@@ -639,7 +1043,11 @@ func (m Model) renderPressureIndicator() string {
 
 // renderHeader creates the header bar with version, stats, and clock
 func (m Model) renderHeader() string {
-	base := lipgloss.NewStyle().Background(m.theme.BackgroundSecondary)
+	background := m.theme.BackgroundSecondary
+	if m.notifyFlashing(time.Now()) {
+		background = m.theme.UnreadSeparator
+	}
+	base := lipgloss.NewStyle().Background(background)
 	titleStyle := base.Foreground(m.theme.Accent).Bold(true)
 	versionStyle := base.Foreground(m.theme.TextMuted)
 	statsStyle := base.Foreground(m.theme.Text)
@@ -659,6 +1067,9 @@ func (m Model) renderHeader() string {
 
 	statsText := fmt.Sprintf("new %d posts • %d agents • %d nudges",
 		m.unreadCount, m.unreadAgentCount, m.nudgeCount)
+	if m.draftCount > 0 {
+		statsText += fmt.Sprintf(" • %d drafts", m.draftCount)
+	}
 	stats := statsStyle.Render(statsText)
 
 	leftContent := title + base.Render(" ") + version + base.Render("  ") + stats
@@ -676,7 +1087,15 @@ func (m Model) renderHeader() string {
 	return leftContent + gap + rightContent
 }
 
-// renderStatusBar creates the status bar showing settings and keybindings
+// showStatusBar reports whether the status bar takes a row. It can be turned
+// off in tui.yaml, but the search prompt still needs it while typing, and
+// queued notices (such as the delete prompt) still need somewhere to show.
+func (m Model) showStatusBar() bool {
+	return m.searchActive || len(m.notices) > 0 || m.config.StatusBarShown()
+}
+
+// renderStatusBar creates the status bar showing notices, settings, and a
+// compact keybinding legend
 func (m Model) renderStatusBar() string {
 	base := lipgloss.NewStyle().Background(m.theme.BackgroundSecondary)
 	keyStyle := base.Foreground(m.theme.Accent).Bold(true)
@@ -688,46 +1107,81 @@ func (m Model) renderStatusBar() string {
 		width = DefaultTerminalWidth
 	}
 
+	// Legend entries use the help overlay's keys and wording. Help comes
+	// first so it survives trimming at narrow widths.
+	legend := func(key, label, value string) string {
+		item := keyStyle.Render(key) + labelStyle.Render(" "+label)
+		if value != "" {
+			item += labelStyle.Render(" ") + valueStyle.Render(value)
+		}
+		return item
+	}
+
 	autoStr := "OFF"
 	if m.autoRefresh {
 		autoStr = "ON"
 	}
-
-	layoutName := "comfy"
+	layoutName := "Comfy"
 	if m.layout != nil {
-		layoutName = m.layout.Name
+		layoutName = m.layout.DisplayName
 	}
-
-	markValue := "to here"
+	unread := ""
 	if m.unreadCount > 0 {
-		markValue = fmt.Sprintf("to here (%d new)", m.unreadCount)
+		unread = fmt.Sprintf("%d new", m.unreadCount)
 	}
 
 	items := []string{
-		keyStyle.Render("Space") + labelStyle.Render(" Read ") + valueStyle.Render(markValue),
-		keyStyle.Render("c") + labelStyle.Render(" Copy"),
-		keyStyle.Render("r") + labelStyle.Render(" Refresh"),
-		keyStyle.Render("a") + labelStyle.Render(" Auto Refresh ") + valueStyle.Render(autoStr),
-		keyStyle.Render("l/L") + labelStyle.Render(" Layout ") + valueStyle.Render(layoutName),
-		keyStyle.Render("t/T") + labelStyle.Render(" Theme ") + valueStyle.Render(m.theme.Name),
-		keyStyle.Render("?") + labelStyle.Render(" Help"),
-		keyStyle.Render("q") + labelStyle.Render(" Quit"),
+		legend("?", "Help", ""),
+		legend("Space", "Read", unread),
+		legend("c", "Copy", ""),
+		legend("e", "React", ""),
+		legend("r", "Refresh", ""),
+		legend("a", "Auto", autoStr),
+		legend("l/L", "Layout", layoutName),
+		legend("t/T", "Theme", m.theme.DisplayName),
+		legend("q", "Quit", ""),
+	}
+
+	// Newest notice first so it survives fitStatusLine trimming
+	prefixItems := make([]string, 0, len(m.notices))
+	for i := len(m.notices) - 1; i >= 0; i-- {
+		n := m.notices[i]
+		if n.isError {
+			prefixItems = append(prefixItems, keyStyle.Render("!")+
+				labelStyle.Render(" config error"))
+			continue
+		}
+		prefixItems = append(prefixItems, valueStyle.Render(n.text))
 	}
 
-	prefixItems := make([]string, 0, 3)
-	if m.copyConfirmation != "" {
-		prefixItems = append(prefixItems, valueStyle.Render(m.copyConfirmation))
+	if m.searchActive {
+		// The search prompt takes over the status bar while typing
+		prompt := keyStyle.Render("/") + valueStyle.Render(m.searchQuery+"▏") +
+			labelStyle.Render(fmt.Sprintf("  %d matches  Enter jump  Esc cancel", len(m.searchMatches)))
+		return clampStatusLine(fitStatusLine([]string{prompt}, sep, width), width, base)
 	}
-	if m.deleteNotice != "" {
-		prefixItems = append(prefixItems, valueStyle.Render(m.deleteNotice))
+	if m.searchQuery != "" {
+		prefixItems = append(prefixItems, keyStyle.Render("/")+valueStyle.Render(m.searchQuery)+
+			labelStyle.Render(fmt.Sprintf(" %d/%d  n/N next  Esc clear", m.currentMatchNumber(), len(m.searchMatches))))
 	}
-	if m.err != nil {
-		prefixItems = append(prefixItems, keyStyle.Render("!")+
-			labelStyle.Render(" config error"))
+	if m.focusThreadID != "" {
+		prefixItems = append(prefixItems, keyStyle.Render("f")+
+			labelStyle.Render(fmt.Sprintf(" thread focus %d replies  Esc show all", m.focusReplyCount())))
+	}
+	if m.tagFilter != "" {
+		prefixItems = append(prefixItems, keyStyle.Render("#")+valueStyle.Render(m.tagFilter)+
+			labelStyle.Render(fmt.Sprintf(" %d posts  # next tag  Esc clear", len(m.displayedPosts))))
+	}
+	if m.showBookmarksOnly {
+		prefixItems = append(prefixItems, keyStyle.Render(BookmarkGlyph)+
+			labelStyle.Render(fmt.Sprintf(" %d bookmarked  B show all", len(m.displayedPosts))))
 	}
 
 	allItems := append([]string{}, prefixItems...)
-	allItems = append(allItems, items...)
+	if m.config.StatusBarShown() {
+		// A hidden status bar only comes back for notices, without the legend
+		allItems = append(allItems, items...)
+	}
 	statusText := fitStatusLine(allItems, sep, width)
 	statusText = clampStatusLine(statusText, width, base)
 	return statusText
@@ -787,7 +1241,10 @@ func (m Model) contentWidth() int {
 
 // contentHeight returns the available height inside the content border.
 func (m Model) contentHeight() int {
-	height := m.height - 2 // header + status
+	height := m.height - 1 // header
+	if m.showStatusBar() {
+		height--
+	}
 	if height <= 2 {
 		return 1
 	}
@@ -807,7 +1264,7 @@ func (m Model) renderContentBox() string {
 	return boxStyle.Render(content)
 }
 
-// buildAllContentLines builds all content lines for the feed (used for scrolling)
+// buildAllContentLines renders the text of every content line in the feed.
 func (m Model) buildAllContentLines() []string {
 	contentLines := m.buildAllContentLinesWithPosts()
 	lines := make([]string, len(contentLines))
@@ -852,61 +1309,117 @@ func (m Model) formatDaySeparator(t time.Time) string {
 	return m.formatSeparator(DayLabel(t), m.theme.DaySeparator)
 }
 
+// formatMoreReplies renders the stub shown in place of collapsed replies.
+func (m Model) formatMoreReplies(hidden int) string {
+	style := lipgloss.NewStyle().
+		Foreground(m.theme.TextMuted).
+		Background(m.theme.Background)
+	return m.styleSpace("  ") + style.Render(moreRepliesLabel(hidden))
+}
+
+// showsReplySummary reports whether parents get a reply summary line, which
+// the dense layout leaves out.
+func (m Model) showsReplySummary() bool {
+	return m.layout == nil || m.layout.Name != "dense"
+}
+
+// formatReplySummary renders "· 3 replies · last 5m ago" under a thread's
+// parent post, from the replies already grouped by buildThreads.
+func (m Model) formatReplySummary(t thread) string {
+	summary := "· 1 reply"
+	if len(t.replies) != 1 {
+		summary = fmt.Sprintf("· %d replies", len(t.replies))
+	}
+	if last := t.lastReplyAt(); !last.IsZero() {
+		summary += " · last " + FormatTimeAgo(last)
+	}
+	style := lipgloss.NewStyle().
+		Foreground(m.theme.TextMuted).
+		Background(m.theme.Background)
+	return m.styleSpace("       ") + style.Render(summary)
+}
+
 // formatUnreadSeparator creates a styled "UNREAD" separator line.
 func (m Model) formatUnreadSeparator() string {
 	return m.formatSeparator("UNREAD", m.theme.UnreadSeparator)
 }
 
-// countUnread counts the number of unread posts based on lastReadPostID.
-// Returns 0 if lastReadPostID is empty (first-time user) or not found.
-func (m Model) countUnread() int {
-	if m.lastReadPostID == "" || len(m.displayedPosts) == 0 {
-		return 0
+// readBoundary returns the read/unread boundary in displayedPosts, or false
+// when there is no read marker (first-time user).
+func (m Model) readBoundary() (ReadBoundary, bool) {
+	if m.lastReadPostID == "" {
+		return ReadBoundary{}, false
 	}
+	b := NewReadBoundary(m.displayedPosts, m.lastReadPostID, m.lastReadPostAt)
+	if b.At.IsZero() && b.Index < 0 {
+		// Legacy read state whose post is gone: fall back to when it was marked
+		b.At = m.lastReadAt
+	}
+	return b, true
+}
 
-	lastReadIndex := -1
+// firstUnreadIndex returns the index of the first unread post in
+// displayedPosts, or -1 if every post is read.
+func (m Model) firstUnreadIndex() int {
+	b, ok := m.readBoundary()
+	if !ok {
+		return -1
+	}
 	for i, post := range m.displayedPosts {
-		if post.ID == m.lastReadPostID {
-			lastReadIndex = i
-			break
+		if b.IsUnread(i, post) {
+			return i
 		}
 	}
-	if lastReadIndex == -1 {
-		return 0
+	return -1
+}
+
+// resolveLastReadTime fills in the last-read post's creation time for read
+// state saved before it was recorded, while that post still exists.
+func (m *Model) resolveLastReadTime() {
+	if m.lastReadPostID == "" || !m.lastReadPostAt.IsZero() {
+		return
+	}
+	for _, post := range m.posts {
+		if post.ID == m.lastReadPostID {
+			m.lastReadPostAt, _ = post.GetCreatedTime()
+			return
+		}
 	}
-	return len(m.displayedPosts) - lastReadIndex - 1
 }
 
-func (m Model) countUnreadAgents() int {
-	if m.lastReadPostID == "" || len(m.displayedPosts) == 0 {
+// countUnread counts the number of unread posts after the read boundary.
+// Returns 0 if lastReadPostID is empty (first-time user).
+func (m Model) countUnread() int {
+	b, ok := m.readBoundary()
+	if !ok {
 		return 0
 	}
-
-	lastReadIndex := -1
+	count := 0
 	for i, post := range m.displayedPosts {
-		if post != nil && post.ID == m.lastReadPostID {
-			lastReadIndex = i
-			break
+		if b.IsUnread(i, post) {
+			count++
 		}
 	}
-	if lastReadIndex == -1 {
+	return count
+}
+
+func (m Model) countUnreadAgents() int {
+	b, ok := m.readBoundary()
+	if !ok {
 		return 0
 	}
-
 	seen := make(map[string]struct{})
-	for i := lastReadIndex + 1; i < len(m.displayedPosts); i++ {
-		post := m.displayedPosts[i]
-		if post == nil {
-			continue
+	for i, post := range m.displayedPosts {
+		if b.IsUnread(i, post) {
+			seen[post.Author] = struct{}{}
 		}
-		seen[post.Author] = struct{}{}
 	}
 	return len(seen)
 }
 
 // maxScrollOffset returns the maximum scroll offset based on content size
 func (m Model) maxScrollOffset() int {
-	allLines := m.buildAllContentLines()
+	allLines := m.contentLayout()
 	availableHeight := m.contentHeight()
 	if availableHeight <= 0 {
 		availableHeight = 1
@@ -920,10 +1433,9 @@ func (m Model) maxScrollOffset() int {
 
 // renderContent renders the feed content area with scroll support
 func (m Model) renderContent(availableHeight, availableWidth int) string {
-	contentLines := m.buildAllContentLinesWithPosts()
-	allLines := flattenContentLines(contentLines)
+	contentLines := m.contentLayout()
 
-	offset := m.clampScrollOffset(len(allLines), availableHeight)
+	offset := m.clampScrollOffset(len(contentLines), availableHeight)
 	markerLine := m.findUnreadMarkerLine(contentLines)
 	unreadAboveCount := countUnreadAbove(contentLines, markerLine, offset)
 
@@ -932,7 +1444,8 @@ func (m Model) renderContent(availableHeight, availableWidth int) string {
 		contentHeight = 1
 	}
 
-	endIdx, unreadBelowCount, contentHeight := m.computeUnreadBelowWindow(contentLines, offset, contentHeight, markerLine, len(allLines))
+	endIdx, unreadBelowCount, contentHeight := m.computeUnreadBelowWindow(contentLines, offset, contentHeight, markerLine, len(contentLines))
+	allLines := flattenContentLines(m.buildContentLines(offset, endIdx))
 	visibleLines := m.buildVisibleLines(allLines, offset, endIdx, contentHeight, unreadAboveCount, unreadBelowCount)
 	styledLines := m.applyContentBackground(visibleLines, availableHeight, availableWidth)
 
@@ -971,22 +1484,13 @@ func (m Model) findUnreadMarkerLine(contentLines []contentLine) int {
 			return i
 		}
 	}
-	if m.lastReadPostID == "" {
-		return -1
-	}
-	lastReadIndex := -1
-	for i, post := range m.displayedPosts {
-		if post.ID == m.lastReadPostID {
-			lastReadIndex = i
-			break
-		}
-	}
-	if lastReadIndex < 0 {
+	firstUnread := m.firstUnreadIndex()
+	if firstUnread <= 0 {
 		return -1
 	}
 	lastLine := -1
 	for i, cl := range contentLines {
-		if cl.postIndex == lastReadIndex {
+		if cl.postIndex == firstUnread-1 {
 			lastLine = i
 		}
 	}
@@ -1148,10 +1652,19 @@ func (m Model) formatPost(post *Post) []string {
 // formatPostWithBackground formats a post with a custom background.
 // When selected is true, timestamp uses accent color for stronger highlight.
 func (m Model) formatPostWithBackground(post *Post, background lipgloss.AdaptiveColor, selected bool) []string {
-	if m.layout == nil {
-		return m.formatPostComfyWithBackground(post, background, selected)
+	lines := m.formatPostLayout(post, background, selected)
+	lines = m.collapsePostLines(lines, post, background)
+	return m.appendPostMarks(lines, post, background)
+}
+
+// formatPostLayout renders a post's header and content in the current
+// layout, before collapsing and marks.
+func (m Model) formatPostLayout(post *Post, background lipgloss.AdaptiveColor, selected bool) []string {
+	layoutName := ""
+	if m.layout != nil {
+		layoutName = m.layout.Name
 	}
-	switch m.layout.Name {
+	switch layoutName {
 	case "dense":
 		return m.formatPostDenseWithBackground(post, background, selected)
 	case "relaxed":
@@ -1161,15 +1674,57 @@ func (m Model) formatPostWithBackground(post *Post, background lipgloss.Adaptive
 	}
 }
 
-// formatReply formats a reply (indented post)
+// appendPostMarks adds a star to bookmarked posts and a muted "(edited)" to
+// edited ones after the content, on their own line when the last content
+// line has no room left.
+func (m Model) appendPostMarks(lines []string, post *Post, background lipgloss.AdaptiveColor) []string {
+	if len(lines) == 0 {
+		return lines
+	}
+	var marks []string
+	if m.bookmarks[post.ID] {
+		marks = append(marks, lipgloss.NewStyle().
+			Foreground(m.theme.Accent).
+			Background(background).
+			Render(BookmarkGlyph))
+	}
+	if post.EditedAt != "" {
+		marks = append(marks, lipgloss.NewStyle().
+			Foreground(m.theme.TextMuted).
+			Background(background).
+			Italic(true).
+			Render("(edited)"))
+	}
+	if len(marks) == 0 {
+		return lines
+	}
+	tag := strings.Join(marks, m.styleSpaceWithBackground(" ", background))
+
+	width := m.contentWidth()
+	if width <= 0 {
+		width = DefaultTerminalWidth
+	}
+	last := len(lines) - 1
+	if lipgloss.Width(lines[last])+1+lipgloss.Width(tag) <= width {
+		lines[last] += m.styleSpaceWithBackground(" ", background) + tag
+		return lines
+	}
+	return append(lines, tag)
+}
+
+// formatReply formats a reply (indented post). Nested replies indent further
+// per level, up to MaxReplyIndentDepth, and wrap to the width left over.
 func (m Model) formatReply(reply *Post) []string {
-	lines := m.formatPost(reply)
+	indent := replyIndent(reply.Depth)
+	narrow := m
+	narrow.width = m.width - len(indent) - 5
+	lines := narrow.formatPost(reply)
 	indented := make([]string, len(lines))
 	for i, line := range lines {
 		if i == 0 {
-			indented[i] = m.styleSpace("  └─ ") + line
+			indented[i] = m.styleSpace("  "+indent+"└─ ") + line
 		} else {
-			indented[i] = m.styleSpace("     ") + line
+			indented[i] = m.styleSpace(indent+"     ") + line
 		}
 	}
 	return indented
@@ -1188,12 +1743,13 @@ func (m Model) formatPostDenseWithBackground(post *Post, background lipgloss.Ada
 		termWidth = DefaultTerminalWidth
 	}
 
-	timeStr := m.styleTimestampWithBackground(formatTimestamp(post), background, selected)
+	timestamp := m.postTimestamp(post)
+	timeStr := m.styleTimestampWithBackground(timestamp, background, selected)
 	identity := m.styleIdentityWithBackground(post, background)
 
 	// Build prefix with styled spaces to avoid black gaps: "HH:MM author: "
 	prefix := timeStr + m.styleSpaceWithBackground(" ", background) + identity + m.styleSpaceWithBackground(": ", background)
-	prefixLen := len(formatTimestamp(post)) + 1 + len(post.Author) + 2
+	prefixLen := xansi.StringWidth(timestamp) + 1 + xansi.StringWidth(post.Author) + 2
 
 	// Calculate content width for first line
 	firstLineWidth := termWidth - prefixLen
@@ -1202,13 +1758,12 @@ func (m Model) formatPostDenseWithBackground(post *Post, background lipgloss.Ada
 	}
 
 	// Wrap text: first line shorter, continuation lines full width
-	contentLines := wrapTextWithWidths(post.Content, firstLineWidth, termWidth)
+	contentLines := wrapContent(post.Content, firstLineWidth, termWidth, m.codeIndent())
 
 	// Build result lines
 	lines := make([]string, 0, len(contentLines))
 	for i, line := range contentLines {
-		// Apply background to message content (HighlightAll only adds foreground colors)
-		highlighted := m.styleSpaceWithBackground(HighlightWithThemeAndBackground(line, m.theme, background), background)
+		highlighted := m.renderContentLine(line, background)
 		if i == 0 {
 			lines = append(lines, prefix+highlighted)
 		} else {
@@ -1233,12 +1788,13 @@ func (m Model) formatPostComfyWithBackground(post *Post, background lipgloss.Ada
 		termWidth = DefaultTerminalWidth
 	}
 
-	timeStr := m.styleTimestampWithBackground(formatTimestamp(post), background, selected)
+	timestamp := m.postTimestamp(post)
+	timeStr := m.styleTimestampWithBackground(timestamp, background, selected)
 	identity := m.styleIdentityWithBackground(post, background)
 	callerTag := ResolveCallerTag(post)
 	tagLen := 0
 	if callerTag != "" {
-		tagLen = len(callerTag) + 3 // leading space + brackets
+		tagLen = xansi.StringWidth(callerTag) + 3 // leading space + brackets
 	}
 
 	// Build prefix with styled spaces to avoid black gaps: "HH:MM  author "
@@ -1247,7 +1803,7 @@ func (m Model) formatPostComfyWithBackground(post *Post, background lipgloss.Ada
 		prefix += m.styleSpaceWithBackground(" ", background) + m.styleAgentTagWithBackground(callerTag, background)
 	}
 	prefix += m.styleSpaceWithBackground(" ", background)
-	prefixLen := len(formatTimestamp(post)) + 2 + len(post.Author) + 1 + len(post.Suffix) + 1 + tagLen
+	prefixLen := xansi.StringWidth(timestamp) + 2 + xansi.StringWidth(post.Author) + 1 + xansi.StringWidth(post.Suffix) + 1 + tagLen
 
 	// Calculate content width
 	contentWidth := termWidth - prefixLen
@@ -1256,14 +1812,13 @@ func (m Model) formatPostComfyWithBackground(post *Post, background lipgloss.Ada
 	}
 
 	// Wrap text: all lines same width
-	contentLines := wrapText(post.Content, contentWidth)
+	contentLines := wrapContent(post.Content, contentWidth, contentWidth, m.codeIndent())
 
 	// Build result lines with continuation padding
 	continuationPadding := strings.Repeat(" ", prefixLen)
 	lines := make([]string, 0, len(contentLines))
 	for i, line := range contentLines {
-		// Apply background to message content (HighlightAll only adds foreground colors)
-		highlighted := m.styleSpaceWithBackground(HighlightWithThemeAndBackground(line, m.theme, background), background)
+		highlighted := m.renderContentLine(line, background)
 		if i == 0 {
 			lines = append(lines, prefix+highlighted)
 		} else {
@@ -1289,7 +1844,7 @@ func (m Model) formatPostRelaxedWithBackground(post *Post, background lipgloss.A
 		termWidth = DefaultTerminalWidth
 	}
 
-	timeStr := m.styleTimestampWithBackground(formatTimestamp(post), background, selected)
+	timeStr := m.styleTimestampWithBackground(m.postTimestamp(post), background, selected)
 	identity := m.styleIdentityWithBackground(post, background)
 	agentTag := ResolveCallerTag(post)
 
@@ -1300,18 +1855,68 @@ func (m Model) formatPostRelaxedWithBackground(post *Post, background lipgloss.A
 	}
 
 	// Content lines: wrap to full width minus small margin
-	contentLines := wrapText(post.Content, termWidth-2)
+	contentLines := wrapContent(post.Content, termWidth-2, termWidth-2, m.codeIndent())
 
 	// Build result: header + content lines
 	lines := make([]string, 0, 1+len(contentLines))
 	lines = append(lines, headerLine)
 	for _, line := range contentLines {
-		lines = append(lines, m.styleSpaceWithBackground(HighlightWithThemeAndBackground(line, m.theme, background), background))
+		lines = append(lines, m.renderContentLine(line, background))
 	}
 
 	return lines
 }
 
+// postTimestamp returns the post's time in the configured TimeFormat.
+// Prefix widths are measured from this string, so they follow the format.
+func (m Model) postTimestamp(post *Post) string {
+	format := TimestampClock
+	if m.config != nil && m.config.TimeFormat != "" {
+		format = m.config.TimeFormat
+	}
+	return formatPostTime(post, format)
+}
+
+// codeIndent returns how far fenced code lines are indented in the current
+// layout. Dense and relaxed content starts at column 0, so code needs more
+// room to stand apart; comfy already aligns lines with the content column.
+func (m Model) codeIndent() int {
+	if m.layout != nil && (m.layout.Name == "dense" || m.layout.Name == "relaxed") {
+		return 4
+	}
+	return 2
+}
+
+// renderContentLine styles one wrapped content line. Prose gets tag, mention,
+// and search highlighting, with URLs linked to their full target even when
+// wrapping split them; fenced code is indented and drawn on the code
+// background, with a muted marker on soft-wrapped continuations.
+func (m Model) renderContentLine(line wrappedLine, background lipgloss.AdaptiveColor) string {
+	if !line.code {
+		if NoColor() || len(line.links) == 0 {
+			// Apply background to message content (HighlightAll only adds foreground colors)
+			return m.styleSpaceWithBackground(m.highlightContent(line.text, background), background)
+		}
+		var result strings.Builder
+		lastEnd := 0
+		for _, link := range line.links {
+			result.WriteString(m.highlightContent(line.text[lastEnd:link.start], background))
+			result.WriteString(renderLink(line.text[link.start:link.end], link.url, m.theme, background))
+			lastEnd = link.end
+		}
+		result.WriteString(m.highlightContent(line.text[lastEnd:], background))
+		return m.styleSpaceWithBackground(result.String(), background)
+	}
+	rendered := m.styleSpaceWithBackground(strings.Repeat(" ", m.codeIndent()), background)
+	if line.cont {
+		rendered += lipgloss.NewStyle().
+			Foreground(m.theme.TextMuted).
+			Background(codeBackground(m.theme, background)).
+			Render(CodeContinuation)
+	}
+	return rendered + HighlightCode(line.text, line.lang, m.theme, background)
+}
+
 // styleTimestamp applies theme styling to timestamp
 func (m Model) styleTimestamp(s string) string {
 	return m.styleTimestampWithBackground(s, m.theme.Background, false)
@@ -1467,14 +2072,15 @@ func buildLeftHelpColumn(hs helpStyles) string {
 	b.WriteString(hs.renderSection("NAVIGATION", []helpRow{
 		{"↑/k", "Select previous post"}, {"↓/j", "Select next post"},
 		{"PgUp", "Select previous page"}, {"PgDn", "Select next page"},
-		{"Home", "Top post"}, {"End", "Bottom post"}, {"g/G", "Top/bottom post"},
-	}, 5))
+		{"g/G", "Top, bottom post"}, {"f", "Focus selected thread"},
+		{"/ n/N", "Search, next/prev match"}, {"#", "Filter by post's tag"},
+	}, 6))
 	b.WriteString("\n")
-	b.WriteString(hs.renderSection("SHARE", []helpRow{{"c", "Copy selected post"}}, 5))
-	b.WriteString("\n")
-	b.WriteString(hs.renderSection("READ STATUS", []helpRow{
-		{"Space", "Mark read to here"}, {"d d", "Delete selected post"}, {"q", "Quit"},
-	}, 5))
+	b.WriteString(hs.renderSection("POST ACTIONS", []helpRow{
+		{"c", "Copy selected post"}, {"e", "React to post"}, {"d d", "Delete selected post"},
+		{"Space/A", "Mark read to here, all"},
+		{"u", "Mark unread from here"}, {"o", "Expand/collapse post"},
+	}, 7))
 	return b.String()
 }
 
@@ -1488,17 +2094,25 @@ func (m Model) buildRightHelpColumn(hs helpStyles) string {
 	if m.layout != nil {
 		layoutName = m.layout.DisplayName
 	}
+	contrastName := GetContrastLevel(DefaultContrastName).DisplayName
+	if m.contrast != nil {
+		contrastName = m.contrast.DisplayName
+	}
 	pressureLevel := config.GetPressureLevel(m.pressure)
 
 	var b strings.Builder
 	b.WriteString(hs.renderSection("SETTINGS", []helpRow{
-		{"a", "Toggle auto-refresh"}, {"l/L", "Cycle layout"},
-		{"t/T", "Cycle theme"}, {"+/-", "Adjust pressure"}, {"r", "Refresh now"},
+		{"l/L y/Y", "Cycle layout, contrast"},
+		{"t/T D", "Theme, light/dark"}, {"z", "Clock/relative/date"},
+		{"+/-", "Adjust pressure"}, {"q/r a", "Quit, refresh, auto on/off"},
+		{"b/B", "Bookmark, show saved"}, {"w", "Who's who"},
+		{"m", "Mute/unmute author"}, {"s", "Save card image"},
 	}, 7))
 	b.WriteString("\n")
 	b.WriteString(hs.renderSection("CURRENT SETTINGS", []helpRow{
 		{"Auto:", autoStr}, {"Layout:", layoutName},
-		{"Theme:", m.theme.DisplayName}, {"Pressure:", pressureLevel.Label},
+		{"Theme:", m.theme.DisplayName}, {"Contrast:", contrastName},
+		{"Pressure:", pressureLevel.Label},
 	}, 7))
 	return b.String()
 }
@@ -1581,14 +2195,12 @@ func (m *Model) updateDisplayedPosts() {
 	if len(m.posts) == 0 {
 		m.displayedPosts = nil
 		m.selectedPostIndex = 0
+		m.updateSearchMatches()
 		return
 	}
 
-	// Build threads and flatten to display order
-	threads := buildThreads(m.posts)
-	for i, j := 0, len(threads)-1; i < j; i, j = i+1, j-1 {
-		threads[i], threads[j] = threads[j], threads[i]
-	}
+	// Build threads (filtered by any search) and flatten to display order
+	threads := m.visibleThreads()
 
 	// Flatten threads to posts in display order (main posts only, not replies)
 	m.displayedPosts = make([]*Post, 0, len(threads))
@@ -1603,6 +2215,7 @@ func (m *Model) updateDisplayedPosts() {
 	if m.selectedPostIndex < 0 {
 		m.selectedPostIndex = 0
 	}
+	m.updateSearchMatches()
 }
 
 // initSelectionToUnread moves selection to the first unread post if available.
@@ -1613,32 +2226,13 @@ func (m *Model) initSelectionToUnread() {
 		return
 	}
 
-	if m.lastReadPostID == "" {
-		m.selectedPostIndex = len(m.displayedPosts) - 1
-		return
-	}
-
-	lastReadIndex := -1
-	for i, post := range m.displayedPosts {
-		if post.ID == m.lastReadPostID {
-			lastReadIndex = i
-			break
-		}
-	}
-
-	if lastReadIndex == -1 {
-		m.selectedPostIndex = len(m.displayedPosts) - 1
-		return
-	}
-
-	if lastReadIndex < len(m.displayedPosts)-1 {
-		// Select first unread post (right after marker)
-		m.selectedPostIndex = lastReadIndex + 1
+	if firstUnread := m.firstUnreadIndex(); firstUnread >= 0 {
+		m.selectedPostIndex = firstUnread
 		return
 	}
 
 	// No unread posts
-	m.selectedPostIndex = lastReadIndex
+	m.selectedPostIndex = len(m.displayedPosts) - 1
 }
 
 // findPostLineRange finds the first and last line indices for the given post index.
@@ -1687,7 +2281,7 @@ func (m *Model) ensureSelectedVisible() {
 	if len(m.displayedPosts) == 0 || m.contentHeight() <= 0 {
 		return
 	}
-	contentLines := m.buildAllContentLinesWithPosts()
+	contentLines := m.contentLayout()
 	firstLine, lastLine, found := findPostLineRange(contentLines, m.selectedPostIndex)
 	if !found {
 		return
@@ -1703,7 +2297,7 @@ func (m *Model) ensureSelectedVisibleWithUnread() {
 	if len(m.displayedPosts) == 0 || m.contentHeight() <= 0 {
 		return
 	}
-	contentLines := m.buildAllContentLinesWithPosts()
+	contentLines := m.contentLayout()
 	firstLine, lastLine, found := findPostLineRange(contentLines, m.selectedPostIndex)
 	if !found {
 		return
@@ -1765,7 +2359,7 @@ func (m *Model) moveSelectionByLines(delta int) {
 		return
 	}
 
-	contentLines := m.buildAllContentLinesWithPosts()
+	contentLines := m.contentLayout()
 	if len(contentLines) == 0 {
 		return
 	}
@@ -1811,13 +2405,16 @@ func (m *Model) moveSelectionToEdge(top bool) {
 }
 
 // contentBuilder tracks state while building content lines for the feed display.
+// Only blocks of lines reaching into [from, to) are rendered; the rest keep
+// their post index but no text.
 type contentBuilder struct {
 	model                  Model
 	lines                  []contentLine
+	from, to               int
 	lastDay                time.Time
 	separatorInserted      bool
 	pendingUnreadSeparator bool
-	hasUnread              bool
+	firstUnread            int // thread index the UNREAD separator precedes, -1 for none
 }
 
 func (cb *contentBuilder) addDaySeparator(thread thread, threadIdx int) {
@@ -1825,7 +2422,7 @@ func (cb *contentBuilder) addDaySeparator(thread thread, threadIdx int) {
 	if err != nil {
 		return
 	}
-	localTime := postTime.Local()
+	localTime := inDisplayZone(postTime)
 	postDay := time.Date(localTime.Year(), localTime.Month(), localTime.Day(), 0, 0, 0, 0, localTime.Location())
 	if !cb.lastDay.IsZero() && postDay.Equal(cb.lastDay) {
 		return
@@ -1833,31 +2430,73 @@ func (cb *contentBuilder) addDaySeparator(thread thread, threadIdx int) {
 	if threadIdx > 0 {
 		cb.lines = append(cb.lines, contentLine{text: "", postIndex: -1})
 	}
-	cb.lines = append(cb.lines, contentLine{text: cb.model.formatDaySeparator(localTime), postIndex: -1})
+	cb.addLine(-1, func() string { return cb.model.formatDaySeparator(localTime) })
 	cb.lastDay = postDay
 }
 
+// addBlock appends count lines for postIndex, calling render only when the
+// block reaches into the window.
+func (cb *contentBuilder) addBlock(postIndex, count int, render func() []string) {
+	start := len(cb.lines)
+	if start < cb.to && start+count > cb.from {
+		for _, line := range render() {
+			cb.lines = append(cb.lines, contentLine{text: line, postIndex: postIndex})
+		}
+		return
+	}
+	for i := 0; i < count; i++ {
+		cb.lines = append(cb.lines, contentLine{postIndex: postIndex})
+	}
+}
+
+func (cb *contentBuilder) addLine(postIndex int, render func() string) {
+	cb.addBlock(postIndex, 1, func() []string { return []string{render()} })
+}
+
 func (cb *contentBuilder) addThread(thread thread, postIndex int) {
+	post := thread.post
 	isSelected := postIndex == cb.model.selectedPostIndex
-	for _, line := range cb.model.formatPostWithSelection(thread.post, isSelected) {
-		cb.lines = append(cb.lines, contentLine{text: line, postIndex: postIndex})
-	}
-	for _, reply := range thread.replies {
-		for _, line := range cb.model.formatReply(reply) {
-			cb.lines = append(cb.lines, contentLine{text: line, postIndex: -1})
+	cb.addBlock(postIndex, cb.model.postLineCount(post, cb.model.formatPost), func() []string {
+		return cb.model.formatPostWithSelection(post, isSelected)
+	})
+	if FormatReactions(post.Reactions) != "" {
+		cb.addLine(postIndex, func() string {
+			return cb.model.formatReactionLine(post, "       ", cb.model.theme.Background)
+		})
+	}
+	if len(thread.replies) > 0 && cb.model.showsReplySummary() {
+		cb.addLine(postIndex, func() string { return cb.model.formatReplySummary(thread) })
+	}
+	head, tail, hidden := collapseReplies(thread.replies, cb.model.replyLimit())
+	cb.addReplies(head)
+	if hidden > 0 {
+		cb.addLine(-1, func() string { return cb.model.formatMoreReplies(hidden) })
+	}
+	cb.addReplies(tail)
+}
+
+func (cb *contentBuilder) addReplies(replies []*Post) {
+	for _, reply := range replies {
+		cb.addBlock(-1, cb.model.postLineCount(reply, cb.model.formatReply), func() []string {
+			return cb.model.formatReply(reply)
+		})
+		if FormatReactions(reply.Reactions) != "" {
+			cb.addLine(-1, func() string {
+				return cb.model.formatReactionLine(reply, "            "+replyIndent(reply.Depth), cb.model.theme.Background)
+			})
 		}
 	}
 }
 
-func (cb *contentBuilder) addThreadSeparator(thread thread, isLast bool) {
-	if cb.hasUnread && !cb.separatorInserted && cb.model.lastReadPostID == thread.post.ID {
+func (cb *contentBuilder) addThreadSeparator(threadIdx int, isLast bool) {
+	if !cb.separatorInserted && threadIdx+1 == cb.firstUnread {
 		cb.pendingUnreadSeparator = true
 	}
 	if isLast {
 		return
 	}
 	if cb.pendingUnreadSeparator && !cb.separatorInserted {
-		cb.lines = append(cb.lines, contentLine{text: cb.model.formatUnreadSeparator(), postIndex: unreadSeparatorIndex})
+		cb.addLine(unreadSeparatorIndex, cb.model.formatUnreadSeparator)
 		cb.separatorInserted = true
 		cb.pendingUnreadSeparator = false
 	} else {
@@ -1867,24 +2506,36 @@ func (cb *contentBuilder) addThreadSeparator(thread thread, isLast bool) {
 
 // buildAllContentLinesWithPosts builds content lines with post index tracking.
 func (m Model) buildAllContentLinesWithPosts() []contentLine {
+	return m.buildContentLines(0, math.MaxInt)
+}
+
+// buildContentLines builds content lines with post index tracking, rendering
+// text only for posts and separators that overlap lines [from, to).
+func (m Model) buildContentLines(from, to int) []contentLine {
 	if len(m.posts) == 0 {
 		return []contentLine{{text: "No posts yet. Exit TUI (q) and try: smoke post \"hello world\"", postIndex: -1}}
 	}
 
-	threads := buildThreads(m.posts)
-	for i, j := 0, len(threads)-1; i < j; i, j = i+1, j-1 {
-		threads[i], threads[j] = threads[j], threads[i]
+	threads := m.visibleThreads()
+	if len(threads) == 0 {
+		switch {
+		case m.searchQuery != "":
+			return []contentLine{{text: fmt.Sprintf("No posts match %q. Press Esc to clear the search.", m.searchQuery), postIndex: -1}}
+		case m.focusThreadID != "":
+			return []contentLine{{text: "This thread is no longer in the feed. Press Esc to show all posts.", postIndex: -1}}
+		case m.tagFilter != "":
+			return []contentLine{{text: fmt.Sprintf("No posts tagged #%s. Press Esc to clear the filter.", m.tagFilter), postIndex: -1}}
+		default:
+			return []contentLine{{text: "No bookmarked posts. Press b to bookmark a post, B to show all posts.", postIndex: -1}}
+		}
 	}
 
-	cb := contentBuilder{model: m}
-	if m.lastReadPostID != "" && len(threads) > 0 {
-		cb.hasUnread = m.lastReadPostID != threads[len(threads)-1].post.ID
-	}
+	cb := contentBuilder{model: m, from: from, to: to, firstUnread: m.firstUnreadIndex()}
 
 	for i, thread := range threads {
 		cb.addDaySeparator(thread, i)
 		cb.addThread(thread, i)
-		cb.addThreadSeparator(thread, i == len(threads)-1)
+		cb.addThreadSeparator(i, i == len(threads)-1)
 	}
 
 	return cb.lines
@@ -1917,7 +2568,7 @@ func (m *Model) handleCopyMenuKey(msg tea.KeyMsg) tea.Cmd {
 		return nil
 
 	case "down", "j":
-		if m.copyMenuIndex < 2 {
+		if m.copyMenuIndex < len(m.copyMenuItems())-1 {
 			m.copyMenuIndex++
 		}
 		return nil
@@ -1927,25 +2578,19 @@ func (m *Model) handleCopyMenuKey(msg tea.KeyMsg) tea.Cmd {
 		m.executeCopyAction()
 		return nil
 
-	case "1":
-		m.showCopyMenu = false
-		m.copyMenuIndex = 0
-		m.executeCopyAction()
-		return nil
-
-	case "2":
+	case "s":
 		m.showCopyMenu = false
-		m.copyMenuIndex = 1
-		m.executeCopyAction()
+		m.saveSelectedCard()
 		return nil
+	}
 
-	case "3":
+	// Number keys pick an option directly
+	key := msg.String()
+	if len(key) == 1 && key[0] >= '1' && int(key[0]-'0') <= len(m.copyMenuItems()) {
 		m.showCopyMenu = false
-		m.copyMenuIndex = 2
+		m.copyMenuIndex = int(key[0] - '1')
 		m.executeCopyAction()
-		return nil
 	}
-
 	return nil
 }
 
@@ -1963,33 +2608,113 @@ func copyImageAction(post *Post, theme *Theme, dims ImageDimensions, label strin
 // executeCopyAction performs the copy operation based on copyMenuIndex.
 func (m *Model) executeCopyAction() {
 	if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
-		m.copyConfirmation = "⚠ No post selected"
+		m.pushNotice("⚠ No post selected")
 		return
 	}
 
 	post := m.displayedPosts[m.selectedPostIndex]
+	if !ClipboardAvailable() {
+		m.pushNotice(NoClipboardNotice)
+		return
+	}
 
-	switch m.copyMenuIndex {
-	case 0:
-		if err := CopyTextToClipboard(FormatPostAsText(post)); err != nil {
-			m.copyConfirmation = "⚠ Copy failed"
+	if text, label, ok := copyMenuText(m.copyMenuIndex, post); ok {
+		if err := CopyTextToClipboard(text); err != nil {
+			m.pushNotice("⚠ Copy failed")
 		} else {
-			m.copyConfirmation = "✓ Copied text"
+			m.pushNotice("✓ Copied " + label)
 		}
+		return
+	}
+
+	switch m.copyMenuIndex {
 	case 1:
-		m.copyConfirmation = copyImageAction(post, m.theme, SquareImage, "square image")
+		m.pushNotice(copyImageAction(post, m.theme, SquareImage, "square image"))
 	case 2:
-		m.copyConfirmation = copyImageAction(post, m.theme, LandscapeImage, "landscape image")
+		m.pushNotice(copyImageAction(post, m.theme, LandscapeImage, "landscape image"))
+	case 5:
+		parent, reply := m.threadCardPosts(post)
+		if parent == nil {
+			m.pushNotice("⚠ No reply to share")
+			return
+		}
+		data, err := RenderThreadCard(parent, reply, m.theme, SquareImage)
+		if err != nil {
+			m.pushNotice("⚠ Render failed")
+			return
+		}
+		if err := CopyImageToClipboard(data); err != nil {
+			m.pushNotice("⚠ Copy failed")
+			return
+		}
+		m.pushNotice("✓ Copied thread image")
 	}
 }
 
-// renderCopyMenuOverlayBox renders the copy menu as a centered overlay box.
-func (m Model) renderCopyMenuOverlayBox() overlayBox {
-	menuItems := []string{
+// copyMenuText returns the text a copy menu option puts on the clipboard and
+// the label its notice uses. ok is false for the image options.
+func copyMenuText(index int, post *Post) (text, label string, ok bool) {
+	switch index {
+	case 0:
+		return FormatPostAsText(post), "text", true
+	case 3:
+		return post.ID, "post ID", true
+	case 4:
+		return replyCommand(post.ID), "reply command", true
+	}
+	return "", "", false
+}
+
+// replyCommand returns a smoke reply command for the post, ready for the
+// reply text to be typed between the quotes.
+func replyCommand(id string) string {
+	return fmt.Sprintf("smoke reply %s ''", id)
+}
+
+// threadCardPosts picks the parent and reply a thread image shows for post:
+// its parent when post is a reply, otherwise its newest reply. Returns nils
+// when post is not part of a conversation.
+func (m Model) threadCardPosts(post *Post) (parent, reply *Post) {
+	if post.IsReply() {
+		for _, p := range m.posts {
+			if p.ID == post.ParentID {
+				return p, post
+			}
+		}
+		return nil, nil
+	}
+	for _, p := range m.posts {
+		if p.ParentID == post.ID {
+			reply = p
+		}
+	}
+	if reply == nil {
+		return nil, nil
+	}
+	return post, reply
+}
+
+// copyMenuItems lists the copy menu options for the selected post. The
+// thread image is only offered for posts with a parent or replies.
+func (m Model) copyMenuItems() []string {
+	items := []string{
 		"1. Text",
 		"2. Square (1200×1200)",
 		"3. Landscape (1200×630)",
+		"4. Post ID",
+		"5. Reply command",
+	}
+	if m.selectedPostIndex >= 0 && m.selectedPostIndex < len(m.displayedPosts) {
+		if parent, _ := m.threadCardPosts(m.displayedPosts[m.selectedPostIndex]); parent != nil {
+			items = append(items, "6. Thread (1200×1200)")
+		}
 	}
+	return items
+}
+
+// renderCopyMenuOverlayBox renders the copy menu as a centered overlay box.
+func (m Model) renderCopyMenuOverlayBox() overlayBox {
+	menuItems := m.copyMenuItems()
 
 	base := lipgloss.NewStyle().Background(m.theme.BackgroundSecondary)
 	titleStyle := base.Foreground(m.theme.Accent).Bold(true)
@@ -2013,6 +2738,10 @@ func (m Model) renderCopyMenuOverlayBox() overlayBox {
 	}
 
 	menuContent.WriteString("\n")
+	if !ClipboardAvailable() {
+		menuContent.WriteString(hintStyle.Width(menuWidth).Render("  No clipboard · s to save"))
+		menuContent.WriteString("\n")
+	}
 	menuContent.WriteString(hintStyle.Width(menuWidth).Render("  ↑/↓ navigate · Enter select"))
 	menuContent.WriteString("\n")
 	menuContent.WriteString(hintStyle.Width(menuWidth).Render("  Esc/q to cancel"))
@@ -0,0 +1,84 @@
+package feed
+
+import (
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+// loadBookmarks refreshes the bookmark set from disk. Bookmarks of deleted
+// posts are pruned only while showing the shared feed.
+func (m *Model) loadBookmarks() {
+	ids, err := ActiveBookmarks(m.posts, m.showingSharedFeed())
+	if err != nil {
+		return
+	}
+	m.bookmarks = make(map[string]bool, len(ids))
+	for _, id := range ids {
+		m.bookmarks[id] = true
+	}
+}
+
+// showingSharedFeed reports whether the TUI reads the shared feed file.
+func (m Model) showingSharedFeed() bool {
+	fileStore, ok := m.store.(*FileStore)
+	if !ok || fileStore == nil {
+		return false
+	}
+	shared, err := config.GetFeedPath()
+	return err == nil && filepath.Clean(shared) == filepath.Clean(fileStore.Path())
+}
+
+// handleBookmarkKeys toggles a bookmark on the selected post with b and the
+// bookmarks-only view with B. Esc leaves the bookmarks-only view.
+func (m *Model) handleBookmarkKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "b":
+		if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+			m.pushNotice("⚠ No post selected")
+			return nil, true
+		}
+		m.toggleBookmark(m.displayedPosts[m.selectedPostIndex].ID)
+		return nil, true
+	case "B":
+		m.setBookmarksOnly(!m.showBookmarksOnly)
+		return nil, true
+	case "esc":
+		if !m.showBookmarksOnly {
+			return nil, false
+		}
+		m.setBookmarksOnly(false)
+		return nil, true
+	}
+	return nil, false
+}
+
+// toggleBookmark saves or removes a bookmark and reports the result.
+func (m *Model) toggleBookmark(id string) {
+	bookmarked, err := config.ToggleBookmark(id)
+	if err != nil {
+		m.pushNotice("⚠ Bookmark failed")
+		return
+	}
+	if m.bookmarks == nil {
+		m.bookmarks = make(map[string]bool)
+	}
+	if bookmarked {
+		m.bookmarks[id] = true
+		m.pushNotice("✓ Bookmarked")
+		return
+	}
+	delete(m.bookmarks, id)
+	m.pushNotice("✓ Bookmark removed")
+	if m.showBookmarksOnly {
+		m.refilter()
+	}
+}
+
+// setBookmarksOnly switches between all posts and bookmarked posts only.
+func (m *Model) setBookmarksOnly(on bool) {
+	m.showBookmarksOnly = on
+	m.refilter()
+}
@@ -0,0 +1,59 @@
+package feed
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// collapsePostLines cuts a long top-level post to m.collapseLines lines and
+// adds a stub saying how to see the rest. Replies and expanded posts are
+// left whole.
+func (m Model) collapsePostLines(lines []string, post *Post, background lipgloss.AdaptiveColor) []string {
+	if !m.isCollapsible(post, len(lines)) || m.expanded[post.ID] {
+		return lines
+	}
+	hidden := len(lines) - m.collapseLines
+	stub := lipgloss.NewStyle().
+		Foreground(m.theme.TextMuted).
+		Background(background).
+		Italic(true).
+		Render(fmt.Sprintf("… %d more lines (press o to expand)", hidden))
+	return append(lines[:m.collapseLines:m.collapseLines], stub)
+}
+
+// isCollapsible reports whether post, rendering to n lines, is long enough
+// to collapse.
+func (m Model) isCollapsible(post *Post, n int) bool {
+	return m.collapseLines > 0 && !post.IsReply() && n > m.collapseLines
+}
+
+// handleExpandKey toggles the selected post between collapsed and expanded
+// with o.
+func (m *Model) handleExpandKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if msg.String() != "o" {
+		return nil, false
+	}
+	post := m.selectedPost()
+	if post == nil {
+		m.pushNotice("⚠ No post selected")
+		return nil, true
+	}
+	if !m.isCollapsible(post, len(m.formatPostLayout(post, m.theme.Background, false))) {
+		m.pushNotice("Post is already shown in full")
+		return nil, true
+	}
+	if m.expanded[post.ID] {
+		delete(m.expanded, post.ID)
+		m.pushNotice("✓ Collapsed")
+	} else {
+		if m.expanded == nil {
+			m.expanded = make(map[string]bool)
+		}
+		m.expanded[post.ID] = true
+		m.pushNotice("✓ Expanded")
+	}
+	m.ensureSelectedVisible()
+	return nil, true
+}
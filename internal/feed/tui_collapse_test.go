@@ -0,0 +1,74 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+func TestCollapseLongPost(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	model = updated.(Model)
+
+	var long []string
+	for i := 1; i <= 30; i++ {
+		long = append(long, fmt.Sprintf("line %d", i))
+	}
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "short", Author: "ember", Suffix: "fox", Content: "short post", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "long", Author: "wisp", Suffix: "owl", Content: strings.Join(long, "\n"), CreatedAt: now.Add(-time.Minute).Format(time.RFC3339)},
+	}
+	updated, _ = model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	press := func() {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+		model = updated.(Model)
+	}
+	postText := func(index int) (string, int) {
+		var text []string
+		for _, line := range model.buildAllContentLinesWithPosts() {
+			if line.postIndex == index {
+				text = append(text, line.text)
+			}
+		}
+		return strings.Join(text, "\n"), len(text)
+	}
+
+	text, n := postText(1)
+	if n != config.DefaultCollapseLines+1 || !strings.Contains(text, "18 more lines (press o to expand)") || strings.Contains(text, "line 30") {
+		t.Fatalf("long post not collapsed: %d lines\n%s", n, text)
+	}
+	if len(model.contentLayout()) != len(model.buildAllContentLinesWithPosts()) {
+		t.Error("layout and rendered content disagree on a collapsed post")
+	}
+
+	model.selectedPostIndex = 1
+	press()
+	text, n = postText(1)
+	if n != 30 || !strings.Contains(text, "line 30") || strings.Contains(text, "press o") {
+		t.Fatalf("long post not expanded after o: %d lines\n%s", n, text)
+	}
+	if len(model.contentLayout()) != len(model.buildAllContentLinesWithPosts()) {
+		t.Error("layout and rendered content disagree on an expanded post")
+	}
+
+	press()
+	if _, n = postText(1); n != config.DefaultCollapseLines+1 {
+		t.Errorf("long post has %d lines after a second o, want it collapsed again", n)
+	}
+
+	// Short posts are never collapsed, so o leaves them alone
+	model.selectedPostIndex = 0
+	press()
+	if model.expanded["short"] {
+		t.Error("o expanded a short post")
+	}
+}
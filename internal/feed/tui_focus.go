@@ -0,0 +1,56 @@
+package feed
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// handleFocusKey narrows the feed to the selected thread with f. Pressing f
+// again or Esc restores the full feed.
+func (m *Model) handleFocusKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "f":
+		if m.focusThreadID != "" {
+			m.setFocusThread("")
+			m.pushNotice("✓ Showing all threads")
+			return nil, true
+		}
+		if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+			m.pushNotice("⚠ No post selected")
+			return nil, true
+		}
+		m.setFocusThread(m.displayedPosts[m.selectedPostIndex].ID)
+		m.pushNotice("✓ Focused on thread")
+		return nil, true
+	case "esc":
+		if m.focusThreadID == "" {
+			return nil, false
+		}
+		m.setFocusThread("")
+		return nil, true
+	}
+	return nil, false
+}
+
+// setFocusThread limits the feed to the thread rooted at id, or shows every
+// thread again when id is empty.
+func (m *Model) setFocusThread(id string) {
+	m.focusThreadID = id
+	m.refilter()
+}
+
+// replyLimit returns the reply cap for a thread; a focused thread shows all
+// of its replies.
+func (m Model) replyLimit() int {
+	if m.focusThreadID != "" {
+		return 0
+	}
+	return m.maxReplies
+}
+
+// focusReplyCount returns the number of replies in the focused thread.
+func (m Model) focusReplyCount() int {
+	for _, t := range m.visibleThreads() {
+		if t.post.ID == m.focusThreadID {
+			return len(t.replies)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,114 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFocusThread(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	model = updated.(Model)
+	model.maxReplies = 1
+
+	now := time.Now().UTC()
+	at := func(minutes int) string { return now.Add(time.Duration(-minutes) * time.Minute).Format(time.RFC3339) }
+	posts := []*Post{
+		{ID: "old", Author: "ember", Suffix: "fox", Content: "older thread", CreatedAt: at(10)},
+		{ID: "parent", Author: "wisp", Suffix: "owl", Content: "focus me", CreatedAt: at(8)},
+		{ID: "reply1", Author: "ember", Suffix: "fox", Content: "first reply", ParentID: "parent", CreatedAt: at(6)},
+		{ID: "nested", Author: "wisp", Suffix: "owl", Content: "nested reply", ParentID: "reply1", CreatedAt: at(5)},
+		{ID: "new", Author: "ember", Suffix: "fox", Content: "newer thread", CreatedAt: at(2)},
+	}
+	updated, _ = model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	press := func(key tea.KeyMsg) {
+		updated, _ := model.Update(key)
+		model = updated.(Model)
+	}
+	content := func() string {
+		var text []string
+		for _, line := range model.buildAllContentLinesWithPosts() {
+			text = append(text, line.text)
+		}
+		return strings.Join(text, "\n")
+	}
+
+	if len(model.displayedPosts) != 3 {
+		t.Fatalf("expected 3 threads before focusing, got %d", len(model.displayedPosts))
+	}
+	for i, post := range model.displayedPosts {
+		if post.ID == "parent" {
+			model.selectedPostIndex = i
+		}
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if model.focusThreadID != "parent" {
+		t.Fatalf("focusThreadID = %q, want parent", model.focusThreadID)
+	}
+	if len(model.displayedPosts) != 1 || model.displayedPosts[0].ID != "parent" || model.selectedPostIndex != 0 {
+		t.Fatalf("focus should show only the parent thread, got %d posts", len(model.displayedPosts))
+	}
+	text := content()
+	for _, want := range []string{"focus me", "first reply", "nested reply"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("focused thread missing %q:\n%s", want, text)
+		}
+	}
+	for _, unwanted := range []string{"older thread", "newer thread", "more replies"} {
+		if strings.Contains(text, unwanted) {
+			t.Errorf("focused view should not contain %q:\n%s", unwanted, text)
+		}
+	}
+	if got := model.focusReplyCount(); got != 2 {
+		t.Errorf("focusReplyCount() = %d, want 2", got)
+	}
+
+	model.lastReadPostID = "old"
+	model.lastReadPostAt = time.Time{}
+	model.resolveLastReadTime()
+	if got := model.countUnread(); got != 1 {
+		t.Errorf("countUnread() in focus = %d, want 1", got)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.focusThreadID != "" || len(model.displayedPosts) != 3 {
+		t.Fatalf("Esc should restore the full feed, got %d posts", len(model.displayedPosts))
+	}
+	if model.displayedPosts[model.selectedPostIndex].ID != "parent" {
+		t.Error("selection should stay on the focused thread after Esc")
+	}
+}
+
+func TestFocusThread_ToggleAndEmpty(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	now := time.Now().UTC().Format(time.RFC3339)
+	updated, _ := model.Update(loadPostsMsg{posts: []*Post{
+		{ID: "a", Author: "ember", Suffix: "fox", Content: "one", CreatedAt: now},
+		{ID: "b", Author: "wisp", Suffix: "owl", Content: "two", CreatedAt: now},
+	}})
+	model = updated.(Model)
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	model = updated.(Model)
+	if len(model.displayedPosts) != 1 {
+		t.Fatalf("expected focus on one thread, got %d", len(model.displayedPosts))
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	model = updated.(Model)
+	if model.focusThreadID != "" || len(model.displayedPosts) != 2 {
+		t.Fatal("pressing f again should leave focus")
+	}
+
+	model.setFocusThread("gone")
+	lines := model.buildAllContentLinesWithPosts()
+	if len(lines) != 1 || !strings.Contains(lines[0].text, "no longer in the feed") {
+		t.Errorf("unexpected empty focus state: %+v", lines)
+	}
+}
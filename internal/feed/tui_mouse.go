@@ -0,0 +1,125 @@
+package feed
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+const (
+	// mouseWheelLines is how many lines one wheel notch scrolls.
+	mouseWheelLines = 3
+	// contentTopRow is the screen row of the first content line (below the header and top border).
+	contentTopRow = 2
+	// pressureIndicatorPrefix starts the pressure indicator in the header.
+	pressureIndicatorPrefix = "(+/-)"
+)
+
+// handleMouseMsg scrolls with the wheel, selects posts on click, and adjusts
+// pressure when the header's pressure indicator is clicked.
+func (m *Model) handleMouseMsg(msg tea.MouseMsg) tea.Cmd {
+	if m.plain || m.searchActive || m.showHelp || m.showCopyMenu || m.showReactMenu {
+		return nil
+	}
+	if msg.Action != tea.MouseActionPress {
+		return nil
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.scrollBy(-mouseWheelLines)
+	case tea.MouseButtonWheelDown:
+		m.scrollBy(mouseWheelLines)
+	case tea.MouseButtonLeft, tea.MouseButtonRight:
+		if msg.Y == 0 {
+			m.handlePressureClick(msg)
+			return nil
+		}
+		if msg.Button != tea.MouseButtonLeft {
+			return nil
+		}
+		if cl, ok := m.contentLineAt(msg.Y); ok && cl.postIndex >= 0 && cl.postIndex < len(m.displayedPosts) {
+			m.selectedPostIndex = cl.postIndex
+		}
+	}
+	return nil
+}
+
+// scrollBy moves the viewport by delta lines without changing the selection.
+func (m *Model) scrollBy(delta int) {
+	m.scrollOffset += delta
+	if maxOffset := m.maxScrollOffset(); m.scrollOffset > maxOffset {
+		m.scrollOffset = maxOffset
+	}
+	if m.scrollOffset < 0 {
+		m.scrollOffset = 0
+	}
+}
+
+// handlePressureClick raises pressure on the "+" of "(+/-)" and lowers it on
+// the "-". Elsewhere on the indicator, left click raises and right click lowers.
+func (m *Model) handlePressureClick(msg tea.MouseMsg) {
+	start, end, ok := m.pressureIndicatorColumns()
+	if !ok || msg.X < start || msg.X >= end {
+		return
+	}
+	switch col := msg.X - start; {
+	case col <= 1:
+		m.adjustPressure(1)
+	case col == 3 || col == 4:
+		m.adjustPressure(-1)
+	case col == 2:
+		// The "/" between + and - does nothing
+	case msg.Button == tea.MouseButtonRight:
+		m.adjustPressure(-1)
+	default:
+		m.adjustPressure(1)
+	}
+}
+
+// pressureIndicatorColumns returns the screen columns [start, end) covered by
+// the pressure indicator in the rendered header.
+func (m Model) pressureIndicatorColumns() (int, int, bool) {
+	header := xansi.Strip(m.renderHeader())
+	idx := strings.Index(header, pressureIndicatorPrefix)
+	if idx < 0 {
+		return 0, 0, false
+	}
+	start := lipgloss.Width(header[:idx])
+	if m.width > 0 && start >= m.width {
+		return 0, 0, false
+	}
+	return start, start + lipgloss.Width(m.renderPressureIndicator()), true
+}
+
+// contentLineAt maps a screen row to the content line drawn there, following
+// the same windowing as renderContent. Rows showing unread indicators, borders,
+// or padding report ok=false.
+func (m Model) contentLineAt(row int) (contentLine, bool) {
+	i := row - contentTopRow
+	availableHeight := m.contentHeight()
+	if i < 0 || i >= availableHeight {
+		return contentLine{}, false
+	}
+
+	contentLines := m.contentLayout()
+	offset := m.clampScrollOffset(len(contentLines), availableHeight)
+	markerLine := m.findUnreadMarkerLine(contentLines)
+	endIdx, _, visibleHeight := m.computeUnreadBelowWindow(contentLines, offset, availableHeight, markerLine, len(contentLines))
+
+	idx := offset + i
+	if countUnreadAbove(contentLines, markerLine, offset) > 0 {
+		// The first row shows the indicator and takes one row from content
+		if i == 0 {
+			return contentLine{}, false
+		}
+		idx--
+		visibleHeight--
+	}
+	if idx-offset >= visibleHeight || idx >= endIdx {
+		return contentLine{}, false
+	}
+	return contentLines[idx], true
+}
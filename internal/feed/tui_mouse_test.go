@@ -0,0 +1,149 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+func mouseClick(x, y int, button tea.MouseButton) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: button}
+}
+
+func sendMouse(t *testing.T, model Model, msg tea.MouseMsg) Model {
+	t.Helper()
+	updated, _ := model.Update(msg)
+	return updated.(Model)
+}
+
+// screenRow returns the first screen row whose visible text contains s.
+func screenRow(t *testing.T, model Model, s string) int {
+	t.Helper()
+	for row, line := range strings.Split(model.View(), "\n") {
+		if strings.Contains(xansi.Strip(line), s) {
+			return row
+		}
+	}
+	t.Fatalf("%q not found on screen", s)
+	return -1
+}
+
+func TestMouse_ClickSelectsPost(t *testing.T) {
+	model := searchTestModel(t)
+
+	model = sendMouse(t, model, mouseClick(20, screenRow(t, model, "coffee"), tea.MouseButtonLeft))
+	if got := model.displayedPosts[model.selectedPostIndex].Content; got != "coffee" {
+		t.Errorf("selected %q, want coffee", got)
+	}
+
+	model = sendMouse(t, model, mouseClick(20, screenRow(t, model, "found the retry bug"), tea.MouseButtonLeft))
+	if got := model.displayedPosts[model.selectedPostIndex].Content; got != "found the retry bug" {
+		t.Errorf("selected %q, want first post", got)
+	}
+}
+
+func TestMouse_ClickIgnoresNonPostLines(t *testing.T) {
+	model := searchTestModel(t)
+	selected := model.selectedPostIndex
+
+	rows := []int{
+		0,                                       // header
+		1,                                       // top border
+		screenRow(t, model, "retry in a reply"), // reply under the coffee post
+		screenRow(t, model, "Retry storms") + 1, // blank line between threads
+		model.height - 1,                        // status bar
+		screenRow(t, model, "found the retry") - 1, // day separator
+	}
+	for _, row := range rows {
+		model = sendMouse(t, model, mouseClick(30, row, tea.MouseButtonLeft))
+		if model.selectedPostIndex != selected {
+			t.Errorf("click on row %d changed selection to %d", row, model.selectedPostIndex)
+			model.selectedPostIndex = selected
+		}
+	}
+}
+
+func TestMouse_WheelScrolls(t *testing.T) {
+	model := searchTestModel(t)
+	now := time.Now().UTC()
+	for i := 0; i < 40; i++ {
+		model.posts = append(model.posts, &Post{
+			ID:        fmt.Sprintf("smk-x%05d", i),
+			Author:    "ember@smoke",
+			Content:   fmt.Sprintf("filler %d", i),
+			CreatedAt: now.Add(time.Duration(i+1) * time.Second).Format(time.RFC3339),
+		})
+	}
+	model.updateDisplayedPosts()
+	model.scrollOffset = 0
+	selected := model.selectedPostIndex
+
+	model = sendMouse(t, model, mouseClick(10, 10, tea.MouseButtonWheelDown))
+	if model.scrollOffset != mouseWheelLines {
+		t.Errorf("scrollOffset = %d after wheel down, want %d", model.scrollOffset, mouseWheelLines)
+	}
+	if model.selectedPostIndex != selected {
+		t.Error("wheel should not change selection")
+	}
+
+	model = sendMouse(t, model, mouseClick(10, 10, tea.MouseButtonWheelUp))
+	model = sendMouse(t, model, mouseClick(10, 10, tea.MouseButtonWheelUp))
+	if model.scrollOffset != 0 {
+		t.Errorf("scrollOffset = %d, want clamp at 0", model.scrollOffset)
+	}
+
+	for i := 0; i < 100; i++ {
+		model = sendMouse(t, model, mouseClick(10, 10, tea.MouseButtonWheelDown))
+	}
+	if model.scrollOffset != model.maxScrollOffset() {
+		t.Errorf("scrollOffset = %d, want clamp at %d", model.scrollOffset, model.maxScrollOffset())
+	}
+}
+
+func TestMouse_PressureClick(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := searchTestModel(t)
+	model.pressure = 2
+
+	start, _, ok := model.pressureIndicatorColumns()
+	if !ok {
+		t.Fatal("pressure indicator not found in header")
+	}
+	header := xansi.Strip(strings.Split(model.View(), "\n")[0])
+	if !strings.HasPrefix(xansi.Cut(header, start, start+5), "(+/-)") {
+		t.Fatalf("indicator column %d does not point at (+/-) in %q", start, header)
+	}
+
+	model = sendMouse(t, model, mouseClick(start+1, 0, tea.MouseButtonLeft))
+	if model.pressure != 3 {
+		t.Errorf("click on + gave pressure %d, want 3", model.pressure)
+	}
+	model = sendMouse(t, model, mouseClick(start+3, 0, tea.MouseButtonLeft))
+	if model.pressure != 2 {
+		t.Errorf("click on - gave pressure %d, want 2", model.pressure)
+	}
+	model = sendMouse(t, model, mouseClick(start+8, 0, tea.MouseButtonRight))
+	if model.pressure != 1 {
+		t.Errorf("right click on indicator gave pressure %d, want 1", model.pressure)
+	}
+	model = sendMouse(t, model, mouseClick(0, 0, tea.MouseButtonLeft))
+	if model.pressure != 1 {
+		t.Errorf("click outside indicator changed pressure to %d", model.pressure)
+	}
+}
+
+func TestMouse_IgnoredWithOverlay(t *testing.T) {
+	model := searchTestModel(t)
+	row := screenRow(t, model, "coffee")
+	model.showHelp = true
+	selected := model.selectedPostIndex
+
+	model = sendMouse(t, model, mouseClick(20, row, tea.MouseButtonLeft))
+	if model.selectedPostIndex != selected {
+		t.Error("clicks should be ignored while an overlay is open")
+	}
+}
@@ -0,0 +1,45 @@
+package feed
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+// handleMuteKey toggles muting the selected post's author with m.
+func (m *Model) handleMuteKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if msg.String() != "m" {
+		return nil, false
+	}
+	if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+		m.pushNotice("⚠ No post selected")
+		return nil, true
+	}
+	return m.toggleMute(m.displayedPosts[m.selectedPostIndex].Author), true
+}
+
+// toggleMute mutes author, or unmutes every entry matching author when it is
+// already muted, then reloads posts so the feed reflects the change.
+func (m *Model) toggleMute(author string) tea.Cmd {
+	muted := config.GetMutedAuthors()
+	if !IsMutedAuthor(author, muted) {
+		if _, err := config.MuteAuthor(NormalizeMention(author)); err != nil {
+			m.pushNotice("⚠ Mute failed")
+			return nil
+		}
+		m.pushNotice("✓ Muted " + NormalizeMention(author))
+		return m.loadPostsCmd
+	}
+
+	for _, entry := range muted {
+		if !MentionRefersTo(entry, author) {
+			continue
+		}
+		if _, err := config.UnmuteAuthor(entry); err != nil {
+			m.pushNotice("⚠ Unmute failed")
+			return nil
+		}
+	}
+	m.pushNotice("✓ Unmuted " + NormalizeMention(author))
+	return m.loadPostsCmd
+}
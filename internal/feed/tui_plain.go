@@ -0,0 +1,205 @@
+package feed
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// PlainTUIEnv enables the screen-reader friendly plain TUI when set to a truthy value.
+const PlainTUIEnv = "SMOKE_PLAIN_TUI"
+
+// PlainTUIFromEnv reports whether SMOKE_PLAIN_TUI requests the plain TUI.
+func PlainTUIFromEnv() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(PlainTUIEnv))) {
+	case "1", "true", "yes", "on":
+		return true
+	}
+	return false
+}
+
+// viewPlain renders the feed as labeled lines without borders, colors, or overlays.
+// The last lines always describe the selected post so screen readers can announce it.
+func (m Model) viewPlain() string {
+	var b strings.Builder
+
+	header := fmt.Sprintf("Smoke feed. %d posts, %d unread.", len(m.displayedPosts), m.unreadCount)
+	b.WriteString(header + "\n")
+
+	var body []string
+	switch {
+	case m.showHelp:
+		body = plainHelpLines()
+	case m.showCopyMenu:
+		body = []string{
+			"Copy selected post:",
+			"1 Text",
+			"2 Square image",
+			"3 Landscape image",
+		}
+		if len(m.copyMenuItems()) > 3 {
+			body = append(body, "4 Thread image")
+		}
+		body = append(body, "s Save card to file")
+		if !ClipboardAvailable() {
+			body = append(body, "No clipboard in this session; s saves to file instead")
+		}
+		body = append(body, "Esc Cancel")
+	case m.showReactMenu:
+		body = []string{"React to selected post:"}
+		for i, emoji := range ReactionEmojis {
+			body = append(body, fmt.Sprintf("%d %s", i+1, emoji))
+		}
+		body = append(body, "Esc Cancel")
+	default:
+		body = m.plainContentWindow()
+	}
+	for _, line := range body {
+		b.WriteString(line + "\n")
+	}
+
+	for i := len(m.notices) - 1; i >= 0; i-- {
+		b.WriteString("Notice: " + m.notices[i].text + "\n")
+	}
+	if m.searchActive || m.searchQuery != "" {
+		b.WriteString(fmt.Sprintf("Search: %s (%d matches)\n", m.searchQuery, len(m.searchMatches)))
+	}
+	if m.focusThreadID != "" {
+		b.WriteString(fmt.Sprintf("Thread focus (%d replies)\n", m.focusReplyCount()))
+	}
+	if m.tagFilter != "" {
+		b.WriteString(fmt.Sprintf("Tag filter: #%s (%d posts)\n", m.tagFilter, len(m.displayedPosts)))
+	}
+	if m.showBookmarksOnly {
+		b.WriteString(fmt.Sprintf("Bookmarks only (%d posts)\n", len(m.displayedPosts)))
+	}
+	b.WriteString(m.plainSelectedLine() + "\n")
+	b.WriteString("Keys: j/k move, Space mark read, c copy, e react, r refresh, ? help, q quit")
+	return b.String()
+}
+
+// plainPostLabel describes a post as a single sentence, e.g. "Post by alice at 09:24: hi".
+func (m Model) plainPostLabel(post *Post) string {
+	kind := "Post"
+	if post.IsReply() {
+		kind = "Reply"
+	}
+	label := fmt.Sprintf("%s by %s at %s: %s", kind, post.Author, formatTimestamp(post), post.Content)
+	if post.EditedAt != "" {
+		label += " (edited)"
+	}
+	if m.bookmarks[post.ID] {
+		label += " (bookmarked)"
+	}
+	if summary := FormatReactions(post.Reactions); summary != "" {
+		label += " Reactions: " + summary
+	}
+	return label
+}
+
+// plainContentLines builds wrapped plain lines for every thread, tagged with the
+// displayedPosts index of the top-level post they belong to.
+func (m Model) plainContentLines() []contentLine {
+	if len(m.posts) == 0 {
+		return []contentLine{{text: "No posts yet.", postIndex: -1}}
+	}
+
+	width := m.width
+	if width <= 0 {
+		width = DefaultTerminalWidth
+	}
+
+	var lines []contentLine
+	for postIndex, t := range m.visibleThreads() {
+		marker := "  "
+		if postIndex == m.selectedPostIndex {
+			marker = "> "
+		}
+		for _, line := range wrapText(marker+m.plainPostLabel(t.post), width) {
+			lines = append(lines, contentLine{text: line, postIndex: postIndex})
+		}
+
+		head, tail, hidden := collapseReplies(t.replies, m.replyLimit())
+		for _, reply := range head {
+			for _, line := range wrapText("    "+replyIndent(reply.Depth)+m.plainPostLabel(reply), width) {
+				lines = append(lines, contentLine{text: line, postIndex: postIndex})
+			}
+		}
+		if hidden > 0 {
+			lines = append(lines, contentLine{text: "    " + moreRepliesLabel(hidden), postIndex: postIndex})
+		}
+		for _, reply := range tail {
+			for _, line := range wrapText("    "+replyIndent(reply.Depth)+m.plainPostLabel(reply), width) {
+				lines = append(lines, contentLine{text: line, postIndex: postIndex})
+			}
+		}
+	}
+	return lines
+}
+
+// plainContentWindow returns the slice of plain lines that fits the screen,
+// positioned so the selected post is visible.
+func (m Model) plainContentWindow() []string {
+	lines := m.plainContentLines()
+	// Reserve header, selected line, and key hint line
+	available := m.height - 3 - len(m.notices)
+	if available < 1 {
+		available = 1
+	}
+
+	start := 0
+	if len(lines) > available {
+		first, last, found := findPostLineRange(lines, m.selectedPostIndex)
+		if found {
+			start = first - available/3
+			if last >= start+available {
+				start = last - available + 1
+			}
+		}
+		if start > len(lines)-available {
+			start = len(lines) - available
+		}
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	end := start + available
+	if end > len(lines) {
+		end = len(lines)
+	}
+	out := make([]string, 0, end-start)
+	for _, cl := range lines[start:end] {
+		out = append(out, cl.text)
+	}
+	return out
+}
+
+// plainSelectedLine announces the selected post and its position.
+func (m Model) plainSelectedLine() string {
+	if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+		return "Selected: none"
+	}
+	post := m.displayedPosts[m.selectedPostIndex]
+	return fmt.Sprintf("Selected %d of %d. %s", m.selectedPostIndex+1, len(m.displayedPosts), m.plainPostLabel(post))
+}
+
+// plainHelpLines lists key bindings as plain text.
+func plainHelpLines() []string {
+	return []string{
+		"Help. Press any key to close.",
+		"j or down: next post",
+		"k or up: previous post",
+		"g or Home: first post, G or End: last post",
+		"Space: mark read up to selected post",
+		"c: copy selected post",
+		"/: search, n or N: next or previous match, Esc: clear search",
+		"#: filter by the selected post's tags, Esc: clear filter",
+		"b: bookmark selected post, B: show bookmarks only",
+		"m: mute or unmute the selected post's author",
+		"d twice: delete selected post",
+		"r: refresh, a: toggle auto refresh",
+		"+ or -: change pressure",
+		"q: quit",
+	}
+}
@@ -0,0 +1,79 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func plainTestModel(t *testing.T) Model {
+	t.Helper()
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.plain = true
+	model.width = 80
+	model.height = 20
+
+	now := time.Now().UTC()
+	model.posts = []*Post{
+		{ID: "smk-aaaaaa", Author: "alice@smoke", Content: "first post", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "smk-bbbbbb", Author: "bob@smoke", Content: "second post", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "smk-cccccc", Author: "carol@smoke", Content: "a reply", CreatedAt: now.Format(time.RFC3339), ParentID: "smk-aaaaaa"},
+	}
+	model.updateDisplayedPosts()
+	return model
+}
+
+func TestViewPlain(t *testing.T) {
+	model := plainTestModel(t)
+	model.selectedPostIndex = 0
+
+	view := model.View()
+
+	if strings.Contains(view, "\x1b[") {
+		t.Error("plain view should not contain ANSI escape sequences")
+	}
+	if strings.ContainsAny(view, "╭│╰") {
+		t.Error("plain view should not contain box borders")
+	}
+	for _, want := range []string{"Post by alice@smoke at", "Reply by carol@smoke at", "Selected 1 of 2. Post by alice@smoke"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("plain view missing %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestViewPlain_NavigationAnnouncesSelection(t *testing.T) {
+	model := plainTestModel(t)
+	model.selectedPostIndex = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	view := updated.(Model).View()
+
+	if !strings.Contains(view, "Selected 2 of 2. Post by bob@smoke") {
+		t.Errorf("selection line should follow navigation:\n%s", view)
+	}
+}
+
+func TestViewPlain_Help(t *testing.T) {
+	model := plainTestModel(t)
+	model.showHelp = true
+
+	view := model.View()
+	if !strings.Contains(view, "Help. Press any key to close.") {
+		t.Errorf("plain help should be inline text:\n%s", view)
+	}
+}
+
+func TestPlainTUIFromEnv(t *testing.T) {
+	t.Setenv(PlainTUIEnv, "1")
+	if !PlainTUIFromEnv() {
+		t.Error("SMOKE_PLAIN_TUI=1 should enable plain mode")
+	}
+	t.Setenv(PlainTUIEnv, "")
+	if PlainTUIFromEnv() {
+		t.Error("empty SMOKE_PLAIN_TUI should not enable plain mode")
+	}
+}
@@ -0,0 +1,146 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dreamiurg/smoke/internal/config"
+)
+
+// handleReactKey opens the emoji picker for the selected post.
+func (m *Model) handleReactKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if msg.String() != "e" {
+		return nil, false
+	}
+	if len(m.displayedPosts) > 0 && m.selectedPostIndex >= 0 && m.selectedPostIndex < len(m.displayedPosts) {
+		m.showReactMenu = true
+		m.reactMenuIndex = 0
+	}
+	return nil, true
+}
+
+// handleReactMenuKey handles key events when the emoji picker is visible.
+// Number keys pick an emoji directly; arrows and Enter pick the highlighted one.
+func (m *Model) handleReactMenuKey(msg tea.KeyMsg) tea.Cmd {
+	key := msg.String()
+	switch key {
+	case "q", "esc":
+		m.showReactMenu = false
+		return nil
+	case "left", "h", "up", "k":
+		if m.reactMenuIndex > 0 {
+			m.reactMenuIndex--
+		}
+		return nil
+	case "right", "l", "down", "j":
+		if m.reactMenuIndex < len(ReactionEmojis)-1 {
+			m.reactMenuIndex++
+		}
+		return nil
+	case "enter", " ":
+		m.showReactMenu = false
+		return m.executeReaction()
+	}
+
+	if len(key) == 1 && key[0] >= '1' && int(key[0]-'0') <= len(ReactionEmojis) {
+		m.showReactMenu = false
+		m.reactMenuIndex = int(key[0] - '1')
+		return m.executeReaction()
+	}
+	return nil
+}
+
+// executeReaction records the highlighted emoji on the selected post and
+// reloads the feed so the summary line updates.
+func (m *Model) executeReaction() tea.Cmd {
+	if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+		m.pushNotice("⚠ No post selected")
+		return nil
+	}
+	post := m.displayedPosts[m.selectedPostIndex]
+	emoji := ReactionEmojis[m.reactMenuIndex]
+	reacter, ok := m.store.(interface{ AppendReaction(*Reaction) error })
+	if !ok {
+		m.pushNotice("⚠ This feed does not support reactions")
+		return nil
+	}
+
+	identity, err := config.GetIdentity("")
+	if err != nil {
+		m.pushNotice("⚠ React failed: no identity")
+		return nil
+	}
+	reaction, err := NewReaction(identity.String(), post.ID, emoji)
+	if err == nil {
+		err = reacter.AppendReaction(reaction)
+	}
+	if err != nil {
+		m.pushNotice("⚠ React failed")
+		return nil
+	}
+	m.pushNotice("✓ Reacted " + emoji)
+	return m.loadPostsCmd
+}
+
+// formatReactionLine renders a post's reaction summary, indented to sit under
+// the post content. Returns "" when the post has no reactions.
+func (m Model) formatReactionLine(post *Post, indent string, background lipgloss.AdaptiveColor) string {
+	summary := FormatReactions(post.Reactions)
+	if summary == "" {
+		return ""
+	}
+	style := lipgloss.NewStyle().Foreground(m.theme.TextMuted).Background(background)
+	return m.styleSpaceWithBackground(indent, background) + style.Render(summary)
+}
+
+// renderReactMenuOverlayBox renders the emoji picker as a centered overlay box.
+func (m Model) renderReactMenuOverlayBox() overlayBox {
+	base := lipgloss.NewStyle().Background(m.theme.BackgroundSecondary)
+	titleStyle := base.Foreground(m.theme.Accent).Bold(true)
+	itemStyle := base.Foreground(m.theme.Text)
+	selectedStyle := base.Foreground(m.theme.Background).Background(m.theme.Accent).Bold(true)
+	hintStyle := base.Foreground(m.theme.TextMuted)
+
+	menuWidth := 32
+
+	var items []string
+	for i, emoji := range ReactionEmojis {
+		item := fmt.Sprintf("%d %s", i+1, emoji)
+		if i == m.reactMenuIndex {
+			items = append(items, selectedStyle.Render(item))
+		} else {
+			items = append(items, itemStyle.Render(item))
+		}
+	}
+	half := (len(items) + 1) / 2
+
+	var menuContent strings.Builder
+	menuContent.WriteString(titleStyle.Width(menuWidth).Align(lipgloss.Center).Render("React"))
+	menuContent.WriteString("\n\n")
+	menuContent.WriteString(base.Width(menuWidth).Render("  " + strings.Join(items[:half], base.Render("  "))))
+	menuContent.WriteString("\n")
+	menuContent.WriteString(base.Width(menuWidth).Render("  " + strings.Join(items[half:], base.Render("  "))))
+	menuContent.WriteString("\n\n")
+	menuContent.WriteString(hintStyle.Width(menuWidth).Render("  1-8 pick · ←/→ Enter select"))
+	menuContent.WriteString("\n")
+	menuContent.WriteString(hintStyle.Width(menuWidth).Render("  Esc/q to cancel"))
+	menuContent.WriteString("\n")
+
+	menuStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.Accent).
+		Background(m.theme.BackgroundSecondary).
+		Padding(1, 2).
+		Width(menuWidth)
+
+	contentWithBackground := m.fillBackgroundBlock(menuContent.String(), menuWidth, m.theme.BackgroundSecondary)
+	return m.centerOverlay(menuStyle.Render(contentWithBackground))
+}
+
+// renderReactMenuOverlay returns a string-rendered overlay (used in tests).
+func (m Model) renderReactMenuOverlay() string {
+	return m.renderOverlayBoxString(m.renderReactMenuOverlayBox())
+}
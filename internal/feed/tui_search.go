@@ -0,0 +1,237 @@
+package feed
+
+import (
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// visibleThreads returns threads in display order (oldest first), limited to
+// the focused thread and threads matching the search query, tag filter, and
+// bookmarks when set.
+func (m Model) visibleThreads() []thread {
+	threads := buildThreads(m.posts)
+	for i, j := 0, len(threads)-1; i < j; i, j = i+1, j-1 {
+		threads[i], threads[j] = threads[j], threads[i]
+	}
+	if m.focusThreadID == "" && m.searchQuery == "" && m.tagFilter == "" && !m.showBookmarksOnly {
+		return threads
+	}
+
+	filtered := make([]thread, 0, len(threads))
+	for _, t := range threads {
+		if m.focusThreadID != "" && t.post.ID != m.focusThreadID {
+			continue
+		}
+		if m.searchQuery != "" && !threadMatchesQuery(t, m.searchQuery) {
+			continue
+		}
+		if m.tagFilter != "" && !threadHasTag(t, m.tagFilter) {
+			continue
+		}
+		if m.showBookmarksOnly && !m.bookmarks[t.post.ID] {
+			continue
+		}
+		filtered = append(filtered, t)
+	}
+	return filtered
+}
+
+// refilter rebuilds displayedPosts after a filter change, keeping the
+// selected post selected when it is still shown.
+func (m *Model) refilter() {
+	selectedID := ""
+	if m.selectedPostIndex >= 0 && m.selectedPostIndex < len(m.displayedPosts) {
+		selectedID = m.displayedPosts[m.selectedPostIndex].ID
+	}
+
+	m.updateDisplayedPosts()
+
+	m.selectedPostIndex = 0
+	for i, post := range m.displayedPosts {
+		if post.ID == selectedID {
+			m.selectedPostIndex = i
+			break
+		}
+	}
+	m.ensureSelectedVisible()
+}
+
+// threadMatchesQuery reports whether the post or any of its replies contains the query.
+func threadMatchesQuery(t thread, query string) bool {
+	if postMatchesQuery(t.post, query) {
+		return true
+	}
+	for _, reply := range t.replies {
+		if postMatchesQuery(reply, query) {
+			return true
+		}
+	}
+	return false
+}
+
+// postMatchesQuery reports whether content or author contains the query, ignoring case.
+func postMatchesQuery(post *Post, query string) bool {
+	q := strings.ToLower(query)
+	return strings.Contains(strings.ToLower(post.Content), q) ||
+		strings.Contains(strings.ToLower(post.Author), q)
+}
+
+// handleSearchKeys starts a search with / and cycles matches with n/N.
+func (m *Model) handleSearchKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "/":
+		m.searchActive = true
+		return nil, true
+	case "n":
+		if m.searchQuery == "" {
+			return nil, false
+		}
+		m.cycleSearchMatch(1)
+		return nil, true
+	case "N":
+		if m.searchQuery == "" {
+			return nil, false
+		}
+		m.cycleSearchMatch(-1)
+		return nil, true
+	case "esc":
+		if m.searchQuery == "" {
+			return nil, false
+		}
+		m.setSearchQuery("")
+		return nil, true
+	}
+	return nil, false
+}
+
+// handleSearchInputKey edits the search prompt while search mode is active.
+func (m *Model) handleSearchInputKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.searchActive = false
+		m.setSearchQuery("")
+	case tea.KeyEnter:
+		m.searchActive = false
+		m.jumpToFirstMatch()
+	case tea.KeyBackspace:
+		if query := []rune(m.searchQuery); len(query) > 0 {
+			m.setSearchQuery(string(query[:len(query)-1]))
+		}
+	case tea.KeyCtrlC:
+		return tea.Quit
+	case tea.KeySpace:
+		m.setSearchQuery(m.searchQuery + " ")
+	case tea.KeyRunes:
+		m.setSearchQuery(m.searchQuery + string(msg.Runes))
+	}
+	return nil
+}
+
+// setSearchQuery applies a new filter and moves the cursor to the first match.
+func (m *Model) setSearchQuery(query string) {
+	selectedID := ""
+	if m.selectedPostIndex >= 0 && m.selectedPostIndex < len(m.displayedPosts) {
+		selectedID = m.displayedPosts[m.selectedPostIndex].ID
+	}
+
+	m.searchQuery = query
+	m.updateDisplayedPosts()
+
+	if query == "" {
+		// Restore the previous selection in the full feed
+		for i, post := range m.displayedPosts {
+			if post.ID == selectedID {
+				m.selectedPostIndex = i
+				break
+			}
+		}
+		m.ensureSelectedVisible()
+		return
+	}
+	m.jumpToFirstMatch()
+}
+
+// updateSearchMatches records which displayed posts match the current query.
+func (m *Model) updateSearchMatches() {
+	m.searchMatches = nil
+	if m.searchQuery == "" {
+		return
+	}
+	for i := range m.displayedPosts {
+		m.searchMatches = append(m.searchMatches, i)
+	}
+}
+
+func (m *Model) jumpToFirstMatch() {
+	if len(m.searchMatches) == 0 {
+		return
+	}
+	m.selectedPostIndex = m.searchMatches[0]
+	m.ensureSelectedVisible()
+}
+
+// cycleSearchMatch moves to the next (+1) or previous (-1) match, wrapping around.
+func (m *Model) cycleSearchMatch(direction int) {
+	if len(m.searchMatches) == 0 {
+		return
+	}
+	current := -1
+	for i, idx := range m.searchMatches {
+		if idx == m.selectedPostIndex {
+			current = i
+			break
+		}
+	}
+	next := 0
+	if current != -1 {
+		next = (current + direction + len(m.searchMatches)) % len(m.searchMatches)
+	}
+	m.selectedPostIndex = m.searchMatches[next]
+	m.ensureSelectedVisible()
+}
+
+// currentMatchNumber returns the 1-based position of the selection among matches, or 0.
+func (m Model) currentMatchNumber() int {
+	for i, idx := range m.searchMatches {
+		if idx == m.selectedPostIndex {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// highlightContent applies hashtag/mention highlighting and marks search matches.
+// Mentions of the current identity are emphasized.
+func (m Model) highlightContent(text string, background lipgloss.AdaptiveColor) string {
+	if m.searchQuery == "" {
+		return HighlightForIdentity(text, m.theme, background, m.identity)
+	}
+
+	matchStyle := lipgloss.NewStyle().
+		Foreground(m.theme.Background).
+		Background(m.theme.Accent).
+		Bold(true)
+
+	pattern := regexp.MustCompile("(?i)" + regexp.QuoteMeta(m.searchQuery))
+	matches := pattern.FindAllStringIndex(text, -1)
+	if len(matches) == 0 {
+		return HighlightForIdentity(text, m.theme, background, m.identity)
+	}
+
+	var result strings.Builder
+	lastEnd := 0
+	for _, match := range matches {
+		if match[0] > lastEnd {
+			result.WriteString(HighlightForIdentity(text[lastEnd:match[0]], m.theme, background, m.identity))
+		}
+		result.WriteString(matchStyle.Render(text[match[0]:match[1]]))
+		lastEnd = match[1]
+	}
+	if lastEnd < len(text) {
+		result.WriteString(HighlightForIdentity(text[lastEnd:], m.theme, background, m.identity))
+	}
+	return result.String()
+}
@@ -0,0 +1,134 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func searchTestModel(t *testing.T) Model {
+	t.Helper()
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 100
+	model.height = 30
+
+	now := time.Now().UTC()
+	model.posts = []*Post{
+		{ID: "smk-aaaaaa", Author: "ember@smoke", Content: "found the retry bug", CreatedAt: now.Add(-4 * time.Minute).Format(time.RFC3339)},
+		{ID: "smk-bbbbbb", Author: "spark@smoke", Content: "lunch", CreatedAt: now.Add(-3 * time.Minute).Format(time.RFC3339)},
+		{ID: "smk-cccccc", Author: "wisp@smoke", Content: "Retry storms again", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "smk-dddddd", Author: "flare@smoke", Content: "coffee", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "smk-eeeeee", Author: "ember@smoke", Content: "retry in a reply", CreatedAt: now.Format(time.RFC3339), ParentID: "smk-dddddd"},
+	}
+	model.updateDisplayedPosts()
+	model.selectedPostIndex = 1
+	return model
+}
+
+func typeKeys(t *testing.T, model Model, keys ...tea.KeyMsg) Model {
+	t.Helper()
+	for _, key := range keys {
+		updated, _ := model.Update(key)
+		model = updated.(Model)
+	}
+	return model
+}
+
+func runeKey(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestSearch_FiltersLiveAndJumps(t *testing.T) {
+	model := searchTestModel(t)
+
+	model = typeKeys(t, model, runeKey("/"))
+	if !model.searchActive {
+		t.Fatal("/ should activate search")
+	}
+
+	model = typeKeys(t, model, runeKey("r"), runeKey("e"), runeKey("t"), runeKey("r"), runeKey("y"))
+	if model.searchQuery != "retry" {
+		t.Fatalf("searchQuery = %q, want %q", model.searchQuery, "retry")
+	}
+	// Two posts match directly and one thread matches through its reply
+	if len(model.displayedPosts) != 3 || len(model.searchMatches) != 3 {
+		t.Fatalf("expected 3 matching threads, got %d displayed, %d matches", len(model.displayedPosts), len(model.searchMatches))
+	}
+	if !strings.Contains(model.renderStatusBar(), "retry") {
+		t.Error("status bar should show the search prompt")
+	}
+
+	model = typeKeys(t, model, tea.KeyMsg{Type: tea.KeyEnter})
+	if model.searchActive {
+		t.Error("Enter should close the search prompt")
+	}
+	if model.displayedPosts[model.selectedPostIndex].ID != "smk-aaaaaa" {
+		t.Errorf("Enter should jump to first match, got %s", model.displayedPosts[model.selectedPostIndex].ID)
+	}
+}
+
+func TestSearch_CycleMatches(t *testing.T) {
+	model := searchTestModel(t)
+	model = typeKeys(t, model, runeKey("/"), runeKey("retry"), tea.KeyMsg{Type: tea.KeyEnter})
+
+	model = typeKeys(t, model, runeKey("n"))
+	if got := model.displayedPosts[model.selectedPostIndex].ID; got != "smk-cccccc" {
+		t.Errorf("n should move to next match, got %s", got)
+	}
+	model = typeKeys(t, model, runeKey("N"), runeKey("N"))
+	if got := model.displayedPosts[model.selectedPostIndex].ID; got != "smk-dddddd" {
+		t.Errorf("N should wrap to last match, got %s", got)
+	}
+}
+
+func TestSearch_EscRestoresFeed(t *testing.T) {
+	model := searchTestModel(t)
+	model = typeKeys(t, model, runeKey("/"), runeKey("coffee"), tea.KeyMsg{Type: tea.KeyEnter})
+	if len(model.displayedPosts) != 1 {
+		t.Fatalf("expected 1 match, got %d", len(model.displayedPosts))
+	}
+
+	model = typeKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc})
+	if model.searchQuery != "" || len(model.searchMatches) != 0 {
+		t.Error("Esc should clear the search")
+	}
+	if len(model.displayedPosts) != 4 {
+		t.Errorf("Esc should restore the full feed, got %d posts", len(model.displayedPosts))
+	}
+	if got := model.displayedPosts[model.selectedPostIndex].ID; got != "smk-dddddd" {
+		t.Errorf("selection should stay on the matched post, got %s", got)
+	}
+}
+
+func TestSearch_EmptyQueryIsNoFilter(t *testing.T) {
+	model := searchTestModel(t)
+	model = typeKeys(t, model, runeKey("/"), runeKey("x"), tea.KeyMsg{Type: tea.KeyBackspace})
+	if len(model.displayedPosts) != 4 || model.searchMatches != nil {
+		t.Errorf("empty query should show all posts, got %d", len(model.displayedPosts))
+	}
+}
+
+func TestSearch_NoMatchesMessage(t *testing.T) {
+	model := searchTestModel(t)
+	model = typeKeys(t, model, runeKey("/"), runeKey("zzz"))
+
+	lines := model.buildAllContentLinesWithPosts()
+	if len(lines) != 1 || !strings.Contains(lines[0].text, "No posts match") {
+		t.Errorf("expected no-match message, got %+v", lines)
+	}
+}
+
+func TestHighlightContent_KeepsText(t *testing.T) {
+	model := searchTestModel(t)
+	model.searchQuery = "retry"
+
+	highlighted := model.highlightContent("Retry later, retry now", model.theme.Background)
+	for _, want := range []string{"Retry", " later, ", "retry", " now"} {
+		if !strings.Contains(highlighted, want) {
+			t.Errorf("highlighted text missing %q: %q", want, highlighted)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package feed
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// threadHasTag reports whether the post or any of its replies uses the tag.
+func threadHasTag(t thread, tag string) bool {
+	if t.post.HasTag(tag) {
+		return true
+	}
+	for _, reply := range t.replies {
+		if reply.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleTagKey filters by the selected post's tags with #. Pressing # again
+// moves to the post's next tag, and past the last one clears the filter.
+func (m *Model) handleTagKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "#":
+		if m.selectedPostIndex < 0 || m.selectedPostIndex >= len(m.displayedPosts) {
+			return nil, true
+		}
+		tags := m.displayedPosts[m.selectedPostIndex].Tags()
+		if len(tags) == 0 {
+			m.pushNotice("No tags on this post")
+			return nil, true
+		}
+		m.setTagFilter(nextTag(tags, m.tagFilter))
+		return nil, true
+	case "esc":
+		if m.tagFilter == "" {
+			return nil, false
+		}
+		m.setTagFilter("")
+		return nil, true
+	}
+	return nil, false
+}
+
+// nextTag returns the tag after current in tags, the first tag when current
+// is not among them, or "" after the last tag.
+func nextTag(tags []string, current string) string {
+	for i, tag := range tags {
+		if tag == current {
+			if i+1 < len(tags) {
+				return tags[i+1]
+			}
+			return ""
+		}
+	}
+	return tags[0]
+}
+
+// setTagFilter applies a tag filter and keeps the selected post selected.
+func (m *Model) setTagFilter(tag string) {
+	m.tagFilter = tag
+	m.refilter()
+}
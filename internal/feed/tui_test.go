@@ -1,20 +1,25 @@
 package feed
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"image/png"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	xansi "github.com/charmbracelet/x/ansi"
 
 	"github.com/dreamiurg/smoke/internal/config"
 )
 
 // testModel creates a test model with default theme, contrast, layout, and config
-func testModel(store *Store) Model {
+func testModel(store Store) Model {
 	theme := GetTheme("dracula")
 	contrast := GetContrastLevel("medium")
 	layout := GetLayout("comfy")
@@ -92,6 +97,7 @@ func TestModelInit(t *testing.T) {
 }
 
 func TestModelUpdate_QuitKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 
 	tests := []struct {
@@ -187,6 +193,41 @@ func TestModelUpdate_LayoutCycling(t *testing.T) {
 	}
 }
 
+func TestModelUpdate_ContrastCycling(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".config", "smoke"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")}
+	updated, _ := model.Update(msg)
+	updatedModel := updated.(Model)
+
+	if want := NextContrastLevel("medium"); updatedModel.config.Contrast != want {
+		t.Errorf("Update(y) contrast = %q, want %q", updatedModel.config.Contrast, want)
+	}
+	if updatedModel.contrast.Name != updatedModel.config.Contrast {
+		t.Error("Update(y) should update model contrast to match config")
+	}
+	if saved := config.LoadTUIConfig().Contrast; saved != updatedModel.config.Contrast {
+		t.Errorf("Update(y) saved contrast %q, want %q", saved, updatedModel.config.Contrast)
+	}
+
+	msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")}
+	updated, _ = updatedModel.Update(msg)
+	updatedModel = updated.(Model)
+
+	if updatedModel.config.Contrast != "medium" || updatedModel.contrast.Name != "medium" {
+		t.Errorf("Update(Y) should cycle back to medium, got %q", updatedModel.config.Contrast)
+	}
+	if saved := config.LoadTUIConfig().Contrast; saved != "medium" {
+		t.Errorf("Update(Y) saved contrast %q, want medium", saved)
+	}
+}
+
 func TestModelUpdate_AutoRefreshToggle(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
@@ -287,15 +328,7 @@ func TestModelUpdate_TickMsgDisabled(t *testing.T) {
 }
 
 func TestModelUpdate_LoadPostsMsg(t *testing.T) {
-	tmpDir := t.TempDir()
-	feedPath := tmpDir + "/feed.jsonl"
-
-	// Create empty feed file
-	if err := os.WriteFile(feedPath, []byte{}, 0644); err != nil {
-		t.Fatalf("Failed to create feed file: %v", err)
-	}
-
-	store := NewStoreWithPath(feedPath)
+	store := &memStore{}
 	model := testModel(store)
 
 	// Add a post to the store
@@ -497,15 +530,88 @@ func TestStyleIdentity(t *testing.T) {
 func TestRenderStatusBar(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
-	model.width = 80
+	model.width = 120
 
 	result := model.renderStatusBar()
 
 	if result == "" {
 		t.Error("renderStatusBar() should return status bar")
 	}
-	if !strings.Contains(result, "Layout") {
-		t.Error("renderStatusBar() should show layout keybinding")
+	plain := xansi.Strip(result)
+	for _, want := range []string{"? Help", "Space Read", "a Auto ON", "l/L Layout Comfy", "t/T Theme Dracula"} {
+		if !strings.Contains(plain, want) {
+			t.Errorf("renderStatusBar() = %q, want it to contain %q", plain, want)
+		}
+	}
+}
+
+func TestRenderStatusBar_NarrowWidth(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	for _, width := range []int{1, 8, 20, 45} {
+		model := testModel(store)
+		model.width = width
+		model.unreadCount = 12
+		model.reportError(errors.New("config save failed"))
+
+		result := model.renderStatusBar()
+		if strings.Contains(result, "\n") {
+			t.Errorf("width %d: status bar wrapped: %q", width, result)
+		}
+		if got := lipgloss.Width(result); got != width {
+			t.Errorf("width %d: status bar is %d cells wide", width, got)
+		}
+	}
+
+	model := testModel(store)
+	model.width = 20
+	if plain := xansi.Strip(model.renderStatusBar()); !strings.HasPrefix(plain, "? Help") {
+		t.Errorf("help hint should survive trimming, got %q", plain)
+	}
+}
+
+func TestView_HideStatusBar(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 80
+	model.height = 24
+	shown := model.contentHeight()
+	hide := false
+	model.config.ShowStatusBar = &hide
+
+	if model.showStatusBar() {
+		t.Fatal("status bar should be hidden when show_status_bar is false")
+	}
+	if strings.Contains(xansi.Strip(model.View()), "? Help") {
+		t.Error("View() should not render the status bar when hidden")
+	}
+	if got := model.contentHeight(); got != shown+1 {
+		t.Errorf("contentHeight() = %d, want %d when the status bar is hidden", got, shown+1)
+	}
+
+	model.searchActive = true
+	if !model.showStatusBar() {
+		t.Error("status bar should appear while typing a search")
+	}
+}
+
+func TestView_HiddenStatusBarShowsDeletePrompt(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 80
+	model.height = 24
+	hide := false
+	model.config.ShowStatusBar = &hide
+	model.displayedPosts = []*Post{{ID: "smk-abc123", Author: "a", Suffix: "b", Content: "x"}}
+	model.selectedPostIndex = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	model = updated.(Model)
+	view := xansi.Strip(model.View())
+	if !strings.Contains(view, deleteArmedNotice) {
+		t.Error("the delete prompt should be visible with the status bar hidden")
+	}
+	if strings.Contains(view, "? Help") {
+		t.Error("the legend should stay hidden while a notice shows")
 	}
 }
 
@@ -513,7 +619,7 @@ func TestRenderStatusBar_WithError(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
 	model.width = 80
-	model.err = errors.New("config save failed")
+	model.reportError(errors.New("config save failed"))
 
 	result := model.renderStatusBar()
 
@@ -563,6 +669,9 @@ func TestRenderHelpOverlay(t *testing.T) {
 	if !strings.Contains(result, "Cycle layout") {
 		t.Error("renderHelpOverlay() should show layout cycling keybinding")
 	}
+	if !strings.Contains(result, "Contrast:") || !strings.Contains(result, "Medium") {
+		t.Error("renderHelpOverlay() should show current contrast")
+	}
 	if !strings.Contains(result, "Copy selected post") {
 		t.Error("renderHelpOverlay() should show copy keybinding")
 	}
@@ -805,6 +914,34 @@ func TestFormatPostComfy(t *testing.T) {
 	}
 }
 
+func TestFormatPost_WideCharactersFitContentWidth(t *testing.T) {
+	post := &Post{
+		ID:        "smk-test123",
+		Author:    "クロード@プロジェクト",
+		Suffix:    "スモーク",
+		Content:   "今日はリリースの準備をしました 🚀 テストはすべて通りました 🎉 次はドキュメントを更新します 📝 よろしくお願いします",
+		CreatedAt: "2026-01-30T09:24:00Z",
+	}
+	for _, layout := range []string{"dense", "comfy", "relaxed"} {
+		t.Run(layout, func(t *testing.T) {
+			store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+			model := testModel(store)
+			model.width = 90
+			model.layout = GetLayout(layout)
+
+			lines := model.formatPost(post)
+			if len(lines) < 2 {
+				t.Fatalf("expected wrapped output, got %q", lines)
+			}
+			for _, line := range lines {
+				if w := lipgloss.Width(line); w > model.contentWidth() {
+					t.Errorf("line is %d cells, want <= %d: %q", w, model.contentWidth(), line)
+				}
+			}
+		})
+	}
+}
+
 func TestFormatPostRelaxed(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
@@ -937,6 +1074,21 @@ func TestRenderHeader_ContainsStats(t *testing.T) {
 	}
 }
 
+func TestRenderHeader_DraftCount(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 120
+
+	if strings.Contains(model.renderHeader(), "drafts") {
+		t.Error("renderHeader() should hide the draft count when nothing is queued")
+	}
+
+	updated, _ := model.Update(loadPostsMsg{draftCount: 2})
+	if result := updated.(Model).renderHeader(); !strings.Contains(result, "2 drafts") {
+		t.Errorf("renderHeader() should show queued drafts, got: %s", result)
+	}
+}
+
 func TestRenderHeader_ContainsClock(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
@@ -991,6 +1143,399 @@ func TestInitialSelection_Unread(t *testing.T) {
 	}
 }
 
+func TestUnread_LastReadPostDeleted(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.lastReadPostID = "2"
+
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "1", Author: "ember", Content: "post 1", CreatedAt: now.Add(-3 * time.Minute).Format(time.RFC3339)},
+		{ID: "2", Author: "ember", Content: "post 2", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "3", Author: "swift", Content: "post 3", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "4", Author: "spark", Content: "post 4", CreatedAt: now.Format(time.RFC3339)},
+	}
+	updated, _ := model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	if model.unreadCount != 2 {
+		t.Fatalf("unreadCount = %d, want 2", model.unreadCount)
+	}
+
+	// The last read post is deleted on the next refresh
+	updated, _ = model.Update(loadPostsMsg{posts: []*Post{posts[0], posts[2], posts[3]}})
+	model = updated.(Model)
+	updated, _ = model.Update(tea.WindowSizeMsg{Width: 80, Height: 40})
+	model = updated.(Model)
+
+	if model.unreadCount != 2 {
+		t.Errorf("unreadCount after delete = %d, want 2", model.unreadCount)
+	}
+	if model.unreadAgentCount != 2 {
+		t.Errorf("unreadAgentCount after delete = %d, want 2", model.unreadAgentCount)
+	}
+	lines := model.buildAllContentLinesWithPosts()
+	marker := model.findUnreadMarkerLine(lines)
+	if marker < 0 || lines[marker].postIndex != unreadSeparatorIndex {
+		t.Fatalf("marker line = %d, want the UNREAD separator", marker)
+	}
+	if next := lines[marker+1].postIndex; next != 1 {
+		t.Errorf("post after UNREAD separator = %d, want 1 (post 3)", next)
+	}
+}
+
+func TestUnread_SavedLastReadTime(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	now := time.Now().UTC().Truncate(time.Second)
+	if err := config.SaveLastRead("gone", now.Add(-90*time.Second)); err != nil {
+		t.Fatalf("SaveLastRead: %v", err)
+	}
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := NewModel(ModelOptions{
+		Store:    store,
+		Theme:    GetTheme("dracula"),
+		Contrast: GetContrastLevel("medium"),
+		Layout:   GetLayout("comfy"),
+		Config:   &config.TUIConfig{Theme: "dracula", Contrast: "medium", Layout: "comfy"},
+		Version:  "test",
+	})
+
+	posts := []*Post{
+		{ID: "1", Content: "post 1", CreatedAt: now.Add(-3 * time.Minute).Format(time.RFC3339)},
+		{ID: "2", Content: "post 2", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "3", Content: "post 3", CreatedAt: now.Format(time.RFC3339)},
+	}
+	updated, _ := model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	updated, _ = model.Update(tea.WindowSizeMsg{Width: 80, Height: 20})
+	model = updated.(Model)
+
+	if model.unreadCount != 2 {
+		t.Errorf("unreadCount = %d, want 2", model.unreadCount)
+	}
+	if model.selectedPostIndex != 1 {
+		t.Errorf("selectedPostIndex = %d, want 1 (first unread)", model.selectedPostIndex)
+	}
+}
+
+func TestMarkAllReadAndUnreadKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "1", Content: "post 1", CreatedAt: now.Add(-4 * time.Minute).Format(time.RFC3339)},
+		{ID: "2", Content: "post 2", CreatedAt: now.Add(-3 * time.Minute).Format(time.RFC3339)},
+		{ID: "r", ParentID: "2", Content: "reply", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "3", Content: "post 3", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "4", Content: "post 4", CreatedAt: now.Format(time.RFC3339)},
+	}
+	updated, _ := model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	press := func(key string) {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		model = updated.(Model)
+	}
+
+	press("A")
+	if model.lastReadPostID != "4" || model.unreadCount != 0 {
+		t.Fatalf("after A: lastReadPostID = %q, unreadCount = %d, want \"4\", 0", model.lastReadPostID, model.unreadCount)
+	}
+	if state, err := config.LoadReadState(); err != nil || state.LastReadPostID != "4" {
+		t.Errorf("saved LastReadPostID = %v (err %v), want \"4\"", state, err)
+	}
+
+	// Post 3 is displayed at index 2; its unread boundary is post 2
+	model.selectedPostIndex = 2
+	press("u")
+	if model.lastReadPostID != "2" || model.unreadCount != 2 {
+		t.Errorf("after u: lastReadPostID = %q, unreadCount = %d, want \"2\", 2", model.lastReadPostID, model.unreadCount)
+	}
+
+	// Marking the first post unread makes the whole feed unread
+	model.selectedPostIndex = 0
+	press("u")
+	if model.unreadCount != 4 {
+		t.Errorf("after u on first post: unreadCount = %d, want 4", model.unreadCount)
+	}
+	if state, err := config.LoadReadState(); err != nil || state.LastReadPostID != "1" {
+		t.Errorf("saved LastReadPostID = %v (err %v), want \"1\"", state, err)
+	}
+	model.initSelectionToUnread()
+	if model.selectedPostIndex != 0 {
+		t.Errorf("first unread = %d, want 0", model.selectedPostIndex)
+	}
+}
+
+func TestNotifyAuthors(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.config.NotifyAuthors = []string{"swift-fox"}
+	model.identity = "ember@smoke"
+
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "1", Author: "swift-fox@smoke", Content: "old", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+	}
+	updated, cmd := model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	if cmd != nil || model.notifyFlashing(time.Now()) {
+		t.Fatal("posts present at the first load should not notify")
+	}
+
+	// A new post from someone else does not notify
+	posts = append(posts, &Post{ID: "2", Author: "calm-owl@smoke", Content: "hi", CreatedAt: now.Add(-time.Minute).Format(time.RFC3339)})
+	updated, cmd = model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	if cmd != nil || model.notifyFlashing(time.Now()) {
+		t.Fatal("post by an unwatched author should not notify")
+	}
+
+	posts = append(posts, &Post{ID: "3", Author: "swift-fox@smoke", Content: "new", CreatedAt: now.Format(time.RFC3339)})
+	updated, cmd = model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	if cmd == nil {
+		t.Error("post by a watched author should ring the bell")
+	}
+	if !model.notifyFlashing(time.Now()) {
+		t.Error("post by a watched author should flash the header")
+	}
+	if len(model.notices) == 0 || !strings.Contains(model.notices[len(model.notices)-1].text, "swift-fox@smoke") {
+		t.Errorf("notices = %v, want one naming swift-fox@smoke", model.notices)
+	}
+
+	// The same post does not ring again on the next refresh
+	model.notifyUntil = time.Time{}
+	updated, cmd = model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	if cmd != nil || model.notifyFlashing(time.Now()) {
+		t.Error("a post should notify only once per run")
+	}
+}
+
+func TestInitialSelection_RestoresSavedPosition(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.savedPostID = "2"
+	model.lastReadPostID = "3"
+
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "1", Content: "post 1", CreatedAt: now.Add(-3 * time.Minute).Format(time.RFC3339)},
+		{ID: "2", Content: "post 2", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "3", Content: "post 3", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "4", Content: "post 4", CreatedAt: now.Format(time.RFC3339)},
+	}
+
+	updated, _ := model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+	updated, _ = model.Update(tea.WindowSizeMsg{Width: 80, Height: 10})
+	model = updated.(Model)
+
+	if model.selectedPostIndex != 1 {
+		t.Errorf("selectedPostIndex = %d, want 1 (saved post)", model.selectedPostIndex)
+	}
+
+	// Quitting saves the current selection
+	model.selectedPostIndex = 2
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if got := savedReadState(t).SelectedPostID; got != "3" {
+		t.Errorf("saved SelectedPostID = %q, want %q", got, "3")
+	}
+}
+
+// savedReadState returns the read state on disk.
+func savedReadState(t *testing.T) *config.ReadState {
+	t.Helper()
+	state, err := config.LoadReadState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+func TestInitialSelection_RestoresAcrossRestart(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "1", Content: "post 1", CreatedAt: now.Add(-3 * time.Minute).Format(time.RFC3339)},
+		{ID: "2", Content: "post 2", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "3", Content: "post 3", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+	}
+	launch := func() Model {
+		model := testModel(store)
+		updated, _ := model.Update(loadPostsMsg{posts: posts})
+		updated, _ = updated.(Model).Update(tea.WindowSizeMsg{Width: 80, Height: 10})
+		return updated.(Model)
+	}
+
+	first := launch()
+	for i, post := range first.displayedPosts {
+		if post.ID == "2" {
+			first.selectedPostIndex = i
+		}
+	}
+	first.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+
+	second := launch()
+	if got := second.displayedPosts[second.selectedPostIndex].ID; got != "2" {
+		t.Errorf("selected post after restart = %q, want %q", got, "2")
+	}
+}
+
+func TestSavePosition_OptOut(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := config.SaveLastRead("1", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	off := false
+	model.config.RememberPosition = &off
+	model.displayedPosts = []*Post{{ID: "1"}, {ID: "2"}}
+	model.selectedPostIndex = 1
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	state := savedReadState(t)
+	if state.SelectedPostID != "" {
+		t.Errorf("saved SelectedPostID = %q, want none with remember_position off", state.SelectedPostID)
+	}
+
+	// Turned back on, the position is saved alongside the read marker
+	model.config.RememberPosition = nil
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	state = savedReadState(t)
+	if state.SelectedPostID != "2" || state.LastReadPostID != "1" {
+		t.Errorf("read state = %+v, want selected 2 and last read 1", state)
+	}
+}
+
+func TestInitialSelection_SavedPostMissing(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.savedPostID = "gone"
+	model.lastReadPostID = "1"
+
+	now := time.Now().UTC()
+	posts := []*Post{
+		{ID: "1", Content: "post 1", CreatedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)},
+		{ID: "2", Content: "post 2", CreatedAt: now.Add(-1 * time.Minute).Format(time.RFC3339)},
+		{ID: "3", Content: "post 3", CreatedAt: now.Format(time.RFC3339)},
+	}
+
+	updated, _ := model.Update(loadPostsMsg{posts: posts})
+	model = updated.(Model)
+
+	if model.selectedPostIndex != 1 {
+		t.Errorf("selectedPostIndex = %d, want 1 (fallback to first unread)", model.selectedPostIndex)
+	}
+}
+
+func TestBuildAllContentLines_MaxReplies(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 100
+	model.height = 40
+	model.maxReplies = 2
+
+	now := time.Now().UTC()
+	model.posts = []*Post{{ID: "smk-parent", Author: "a", Suffix: "b", Content: "parent", CreatedAt: now.Add(-time.Hour).Format(time.RFC3339)}}
+	for i := 1; i <= 5; i++ {
+		model.posts = append(model.posts, &Post{
+			ID:        fmt.Sprintf("smk-reply%d", i),
+			Author:    "c",
+			Suffix:    "d",
+			Content:   fmt.Sprintf("reply number %d", i),
+			CreatedAt: now.Add(time.Duration(i-60) * time.Minute).Format(time.RFC3339),
+			ParentID:  "smk-parent",
+		})
+	}
+	model.updateDisplayedPosts()
+
+	var text strings.Builder
+	for _, line := range model.buildAllContentLinesWithPosts() {
+		text.WriteString(line.text)
+		text.WriteString("\n")
+	}
+	output := text.String()
+
+	if !strings.Contains(output, "… 3 more replies") {
+		t.Errorf("expected collapsed replies stub, got: %s", output)
+	}
+	if strings.Contains(output, "reply number 3") {
+		t.Error("middle replies should be collapsed")
+	}
+	if !strings.Contains(output, "reply number 5") {
+		t.Error("last reply should be shown")
+	}
+}
+
+func TestBuildAllContentLines_ReplySummary(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 100
+	model.height = 40
+
+	now := time.Now().UTC()
+	at := func(minutesAgo int) string {
+		return now.Add(-time.Duration(minutesAgo) * time.Minute).Format(time.RFC3339)
+	}
+	model.posts = []*Post{
+		{ID: "smk-parent", Author: "ember@smoke", Content: "deploy is green", CreatedAt: at(60)},
+		{ID: "smk-reply1", Author: "wisp@smoke", Content: "nice", CreatedAt: at(30), ParentID: "smk-parent"},
+		{ID: "smk-reply2", Author: "spark@smoke", Content: "finally", CreatedAt: at(5), ParentID: "smk-reply1"},
+		{ID: "smk-reply3", Author: "flare@smoke", Content: "ship it", CreatedAt: at(20), ParentID: "smk-parent"},
+		{ID: "smk-single", Author: "wisp@smoke", Content: "lunch?", CreatedAt: at(50)},
+		{ID: "smk-reply4", Author: "ember@smoke", Content: "yes", CreatedAt: at(45), ParentID: "smk-single"},
+		{ID: "smk-quiet", Author: "spark@smoke", Content: "no replies here", CreatedAt: at(40)},
+	}
+	model.updateDisplayedPosts()
+
+	render := func() []contentLine { return model.buildAllContentLinesWithPosts() }
+	lineAfter := func(lines []contentLine, content string) contentLine {
+		for i, line := range lines {
+			if !strings.Contains(xansi.Strip(line.text), content) {
+				continue
+			}
+			if i+1 == len(lines) {
+				return contentLine{}
+			}
+			return lines[i+1]
+		}
+		t.Fatalf("%q not rendered", content)
+		return contentLine{}
+	}
+
+	for _, layout := range []string{"comfy", "relaxed"} {
+		model.layout = GetLayout(layout)
+		lines := render()
+
+		summary := lineAfter(lines, "deploy is green")
+		if got := xansi.Strip(summary.text); !strings.Contains(got, "· 3 replies · last 5m ago") {
+			t.Errorf("%s: summary under parent = %q, want 3 replies, last 5m ago", layout, got)
+		}
+		if parent := model.displayedPosts[summary.postIndex]; parent.ID != "smk-parent" {
+			t.Errorf("%s: summary belongs to %s, want smk-parent", layout, parent.ID)
+		}
+		if got := xansi.Strip(lineAfter(lines, "lunch?").text); !strings.Contains(got, "· 1 reply · last 45m ago") {
+			t.Errorf("%s: summary under single-reply parent = %q", layout, got)
+		}
+		if got := xansi.Strip(lineAfter(lines, "no replies here").text); strings.Contains(got, "repl") {
+			t.Errorf("%s: post without replies should have no summary, got %q", layout, got)
+		}
+	}
+
+	model.layout = GetLayout("dense")
+	for _, line := range render() {
+		if strings.Contains(xansi.Strip(line.text), "3 replies") {
+			t.Error("dense layout should not show reply summaries")
+		}
+	}
+}
+
 func TestCursorNavigation(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
@@ -1111,11 +1656,11 @@ func TestRenderPressureIndicator(t *testing.T) {
 		pressure int
 		wantBlks string // Expected block pattern
 	}{
-		{"level 0", 0, "[░░░░]"},
-		{"level 1", 1, "[▓░░░]"},
-		{"level 2", 2, "[▓▓░░]"},
-		{"level 3", 3, "[▓▓▓░]"},
-		{"level 4", 4, "[▓▓▓▓]"},
+		{"level 0", 0, "[░░░░░░░░░░]"},
+		{"level 1", 1, "[▓░░░░░░░░░]"},
+		{"level 5", 5, "[▓▓▓▓▓░░░░░]"},
+		{"level 9", 9, "[▓▓▓▓▓▓▓▓▓░]"},
+		{"level 10", 10, "[▓▓▓▓▓▓▓▓▓▓]"},
 	}
 
 	for _, tt := range tests {
@@ -1165,18 +1710,53 @@ func TestModelUpdate_PressureDecrease(t *testing.T) {
 	}
 }
 
+// TestModelUpdate_PressureSchedule tests that clock ticks follow the schedule
+// until the user adjusts pressure manually.
+func TestModelUpdate_PressureSchedule(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	static := 3
+	model.pressureConfig = &config.SuggestConfig{
+		Pressure:         &static,
+		PressureSchedule: []config.PressureWindow{{Start: "22:00", End: "08:00", Pressure: 0}},
+	}
+
+	night := time.Date(2026, 6, 15, 23, 0, 0, 0, time.Local)
+	day := time.Date(2026, 6, 15, 14, 0, 0, 0, time.Local)
+
+	updated, _ := model.Update(clockTickMsg(night))
+	model = updated.(Model)
+	if model.pressure != 0 {
+		t.Fatalf("pressure at 23:00 = %d, want scheduled 0", model.pressure)
+	}
+	updated, _ = model.Update(clockTickMsg(day))
+	model = updated.(Model)
+	if model.pressure != 3 {
+		t.Fatalf("pressure at 14:00 = %d, want static 3", model.pressure)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	model = updated.(Model)
+	updated, _ = model.Update(clockTickMsg(night))
+	model = updated.(Model)
+	if model.pressure != 2 {
+		t.Errorf("manual pressure should survive schedule changes, got %d", model.pressure)
+	}
+}
+
 // TestModelUpdate_PressureClampUp tests clamping at max pressure
 func TestModelUpdate_PressureClampUp(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
-	model.pressure = 4
+	model.pressure = config.MaxPressure
 
 	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")}
 	updated, _ := model.Update(msg)
 	updatedModel := updated.(Model)
 
-	if updatedModel.pressure != 4 {
-		t.Errorf("Update(+) at level 4 should stay at 4, got %d", updatedModel.pressure)
+	if updatedModel.pressure != config.MaxPressure {
+		t.Errorf("Update(+) at level 10 should stay at 10, got %d", updatedModel.pressure)
 	}
 }
 
@@ -1385,6 +1965,8 @@ func TestHandleCopyMenuKey(t *testing.T) {
 			{"1", 0},
 			{"2", 1},
 			{"3", 2},
+			{"4", 3},
+			{"5", 4},
 		}
 
 		for _, tt := range tests {
@@ -1401,6 +1983,143 @@ func TestHandleCopyMenuKey(t *testing.T) {
 	})
 }
 
+func TestCopyMenu_ThreadOption(t *testing.T) {
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	lonely, _ := NewPost("author", "project", "sfx", "nobody answered")
+	parent, _ := NewPost("author", "project", "sfx", "question")
+	first, _ := NewReply("other", "project", "sfx", "first answer", parent.ID)
+	second, _ := NewReply("third", "project", "sfx", "second answer", parent.ID)
+	model.posts = []*Post{lonely, parent, first, second}
+	model.updateDisplayedPosts()
+
+	for i, post := range model.displayedPosts {
+		if post.ID == lonely.ID {
+			model.selectedPostIndex = i
+		}
+	}
+	if got := len(model.copyMenuItems()); got != 5 {
+		t.Errorf("post without replies: %d menu items, want 5", got)
+	}
+	m := model
+	m.showCopyMenu = true
+	m.handleCopyMenuKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("6")})
+	if !m.showCopyMenu {
+		t.Error("6 should do nothing when there is no thread to share")
+	}
+
+	for i, post := range model.displayedPosts {
+		if post.ID == parent.ID {
+			model.selectedPostIndex = i
+		}
+	}
+	items := model.copyMenuItems()
+	if len(items) != 6 || !strings.Contains(items[5], "Thread") {
+		t.Errorf("post with replies: menu items %v, want a Thread option", items)
+	}
+	m = model
+	m.copyMenuIndex = 4
+	m.handleCopyMenuKey(tea.KeyMsg{Type: tea.KeyDown})
+	if m.copyMenuIndex != 5 {
+		t.Errorf("Down should reach the thread option, got index %d", m.copyMenuIndex)
+	}
+
+	if p, r := model.threadCardPosts(parent); p != parent || r != second {
+		t.Errorf("threadCardPosts(parent) = %v, %v; want parent and newest reply", p, r)
+	}
+	if p, r := model.threadCardPosts(first); p != parent || r != first {
+		t.Errorf("threadCardPosts(reply) = %v, %v; want its parent and itself", p, r)
+	}
+}
+
+func TestCopyMenu_IDAndReplyCommand(t *testing.T) {
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	post, _ := NewPost("author", "project", "sfx", "reply to me")
+	model.posts = []*Post{post}
+	model.updateDisplayedPosts()
+	model.width, model.height = 100, 30
+
+	box := model.renderCopyMenuOverlay()
+	for _, want := range []string{"4. Post ID", "5. Reply command"} {
+		if !strings.Contains(box, want) {
+			t.Errorf("copy menu should list %q:\n%s", want, box)
+		}
+	}
+
+	tests := []struct {
+		index     int
+		wantText  string
+		wantLabel string
+	}{
+		{3, post.ID, "post ID"},
+		{4, "smoke reply " + post.ID + " ''", "reply command"},
+	}
+	for _, tt := range tests {
+		text, label, ok := copyMenuText(tt.index, post)
+		if !ok || text != tt.wantText || label != tt.wantLabel {
+			t.Errorf("copyMenuText(%d) = %q, %q, %v; want %q, %q", tt.index, text, label, ok, tt.wantText, tt.wantLabel)
+		}
+	}
+	if _, _, ok := copyMenuText(1, post); ok {
+		t.Error("image options should not produce clipboard text")
+	}
+}
+
+func TestSaveCardKey(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	post, _ := NewPost("author", "project", "sfx", "worth keeping")
+	model.posts = []*Post{post}
+	model.updateDisplayedPosts()
+	model.selectedPostIndex = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	model = updated.(Model)
+
+	path := filepath.Join(home, "smoke-cards", post.ID+".png")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("card not saved: %v", err)
+	}
+	if _, err := png.Decode(bytes.NewReader(data)); err != nil {
+		t.Errorf("saved card is not a valid PNG: %v", err)
+	}
+	if len(model.notices) == 0 || !strings.Contains(model.notices[len(model.notices)-1].text, path) {
+		t.Errorf("notice should show %s, got %v", path, model.notices)
+	}
+}
+
+func TestCopyMenu_NoClipboard(t *testing.T) {
+	if ClipboardAvailable() {
+		t.Skip("clipboard is available in this session")
+	}
+	t.Setenv("HOME", t.TempDir())
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	post, _ := NewPost("author", "project", "sfx", "content")
+	model.posts = []*Post{post}
+	model.updateDisplayedPosts()
+	model.showCopyMenu = true
+
+	if box := model.renderCopyMenuOverlay(); !strings.Contains(box, "No clipboard · s to save") {
+		t.Error("copy menu should point to s when there is no clipboard")
+	}
+
+	m := model
+	m.handleCopyMenuKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if len(m.notices) == 0 || m.notices[len(m.notices)-1].text != NoClipboardNotice {
+		t.Errorf("copy without clipboard should explain why, got %v", m.notices)
+	}
+
+	m = model
+	m.handleCopyMenuKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if m.showCopyMenu {
+		t.Error("s should close the copy menu")
+	}
+	if len(m.notices) == 0 || !strings.Contains(m.notices[len(m.notices)-1].text, "✓ Saved") {
+		t.Errorf("s in the copy menu should save the card, got %v", m.notices)
+	}
+}
+
 func TestCountUnreadBetween(t *testing.T) {
 	lines := []contentLine{
 		{text: "a", postIndex: 0},
@@ -1542,19 +2261,116 @@ func TestRenderCopyMenuOverlay(t *testing.T) {
 	}
 }
 
-// TestCopyConfirmationClears tests that copy confirmation clears on key press
-func TestCopyConfirmationClears(t *testing.T) {
+// TestNoticeSurvivesKeyPress tests that notices are not cleared by unrelated keys
+func TestNoticeSurvivesKeyPress(t *testing.T) {
 	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
 	model := testModel(store)
-	model.copyConfirmation = "✓ Copied"
+	model.pushNotice("✓ Copied")
 
-	// Any key should clear the confirmation
-	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")}
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}
 	updated, _ := model.Update(msg)
 	updatedModel := updated.(Model)
 
-	if updatedModel.copyConfirmation != "" {
-		t.Error("Key press should clear copy confirmation")
+	if len(updatedModel.notices) != 1 {
+		t.Fatalf("Key press should keep notice, got %d notices", len(updatedModel.notices))
+	}
+}
+
+// TestNoticeExpiresOnClockTick tests that expired notices clear on the clock tick
+func TestNoticeExpiresOnClockTick(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 120
+	model.pushNotice("✓ Copied")
+
+	updated, _ := model.Update(clockTickMsg(time.Now()))
+	updatedModel := updated.(Model)
+	if len(updatedModel.notices) != 1 {
+		t.Fatal("Notice should remain before expiry")
+	}
+
+	updated, _ = updatedModel.Update(clockTickMsg(time.Now().Add(noticeTTL + time.Second)))
+	updatedModel = updated.(Model)
+	if len(updatedModel.notices) != 0 {
+		t.Error("Notice should clear after expiry")
+	}
+	if strings.Contains(updatedModel.renderStatusBar(), "Copied") {
+		t.Error("Status bar should not show expired notice")
+	}
+}
+
+// TestNoticeQueue tests that notices queue instead of overwriting each other
+func TestNoticeQueue(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.width = 200
+
+	model.pushNotice("✓ Copied text")
+	model.pushNotice("✓ Deleted post")
+
+	result := model.renderStatusBar()
+	if !strings.Contains(result, "Copied text") || !strings.Contains(result, "Deleted post") {
+		t.Errorf("Status bar should show both notices, got %q", result)
+	}
+
+	for i := 0; i < maxNotices+2; i++ {
+		model.pushNotice(fmt.Sprintf("notice %d", i))
+	}
+	if len(model.notices) != maxNotices {
+		t.Errorf("Queue should be capped at %d, got %d", maxNotices, len(model.notices))
+	}
+	if model.notices[len(model.notices)-1].text != fmt.Sprintf("notice %d", maxNotices+1) {
+		t.Error("Newest notice should be kept")
+	}
+}
+
+// TestDeleteArmedNoticeClearsOnOtherKey tests the delete prompt is withdrawn when disarmed
+func TestDeleteArmedNoticeClearsOnOtherKey(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.displayedPosts = []*Post{{ID: "smk-abc123", Author: "a", Suffix: "b", Content: "x"}}
+	model.selectedPostIndex = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	updatedModel := updated.(Model)
+	if !updatedModel.deleteArmed || len(updatedModel.notices) != 1 {
+		t.Fatal("First d should arm delete and show prompt")
+	}
+
+	updated, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	updatedModel = updated.(Model)
+	if updatedModel.deleteArmed || len(updatedModel.notices) != 0 {
+		t.Error("Other key should disarm delete and drop prompt")
+	}
+}
+
+// TestDeleteArmedExpiresWithNotice tests a late second d asks again instead of deleting
+func TestDeleteArmedExpiresWithNotice(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	model := testModel(store)
+	model.displayedPosts = []*Post{{ID: "smk-abc123", Author: "a", Suffix: "b", Content: "x"}}
+	model.selectedPostIndex = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	updatedModel := updated.(Model)
+	if !updatedModel.deleteArmed {
+		t.Fatal("First d should arm delete")
+	}
+
+	updated, _ = updatedModel.Update(clockTickMsg(time.Now().Add(noticeTTL + time.Second)))
+	updatedModel = updated.(Model)
+	if updatedModel.deleteArmed || len(updatedModel.notices) != 0 {
+		t.Fatal("Expired prompt should disarm delete")
+	}
+
+	// Without a tick, a second d after the prompt expired must also re-arm
+	updatedModel.deleteArmed = true
+	updatedModel.deletePostID = "smk-abc123"
+	updatedModel.notices = []notice{{text: deleteArmedNotice, expires: time.Now().Add(-time.Second)}}
+	updated, cmd := updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	updatedModel = updated.(Model)
+	if cmd != nil || !updatedModel.deleteArmed || updatedModel.notices[len(updatedModel.notices)-1].text != deleteArmedNotice {
+		t.Error("Late second d should show the prompt again, not delete")
 	}
 }
 
@@ -1609,3 +2425,84 @@ func TestCopyMenuDoesNotOpenWithoutPosts(t *testing.T) {
 		t.Error("Copy menu should not open when there are no posts")
 	}
 }
+
+func TestNestedRepliesRenderInOrder(t *testing.T) {
+	m := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	m.width = 100
+	m.height = 40
+	m.posts = append(nestedThreadPosts(), &Post{ID: "smk-other0", Author: "ember@smoke",
+		Content: "another thread", CreatedAt: "2026-01-30T10:00:00Z"})
+	m.updateDisplayedPosts()
+
+	if len(m.displayedPosts) != 2 {
+		t.Fatalf("only top-level posts are selectable, got %d", len(m.displayedPosts))
+	}
+
+	var texts []string
+	var indents []int
+	for _, cl := range m.buildAllContentLinesWithPosts() {
+		text := stripANSI(cl.text)
+		for _, content := range []string{"later level one", "level one", "level two", "level three"} {
+			if strings.HasSuffix(strings.TrimRight(text, " "), " "+content) {
+				texts = append(texts, content)
+				indents = append(indents, strings.Index(text, "└─"))
+				break
+			}
+		}
+		if w := lipgloss.Width(cl.text); w > m.contentWidth() {
+			t.Errorf("line wider than content (%d > %d): %q", w, m.contentWidth(), text)
+		}
+	}
+	if strings.Join(texts, ",") != "level one,level two,level three,later level one" {
+		t.Fatalf("nested replies out of order: %v", texts)
+	}
+	if indents[0] >= indents[1] || indents[1] >= indents[2] || indents[3] != indents[0] {
+		t.Errorf("expected indentation to grow per level, got %v", indents)
+	}
+
+	// Navigation still moves between threads
+	m.selectedPostIndex = 0
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if got := updated.(Model).displayedPosts[updated.(Model).selectedPostIndex].ID; got != "smk-other0" {
+		t.Errorf("j should move to the next thread, got %s", got)
+	}
+}
+
+func TestModelFormatPost_MultilineAllLayouts(t *testing.T) {
+	store := NewStoreWithPath(t.TempDir() + "/feed.jsonl")
+	post := &Post{
+		ID:        "smk-test123",
+		Author:    "test-author",
+		Suffix:    "test",
+		Content:   "first paragraph\n\nsecond paragraph",
+		CreatedAt: "2026-01-30T09:24:00Z",
+	}
+
+	for _, layout := range AllLayouts {
+		t.Run(layout.Name, func(t *testing.T) {
+			model := testModel(store)
+			model.width = 80
+			model.layout = GetLayout(layout.Name)
+
+			first, second := -1, -1
+			for i, line := range model.formatPost(post) {
+				plain := stripANSI(line)
+				if strings.Contains(plain, "\n") {
+					t.Fatalf("line %d contains a raw newline: %q", i, plain)
+				}
+				if strings.Contains(plain, "first paragraph") {
+					first = i
+				}
+				if strings.Contains(plain, "second paragraph") {
+					second = i
+				}
+			}
+			if first < 0 || second < 0 {
+				t.Fatalf("both paragraphs should render, got first=%d second=%d", first, second)
+			}
+			if second != first+2 {
+				t.Errorf("paragraphs should be separated by one blank line, got lines %d and %d", first, second)
+			}
+		})
+	}
+}
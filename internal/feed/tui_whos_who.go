@@ -0,0 +1,128 @@
+package feed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// authorActivity is one row of the who's who overlay.
+type authorActivity struct {
+	Author string
+	Posts  int
+	Latest time.Time
+}
+
+// authorActivities counts posts per author, most recently active first.
+// Authors with equal activity times are ordered by name.
+func authorActivities(posts []*Post) []authorActivity {
+	byAuthor := make(map[string]*authorActivity)
+	for _, post := range posts {
+		if post == nil || post.Author == "" {
+			continue
+		}
+		a := byAuthor[post.Author]
+		if a == nil {
+			a = &authorActivity{Author: post.Author}
+			byAuthor[post.Author] = a
+		}
+		a.Posts++
+		if t, err := post.GetCreatedTime(); err == nil && t.After(a.Latest) {
+			a.Latest = t
+		}
+	}
+
+	activities := make([]authorActivity, 0, len(byAuthor))
+	for _, a := range byAuthor {
+		activities = append(activities, *a)
+	}
+	sort.Slice(activities, func(i, j int) bool {
+		if !activities[i].Latest.Equal(activities[j].Latest) {
+			return activities[i].Latest.After(activities[j].Latest)
+		}
+		return activities[i].Author < activities[j].Author
+	})
+	return activities
+}
+
+// handleWhosWhoKey opens the who's who overlay with w.
+func (m *Model) handleWhosWhoKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if msg.String() != "w" {
+		return nil, false
+	}
+	m.showWhosWho = true
+	return nil, true
+}
+
+// renderWhosWhoOverlayBox lists the feed's authors in their identity colors
+// with post counts, as a centered overlay box.
+func (m Model) renderWhosWhoOverlayBox() overlayBox {
+	background := m.theme.BackgroundSecondary
+	base := lipgloss.NewStyle().Background(background)
+	titleStyle := base.Foreground(m.theme.Accent).Bold(true)
+	itemStyle := base.Foreground(m.theme.Text)
+	hintStyle := base.Foreground(m.theme.TextMuted)
+
+	activities := authorActivities(m.posts)
+	stats := ComputeStats(m.posts)
+
+	nameWidth := 0
+	for _, a := range activities {
+		nameWidth = max(nameWidth, lipgloss.Width(a.Author))
+	}
+	menuWidth := max(32, nameWidth+14)
+
+	// Leave room for the title, summary, hint, border, and padding
+	maxRows := max(1, m.height-12)
+	shown := activities
+	if len(shown) > maxRows {
+		shown = shown[:maxRows]
+	}
+
+	var menuContent strings.Builder
+	menuContent.WriteString(titleStyle.Width(menuWidth).Align(lipgloss.Center).Render("Who's who"))
+	menuContent.WriteString("\n")
+	menuContent.WriteString(hintStyle.Width(menuWidth).Align(lipgloss.Center).
+		Render(fmt.Sprintf("%d agents · %d posts", stats.Agents, stats.Posts)))
+	menuContent.WriteString("\n\n")
+	if len(activities) == 0 {
+		menuContent.WriteString(itemStyle.Width(menuWidth).Render("  No posts yet"))
+		menuContent.WriteString("\n")
+	}
+	for _, a := range shown {
+		name := ColorizeIdentityWithBackground(a.Author, m.theme, m.contrast, background)
+		pad := strings.Repeat(" ", nameWidth-lipgloss.Width(a.Author))
+		count := fmt.Sprintf("%d post", a.Posts)
+		if a.Posts != 1 {
+			count += "s"
+		}
+		menuContent.WriteString(base.Width(menuWidth).Render("  " + name + base.Render(pad+"  ") + itemStyle.Render(count)))
+		menuContent.WriteString("\n")
+	}
+	if hidden := len(activities) - len(shown); hidden > 0 {
+		menuContent.WriteString(hintStyle.Width(menuWidth).Render(fmt.Sprintf("  … %d more", hidden)))
+		menuContent.WriteString("\n")
+	}
+	menuContent.WriteString("\n")
+	menuContent.WriteString(hintStyle.Width(menuWidth).Render("  Press any key to close"))
+	menuContent.WriteString("\n")
+
+	menuStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.Accent).
+		Background(background).
+		Padding(1, 2).
+		Width(menuWidth)
+
+	contentWithBackground := m.fillBackgroundBlock(menuContent.String(), menuWidth, background)
+	return m.centerOverlay(menuStyle.Render(contentWithBackground))
+}
+
+// renderWhosWhoOverlay returns a string-rendered overlay (used in tests).
+func (m Model) renderWhosWhoOverlay() string {
+	return m.renderOverlayBoxString(m.renderWhosWhoOverlayBox())
+}
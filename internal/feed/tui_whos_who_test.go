@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	xansi "github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func whosWhoPosts() []*Post {
+	now := time.Now().UTC()
+	at := func(minutes int) string { return now.Add(time.Duration(-minutes) * time.Minute).Format(time.RFC3339) }
+	return []*Post{
+		{ID: "p1", Author: "ember-fox@smoke", Content: "one", CreatedAt: at(30)},
+		{ID: "p2", Author: "wisp-owl@smoke", Content: "two", CreatedAt: at(20)},
+		{ID: "p3", Author: "ember-fox@smoke", Content: "three", CreatedAt: at(10)},
+		{ID: "p4", Author: "ember-fox@smoke", Content: "four", ParentID: "p2", CreatedAt: at(5)},
+		{ID: "p5", Author: "calm-elk@api", Content: "five", CreatedAt: at(40)},
+	}
+}
+
+func TestAuthorActivities(t *testing.T) {
+	activities := authorActivities(whosWhoPosts())
+	require.Len(t, activities, 3)
+
+	var order []string
+	counts := make(map[string]int)
+	for _, a := range activities {
+		order = append(order, a.Author)
+		counts[a.Author] = a.Posts
+	}
+	assert.Equal(t, []string{"ember-fox@smoke", "wisp-owl@smoke", "calm-elk@api"}, order)
+	assert.Equal(t, map[string]int{"ember-fox@smoke": 3, "wisp-owl@smoke": 1, "calm-elk@api": 1}, counts)
+}
+
+func TestWhosWhoOverlay(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := testModel(NewStoreWithPath(t.TempDir() + "/feed.jsonl"))
+	model.width, model.height = 100, 30
+	updated, _ := model.Update(loadPostsMsg{posts: whosWhoPosts()})
+	model = updated.(Model)
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	model = updated.(Model)
+	require.True(t, model.showWhosWho, "w should open the who's who overlay")
+
+	overlay := xansi.Strip(model.renderWhosWhoOverlay())
+	assert.Contains(t, overlay, "Who's who")
+	assert.Contains(t, overlay, "3 agents · 5 posts")
+	for _, want := range []string{"ember-fox@smoke  3 posts", "wisp-owl@smoke   1 post", "calm-elk@api     1 post"} {
+		assert.Contains(t, overlay, want)
+	}
+	assert.Less(t, strings.Index(overlay, "ember-fox"), strings.Index(overlay, "calm-elk"), "most recent author should be listed first")
+	assert.Contains(t, xansi.Strip(model.View()), "Who's who")
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	assert.False(t, model.showWhosWho, "any key should close the overlay")
+}
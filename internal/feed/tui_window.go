@@ -0,0 +1,78 @@
+package feed
+
+// lineCountKey identifies a post's rendered height at a given width and
+// layout. Edits, deletion, bookmarking, and expanding a collapsed post
+// change its height, so they are part of the key. The content is too, since
+// muting swaps it for a placeholder without a new revision. Relative
+// timestamps ("just now", "5m ago") change width as the post ages, which
+// moves where content wraps, so their width is part of the key as well.
+type lineCountKey struct {
+	id             string
+	revision       int
+	content        string
+	deleted        bool
+	bookmarked     bool
+	expanded       bool
+	timestampWidth int
+}
+
+// lineCountCache remembers how many lines each post renders to, so the feed
+// can be laid out without formatting posts that are scrolled out of view.
+// It is cleared whenever the width, layout, timestamp format, or clock
+// changes, since the timestamp's width sets how far content is indented.
+type lineCountCache struct {
+	width      int
+	layout     string
+	timeFormat string
+	clock      TimeFormat
+	counts     map[lineCountKey]int
+}
+
+func newLineCountCache() *lineCountCache {
+	return &lineCountCache{counts: make(map[lineCountKey]int)}
+}
+
+// postLineCount returns len(format(post)), formatting the post only when
+// its height is not cached yet.
+func (m Model) postLineCount(post *Post, format func(*Post) []string) int {
+	c := m.lineCounts
+	if c == nil {
+		return len(format(post))
+	}
+	layout := ""
+	if m.layout != nil {
+		layout = m.layout.Name
+	}
+	timeFormat := ""
+	if m.config != nil {
+		timeFormat = m.config.TimeFormat
+	}
+	if c.width != m.width || c.layout != layout || c.timeFormat != timeFormat || c.clock != detectedFormat {
+		c.width, c.layout, c.timeFormat, c.clock = m.width, layout, timeFormat, detectedFormat
+		clear(c.counts)
+	}
+	key := lineCountKey{
+		id:         post.ID,
+		revision:   post.Revision,
+		content:    post.Content,
+		deleted:    post.Deleted,
+		bookmarked: m.bookmarks[post.ID],
+		expanded:   m.expanded[post.ID],
+	}
+	if timeFormat == TimestampRelative {
+		key.timestampWidth = len(m.postTimestamp(post))
+	}
+	if n, ok := c.counts[key]; ok {
+		return n
+	}
+	n := len(format(post))
+	c.counts[key] = n
+	return n
+}
+
+// contentLayout returns the feed's content lines with post indexes but no
+// text. It is enough for scrolling and selection, and costs no rendering
+// once line counts are cached.
+func (m Model) contentLayout() []contentLine {
+	return m.buildContentLines(0, 0)
+}
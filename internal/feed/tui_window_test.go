@@ -0,0 +1,193 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// longFeedModel returns a model over n posts spread across several days,
+// with every tenth post a reply and every seventh carrying a reaction. The
+// first half of the feed has been read.
+func longFeedModel(tb testing.TB, n int) Model {
+	tb.Helper()
+	model := testModel(NewStoreWithPath(tb.TempDir() + "/feed.jsonl"))
+	model.width = 100
+	model.height = 40
+
+	start := time.Now().Add(-time.Duration(n) * time.Minute).UTC()
+	posts := make([]*Post, 0, n)
+	for i := 0; i < n; i++ {
+		post := &Post{
+			ID:        fmt.Sprintf("smk-%06d", i),
+			Author:    "ember@smoke",
+			Content:   fmt.Sprintf("post %d about retries, long enough to wrap onto a second line once the layout narrows things down", i),
+			CreatedAt: start.Add(time.Duration(i) * time.Minute).Format(time.RFC3339),
+		}
+		if i%10 == 9 {
+			post.ParentID = posts[i-1].ID
+		}
+		if i%7 == 0 {
+			post.Reactions = map[string]int{"👍": 2}
+		}
+		posts = append(posts, post)
+	}
+	model.posts = posts
+	model.updateDisplayedPosts()
+	model.lastReadPostID = posts[n/2].ID
+	model.unreadCount = model.countUnread()
+	model.selectedPostIndex = len(model.displayedPosts) / 3
+	return model
+}
+
+func TestBuildContentLines_WindowMatchesFullRender(t *testing.T) {
+	model := longFeedModel(t, 300)
+	full := model.buildAllContentLinesWithPosts()
+
+	for _, window := range [][2]int{{0, 40}, {len(full) / 2, len(full)/2 + 40}, {len(full) - 40, len(full)}, {0, 0}} {
+		lines := model.buildContentLines(window[0], window[1])
+		if len(lines) != len(full) {
+			t.Fatalf("window %v: %d lines, full render has %d", window, len(lines), len(full))
+		}
+		for i := range full {
+			if lines[i].postIndex != full[i].postIndex {
+				t.Fatalf("window %v: line %d post index %d, want %d", window, i, lines[i].postIndex, full[i].postIndex)
+			}
+			if i >= window[0] && i < window[1] && lines[i].text != full[i].text {
+				t.Fatalf("window %v: line %d = %q, want %q", window, i, lines[i].text, full[i].text)
+			}
+		}
+	}
+}
+
+func TestBuildContentLines_RendersOnlyWindow(t *testing.T) {
+	model := longFeedModel(t, 5000)
+	layout := model.contentLayout()
+
+	from := len(layout) / 2
+	rendered := 0
+	for _, line := range model.buildContentLines(from, from+model.contentHeight()) {
+		if line.text != "" {
+			rendered++
+		}
+	}
+	// Blocks straddling the window edges render whole, so allow one post's
+	// worth of lines on either side.
+	if limit := model.contentHeight() + 2*10; rendered > limit {
+		t.Errorf("rendered %d lines of %d for one screen, want at most %d", rendered, len(layout), limit)
+	}
+}
+
+func TestLineCountCache_TracksWidthAndBookmarks(t *testing.T) {
+	model := longFeedModel(t, 50)
+	check := func(when string) {
+		t.Helper()
+		if got, want := len(model.contentLayout()), len(model.buildAllContentLinesWithPosts()); got != want {
+			t.Errorf("%s: layout has %d lines, full render has %d", when, got, want)
+		}
+	}
+	check("initially")
+
+	model.width = 50
+	check("after narrowing")
+
+	model.width = 30
+	model.bookmarks = map[string]bool{}
+	for _, post := range model.displayedPosts {
+		model.bookmarks[post.ID] = true
+	}
+	check("after bookmarking")
+}
+
+func TestLineCountCache_TracksMutes(t *testing.T) {
+	model := longFeedModel(t, 50)
+	check := func(when string) {
+		t.Helper()
+		if got, want := len(model.contentLayout()), len(model.buildAllContentLinesWithPosts()); got != want {
+			t.Errorf("%s: layout has %d lines, full render has %d", when, got, want)
+		}
+	}
+	check("before muting")
+
+	// Threads with a reply from someone else stay, with ember's posts
+	// swapped for placeholders under the same IDs and revisions.
+	for _, post := range model.posts {
+		if post.IsReply() {
+			post.Author = "wisp@smoke"
+		}
+	}
+	model.posts = ApplyMutes(model.posts, []string{"ember"})
+	model.updateDisplayedPosts()
+	check("after muting")
+}
+
+func TestLineCountCache_TracksTimeFormat(t *testing.T) {
+	model := longFeedModel(t, 50)
+	model.layout = GetLayout("dense")
+	model.width = 60
+	// Short enough to fit beside a clock time, too long beside a date-time.
+	for _, post := range model.displayedPosts {
+		post.Content = "retry budget fixed, deploy is green"
+	}
+
+	for _, format := range AllTimestampFormats {
+		model.config.TimeFormat = format
+		if got, want := len(model.contentLayout()), len(model.buildAllContentLinesWithPosts()); got != want {
+			t.Errorf("%s: layout has %d lines, full render has %d", format, got, want)
+		}
+	}
+}
+
+func TestLineCountCache_TracksClock(t *testing.T) {
+	t.Cleanup(func() { _ = ApplyClockSettings(nil, "") })
+	model := longFeedModel(t, 50)
+	model.layout = GetLayout("dense")
+	model.width = 60
+	// Fits beside 14:14, wraps beside 2:14PM.
+	for _, post := range model.displayedPosts {
+		post.Content = "retry budget fixed, the deploy is green"
+		post.CreatedAt = time.Date(2026, 1, 30, 14, 14, 0, 0, time.UTC).Format(time.RFC3339)
+	}
+
+	for _, clock24h := range []bool{true, false} {
+		if err := ApplyClockSettings(&clock24h, "UTC"); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(model.contentLayout()), len(model.buildAllContentLinesWithPosts()); got != want {
+			t.Errorf("clock_24h %v: layout has %d lines, full render has %d", clock24h, got, want)
+		}
+	}
+}
+
+func TestLineCountCache_TracksRelativeAge(t *testing.T) {
+	model := longFeedModel(t, 50)
+	model.layout = GetLayout("dense")
+	model.width = 60
+	model.config.TimeFormat = TimestampRelative
+	// Wraps beside "just now", fits beside "2h ago".
+	for _, post := range model.displayedPosts {
+		post.Content = strings.Repeat("x", 37)
+	}
+
+	for _, age := range []time.Duration{10 * time.Second, 2 * time.Hour} {
+		for _, post := range model.displayedPosts {
+			post.CreatedAt = time.Now().Add(-age).Format(time.RFC3339)
+		}
+		if got, want := len(model.contentLayout()), len(model.buildAllContentLinesWithPosts()); got != want {
+			t.Errorf("age %v: layout has %d lines, full render has %d", age, got, want)
+		}
+	}
+}
+
+func BenchmarkRenderContent_5000(b *testing.B) {
+	model := longFeedModel(b, 5000)
+	height, width := model.contentHeight(), model.contentWidth()
+	model.renderContent(height, width) // fill the line count cache
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		model.scrollOffset = (i * 7) % 20000
+		model.renderContent(height, width)
+	}
+}
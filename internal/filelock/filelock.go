@@ -0,0 +1,44 @@
+// Package filelock provides the advisory file locks that keep concurrent
+// smoke processes from interleaving writes to shared files.
+package filelock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ErrTimeout is returned when another process holds the lock for longer
+// than lockTimeout.
+var ErrTimeout = errors.New("timed out waiting for file lock")
+
+// Lock retry policy: try without blocking, then back off from
+// lockInitialBackoff, doubling up to lockMaxBackoff, until lockTimeout.
+const (
+	lockTimeout        = 5 * time.Second
+	lockInitialBackoff = 2 * time.Millisecond
+	lockMaxBackoff     = 100 * time.Millisecond
+)
+
+// Lock takes an advisory lock on f (flock on Unix, LockFileEx on Windows),
+// exclusive for writers and shared for readers. The lock is released by
+// Unlock or by closing f.
+func Lock(f *os.File, exclusive bool) error {
+	deadline := time.Now().Add(lockTimeout)
+	backoff := lockInitialBackoff
+	for {
+		acquired, err := tryLock(f, exclusive)
+		if err != nil {
+			return fmt.Errorf("failed to acquire file lock: %w", err)
+		}
+		if acquired {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return ErrTimeout
+		}
+		time.Sleep(backoff)
+		backoff = min(backoff*2, lockMaxBackoff)
+	}
+}
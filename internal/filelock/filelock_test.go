@@ -0,0 +1,44 @@
+package filelock
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLock_WaitsForRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json.lock")
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	holder, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = holder.Close() }()
+	if err := Lock(holder, true); err != nil {
+		t.Fatal(err)
+	}
+
+	waiter, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = waiter.Close() }()
+	if acquired, err := tryLock(waiter, false); err != nil || acquired {
+		t.Fatalf("tryLock while held = %v, %v; want false, nil", acquired, err)
+	}
+
+	released := make(chan struct{})
+	go func() {
+		defer close(released)
+		time.Sleep(30 * time.Millisecond)
+		_ = Unlock(holder)
+	}()
+	if err := Lock(waiter, false); err != nil {
+		t.Fatalf("Lock after release: %v", err)
+	}
+	_ = Unlock(waiter)
+	<-released
+}
@@ -0,0 +1,57 @@
+//go:build windows
+
+package filelock
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32         = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx   = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx = kernel32.NewProc("UnlockFileEx")
+)
+
+// LockFileEx flags and the error it returns on contention.
+const (
+	lockfileFailImmediately = 0x1
+	lockfileExclusiveLock   = 0x2
+	errorLockViolation      = syscall.Errno(33)
+)
+
+// lockRegion returns the byte every lock covers. Windows locks are
+// mandatory, so locking the file's contents would also block plain reads;
+// a single byte far past any real end of file serializes lockers without
+// getting in the way of readers.
+func lockRegion() *syscall.Overlapped {
+	return &syscall.Overlapped{Offset: 0xFFFFFFFE, OffsetHigh: 0x7FFFFFFF}
+}
+
+// tryLock attempts to lock f without blocking. acquired is false when
+// another process holds a conflicting lock.
+func tryLock(f *os.File, exclusive bool) (acquired bool, err error) {
+	flags := uintptr(lockfileFailImmediately)
+	if exclusive {
+		flags |= lockfileExclusiveLock
+	}
+	r, _, callErr := procLockFileEx.Call(f.Fd(), flags, 0, 1, 0, uintptr(unsafe.Pointer(lockRegion())))
+	if r != 0 {
+		return true, nil
+	}
+	if errors.Is(callErr, errorLockViolation) {
+		return false, nil
+	}
+	return false, callErr
+}
+
+// Unlock releases a lock taken by Lock.
+func Unlock(f *os.File) error {
+	r, _, callErr := procUnlockFileEx.Call(f.Fd(), 0, 1, 0, uintptr(unsafe.Pointer(lockRegion())))
+	if r == 0 {
+		return callErr
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+// BlocklistFile is the name of the optional blocklist file in ~/.config/smoke/
+const BlocklistFile = "blocklist.yaml"
+
+// maxRerolls bounds how many perturbed seeds are tried before giving up on
+// finding a name that is not blocklisted.
+const maxRerolls = 100
+
+// blocklistOverride replaces the blocklist file when set via SetBlocklist.
+var blocklistOverride []string
+
+// SetBlocklist replaces the blocklist read from ~/.config/smoke/blocklist.yaml.
+// Entries are single words ("fox") or full names ("swift-fox"). Passing nil
+// restores the file-based blocklist. Intended for tests.
+func SetBlocklist(entries []string) {
+	blocklistOverride = entries
+}
+
+// LoadBlocklist reads a YAML list of blocked words and names.
+// A missing file returns an empty blocklist without error.
+func LoadBlocklist(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []string
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid blocklist %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// activeBlocklist returns the SetBlocklist override, or the entries from
+// ~/.config/smoke/blocklist.yaml, as a lowercase set.
+func activeBlocklist() map[string]bool {
+	entries := blocklistOverride
+	if entries == nil {
+		entries = loadBlocklistFile()
+	}
+	blocked := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if entry = strings.ToLower(strings.TrimSpace(entry)); entry != "" {
+			blocked[entry] = true
+		}
+	}
+	return blocked
+}
+
+// loadBlocklistFile loads ~/.config/smoke/blocklist.yaml, treating a missing
+// or malformed file as an empty blocklist.
+func loadBlocklistFile() []string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	path := filepath.Join(home, ".config", "smoke", BlocklistFile)
+
+	entries, err := LoadBlocklist(path)
+	if err != nil {
+		logging.LogWarn("failed to load blocklist, ignoring it", "path", path, "error", err)
+		return nil
+	}
+	return entries
+}
+
+// isBlocked reports whether name or any of its hyphen-separated words is blocked.
+func isBlocked(name string, blocked map[string]bool) bool {
+	name = strings.ToLower(name)
+	if blocked[name] {
+		return true
+	}
+	for _, word := range strings.Split(name, "-") {
+		if blocked[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// avoidBlocked returns generate(seed) unless it is blocklisted, in which case
+// it deterministically retries with "seed#1", "seed#2", ... until a clean name
+// comes up. If every retry is blocked, the original name is returned.
+func avoidBlocked(seed string, generate func(seed string) (string, error)) (string, error) {
+	first, err := generate(seed)
+	if err != nil {
+		return "", err
+	}
+	blocked := activeBlocklist()
+	if len(blocked) == 0 || !isBlocked(first, blocked) {
+		return first, nil
+	}
+	for i := 1; i <= maxRerolls; i++ {
+		name, err := generate(fmt.Sprintf("%s#%d", seed, i))
+		if err != nil {
+			return "", err
+		}
+		if !isBlocked(name, blocked) {
+			return name, nil
+		}
+	}
+	return first, nil
+}
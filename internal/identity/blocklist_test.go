@@ -0,0 +1,107 @@
+package identity
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func useBlocklist(t *testing.T, entries []string) {
+	t.Helper()
+	SetBlocklist(entries)
+	t.Cleanup(func() { SetBlocklist(nil) })
+}
+
+func TestGenerate_BlocklistedNameRerolls(t *testing.T) {
+	seed := "blocklist-seed"
+	useBlocklist(t, []string{})
+	original := Generate(seed)
+
+	useBlocklist(t, []string{original})
+	got := Generate(seed)
+	if got == original {
+		t.Fatalf("Generate() returned blocklisted name %q", got)
+	}
+	if again := Generate(seed); again != got {
+		t.Errorf("Generate() not stable with blocklist: %q then %q", got, again)
+	}
+}
+
+func TestGenerate_BlocklistedWordRerolls(t *testing.T) {
+	seed := "blocklist-word-seed"
+	useBlocklist(t, []string{})
+	original := Generate(seed)
+	noun := original[strings.LastIndex(original, "-")+1:]
+
+	useBlocklist(t, []string{strings.ToUpper(noun)})
+	got := Generate(seed)
+	for _, word := range strings.Split(got, "-") {
+		if word == noun {
+			t.Errorf("Generate() = %q still uses blocked word %q", got, noun)
+		}
+	}
+}
+
+func TestGenerateWithPattern_BlocklistKeepsPattern(t *testing.T) {
+	seed := "blocklist-pattern-seed"
+	useBlocklist(t, []string{})
+	original, err := GenerateWithPattern(seed, PatternAdjectiveAdjectiveNoun)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	useBlocklist(t, []string{original})
+	got, err := GenerateWithPattern(seed, PatternAdjectiveAdjectiveNoun)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == original {
+		t.Fatalf("GenerateWithPattern() returned blocklisted name %q", got)
+	}
+	if parts := strings.Split(got, "-"); len(parts) != 3 {
+		t.Errorf("GenerateWithPattern() = %q, want adjective-adjective-noun", got)
+	}
+	if again, _ := GenerateWithPattern(seed, PatternAdjectiveAdjectiveNoun); again != got {
+		t.Errorf("GenerateWithPattern() not stable: %q then %q", got, again)
+	}
+}
+
+func TestGenerate_EverythingBlockedFallsBack(t *testing.T) {
+	seed := "blocklist-all-seed"
+	useBlocklist(t, []string{})
+	original := Generate(seed)
+
+	useBlocklist(t, Adjectives[:])
+	if got := Generate(seed); got != original {
+		t.Errorf("Generate() = %q, want original %q when no clean name exists", got, original)
+	}
+}
+
+func TestLoadBlocklist(t *testing.T) {
+	dir := t.TempDir()
+
+	entries, err := LoadBlocklist(filepath.Join(dir, "missing.yaml"))
+	if err != nil || len(entries) != 0 {
+		t.Errorf("missing file should be an empty blocklist, got %v, %v", entries, err)
+	}
+
+	path := filepath.Join(dir, BlocklistFile)
+	if err := os.WriteFile(path, []byte("- fox\n- swift-owl\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	entries, err = LoadBlocklist(path)
+	if err != nil {
+		t.Fatalf("LoadBlocklist() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0] != "fox" || entries[1] != "swift-owl" {
+		t.Errorf("LoadBlocklist() = %v", entries)
+	}
+
+	if err := os.WriteFile(path, []byte("words: [unclosed\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadBlocklist(path); err == nil {
+		t.Error("expected error for malformed blocklist")
+	}
+}
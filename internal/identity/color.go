@@ -0,0 +1,24 @@
+package identity
+
+import (
+	"hash/fnv"
+	"strings"
+)
+
+// Hash returns the 32-bit FNV-1a hash of an identity name.
+// The feed uses the same hash to pick author colors from theme palettes,
+// so the value is part of the public API and will not change.
+func Hash(name string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return h.Sum32()
+}
+
+// PaletteIndex returns the index of an identity's color in a palette of size
+// colors. Only the part before "@" is hashed, matching how the feed colors
+// agent names, so "swift-fox" and "swift-fox@smoke" get the same color.
+// The index is Hash(name) mod size; size must be positive.
+func PaletteIndex(name string, size int) int {
+	agent, _, _ := strings.Cut(name, "@")
+	return int(Hash(agent) % uint32(size))
+}
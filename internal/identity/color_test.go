@@ -0,0 +1,36 @@
+package identity
+
+import "testing"
+
+func TestHash_KnownValues(t *testing.T) {
+	// FNV-1a 32-bit reference values
+	if got := Hash(""); got != 0x811c9dc5 {
+		t.Errorf("Hash(\"\") = %#x, want 0x811c9dc5", got)
+	}
+	if got := Hash("a"); got != 0xe40c292c {
+		t.Errorf("Hash(\"a\") = %#x, want 0xe40c292c", got)
+	}
+}
+
+func TestPaletteIndex_KnownValues(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+		want int
+	}{
+		{"", 5, int(0x811c9dc5 % 5)},
+		{"a", 5, int(0xe40c292c % 5)},
+		{"a", 7, int(0xe40c292c % 7)},
+	}
+	for _, tt := range tests {
+		if got := PaletteIndex(tt.name, tt.size); got != tt.want {
+			t.Errorf("PaletteIndex(%q, %d) = %d, want %d", tt.name, tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestPaletteIndex_IgnoresProject(t *testing.T) {
+	if PaletteIndex("swift-fox@smoke", 5) != PaletteIndex("swift-fox", 5) {
+		t.Error("project suffix should not change the color")
+	}
+}
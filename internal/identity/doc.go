@@ -1,4 +1,7 @@
 // Package identity provides session-unique identity generation for smoke.
 // It generates memorable adjective-animal combinations like "swift-fox" or "calm-owl"
 // based on a session seed derived from environment variables.
+//
+// Hash and PaletteIndex pick an identity's color from a theme palette, the same
+// way the feed colors author names.
 package identity
@@ -3,6 +3,8 @@ package identity
 import (
 	"fmt"
 	"hash/fnv"
+	"strings"
+	"unicode"
 )
 
 // Pattern represents a naming pattern for identity generation.
@@ -37,23 +39,81 @@ func (p Pattern) String() string {
 
 // Generate creates an adjective-animal identity suffix from a seed string.
 // The same seed will always produce the same identity.
+// Word lists come from ~/.config/smoke/wordlists.yaml when present, and names
+// matching ~/.config/smoke/blocklist.yaml are re-rolled.
 func Generate(seed string) string {
-	h := fnv.New32a()
-	h.Write([]byte(seed))
-	hash := h.Sum32()
+	lists := activeWordLists()
+	name, _ := avoidBlocked(seed, func(s string) (string, error) {
+		return GenerateWithLists(s, lists), nil
+	})
+	return name
+}
+
+// GenerateWithLists creates an adjective-noun identity suffix using the given word lists.
+func GenerateWithLists(seed string, lists *WordLists) string {
+	hash := seedHash(seed)
+	adjectives, nouns := lists.Adjectives, lists.nouns()
 
-	adjIdx := hash % uint32(len(Adjectives))
-	animalIdx := (hash / uint32(len(Adjectives))) % uint32(len(Animals))
+	adjIdx := hash % uint32(len(adjectives))
+	nounIdx := (hash / uint32(len(adjectives))) % uint32(len(nouns))
 
-	return fmt.Sprintf("%s-%s", Adjectives[adjIdx], Animals[animalIdx])
+	return fmt.Sprintf("%s-%s", adjectives[adjIdx], nouns[nounIdx])
+}
+
+// GenerateUnique creates the session identity suffix for seed (the pattern
+// chosen by SelectPattern) and, only if that name is already taken, appends
+// the lowest free number: "swift-fox" becomes "swift-fox-2", then "swift-fox-3".
+// Names in taken are compared by NameKey, so "SwiftFox" and "swift_fox" both
+// block "swift-fox". The result depends only on seed and taken.
+func GenerateUnique(seed string, taken map[string]bool) string {
+	base, err := GenerateWithPattern(seed, SelectPattern(seed))
+	if err != nil {
+		base = Generate(seed)
+	}
+	if len(taken) == 0 {
+		return base
+	}
+
+	keys := make(map[string]bool, len(taken))
+	for name, used := range taken {
+		if used {
+			keys[NameKey(name)] = true
+		}
+	}
+	if !keys[NameKey(base)] {
+		return base
+	}
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", base, n)
+		if !keys[NameKey(candidate)] {
+			return candidate
+		}
+	}
+}
+
+// NameKey reduces a suffix to lowercase letters and digits so that style
+// variants of the same name ("SwiftFox", "swift_fox", "swift-fox") compare equal.
+func NameKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// seedHash returns the FNV-1a hash of a seed.
+func seedHash(seed string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(seed))
+	return h.Sum32()
 }
 
 // SelectPattern selects a naming pattern based on a seed hash.
 // Different seeds should produce different (though not necessarily unique) pattern selections.
 func SelectPattern(seed string) Pattern {
-	h := fnv.New32a()
-	h.Write([]byte(seed))
-	hash := h.Sum32()
+	hash := seedHash(seed)
 
 	// Map hash to one of the 5 patterns
 	patternIdx := hash % 5
@@ -80,36 +140,45 @@ func SelectPattern(seed string) Pattern {
 // - AbstractConcrete: abstract concept + animal (e.g., "aether-wolf")
 // - TechTerm: single tech term (e.g., "lambda")
 // - AdjectiveAdjectiveNoun: adjective + adjective + animal (e.g., "swift-clever-fox")
+// Word lists come from ~/.config/smoke/wordlists.yaml when present, and names
+// matching ~/.config/smoke/blocklist.yaml are re-rolled with the same pattern.
 func GenerateWithPattern(seed string, pattern Pattern) (string, error) {
-	h := fnv.New32a()
-	h.Write([]byte(seed))
-	hash := h.Sum32()
+	lists := activeWordLists()
+	return avoidBlocked(seed, func(s string) (string, error) {
+		return GenerateWithPatternAndLists(s, pattern, lists)
+	})
+}
+
+// GenerateWithPatternAndLists generates an identity using the specified pattern and word lists.
+func GenerateWithPatternAndLists(seed string, pattern Pattern, lists *WordLists) (string, error) {
+	hash := seedHash(seed)
+	adjectives, nouns := lists.Adjectives, lists.nouns()
 
 	switch pattern {
 	case PatternVerbNoun:
-		verbIdx := hash % uint32(len(Verbs))
-		animalIdx := (hash / uint32(len(Verbs))) % uint32(len(Animals))
-		return fmt.Sprintf("%s-%s", Verbs[verbIdx], Animals[animalIdx]), nil
+		verbIdx := hash % uint32(len(lists.Verbs))
+		nounIdx := (hash / uint32(len(lists.Verbs))) % uint32(len(nouns))
+		return fmt.Sprintf("%s-%s", lists.Verbs[verbIdx], nouns[nounIdx]), nil
 
 	case PatternAdjectiveNoun:
-		adjIdx := hash % uint32(len(Adjectives))
-		animalIdx := (hash / uint32(len(Adjectives))) % uint32(len(Animals))
-		return fmt.Sprintf("%s-%s", Adjectives[adjIdx], Animals[animalIdx]), nil
+		adjIdx := hash % uint32(len(adjectives))
+		nounIdx := (hash / uint32(len(adjectives))) % uint32(len(nouns))
+		return fmt.Sprintf("%s-%s", adjectives[adjIdx], nouns[nounIdx]), nil
 
 	case PatternAbstractConcrete:
-		abstractIdx := hash % uint32(len(Abstracts))
-		animalIdx := (hash / uint32(len(Abstracts))) % uint32(len(Animals))
-		return fmt.Sprintf("%s-%s", Abstracts[abstractIdx], Animals[animalIdx]), nil
+		abstractIdx := hash % uint32(len(lists.Abstracts))
+		nounIdx := (hash / uint32(len(lists.Abstracts))) % uint32(len(nouns))
+		return fmt.Sprintf("%s-%s", lists.Abstracts[abstractIdx], nouns[nounIdx]), nil
 
 	case PatternTechTerm:
-		techIdx := hash % uint32(len(TechTerms))
-		return TechTerms[techIdx], nil
+		techIdx := hash % uint32(len(lists.TechTerms))
+		return lists.TechTerms[techIdx], nil
 
 	case PatternAdjectiveAdjectiveNoun:
-		adj1Idx := hash % uint32(len(Adjectives))
-		adj2Idx := (hash / uint32(len(Adjectives))) % uint32(len(Adjectives))
-		animalIdx := (hash / uint32(len(Adjectives)*len(Adjectives))) % uint32(len(Animals))
-		return fmt.Sprintf("%s-%s-%s", Adjectives[adj1Idx], Adjectives[adj2Idx], Animals[animalIdx]), nil
+		adj1Idx := hash % uint32(len(adjectives))
+		adj2Idx := (hash / uint32(len(adjectives))) % uint32(len(adjectives))
+		nounIdx := (hash / uint32(len(adjectives)*len(adjectives))) % uint32(len(nouns))
+		return fmt.Sprintf("%s-%s-%s", adjectives[adj1Idx], adjectives[adj2Idx], nouns[nounIdx]), nil
 
 	default:
 		return "", fmt.Errorf("invalid pattern: %v", pattern)
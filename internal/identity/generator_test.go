@@ -1,6 +1,9 @@
 package identity
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestGenerate_Stability(t *testing.T) {
 	seed := "test-session-123"
@@ -548,3 +551,64 @@ func splitByHyphen(s string) []string {
 	}
 	return parts
 }
+
+func TestGenerateUnique_NoCollision(t *testing.T) {
+	seed := "test-session-123"
+	base, err := GenerateWithPattern(seed, SelectPattern(seed))
+	if err != nil {
+		t.Fatalf("GenerateWithPattern failed: %v", err)
+	}
+
+	if got := GenerateUnique(seed, nil); got != base {
+		t.Errorf("GenerateUnique(nil) = %q, want base %q", got, base)
+	}
+	taken := map[string]bool{"someone-else": true}
+	if got := GenerateUnique(seed, taken); got != base {
+		t.Errorf("GenerateUnique() = %q, want base %q when not taken", got, base)
+	}
+}
+
+func TestGenerateUnique_Collision(t *testing.T) {
+	seed := "test-session-123"
+	base := GenerateUnique(seed, nil)
+
+	taken := map[string]bool{base: true}
+	got := GenerateUnique(seed, taken)
+	if got != base+"-2" {
+		t.Errorf("GenerateUnique() = %q, want %q", got, base+"-2")
+	}
+	if again := GenerateUnique(seed, taken); again != got {
+		t.Errorf("GenerateUnique() not stable: %q then %q", got, again)
+	}
+
+	taken[base+"-2"] = true
+	if got := GenerateUnique(seed, taken); got != base+"-3" {
+		t.Errorf("GenerateUnique() = %q, want %q", got, base+"-3")
+	}
+
+	// Style variants of the same name also collide
+	styled := map[string]bool{
+		CamelCase(strings.Split(base, "-")):      true,
+		SnakeCase(strings.Split(base+"-2", "-")): true,
+	}
+	if got := GenerateUnique(seed, styled); got != base+"-3" {
+		t.Errorf("GenerateUnique() = %q, want %q with styled names taken", got, base+"-3")
+	}
+}
+
+func TestNameKey(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"swift-fox", "swiftfox"},
+		{"SwiftFox", "swiftfox"},
+		{"swift_fox", "swiftfox"},
+		{"swift-fox-2", "swiftfox2"},
+	}
+	for _, tt := range tests {
+		if got := NameKey(tt.input); got != tt.want {
+			t.Errorf("NameKey(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/dreamiurg/smoke/internal/logging"
+)
+
+// WordListsFile is the name of the optional word list file in ~/.config/smoke/
+const WordListsFile = "wordlists.yaml"
+
+// WordLists holds the word categories used by the naming patterns.
+// Nouns, when set, replace Animals as the noun in every pattern.
+type WordLists struct {
+	Adjectives []string `yaml:"adjectives,omitempty"`
+	Animals    []string `yaml:"animals,omitempty"`
+	Verbs      []string `yaml:"verbs,omitempty"`
+	Nouns      []string `yaml:"nouns,omitempty"`
+	Abstracts  []string `yaml:"abstracts,omitempty"`
+	TechTerms  []string `yaml:"tech_terms,omitempty"`
+}
+
+// DefaultWordLists returns the embedded word lists.
+func DefaultWordLists() *WordLists {
+	return &WordLists{
+		Adjectives: Adjectives[:],
+		Animals:    Animals[:],
+		Verbs:      Verbs[:],
+		Abstracts:  Abstracts[:],
+		TechTerms:  TechTerms[:],
+	}
+}
+
+// LoadWordLists reads word lists from a YAML file.
+// Categories missing from the file keep their embedded defaults.
+// A missing file returns the defaults without error.
+func LoadWordLists(path string) (*WordLists, error) {
+	lists := DefaultWordLists()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return lists, nil
+		}
+		return lists, err
+	}
+
+	var custom WordLists
+	if err := yaml.Unmarshal(data, &custom); err != nil {
+		return DefaultWordLists(), fmt.Errorf("invalid word lists %s: %w", path, err)
+	}
+
+	if len(custom.Adjectives) > 0 {
+		lists.Adjectives = custom.Adjectives
+	}
+	if len(custom.Animals) > 0 {
+		lists.Animals = custom.Animals
+	}
+	if len(custom.Verbs) > 0 {
+		lists.Verbs = custom.Verbs
+	}
+	if len(custom.Nouns) > 0 {
+		lists.Nouns = custom.Nouns
+	}
+	if len(custom.Abstracts) > 0 {
+		lists.Abstracts = custom.Abstracts
+	}
+	if len(custom.TechTerms) > 0 {
+		lists.TechTerms = custom.TechTerms
+	}
+	return lists, nil
+}
+
+// nouns returns the noun category, preferring Nouns over Animals.
+func (w *WordLists) nouns() []string {
+	if len(w.Nouns) > 0 {
+		return w.Nouns
+	}
+	return w.Animals
+}
+
+// activeWordLists loads ~/.config/smoke/wordlists.yaml, falling back to the
+// embedded defaults when it is absent or malformed.
+func activeWordLists() *WordLists {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return DefaultWordLists()
+	}
+	path := filepath.Join(home, ".config", "smoke", WordListsFile)
+
+	lists, err := LoadWordLists(path)
+	if err != nil {
+		logging.LogWarn("failed to load word lists, using defaults", "path", path, "error", err)
+		return DefaultWordLists()
+	}
+	return lists
+}
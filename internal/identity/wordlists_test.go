@@ -0,0 +1,111 @@
+package identity
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadWordLists_MissingFile(t *testing.T) {
+	lists, err := LoadWordLists(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil {
+		t.Fatalf("LoadWordLists() error = %v", err)
+	}
+	if len(lists.Adjectives) != len(Adjectives) || len(lists.Animals) != len(Animals) {
+		t.Error("missing file should return default word lists")
+	}
+}
+
+func TestLoadWordLists_PartialOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), WordListsFile)
+	content := "adjectives: [mighty, wise]\nnouns: [zeus, hera, athena]\n"
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	lists, err := LoadWordLists(path)
+	if err != nil {
+		t.Fatalf("LoadWordLists() error = %v", err)
+	}
+	if len(lists.Adjectives) != 2 || len(lists.Nouns) != 3 {
+		t.Errorf("custom categories not loaded: %+v", lists)
+	}
+	if len(lists.Verbs) != len(Verbs) {
+		t.Error("missing categories should keep defaults")
+	}
+
+	result := GenerateWithLists("seed", lists)
+	parts := strings.Split(result, "-")
+	if len(parts) != 2 || !contains(lists.Adjectives, parts[0]) || !contains(lists.Nouns, parts[1]) {
+		t.Errorf("GenerateWithLists() = %q, want custom words", result)
+	}
+	if again := GenerateWithLists("seed", lists); again != result {
+		t.Errorf("GenerateWithLists not stable: %q vs %q", result, again)
+	}
+}
+
+func TestLoadWordLists_Malformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), WordListsFile)
+	if err := os.WriteFile(path, []byte("adjectives: [unclosed\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	lists, err := LoadWordLists(path)
+	if err == nil {
+		t.Fatal("expected error for malformed file")
+	}
+	if len(lists.Adjectives) != len(Adjectives) {
+		t.Error("malformed file should return default word lists")
+	}
+}
+
+func TestGenerate_UsesConfigWordLists(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir := filepath.Join(home, ".config", "smoke")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := "adjectives: [golden]\nanimals: [apollo]\nverbs: [sing]\nabstracts: [light]\ntech_terms: [olympus]\n"
+	if err := os.WriteFile(filepath.Join(dir, WordListsFile), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := Generate("any-seed"); got != "golden-apollo" {
+		t.Errorf("Generate() = %q, want golden-apollo", got)
+	}
+	if got, _ := GenerateWithPattern("any-seed", PatternVerbNoun); got != "sing-apollo" {
+		t.Errorf("GenerateWithPattern(VerbNoun) = %q, want sing-apollo", got)
+	}
+	if got, _ := GenerateWithPattern("any-seed", PatternTechTerm); got != "olympus" {
+		t.Errorf("GenerateWithPattern(TechTerm) = %q, want olympus", got)
+	}
+}
+
+func TestGenerate_MalformedConfigFallsBack(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir := filepath.Join(home, ".config", "smoke")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, WordListsFile), []byte(":::\n\t- bad"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := Generate("seed"), GenerateWithLists("seed", DefaultWordLists()); got != want {
+		t.Errorf("Generate() = %q, want default %q", got, want)
+	}
+}
+
+func contains(words []string, word string) bool {
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
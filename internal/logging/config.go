@@ -34,17 +34,27 @@ type Config struct {
 
 	// Verbose enables debug output to stderr (from -v flag)
 	Verbose bool
+
+	// OTLPEndpoint, when set, also sends a span per command to this OTLP/HTTP
+	// collector (from SMOKE_OTLP_ENDPOINT). File logging is unaffected.
+	OTLPEndpoint string
+
+	// RedactPatterns are extra regular expressions scrubbed from logged
+	// command arguments, on top of the built-in secret patterns.
+	RedactPatterns []string
 }
 
 // LoadConfig loads logging configuration from environment variables
 // SMOKE_LOG_LEVEL: debug, info, warn, error, off (default: info)
+// SMOKE_OTLP_ENDPOINT: OTLP/HTTP collector for command spans (default: none)
 func LoadConfig(logPath string) Config {
 	cfg := Config{
-		Level:    slog.LevelInfo,
-		Path:     logPath,
-		MaxSize:  DefaultMaxSize,
-		MaxFiles: DefaultMaxFiles,
-		Verbose:  false,
+		Level:        slog.LevelInfo,
+		Path:         logPath,
+		MaxSize:      DefaultMaxSize,
+		MaxFiles:     DefaultMaxFiles,
+		Verbose:      false,
+		OTLPEndpoint: strings.TrimSpace(os.Getenv(OTLPEndpointEnv)),
 	}
 
 	levelStr := strings.ToLower(os.Getenv("SMOKE_LOG_LEVEL"))
@@ -34,6 +34,21 @@
 //	err.message       Error message
 //	err.type          Categorized error type
 //
+// # OTLP Export
+//
+// When SMOKE_OTLP_ENDPOINT is set (e.g. http://localhost:4318), each
+// completed or failed command is also sent as a span to that OTLP/HTTP
+// collector, with the fields above as span attributes. Export happens in the
+// background alongside file logging; collector errors never fail a command
+// and are only shown with -v.
+//
+// # Redaction
+//
+// cmd.args is scrubbed before it is logged or exported: AWS keys, bearer
+// tokens, provider API keys, secret=value pairs, and long hex or base64
+// blobs become "[redacted]". Extra patterns come from log_redact_patterns
+// in config.yaml (see SetRedactPatterns).
+//
 // # Example Usage
 //
 //	// Initialize logging
@@ -0,0 +1,129 @@
+package logging
+
+import (
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Entry is one parsed line of smoke.log. Fields follow the telemetry schema
+// in the package documentation; anything missing is left zero.
+type Entry struct {
+	Time  time.Time
+	Level slog.Level
+	Msg   string
+	Cmd   EntryCmd
+	Ctx   EntryContext
+	Err   EntryError
+	Post  EntryPost
+}
+
+// EntryCmd is the cmd.* group of an entry.
+type EntryCmd struct {
+	Name       string
+	Args       []string
+	DurationMS int64
+}
+
+// EntryContext is the ctx.* group of an entry.
+type EntryContext struct {
+	Identity string `json:"identity"`
+	Agent    string `json:"agent"`
+	Caller   string `json:"caller"`
+	Session  string `json:"session"`
+	Env      string `json:"env"`
+	Project  string `json:"project"`
+}
+
+// EntryError is the err.* group of an entry.
+type EntryError struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+// EntryPost is the post.* group of an entry.
+type EntryPost struct {
+	ID     string `json:"id"`
+	Author string `json:"author"`
+}
+
+// ParseEntry decodes a smoke.log line. ok is false for lines that are not
+// JSON objects. Older logs wrote cmd as a bare command name; both forms
+// are accepted.
+func ParseEntry(line []byte) (e Entry, ok bool) {
+	var raw struct {
+		Time  string          `json:"time"`
+		Level string          `json:"level"`
+		Msg   string          `json:"msg"`
+		Cmd   json.RawMessage `json:"cmd"`
+		Ctx   EntryContext    `json:"ctx"`
+		Err   EntryError      `json:"err"`
+		Post  EntryPost       `json:"post"`
+	}
+	if json.Unmarshal(line, &raw) != nil {
+		return Entry{}, false
+	}
+	e = Entry{Msg: raw.Msg, Ctx: raw.Ctx, Err: raw.Err, Post: raw.Post}
+	if ts, err := time.Parse(time.RFC3339Nano, raw.Time); err == nil {
+		e.Time = ts
+	}
+	if raw.Level != "" {
+		_ = e.Level.UnmarshalText([]byte(raw.Level))
+	}
+	e.Cmd = parseEntryCmd(raw.Cmd)
+	return e, true
+}
+
+func parseEntryCmd(raw json.RawMessage) EntryCmd {
+	var name string
+	if json.Unmarshal(raw, &name) == nil {
+		return EntryCmd{Name: name}
+	}
+	var cmd struct {
+		Name       string   `json:"name"`
+		Args       []string `json:"args"`
+		DurationMS int64    `json:"duration_ms"`
+	}
+	_ = json.Unmarshal(raw, &cmd)
+	return EntryCmd(cmd)
+}
+
+// IsCommandStart reports whether e marks the start of a command.
+func (e Entry) IsCommandStart() bool {
+	return e.Msg == "command started" || e.Msg == "command invoked"
+}
+
+// EntryFilter selects log entries. Zero fields match everything.
+type EntryFilter struct {
+	// Command matches cmd.name exactly
+	Command string
+	// Since drops entries older than this (and entries without a time)
+	Since time.Time
+	// MinLevel drops entries below this level (nil keeps all levels)
+	MinLevel slog.Leveler
+	// Identity matches ctx.identity or post.author, case-insensitively and
+	// by substring, so "swift-fox" finds "claude-swift-fox@smoke"
+	Identity string
+}
+
+// Match reports whether e passes every set condition of f.
+func (f EntryFilter) Match(e Entry) bool {
+	if f.Command != "" && e.Cmd.Name != f.Command {
+		return false
+	}
+	if !f.Since.IsZero() && (e.Time.IsZero() || e.Time.Before(f.Since)) {
+		return false
+	}
+	if f.MinLevel != nil && e.Level < f.MinLevel.Level() {
+		return false
+	}
+	if f.Identity != "" {
+		want := strings.ToLower(f.Identity)
+		if !strings.Contains(strings.ToLower(e.Ctx.Identity), want) &&
+			!strings.Contains(strings.ToLower(e.Post.Author), want) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestParseEntry(t *testing.T) {
+	line := `{"time":"2026-02-01T10:00:00.5Z","level":"WARN","msg":"command completed","ctx":{"identity":"claude-swift-fox@smoke","caller":"claude"},"cmd":{"name":"post","args":["hi"],"duration_ms":42},"post":{"id":"smk-abc123","author":"claude-swift-fox@smoke"}}`
+	e, ok := ParseEntry([]byte(line))
+	if !ok {
+		t.Fatal("ParseEntry() should accept a JSON entry")
+	}
+	if e.Level != slog.LevelWarn || e.Msg != "command completed" {
+		t.Errorf("level/msg = %v %q", e.Level, e.Msg)
+	}
+	if e.Cmd.Name != "post" || e.Cmd.DurationMS != 42 || len(e.Cmd.Args) != 1 {
+		t.Errorf("cmd = %+v", e.Cmd)
+	}
+	if e.Ctx.Caller != "claude" || e.Post.ID != "smk-abc123" {
+		t.Errorf("ctx/post = %+v %+v", e.Ctx, e.Post)
+	}
+	if !e.Time.Equal(time.Date(2026, 2, 1, 10, 0, 0, 5e8, time.UTC)) {
+		t.Errorf("time = %v", e.Time)
+	}
+
+	legacy, ok := ParseEntry([]byte(`{"msg":"command invoked","cmd":"suggest"}`))
+	if !ok || legacy.Cmd.Name != "suggest" || !legacy.IsCommandStart() {
+		t.Errorf("legacy entry = %+v, ok=%v", legacy, ok)
+	}
+
+	if _, ok := ParseEntry([]byte("not json")); ok {
+		t.Error("ParseEntry() should reject non-JSON lines")
+	}
+}
+
+func TestEntryFilter_Match(t *testing.T) {
+	now := time.Now()
+	e := Entry{
+		Time:  now.Add(-time.Hour),
+		Level: slog.LevelInfo,
+		Cmd:   EntryCmd{Name: "post"},
+		Ctx:   EntryContext{Identity: "claude-swift-fox@smoke"},
+	}
+	tests := []struct {
+		name   string
+		filter EntryFilter
+		want   bool
+	}{
+		{"empty filter", EntryFilter{}, true},
+		{"command match", EntryFilter{Command: "post"}, true},
+		{"command mismatch", EntryFilter{Command: "reply"}, false},
+		{"since inside", EntryFilter{Since: now.Add(-2 * time.Hour)}, true},
+		{"since outside", EntryFilter{Since: now.Add(-30 * time.Minute)}, false},
+		{"level at", EntryFilter{MinLevel: slog.LevelInfo}, true},
+		{"level above", EntryFilter{MinLevel: slog.LevelWarn}, false},
+		{"identity substring", EntryFilter{Identity: "Swift-Fox"}, true},
+		{"identity mismatch", EntryFilter{Identity: "ember"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(e); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if (EntryFilter{Since: now.Add(-time.Hour)}).Match(Entry{}) {
+		t.Error("entries without a time should not pass a Since filter")
+	}
+}
@@ -33,6 +33,15 @@ func Init(cfg Config) {
 // initLogger sets up the logger with lazy file creation
 // Actual file creation is deferred until first log write
 func initLogger(cfg Config) {
+	if len(cfg.RedactPatterns) > 0 {
+		SetRedactPatterns(cfg.RedactPatterns)
+	}
+
+	// OTLP export is independent of the file log level
+	if cfg.OTLPEndpoint != "" {
+		otlp = newOTLPExporter(cfg.OTLPEndpoint)
+	}
+
 	// If logging is disabled, use discard handler immediately
 	if cfg.IsDisabled() {
 		logger = slog.New(slog.NewJSONHandler(io.Discard, nil))
@@ -114,11 +123,14 @@ func Logger() *slog.Logger {
 	return logger
 }
 
-// Close flushes and closes the log file
+// Close waits for pending OTLP exports, then flushes and closes the log file
 // Safe to call multiple times - only the first call takes effect
 func Close() error {
 	var err error
 	closeOnce.Do(func() {
+		if otlp != nil {
+			otlp.wait()
+		}
 		if writer != nil {
 			err = writer.Close()
 			writer = nil
@@ -18,6 +18,7 @@ func resetGlobalState() {
 	warnedOnce = sync.Once{}
 	verboseHandler = nil
 	verboseEnabled = false
+	otlp = nil
 }
 
 func TestLoggerReturnsDiscardWhenNotInitialized(t *testing.T) {
@@ -0,0 +1,208 @@
+package logging
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OTLPEndpointEnv names the OTLP/HTTP collector that receives command spans.
+const OTLPEndpointEnv = "SMOKE_OTLP_ENDPOINT"
+
+// otlpTimeout bounds each export, so an unreachable collector delays exit
+// by at most this long.
+const otlpTimeout = 2 * time.Second
+
+// otlp is the span exporter, or nil when no endpoint is configured.
+var otlp *otlpExporter
+
+// otlpExporter sends one span per command to an OTLP/HTTP collector using
+// the JSON encoding, so no SDK is needed. Exports run in the background and
+// failures are only reported in verbose mode.
+type otlpExporter struct {
+	url     string
+	client  *http.Client
+	pending sync.WaitGroup
+}
+
+// newOTLPExporter returns an exporter for endpoint. A bare endpoint such as
+// "http://localhost:4318" gets the standard /v1/traces path; one without a
+// scheme is assumed to be plain http.
+func newOTLPExporter(endpoint string) *otlpExporter {
+	url := strings.TrimRight(endpoint, "/")
+	if !strings.Contains(url, "://") {
+		url = "http://" + url
+	}
+	if !strings.HasSuffix(url, "/v1/traces") {
+		url += "/v1/traces"
+	}
+	return &otlpExporter{url: url, client: &http.Client{Timeout: otlpTimeout}}
+}
+
+// exportCommand sends the span for a finished command. err is nil on success.
+func (e *otlpExporter) exportCommand(t *CommandTracker, end time.Time, err error) {
+	body, marshalErr := json.Marshal(t.otlpRequest(end, err))
+	if marshalErr != nil {
+		Verbose("otlp export skipped", slog.String("err.message", marshalErr.Error()))
+		return
+	}
+	e.pending.Add(1)
+	go func() {
+		defer e.pending.Done()
+		if err := e.post(body); err != nil {
+			Verbose("otlp export failed", slog.String("err.message", err.Error()))
+		}
+	}()
+}
+
+func (e *otlpExporter) post(body []byte) error {
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector returned %s", resp.Status)
+	}
+	return nil
+}
+
+// wait blocks until in-flight exports finish or time out.
+func (e *otlpExporter) wait() {
+	e.pending.Wait()
+}
+
+// OTLP/HTTP JSON payload, trimmed to the fields smoke sets.
+type (
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpResource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	}
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		Name              string          `json:"name"`
+		Kind              int             `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes"`
+		Status            otlpStatus      `json:"status"`
+	}
+	otlpStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+	otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	otlpValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+		BoolValue   *bool   `json:"boolValue,omitempty"`
+	}
+)
+
+// Span kinds and status codes from the OTLP trace proto.
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusOK         = 1
+	otlpStatusError      = 2
+)
+
+// otlpRequest builds the export request for t, using the same attribute
+// names as the log file (see the package documentation).
+func (t *CommandTracker) otlpRequest(end time.Time, err error) otlpRequest {
+	attrs := []slog.Attr{
+		slog.String("cmd.name", t.name),
+		slog.Int64("cmd.duration_ms", end.Sub(t.startTime).Milliseconds()),
+	}
+	attrs = append(attrs, t.ctx.Attrs())
+	attrs = append(attrs, t.metrics...)
+	status := otlpStatus{Code: otlpStatusOK}
+	if err != nil {
+		attrs = append(attrs,
+			slog.String("err.message", err.Error()),
+			slog.String("err.type", categorizeError(err)),
+		)
+		status = otlpStatus{Code: otlpStatusError, Message: err.Error()}
+	}
+
+	span := otlpSpan{
+		TraceID:           randomHex(16),
+		SpanID:            randomHex(8),
+		Name:              "smoke " + t.name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(t.startTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        otlpAttributes("", attrs),
+		Status:            status,
+	}
+	return otlpRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource: otlpResource{Attributes: otlpAttributes("", []slog.Attr{
+			slog.String("service.name", "smoke"),
+		})},
+		ScopeSpans: []otlpScopeSpans{{
+			Scope: otlpScope{Name: "github.com/dreamiurg/smoke/internal/logging"},
+			Spans: []otlpSpan{span},
+		}},
+	}}}
+}
+
+// otlpAttributes flattens slog attributes into OTLP key/values, joining
+// group names with dots ("post.id"). Empty strings are dropped.
+func otlpAttributes(prefix string, attrs []slog.Attr) []otlpAttribute {
+	var out []otlpAttribute
+	for _, attr := range attrs {
+		key := attr.Key
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		value := attr.Value.Resolve()
+		switch value.Kind() {
+		case slog.KindGroup:
+			out = append(out, otlpAttributes(key, value.Group())...)
+		case slog.KindInt64:
+			s := strconv.FormatInt(value.Int64(), 10)
+			out = append(out, otlpAttribute{Key: key, Value: otlpValue{IntValue: &s}})
+		case slog.KindBool:
+			b := value.Bool()
+			out = append(out, otlpAttribute{Key: key, Value: otlpValue{BoolValue: &b}})
+		default:
+			s := value.String()
+			if s == "" {
+				continue
+			}
+			out = append(out, otlpAttribute{Key: key, Value: otlpValue{StringValue: &s}})
+		}
+	}
+	return out
+}
+
+// randomHex returns n random bytes as lowercase hex.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
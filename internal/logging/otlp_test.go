@@ -0,0 +1,155 @@
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubCollector records the OTLP/HTTP requests it receives.
+type stubCollector struct {
+	mu       sync.Mutex
+	paths    []string
+	requests []otlpRequest
+}
+
+func newStubCollector(t *testing.T) (*stubCollector, *httptest.Server) {
+	t.Helper()
+	c := &stubCollector{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req otlpRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Errorf("collector got invalid JSON: %v", err)
+		}
+		c.mu.Lock()
+		c.paths = append(c.paths, r.URL.Path)
+		c.requests = append(c.requests, req)
+		c.mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+	return c, server
+}
+
+func useOTLP(t *testing.T, endpoint string) {
+	t.Helper()
+	otlp = newOTLPExporter(endpoint)
+	t.Cleanup(func() { otlp = nil })
+}
+
+func spanAttributes(span otlpSpan) map[string]string {
+	attrs := map[string]string{}
+	for _, a := range span.Attributes {
+		switch {
+		case a.Value.StringValue != nil:
+			attrs[a.Key] = *a.Value.StringValue
+		case a.Value.IntValue != nil:
+			attrs[a.Key] = *a.Value.IntValue
+		}
+	}
+	return attrs
+}
+
+func TestOTLP_CompleteExportsSpan(t *testing.T) {
+	collector, server := newStubCollector(t)
+	useOTLP(t, server.URL)
+
+	tracker := StartCommand("post", []string{"hello"})
+	tracker.AddPostMetrics("smk-abc123", "ember@smoke")
+	tracker.Complete()
+	otlp.wait()
+
+	if len(collector.requests) != 1 {
+		t.Fatalf("collector got %d requests, want 1", len(collector.requests))
+	}
+	if collector.paths[0] != "/v1/traces" {
+		t.Errorf("export path = %q, want /v1/traces", collector.paths[0])
+	}
+	span := collector.requests[0].ResourceSpans[0].ScopeSpans[0].Spans[0]
+	attrs := spanAttributes(span)
+	if attrs["cmd.name"] != "post" {
+		t.Errorf("cmd.name = %q, want post", attrs["cmd.name"])
+	}
+	if ms, err := strconv.Atoi(attrs["cmd.duration_ms"]); err != nil || ms < 0 {
+		t.Errorf("cmd.duration_ms = %q, want a non-negative integer", attrs["cmd.duration_ms"])
+	}
+	if attrs["post.id"] != "smk-abc123" {
+		t.Errorf("post.id = %q, want smk-abc123", attrs["post.id"])
+	}
+	if span.Status.Code != otlpStatusOK {
+		t.Errorf("status code = %d, want OK", span.Status.Code)
+	}
+	if len(span.TraceID) != 32 || len(span.SpanID) != 16 {
+		t.Errorf("trace/span IDs have wrong length: %q %q", span.TraceID, span.SpanID)
+	}
+}
+
+func TestOTLP_FailMarksError(t *testing.T) {
+	collector, server := newStubCollector(t)
+	useOTLP(t, server.URL+"/v1/traces")
+
+	StartCommand("reply", nil).Fail(errors.New("post not found"))
+	otlp.wait()
+
+	if len(collector.requests) != 1 {
+		t.Fatalf("collector got %d requests, want 1", len(collector.requests))
+	}
+	span := collector.requests[0].ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if span.Status.Code != otlpStatusError {
+		t.Errorf("status code = %d, want error", span.Status.Code)
+	}
+	if got := spanAttributes(span)["err.type"]; got != "not_found" {
+		t.Errorf("err.type = %q, want not_found", got)
+	}
+}
+
+func TestOTLP_UnreachableCollectorIsHarmless(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+	useOTLP(t, url)
+
+	start := time.Now()
+	StartCommand("feed", nil).Complete()
+	otlp.wait()
+	if elapsed := time.Since(start); elapsed > otlpTimeout+time.Second {
+		t.Errorf("export to a dead collector took %v", elapsed)
+	}
+}
+
+func TestNewOTLPExporter_URL(t *testing.T) {
+	tests := map[string]string{
+		"http://localhost:4318":          "http://localhost:4318/v1/traces",
+		"http://localhost:4318/":         "http://localhost:4318/v1/traces",
+		"https://otel.example/v1/traces": "https://otel.example/v1/traces",
+		"localhost:4318":                 "http://localhost:4318/v1/traces",
+	}
+	for endpoint, want := range tests {
+		if got := newOTLPExporter(endpoint).url; got != want {
+			t.Errorf("newOTLPExporter(%q).url = %q, want %q", endpoint, got, want)
+		}
+	}
+}
+
+func TestLoadConfig_OTLPEndpoint(t *testing.T) {
+	t.Setenv(OTLPEndpointEnv, " http://localhost:4318 ")
+	if got := LoadConfig("/tmp/smoke.log").OTLPEndpoint; got != "http://localhost:4318" {
+		t.Errorf("OTLPEndpoint = %q, want http://localhost:4318", got)
+	}
+}
+
+func TestInit_OTLPWithLoggingOff(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	Init(Config{Level: LevelOff, OTLPEndpoint: "http://localhost:4318"})
+	if otlp == nil {
+		t.Error("OTLP export should not depend on the file log level")
+	}
+}
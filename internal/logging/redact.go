@@ -0,0 +1,97 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Redacted replaces secrets found in logged command arguments.
+const Redacted = "[redacted]"
+
+// redactRule is one secret pattern. keep, when set, can spare a match that
+// looks like a secret but is not (e.g. a long word with no digits).
+type redactRule struct {
+	re   *regexp.Regexp
+	keep func(match string) bool
+}
+
+// defaultRedactRules catch common credential shapes. Post content is the
+// usual carrier, so the rules favor catching a token over sparing prose.
+var defaultRedactRules = []redactRule{
+	// AWS access key IDs
+	{re: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	// Authorization header values
+	{re: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/=-]+`)},
+	// Provider tokens: GitHub, Slack, OpenAI/Anthropic style sk- keys
+	{re: regexp.MustCompile(`\b(?:gh[pousr]|github_pat)_[A-Za-z0-9_]{20,}`)},
+	{re: regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{re: regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}`)},
+	// key=value and key: value assignments of secrets
+	{re: regexp.MustCompile(`(?i)\b(?:api[_-]?key|access[_-]?token|auth[_-]?token|secret|password|passwd|token)\s*[:=]\s*\S+`)},
+	// Long hex strings (hashes, keys)
+	{re: regexp.MustCompile(`\b[0-9a-fA-F]{32,}\b`)},
+	// Long base64/base64url blobs; must mix letters and digits so long
+	// hyphenated words survive
+	{re: regexp.MustCompile(`[A-Za-z0-9+_-]{40,}={0,2}`), keep: isWordLike},
+}
+
+var (
+	redactMu    sync.RWMutex
+	redactRules = defaultRedactRules
+)
+
+// SetRedactPatterns adds extra regular expressions (from log_redact_patterns
+// in config.yaml) to the built-in secret patterns. Invalid patterns are
+// skipped with a warning on stderr.
+func SetRedactPatterns(patterns []string) {
+	rules := append([]redactRule(nil), defaultRedactRules...)
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "warning: ignoring log redaction pattern %q: %v\n", pattern, err)
+			continue
+		}
+		rules = append(rules, redactRule{re: re})
+	}
+	redactMu.Lock()
+	redactRules = rules
+	redactMu.Unlock()
+}
+
+// Redact replaces every secret-looking part of s with Redacted.
+func Redact(s string) string {
+	redactMu.RLock()
+	rules := redactRules
+	redactMu.RUnlock()
+	for _, rule := range rules {
+		s = rule.re.ReplaceAllStringFunc(s, func(match string) string {
+			if rule.keep != nil && rule.keep(match) {
+				return match
+			}
+			return Redacted
+		})
+	}
+	return s
+}
+
+// redactArgs returns a redacted copy of command arguments for logging.
+func redactArgs(args []string) []string {
+	if args == nil {
+		return nil
+	}
+	out := make([]string, len(args))
+	for i, arg := range args {
+		out[i] = Redact(arg)
+	}
+	return out
+}
+
+// isWordLike reports whether s has no digits or no letters, which rules it
+// out as an encoded blob.
+func isWordLike(s string) bool {
+	return !strings.ContainsFunc(s, unicode.IsDigit) || !strings.ContainsFunc(s, unicode.IsLetter)
+}
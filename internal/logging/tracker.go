@@ -18,8 +18,10 @@ type CommandTracker struct {
 
 // StartCommand begins tracking a command execution.
 // Returns a tracker that must be completed with Complete() or Fail().
+// Secrets in args are redacted before anything is logged (see Redact).
 func StartCommand(name string, args []string) *CommandTracker {
 	ctx := CaptureContext()
+	args = redactArgs(args)
 	t := &CommandTracker{
 		ctx:       ctx,
 		name:      name,
@@ -102,6 +104,9 @@ func (t *CommandTracker) Complete() {
 	}
 
 	Logger().Info("command completed", attrs...)
+	if otlp != nil {
+		otlp.exportCommand(t, t.startTime.Add(duration), nil)
+	}
 	Verbose("command completed",
 		slog.String("cmd.name", t.name),
 		slog.Int64("cmd.duration_ms", duration.Milliseconds()),
@@ -134,6 +139,9 @@ func (t *CommandTracker) Fail(err error) {
 	}
 
 	Logger().Error("command failed", attrs...)
+	if otlp != nil {
+		otlp.exportCommand(t, t.startTime.Add(duration), err)
+	}
 	Verbose("command failed",
 		slog.String("cmd.name", t.name),
 		slog.String("err.message", err.Error()),
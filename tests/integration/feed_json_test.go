@@ -0,0 +1,121 @@
+package integration
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// postFromOutput extracts the smk- post ID printed by smoke post.
+func postFromOutput(stdout string) string {
+	for _, field := range strings.Fields(stdout) {
+		if strings.HasPrefix(field, "smk-") {
+			return field
+		}
+	}
+	return ""
+}
+
+func TestSmokeFeedJSON(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+
+	h.SetIdentity("ember@testrig")
+	stdout, _, err := h.Run("post", "json parent")
+	if err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+	postID := postFromOutput(stdout)
+
+	h.SetIdentity("witness@testrig")
+	if _, _, err := h.Run("reply", postID, "json reply"); err != nil {
+		t.Fatalf("smoke reply failed: %v", err)
+	}
+
+	stdout, _, err = h.Run("feed", "--json", "-n", "2")
+	if err != nil {
+		t.Fatalf("smoke feed --json failed: %v", err)
+	}
+	if strings.Contains(stdout, "\x1b[") {
+		t.Errorf("feed --json should not contain ANSI escapes: %q", stdout)
+	}
+
+	var posts []map[string]any
+	if err := json.Unmarshal([]byte(stdout), &posts); err != nil {
+		t.Fatalf("feed --json output is not valid JSON: %v\n%s", err, stdout)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("expected 2 posts with -n 2, got %d", len(posts))
+	}
+
+	// Both posts may share a timestamp, so look them up by content
+	byContent := make(map[string]map[string]any)
+	for _, post := range posts {
+		content, _ := post["content"].(string)
+		byContent[content] = post
+	}
+	reply, parent := byContent["json reply"], byContent["json parent"]
+	if reply == nil || parent == nil {
+		t.Fatalf("expected the post and its reply, got: %s", stdout)
+	}
+	for _, field := range []string{"id", "author", "project", "content", "created_at", "parent_id"} {
+		if _, ok := reply[field]; !ok {
+			t.Errorf("reply missing field %q: %v", field, reply)
+		}
+	}
+	if reply["parent_id"] != postID || parent["id"] != postID {
+		t.Errorf("reply should point at %s: reply=%v parent=%v", postID, reply, parent)
+	}
+}
+
+func TestSmokeFeedJSONNested(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+
+	h.SetIdentity("ember@testrig")
+	stdout, _, err := h.Run("post", "nested parent")
+	if err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+	postID := postFromOutput(stdout)
+
+	h.SetIdentity("witness@testrig")
+	if _, _, err := h.Run("reply", postID, "nested reply"); err != nil {
+		t.Fatalf("smoke reply failed: %v", err)
+	}
+
+	stdout, _, err = h.Run("feed", "--json", "--nested", "--since", "1h")
+	if err != nil {
+		t.Fatalf("smoke feed --json --nested failed: %v", err)
+	}
+
+	var threads []struct {
+		ID      string `json:"id"`
+		Replies []struct {
+			Content  string `json:"content"`
+			ParentID string `json:"parent_id"`
+		} `json:"replies"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &threads); err != nil {
+		t.Fatalf("feed --json --nested output is not valid JSON: %v\n%s", err, stdout)
+	}
+
+	for _, thread := range threads {
+		if thread.ID != postID {
+			continue
+		}
+		if len(thread.Replies) != 1 || thread.Replies[0].Content != "nested reply" {
+			t.Errorf("expected nested reply under parent, got %+v", thread.Replies)
+		}
+		return
+	}
+	t.Errorf("parent post %s not found in nested output: %s", postID, stdout)
+}
@@ -0,0 +1,78 @@
+package integration
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSmokeReplyToReply(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init", "--no-seed"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+
+	h.SetIdentity("ember@testrig")
+	stdout, _, err := h.Run("post", "root of the chain")
+	if err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+	chain := []string{postFromOutput(stdout)}
+
+	// Each reply targets the previous reply, not the root
+	for _, content := range []string{"first level", "second level", "third level"} {
+		stdout, _, err := h.Run("reply", chain[len(chain)-1], content)
+		if err != nil {
+			t.Fatalf("smoke reply failed: %v", err)
+		}
+		chain = append(chain, postFromOutput(stdout))
+	}
+
+	f, err := os.Open(filepath.Join(h.configDir, "feed.jsonl"))
+	if err != nil {
+		t.Fatalf("open feed: %v", err)
+	}
+	defer f.Close()
+	parents := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var post struct {
+			ID       string `json:"id"`
+			ParentID string `json:"parent_id"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &post); err != nil {
+			t.Fatalf("invalid feed line %q: %v", scanner.Text(), err)
+		}
+		parents[post.ID] = post.ParentID
+	}
+
+	if parent, ok := parents[chain[0]]; !ok || parent != "" {
+		t.Errorf("root %s should be stored without a parent, got %q", chain[0], parent)
+	}
+	for i := 1; i < len(chain); i++ {
+		if got := parents[chain[i]]; got != chain[i-1] {
+			t.Errorf("reply %d (%s) parent_id = %q, want %q", i, chain[i], got, chain[i-1])
+		}
+	}
+
+	stdout, _, err = h.Run("feed")
+	if err != nil {
+		t.Fatalf("smoke feed failed: %v", err)
+	}
+	indents := make(map[string]int)
+	for _, line := range strings.Split(stdout, "\n") {
+		for _, content := range []string{"first level", "second level", "third level"} {
+			if strings.Contains(line, content) {
+				indents[content] = strings.Index(line, "└─")
+			}
+		}
+	}
+	if !(indents["first level"] >= 0 && indents["first level"] < indents["second level"] && indents["second level"] < indents["third level"]) {
+		t.Errorf("each reply level should be indented further: %v\n%s", indents, stdout)
+	}
+}
@@ -0,0 +1,75 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSmokeReplyLast(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+
+	h.SetIdentity("ember@testrig")
+	stdout, _, err := h.Run("post", "ember speaks first")
+	if err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+	emberID := postFromOutput(stdout)
+
+	h.SetIdentity("witness@testrig")
+	stdout, _, err = h.Run("post", "witness speaks last")
+	if err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+	witnessID := postFromOutput(stdout)
+
+	// --last targets the most recently appended post
+	stdout, _, err = h.Run("reply", "--last", "replying to the latest")
+	if err != nil {
+		t.Fatalf("smoke reply --last failed: %v", err)
+	}
+	if !strings.Contains(stdout, witnessID) {
+		t.Errorf("--last should reply to %s: %s", witnessID, stdout)
+	}
+
+	// --last-from skips newer posts by other authors
+	h.SetIdentity("owl@testrig")
+	stdout, _, err = h.Run("reply", "--last-from", "ember", "replying to ember")
+	if err != nil {
+		t.Fatalf("smoke reply --last-from failed: %v", err)
+	}
+	if !strings.Contains(stdout, emberID) {
+		t.Errorf("--last-from ember should reply to %s: %s", emberID, stdout)
+	}
+}
+
+func TestSmokeReplyLastFromNoMatch(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+
+	h.SetIdentity("ember@testrig")
+	if _, _, err := h.Run("post", "hello"); err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+
+	_, stderr, err := h.Run("reply", "--last-from", "nobody-here", "anyone?")
+	if err == nil {
+		t.Fatal("smoke reply --last-from should fail when no author matches")
+	}
+	if !strings.Contains(stderr, "no posts from nobody-here") {
+		t.Errorf("expected a clear no-match error: %s", stderr)
+	}
+
+	// A post ID alongside --last is rejected as an extra argument
+	if _, _, err := h.Run("reply", "--last", "smk-abc123", "hi"); err == nil {
+		t.Error("smoke reply --last should not accept a post ID")
+	}
+}
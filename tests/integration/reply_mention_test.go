@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSmokePostReplyToLastMention(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+
+	h.SetIdentity("ember@testrig")
+	stdout, _, err := h.Run("post", "@witness can you double-check the migration?")
+	if err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+	mentionID := postFromOutput(stdout)
+
+	h.SetIdentity("owl@testrig")
+	if _, _, err := h.Run("post", "unrelated chatter"); err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+
+	// witness replies to the post that pinged it, not the newest one
+	h.SetIdentity("witness@testrig")
+	stdout, _, err = h.Run("post", "--reply-to-last-mention", "checked, looks good")
+	if err != nil {
+		t.Fatalf("smoke post --reply-to-last-mention failed: %v", err)
+	}
+	if !strings.Contains(stdout, "-> "+mentionID) {
+		t.Errorf("should reply to %s: %s", mentionID, stdout)
+	}
+
+	// Nobody mentions owl: --strict fails, otherwise it posts normally
+	h.SetIdentity("owl@testrig")
+	_, stderr, err := h.Run("post", "--reply-to-last-mention", "--strict", "anyone?")
+	if err == nil {
+		t.Fatal("--strict should fail when nothing mentions owl")
+	}
+	if !strings.Contains(stderr, "no posts mention") {
+		t.Errorf("expected a clear no-mention error: %s", stderr)
+	}
+	stdout, _, err = h.Run("post", "--reply-to-last-mention", "anyone?")
+	if err != nil {
+		t.Fatalf("smoke post --reply-to-last-mention failed: %v", err)
+	}
+	if !strings.HasPrefix(stdout, "Posted ") {
+		t.Errorf("without a mention it should post normally: %s", stdout)
+	}
+}
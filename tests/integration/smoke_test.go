@@ -2,10 +2,12 @@ package integration
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 )
@@ -102,6 +104,18 @@ func (h *TestHelper) Run(args ...string) (string, string, error) {
 		return "", "", nil
 	}
 
+	cmd := h.command(args...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	return stdout.String(), stderr.String(), err
+}
+
+// command builds a smoke invocation that runs in the test's HOME.
+func (h *TestHelper) command(args ...string) *exec.Cmd {
 	cmd := exec.Command(h.binPath, args...)
 	cmd.Dir = h.tmpDir
 
@@ -119,13 +133,7 @@ func (h *TestHelper) Run(args ...string) (string, string, error) {
 	// Explicitly unset CLAUDECODE to ensure tests use TERM_SESSION_ID for identity
 	env = append(env, "CLAUDECODE=")
 	cmd.Env = env
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	err := cmd.Run()
-	return stdout.String(), stderr.String(), err
+	return cmd
 }
 
 func TestSmokeInit(t *testing.T) {
@@ -269,6 +277,76 @@ func TestSmokeInitDryRunDoesNotSeed(t *testing.T) {
 	}
 }
 
+func TestSmokeInitNoSeed(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	stdout, _, err := h.Run("init", "--no-seed")
+	if err != nil {
+		t.Fatalf("smoke init --no-seed failed: %v", err)
+	}
+	if strings.Contains(stdout, "Seeded") {
+		t.Errorf("Expected no seeding with --no-seed: %s", stdout)
+	}
+
+	feedOut, _, err := h.Run("feed", "--json")
+	if err != nil {
+		t.Fatalf("smoke feed failed: %v", err)
+	}
+	if strings.Contains(feedOut, `"author"`) {
+		t.Errorf("Expected an empty feed after --no-seed, got: %s", feedOut)
+	}
+}
+
+func TestSmokeInitTemplate(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	stdout, _, err := h.Run("init", "--template", "team")
+	if err != nil {
+		t.Fatalf("smoke init --template team failed: %v", err)
+	}
+	if !strings.Contains(stdout, "Seeded 4 example posts") {
+		t.Errorf("Expected seeding message in output: %s", stdout)
+	}
+
+	feedOut, _, err := h.Run("feed", "-n", "10")
+	if err != nil {
+		t.Fatalf("smoke feed failed: %v", err)
+	}
+	for _, author := range []string{"forge", "anvil", "bellows", "tongs"} {
+		if !strings.Contains(feedOut, author) {
+			t.Errorf("Expected team author %q in feed output: %s", author, feedOut)
+		}
+	}
+	if strings.Contains(feedOut, "spark") {
+		t.Errorf("Expected no default seed posts with --template team: %s", feedOut)
+	}
+
+	// --force re-seeds with the new template and keeps agents' posts
+	h.SetIdentity("testuser@test")
+	if _, _, err := h.Run("post", "user post before reseed"); err != nil {
+		t.Fatalf("post failed: %v", err)
+	}
+	if _, _, err := h.Run("init", "--force", "--template", "demo"); err != nil {
+		t.Fatalf("smoke init --force --template demo failed: %v", err)
+	}
+	feedOut, _, err = h.Run("feed", "-n", "20")
+	if err != nil {
+		t.Fatalf("smoke feed failed: %v", err)
+	}
+	if !strings.Contains(feedOut, "blaze") || !strings.Contains(feedOut, "user post before reseed") {
+		t.Errorf("Expected demo seeds and the user post after --force: %s", feedOut)
+	}
+	if strings.Contains(feedOut, "forge") {
+		t.Errorf("Expected team seeds to be replaced after --force: %s", feedOut)
+	}
+
+	if _, _, err := h.Run("init", "--force", "--template", "nope"); err == nil {
+		t.Error("Expected an unknown template to fail")
+	}
+}
+
 func TestSmokePost(t *testing.T) {
 	h := NewTestHelper(t)
 	defer h.Cleanup()
@@ -858,3 +936,122 @@ func TestSmokeDoctorDryRunNoModify(t *testing.T) {
 		t.Error("doctor --fix --dry-run should not create feed.jsonl")
 	}
 }
+
+func TestSmokePostFromStdin(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+	h.SetIdentity("testuser@testrig")
+
+	cmd := h.command("post", "-")
+	cmd.Stdin = strings.NewReader("piped from another command\n")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("smoke post - failed: %v: %s", err, out)
+	}
+	if !strings.Contains(string(out), "Posted smk-") {
+		t.Errorf("post output missing confirmation: %s", out)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(h.configDir, "feed.jsonl"))
+	if !strings.Contains(string(content), `"piped from another command"`) {
+		t.Errorf("piped post not found (or newline kept) in feed file: %s", content)
+	}
+
+	// Empty stdin is rejected rather than posting nothing
+	cmd = h.command("post", "-")
+	cmd.Stdin = strings.NewReader("\n")
+	if out, err := cmd.CombinedOutput(); err == nil || !strings.Contains(string(out), "empty") {
+		t.Errorf("smoke post - with empty stdin should fail: %v: %s", err, out)
+	}
+}
+
+func TestSmokePostFromFile(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+	h.SetIdentity("testuser@testrig")
+
+	path := filepath.Join(h.tmpDir, "note.txt")
+	if err := os.WriteFile(path, []byte("composed in a temp file\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr, err := h.Run("post", "-f", path)
+	if err != nil {
+		t.Fatalf("smoke post -f failed: %v: %s", err, stderr)
+	}
+	if !strings.Contains(stdout, "Posted smk-") {
+		t.Errorf("post output missing confirmation: %s", stdout)
+	}
+
+	long := filepath.Join(h.tmpDir, "long.txt")
+	if err := os.WriteFile(long, []byte(strings.Repeat("a", 281)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, stderr, err := h.Run("post", "--file", long); err == nil || !strings.Contains(stderr, "280 characters") {
+		t.Errorf("smoke post --file should enforce the length limit: %v: %s", err, stderr)
+	}
+}
+
+func TestSmokePostQuiet(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+	h.SetIdentity("testuser@testrig")
+
+	stdout, stderr, err := h.Run("post", "--quiet", "scripted post")
+	if err != nil {
+		t.Fatalf("smoke post --quiet failed: %v: %s", err, stderr)
+	}
+	id := strings.TrimSuffix(stdout, "\n")
+	if !regexp.MustCompile(`^smk-[a-zA-Z0-9]{6}$`).MatchString(id) {
+		t.Fatalf("quiet stdout should be exactly the post ID, got %q", stdout)
+	}
+	content, _ := os.ReadFile(filepath.Join(h.configDir, "feed.jsonl"))
+	if !strings.Contains(string(content), `"id":"`+id+`"`) {
+		t.Errorf("printed ID %s not found in feed file: %s", id, content)
+	}
+
+	// Failures leave stdout empty and explain on stderr
+	stdout, stderr, err = h.Run("post", "-q", strings.Repeat("a", 281))
+	if err == nil {
+		t.Error("smoke post -q should fail for a message over the limit")
+	}
+	if stdout != "" {
+		t.Errorf("failed quiet post should print nothing to stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "280 characters") {
+		t.Errorf("expected the error on stderr: %q", stderr)
+	}
+}
+
+func TestSmokePostJSON(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+	h.SetIdentity("testuser@testrig")
+
+	stdout, stderr, err := h.Run("post", "--json", "scripted post")
+	if err != nil {
+		t.Fatalf("smoke post --json failed: %v: %s", err, stderr)
+	}
+	var result map[string]string
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("--json output is not JSON: %v: %q", err, stdout)
+	}
+	if len(result) != 1 || !strings.HasPrefix(result["id"], "smk-") {
+		t.Errorf(`--json output should be {"id": "smk-..."}, got %q`, stdout)
+	}
+}
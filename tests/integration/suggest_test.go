@@ -18,8 +18,8 @@ func TestSuggestShowsRecentPosts(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -37,8 +37,9 @@ func TestSuggestShowsRecentPosts(t *testing.T) {
 		t.Fatalf("failed to post third message: %v", err)
 	}
 
-	// Run suggest command
-	stdout, _, err := h.Run("suggest")
+	// Run suggest command; these tests post and suggest as the same identity,
+	// so the post cooldown is disabled throughout
+	stdout, _, err := h.Run("suggest", "--cooldown=0")
 	if err != nil {
 		t.Fatalf("smoke suggest failed: %v", err)
 	}
@@ -71,13 +72,13 @@ func TestSuggestShowsTemplates(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
 	// Run suggest without any posts
-	stdout, _, err := h.Run("suggest")
+	stdout, _, err := h.Run("suggest", "--cooldown=0")
 	if err != nil {
 		t.Fatalf("smoke suggest failed: %v", err)
 	}
@@ -105,13 +106,13 @@ func TestSuggestEmptyFeedShowsOnlyTemplates(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
 	// Run suggest with empty feed
-	stdout, stderr, err := h.Run("suggest")
+	stdout, stderr, err := h.Run("suggest", "--cooldown=0")
 
 	// Should not error
 	if err != nil {
@@ -146,8 +147,8 @@ func TestSuggestSinceFlag(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -159,7 +160,7 @@ func TestSuggestSinceFlag(t *testing.T) {
 	}
 
 	// Test with 1 hour window (should include recent post)
-	stdout1, _, err := h.Run("suggest", "--since", "1h")
+	stdout1, _, err := h.Run("suggest", "--cooldown=0", "--since", "1h")
 	if err != nil {
 		t.Fatalf("smoke suggest --since 1h failed: %v", err)
 	}
@@ -175,7 +176,7 @@ func TestSuggestSinceFlag(t *testing.T) {
 
 	// Test with very short window (1 minute) that would exclude older posts
 	// Note: This post was just created, so it should still be included
-	stdout2, _, err := h.Run("suggest", "--since", "1m")
+	stdout2, _, err := h.Run("suggest", "--cooldown=0", "--since", "1m")
 	if err != nil {
 		t.Fatalf("smoke suggest --since 1m failed: %v", err)
 	}
@@ -197,8 +198,8 @@ func TestSuggestJSONFlag(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -210,7 +211,7 @@ func TestSuggestJSONFlag(t *testing.T) {
 	}
 
 	// Run suggest with --json flag
-	stdout, stderr, err := h.Run("suggest", "--json")
+	stdout, stderr, err := h.Run("suggest", "--cooldown=0", "--json")
 	if err != nil {
 		t.Fatalf("smoke suggest --json failed: %v, stderr: %s", err, stderr)
 	}
@@ -254,14 +255,14 @@ func TestSuggestJSONEmptyFeed(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
 	// Run suggest with --json but with a time window that excludes all posts (1 second)
 	// This simulates an empty feed without deleting posts
-	stdout, stderr, err := h.Run("suggest", "--json", "--since", "1s")
+	stdout, stderr, err := h.Run("suggest", "--cooldown=0", "--json", "--since", "1s")
 	if err != nil {
 		t.Fatalf("smoke suggest --json failed: %v, stderr: %s", err, stderr)
 	}
@@ -306,8 +307,8 @@ func TestSuggestReplyHint(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -319,7 +320,7 @@ func TestSuggestReplyHint(t *testing.T) {
 	}
 
 	// Run suggest
-	stdout, _, err := h.Run("suggest")
+	stdout, _, err := h.Run("suggest", "--cooldown=0")
 	if err != nil {
 		t.Fatalf("smoke suggest failed: %v", err)
 	}
@@ -344,8 +345,8 @@ func TestSuggestPostIDFormat(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -357,7 +358,7 @@ func TestSuggestPostIDFormat(t *testing.T) {
 	}
 
 	// Run suggest
-	stdout, _, err := h.Run("suggest")
+	stdout, _, err := h.Run("suggest", "--cooldown=0")
 	if err != nil {
 		t.Fatalf("smoke suggest failed: %v", err)
 	}
@@ -379,8 +380,8 @@ func TestSuggestPostMetadata(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -392,7 +393,7 @@ func TestSuggestPostMetadata(t *testing.T) {
 	}
 
 	// Run suggest
-	stdout, _, err := h.Run("suggest")
+	stdout, _, err := h.Run("suggest", "--cooldown=0")
 	if err != nil {
 		t.Fatalf("smoke suggest failed: %v", err)
 	}
@@ -437,8 +438,8 @@ func TestSuggestMultiplePosts(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -455,7 +456,7 @@ func TestSuggestMultiplePosts(t *testing.T) {
 	}
 
 	// Run suggest
-	stdout, _, err := h.Run("suggest")
+	stdout, _, err := h.Run("suggest", "--cooldown=0")
 	if err != nil {
 		t.Fatalf("smoke suggest failed: %v", err)
 	}
@@ -485,8 +486,8 @@ func TestSuggestSinceFlagParseFormats(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -501,7 +502,7 @@ func TestSuggestSinceFlagParseFormats(t *testing.T) {
 	formats := []string{"30m", "1h", "2h", "6h", "24h"}
 
 	for _, format := range formats {
-		stdout, stderr, err := h.Run("suggest", "--since", format)
+		stdout, stderr, err := h.Run("suggest", "--cooldown=0", "--since", format)
 
 		if err != nil {
 			// Command should succeed even if format is not recognized
@@ -526,8 +527,8 @@ func TestSuggestJSONWithMultiplePosts(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -541,7 +542,7 @@ func TestSuggestJSONWithMultiplePosts(t *testing.T) {
 	}
 
 	// Run suggest with --json
-	stdout, stderr, err := h.Run("suggest", "--json")
+	stdout, stderr, err := h.Run("suggest", "--cooldown=0", "--json")
 	if err != nil {
 		t.Fatalf("smoke suggest --json failed: %v, stderr: %s", err, stderr)
 	}
@@ -604,8 +605,8 @@ func TestSuggestTextFormatReadability(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -617,7 +618,7 @@ func TestSuggestTextFormatReadability(t *testing.T) {
 	}
 
 	// Run suggest
-	stdout, _, err := h.Run("suggest")
+	stdout, _, err := h.Run("suggest", "--cooldown=0")
 	if err != nil {
 		t.Fatalf("smoke suggest failed: %v", err)
 	}
@@ -650,8 +651,8 @@ func TestSuggestTemplateVariety(t *testing.T) {
 		t.Fatalf("smoke init failed: %v", err)
 	}
 
-	// Set pressure to 4 (always fire) for deterministic test behavior
-	if err := h.SetPressure(4); err != nil {
+	// Set pressure to 10 (always fire) for deterministic test behavior
+	if err := h.SetPressure(10); err != nil {
 		t.Fatalf("failed to set pressure: %v", err)
 	}
 
@@ -660,7 +661,7 @@ func TestSuggestTemplateVariety(t *testing.T) {
 	outputs := []string{}
 
 	for i := 0; i < 3; i++ {
-		stdout, _, err := h.Run("suggest")
+		stdout, _, err := h.Run("suggest", "--cooldown=0")
 		if err != nil {
 			t.Fatalf("smoke suggest run %d failed: %v", i+1, err)
 		}
@@ -683,7 +684,7 @@ func TestSuggestWithoutInit(t *testing.T) {
 	// Don't call init - smoke is not initialized
 
 	// Run suggest
-	_, stderr, err := h.Run("suggest")
+	_, stderr, err := h.Run("suggest", "--cooldown=0")
 
 	// Command may fail or show helpful error
 	if err == nil {
@@ -695,3 +696,28 @@ func TestSuggestWithoutInit(t *testing.T) {
 		}
 	}
 }
+
+func TestSuggestRandSeedIsReproducible(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+	h.SetIdentity("seed@test")
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("init failed: %v", err)
+	}
+	t.Setenv("SMOKE_RAND_SEED", "7")
+
+	first, _, err := h.Run("suggest", "--decide", "--pressure", "2")
+	if err != nil {
+		t.Fatalf("suggest --decide failed: %v", err)
+	}
+	for range 3 {
+		again, _, err := h.Run("suggest", "--decide", "--pressure", "2")
+		if err != nil {
+			t.Fatalf("suggest --decide failed: %v", err)
+		}
+		if again != first {
+			t.Fatalf("expected the same decision with a fixed seed:\n%s\nvs\n%s", first, again)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package integration
+
+import (
+	"bufio"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// waitForLine reads lines until one contains want or the timeout expires.
+func waitForLine(t *testing.T, lines <-chan string, want string, timeout time.Duration) string {
+	t.Helper()
+	deadline := time.After(timeout)
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatalf("watch output closed before %q appeared", want)
+			}
+			if strings.Contains(line, want) {
+				return line
+			}
+		case <-deadline:
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+}
+
+func TestSmokeFeedWatch(t *testing.T) {
+	h := NewTestHelper(t)
+	defer h.Cleanup()
+
+	if _, _, err := h.Run("init"); err != nil {
+		t.Fatalf("smoke init failed: %v", err)
+	}
+
+	h.SetIdentity("ember@testrig")
+	if _, _, err := h.Run("post", "already here"); err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+
+	watcher := h.command("feed", "--watch", "--interval", "50ms")
+	stdout, err := watcher.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Process.Kill() }()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	first := waitForLine(t, lines, "already here", 5*time.Second)
+	if !strings.HasPrefix(first, "smk-") {
+		t.Errorf("watch output should be oneline format: %q", first)
+	}
+
+	// Post from a separate process while the watcher polls
+	h.SetIdentity("spark@testrig")
+	if _, _, err := h.Run("post", "fresh from another process"); err != nil {
+		t.Fatalf("smoke post failed: %v", err)
+	}
+	waitForLine(t, lines, "fresh from another process", 5*time.Second)
+
+	if err := watcher.Process.Signal(syscall.SIGINT); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing else is printed: no duplicates of the initial post
+	var rest []string
+	for line := range lines {
+		rest = append(rest, line)
+	}
+	for _, line := range rest {
+		if strings.Contains(line, "already here") || strings.Contains(line, "fresh from another process") {
+			t.Errorf("post printed twice: %q", line)
+		}
+	}
+
+	if err := watcher.Wait(); err != nil {
+		t.Errorf("watcher should exit cleanly on SIGINT: %v", err)
+	}
+}